@@ -0,0 +1,176 @@
+package api
+
+import (
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	digestCheckInterval = time.Minute
+
+	// maxHeldNotifications caps the notifications kept for each channel's digest
+	maxHeldNotifications = 100
+)
+
+// run sends the quiet hours digests and the daily summary when they're due
+func (m *notificationManager) run() {
+	t := time.NewTicker(digestCheckInterval)
+	defer t.Stop()
+	for range t.C {
+		m.tick()
+	}
+}
+
+func (m *notificationManager) tick() {
+	prefs := m.preferences()
+	now := m.now()
+	if !prefs.Quiet(now) {
+		m.flushDigests()
+	}
+	if m.summaryDue(prefs, now) {
+		if err := m.sendSummary(now); err != nil {
+			log.Errorf("Daily summary failed: %s", err.Error())
+		}
+	}
+}
+
+func (m *notificationManager) hold(channel string, n interface{}) {
+	if head, _ := notifications.Describe(n); head == "" {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if len(m.held[channel]) >= maxHeldNotifications {
+		m.dropped[channel]++
+		return
+	}
+	m.held[channel] = append(m.held[channel], n)
+}
+
+// flushDigests sends each channel the notifications held for it as a single digest
+func (m *notificationManager) flushDigests() {
+	m.lock.Lock()
+	held, dropped := m.held, m.dropped
+	if len(held) == 0 {
+		m.lock.Unlock()
+		return
+	}
+	m.held = make(map[string][]interface{})
+	m.dropped = make(map[string]int)
+	m.lock.Unlock()
+
+	for _, notifier := range m.getNotifiers() {
+		c := notifier.channel()
+		if len(held[c]) == 0 {
+			continue
+		}
+		digest := notifications.DigestNotification{Notifications: held[c], Dropped: dropped[c]}
+		if err := notifier.notify(digest); err != nil {
+			log.Errorf("Notification digest failed: %s", err.Error())
+		}
+	}
+}
+
+// summaryDue returns whether the daily summary should be sent now, which is once in the chosen hour
+func (m *notificationManager) summaryDue(prefs *repo.NotificationPreferences, now time.Time) bool {
+	if prefs == nil || !prefs.DailySummary {
+		return false
+	}
+	loc, err := prefs.Location()
+	if err != nil {
+		return false
+	}
+	local := now.In(loc)
+	if local.Hour() != prefs.DailySummaryHour {
+		return false
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	y, mo, d := local.Date()
+	ly, lmo, ld := m.lastSummary.In(loc).Date()
+	if y == ly && mo == lmo && d == ld {
+		return false
+	}
+	m.lastSummary = now
+	return true
+}
+
+// sendSummary emails the orders, disputes and escrows waiting on the user, if there are any
+func (m *notificationManager) sendSummary(now time.Time) error {
+	settings, err := m.node.Datastore.Settings().Get()
+	if err != nil {
+		return err
+	}
+	if settings.SMTPSettings == nil || !settings.SMTPSettings.Notifications {
+		return nil
+	}
+	summary, err := pendingActions(m.node.Datastore, now)
+	if err != nil {
+		return err
+	}
+	notifier := smtpNotifier{settings: settings.SMTPSettings, node: m.node}
+	return notifier.notify(summary)
+}
+
+/* pendingActions collects the sales waiting to be confirmed, the open disputes on sales, purchases
+   and moderated cases, and the fulfilled moderated sales whose escrow timeout has passed so the
+   vendor can release the funds. */
+func pendingActions(db repo.Datastore, now time.Time) (notifications.SummaryNotification, error) {
+	var summary notifications.SummaryNotification
+	pending, _, err := db.Sales().GetAll([]pb.OrderState{pb.OrderState_PENDING}, "", true, false, -1, []string{})
+	if err != nil {
+		return summary, err
+	}
+	for _, s := range pending {
+		summary.UnconfirmedOrders = append(summary.UnconfirmedOrders, describeOrder(s.Title, s.OrderId))
+	}
+
+	disputed := []pb.OrderState{pb.OrderState_DISPUTED}
+	sales, _, err := db.Sales().GetAll(disputed, "", true, false, -1, []string{})
+	if err != nil {
+		return summary, err
+	}
+	for _, s := range sales {
+		summary.OpenDisputes = append(summary.OpenDisputes, describeOrder(s.Title, s.OrderId))
+	}
+	purchases, _, err := db.Purchases().GetAll(disputed, "", true, false, -1, []string{})
+	if err != nil {
+		return summary, err
+	}
+	for _, p := range purchases {
+		summary.OpenDisputes = append(summary.OpenDisputes, describeOrder(p.Title, p.OrderId))
+	}
+	cases, _, err := db.Cases().GetAll(disputed, "", true, false, -1, []string{})
+	if err != nil {
+		return summary, err
+	}
+	for _, c := range cases {
+		summary.OpenDisputes = append(summary.OpenDisputes, describeOrder(c.Title, c.CaseId))
+	}
+
+	fulfilled, _, err := db.Sales().GetAll([]pb.OrderState{pb.OrderState_FULFILLED}, "", true, false, -1, []string{})
+	if err != nil {
+		return summary, err
+	}
+	for _, s := range fulfilled {
+		if !s.Moderated {
+			continue
+		}
+		contract, _, _, _, _, err := db.Sales().GetByOrderId(s.OrderId)
+		if err != nil || len(contract.VendorListings) == 0 || contract.VendorListings[0].Metadata == nil {
+			continue
+		}
+		timeout := time.Duration(contract.VendorListings[0].Metadata.EscrowTimeoutHours) * time.Hour
+		if now.After(s.Timestamp.Add(timeout)) {
+			summary.ReleasableEscrows = append(summary.ReleasableEscrows, describeOrder(s.Title, s.OrderId))
+		}
+	}
+	return summary, nil
+}
+
+func describeOrder(title, orderID string) string {
+	return title + " (" + orderID + ")"
+}
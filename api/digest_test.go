@@ -0,0 +1,68 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestSummaryDue(t *testing.T) {
+	m := newNotificationManager(nil)
+	prefs := &repo.NotificationPreferences{
+		DailySummary:     true,
+		DailySummaryHour: 8,
+		QuietHours:       &repo.QuietHours{Start: "22:00", End: "07:00", Timezone: "UTC"},
+	}
+	morning := time.Date(2018, 3, 1, 8, 5, 0, 0, time.UTC)
+	if m.summaryDue(prefs, morning.Add(-time.Hour)) {
+		t.Error("Summary is not due before its hour")
+	}
+	if !m.summaryDue(prefs, morning) {
+		t.Error("Summary should be due in its hour")
+	}
+	if m.summaryDue(prefs, morning.Add(time.Minute)) {
+		t.Error("Summary should only be sent once a day")
+	}
+	if !m.summaryDue(prefs, morning.Add(24*time.Hour)) {
+		t.Error("Summary should be due the next day")
+	}
+	prefs.DailySummary = false
+	if m.summaryDue(prefs, morning.Add(48*time.Hour)) {
+		t.Error("Summary is not due when turned off")
+	}
+}
+
+func TestHoldNotifications(t *testing.T) {
+	m := newNotificationManager(nil)
+	m.hold(repo.NotificationChannelEmail, notifications.ChatTyping{})
+	if len(m.held) != 0 {
+		t.Error("Notifications without a description should not be held")
+	}
+	for i := 0; i < maxHeldNotifications+3; i++ {
+		m.hold(repo.NotificationChannelEmail, notifications.PaymentNotification{OrderId: "QmOrder"})
+	}
+	if len(m.held[repo.NotificationChannelEmail]) != maxHeldNotifications || m.dropped[repo.NotificationChannelEmail] != 3 {
+		t.Error("Held notifications were not capped")
+	}
+}
+
+func TestNotificationEvent(t *testing.T) {
+	if notificationEvent(notifications.ChatTyping{}) != "typing" || notificationEvent(notifications.PaymentNotification{}) != "payment" {
+		t.Error("Wrong notification event")
+	}
+}
+
+func TestAllowedInUI(t *testing.T) {
+	prefs := &repo.NotificationPreferences{Events: map[string][]string{"*": {repo.NotificationChannelEmail}}}
+	if allowedInUI(prefs, notifications.PaymentNotification{}) {
+		t.Error("Notification sent to the UI against the preferences")
+	}
+	if !allowedInUI(prefs, []byte(`{"id": "QmRequest"}`)) || !allowedInUI(prefs, notifications.StatusNotification{Status: "publishing"}) {
+		t.Error("Broadcasts which are not notifications must always reach the UI")
+	}
+	if !allowedInUI(nil, notifications.PaymentNotification{}) {
+		t.Error("Notifications should reach the UI without preferences")
+	}
+}
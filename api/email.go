@@ -0,0 +1,409 @@
+package api
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	smtpTimeout = time.Second * 30
+
+	// Email clients can't reach the local gateway so images are linked through a public one
+	emailImageGateway = "https://gateway.ob1.io/ob/images/"
+
+	defaultEmailSubject = "[OpenBazaar] {{.Title}}"
+	defaultEmailText    = `{{.Body}}
+{{if .Items}}
+{{T "Items"}}:
+{{range .Items}}- {{.Title}} ({{T "Quantity"}}: {{.Quantity}})
+{{end}}{{end}}{{if .Total}}
+{{T "Total"}}: {{.Total}}
+{{end}}`
+	defaultEmailHTML = `<html><body>
+{{if .Thumbnail}}<img src="{{.Thumbnail}}" alt="" width="160"><br>{{end}}
+<h2>{{.Title}}</h2>
+<p>{{lines .Body}}</p>
+{{if .Items}}<h3>{{T "Items"}}</h3>
+<table>
+{{range .Items}}<tr><td>{{if .Thumbnail}}<img src="{{.Thumbnail}}" alt="" width="48">{{end}}</td><td>{{.Title}}</td><td>{{T "Quantity"}}: {{.Quantity}}</td></tr>
+{{end}}</table>{{end}}
+{{if .Total}}<p><strong>{{T "Total"}}:</strong> {{.Total}}</p>{{end}}
+</body></html>`
+)
+
+// smtpNotifier emails notifications in the profile language
+type smtpNotifier struct {
+	settings *repo.SMTPSettings
+	node     *core.OpenBazaarNode // for the profile language and order details, may be nil
+}
+
+// emailData is what email templates are executed with
+type emailData struct {
+	Event        string
+	Title        string
+	Body         string
+	Language     string
+	Notification interface{}
+	Thumbnail    string // URL of the notification's thumbnail
+	Items        []emailItem
+	Total        string // the order total in the local currency
+}
+
+type emailItem struct {
+	Title     string
+	Quantity  uint32
+	Thumbnail string
+}
+
+func (notifier *smtpNotifier) channel() string {
+	return repo.NotificationChannelEmail
+}
+
+func (notifier *smtpNotifier) notify(n interface{}) error {
+	language := notifier.language()
+	head, body := notifications.DescribeIn(language, n)
+	if head == "" || body == "" {
+		return nil
+	}
+	data := emailData{
+		Event:        notifications.EventType(n),
+		Title:        head,
+		Body:         body,
+		Language:     language,
+		Notification: n,
+	}
+	if thumb, ok := notificationField(n, "Thumbnail").(notifications.Thumbnail); ok && thumb.Small != "" {
+		data.Thumbnail = emailImageGateway + thumb.Small
+	}
+	if orderID, ok := notificationField(n, "OrderId").(string); ok && orderID != "" && notifier.node != nil {
+		notifier.addOrderDetails(&data, orderID)
+	}
+	msg, err := composeEmail(notifier.settings, data)
+	if err != nil {
+		return err
+	}
+	return sendEmail(notifier.settings, msg)
+}
+
+func (notifier *smtpNotifier) language() string {
+	if notifier.node == nil {
+		return ""
+	}
+	profile, err := notifier.node.GetProfile()
+	if err != nil {
+		return ""
+	}
+	return profile.Language
+}
+
+// addOrderDetails adds the items and total of a sale or purchase to an email
+func (notifier *smtpNotifier) addOrderDetails(data *emailData, orderID string) {
+	db := notifier.node.Datastore
+	contract, _, _, _, _, err := db.Sales().GetByOrderId(orderID)
+	if err != nil {
+		contract, _, _, _, _, err = db.Purchases().GetByOrderId(orderID)
+		if err != nil {
+			return
+		}
+	}
+	if contract.BuyerOrder == nil {
+		return
+	}
+	for _, item := range contract.BuyerOrder.Items {
+		listing, err := core.ParseContractForListing(item.ListingHash, contract)
+		if err != nil || listing.Item == nil {
+			continue
+		}
+		i := emailItem{Title: listing.Item.Title, Quantity: item.Quantity}
+		if len(listing.Item.Images) > 0 && listing.Item.Images[0].Small != "" {
+			i.Thumbnail = emailImageGateway + listing.Item.Images[0].Small
+		}
+		data.Items = append(data.Items, i)
+	}
+	if payment := contract.BuyerOrder.Payment; payment != nil && payment.Amount > 0 {
+		data.Total = notifier.formatAmount(payment)
+	}
+}
+
+func (notifier *smtpNotifier) formatAmount(payment *pb.Order_Payment) string {
+	coin := payment.Coin
+	if coin == "" {
+		coin = notifier.node.Wallet.CurrencyCode()
+	}
+	value, currency, err := notifier.node.LocalValue(coin, payment.Amount)
+	if err != nil {
+		return fmt.Sprintf("%.8f %s", float64(payment.Amount)/100000000, strings.ToUpper(coin))
+	}
+	return fmt.Sprintf("%.2f %s", value, currency)
+}
+
+// notificationField returns the named field of a notification struct, or nil if it has none
+func notificationField(n interface{}, name string) interface{} {
+	v := reflect.ValueOf(n)
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	f := v.FieldByName(name)
+	if !f.IsValid() || !f.CanInterface() {
+		return nil
+	}
+	return f.Interface()
+}
+
+// emailTemplate returns the templates for the event type with the defaults filled in
+func emailTemplate(conf *repo.SMTPSettings, event string) repo.EmailTemplate {
+	t, ok := conf.Templates[event]
+	if !ok {
+		t = conf.Templates["*"]
+	}
+	if t.Subject == "" {
+		t.Subject = defaultEmailSubject
+	}
+	if t.Text == "" {
+		t.Text = defaultEmailText
+	}
+	if t.HTML == "" {
+		t.HTML = defaultEmailHTML
+	}
+	return t
+}
+
+func emailFuncs(language string) map[string]interface{} {
+	return map[string]interface{}{
+		"T": func(s string) string {
+			return notifications.Translate(language, s)
+		},
+		"lines": func(s string) htmltemplate.HTML {
+			return htmltemplate.HTML(strings.Replace(htmltemplate.HTMLEscapeString(s), "\n", "<br>", -1))
+		},
+	}
+}
+
+func parseEmailTemplate(t repo.EmailTemplate) (*template.Template, *template.Template, *htmltemplate.Template, error) {
+	funcs := emailFuncs("")
+	subject, err := template.New("subject").Funcs(funcs).Parse(t.Subject)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	text, err := template.New("text").Funcs(funcs).Parse(t.Text)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	html, err := htmltemplate.New("html").Funcs(funcs).Parse(t.HTML)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return subject, text, html, nil
+}
+
+// composeEmail renders a notification as a multipart email with plain text and HTML parts
+func composeEmail(conf *repo.SMTPSettings, data emailData) ([]byte, error) {
+	subjectTmpl, textTmpl, htmlTmpl, err := parseEmailTemplate(emailTemplate(conf, data.Event))
+	if err != nil {
+		return nil, err
+	}
+	funcs := emailFuncs(data.Language)
+	var subject, text, html bytes.Buffer
+	if err := subjectTmpl.Funcs(funcs).Execute(&subject, data); err != nil {
+		return nil, err
+	}
+	if err := textTmpl.Funcs(funcs).Execute(&text, data); err != nil {
+		return nil, err
+	}
+	if err := htmlTmpl.Funcs(funcs).Execute(&html, data); err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     []byte
+	}{
+		{"text/plain; charset=UTF-8", text.Bytes()},
+		{"text/html; charset=UTF-8", html.Bytes()},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write(part.content); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	headers := strings.Join([]string{
+		"From: " + conf.SenderEmail,
+		"To: " + conf.RecipientEmail,
+		"Subject: " + mime.QEncoding.Encode("UTF-8", strings.TrimSpace(subject.String())),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/alternative; boundary=" + mw.Boundary(),
+	}, "\r\n")
+	return append([]byte(headers+"\r\n\r\n"), body.Bytes()...), nil
+}
+
+// Send email over the configured connection security using the configured authentication
+func sendEmail(conf *repo.SMTPSettings, body []byte) error {
+	host := conf.ServerAddress
+	if h, _, err := net.SplitHostPort(conf.ServerAddress); err == nil {
+		host = h
+	}
+	tlsConfig := &tls.Config{ServerName: host}
+	dialer := &net.Dialer{Timeout: smtpTimeout}
+
+	var conn net.Conn
+	var err error
+	if conf.Security == repo.SMTPSecurityTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", conf.ServerAddress, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", conf.ServerAddress)
+	}
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(smtpTimeout))
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if conf.Security != repo.SMTPSecurityTLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(tlsConfig); err != nil {
+				return err
+			}
+		} else if conf.Security == repo.SMTPSecuritySTARTTLS {
+			return errors.New("SMTP server does not support STARTTLS")
+		}
+	}
+	if auth := smtpAuth(conf, host); auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("SMTP server does not support authentication")
+		}
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(conf.SenderEmail); err != nil {
+		return err
+	}
+	if err := c.Rcpt(conf.RecipientEmail); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(body); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func smtpAuth(conf *repo.SMTPSettings, host string) smtp.Auth {
+	switch conf.AuthMethod {
+	case repo.SMTPAuthNone:
+		return nil
+	case repo.SMTPAuthLogin:
+		return &loginAuth{conf.Username, conf.Password, host}
+	case repo.SMTPAuthCRAMMD5:
+		return smtp.CRAMMD5Auth(conf.Username, conf.Password)
+	default:
+		return smtp.PlainAuth("", conf.Username, conf.Password, host)
+	}
+}
+
+/* loginAuth implements the LOGIN authentication mechanism used by servers such as Exchange which
+   don't offer PLAIN. Like PLAIN it sends the password in the clear so it's refused on an
+   unencrypted connection to anywhere but localhost. */
+type loginAuth struct {
+	username, password, host string
+}
+
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if !server.TLS && server.Name != "localhost" && server.Name != "127.0.0.1" && server.Name != "::1" {
+		return "", nil, errors.New("unencrypted connection")
+	}
+	if server.Name != a.host {
+		return "", nil, errors.New("wrong host name")
+	}
+	return "LOGIN", nil, nil
+}
+
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	prompt := strings.ToLower(strings.TrimSpace(string(fromServer)))
+	switch {
+	case strings.HasPrefix(prompt, "username"):
+		return []byte(a.username), nil
+	case strings.HasPrefix(prompt, "password"):
+		return []byte(a.password), nil
+	}
+	return nil, fmt.Errorf("unexpected LOGIN prompt %q", fromServer)
+}
+
+func validateSMTPSettings(s repo.SettingsData) error {
+	if s.SMTPSettings == nil {
+		return nil
+	}
+	return validateSMTP(s.SMTPSettings)
+}
+
+func validateSMTP(conf *repo.SMTPSettings) error {
+	switch conf.Security {
+	case repo.SMTPSecurityOpportunistic, repo.SMTPSecuritySTARTTLS, repo.SMTPSecurityTLS:
+	default:
+		return fmt.Errorf("SMTP security must be empty, %s or %s", repo.SMTPSecuritySTARTTLS, repo.SMTPSecurityTLS)
+	}
+	switch conf.AuthMethod {
+	case "", repo.SMTPAuthPlain, repo.SMTPAuthLogin, repo.SMTPAuthCRAMMD5, repo.SMTPAuthNone:
+	default:
+		return fmt.Errorf("SMTP authentication must be one of %s, %s, %s or %s", repo.SMTPAuthPlain, repo.SMTPAuthLogin, repo.SMTPAuthCRAMMD5, repo.SMTPAuthNone)
+	}
+	credentials := conf.AuthMethod == repo.SMTPAuthNone || (conf.Username != "" && conf.Password != "")
+	if conf.Notifications && (!credentials || conf.RecipientEmail == "" || conf.SenderEmail == "" || conf.ServerAddress == "") {
+		return errors.New("SMTP fields must be set if notifications are turned on")
+	}
+	for event, t := range conf.Templates {
+		if _, _, _, err := parseEmailTemplate(t); err != nil {
+			return fmt.Errorf("Invalid email template for %s: %s", event, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package api
+
+import (
+	"bufio"
+	"encoding/base64"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// readEmail returns the decoded subject and the plain text and HTML parts of an email
+func readEmail(t *testing.T, msg []byte) (string, string, string) {
+	m, err := mail.ReadMessage(strings.NewReader(string(msg)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatal("Email is not multipart/alternative")
+	}
+	parts := make(map[string]string)
+	mr := multipart.NewReader(m.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err != nil {
+			break
+		}
+		b, _ := ioutil.ReadAll(p)
+		ct, _, _ := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		parts[ct] = strings.Replace(string(b), "\r\n", "\n", -1)
+	}
+	return subject, parts["text/plain"], parts["text/html"]
+}
+
+func TestComposeEmail(t *testing.T) {
+	conf := &repo.SMTPSettings{SenderEmail: "node@example.com", RecipientEmail: "me@example.com"}
+	n := notifications.OrderNotification{Title: "Shoes", OrderId: "QmOrder", BuyerHandle: "@buyer"}
+	head, body := notifications.DescribeIn("es", n)
+	data := emailData{
+		Event:        "order",
+		Title:        head,
+		Body:         body,
+		Language:     "es",
+		Notification: n,
+		Thumbnail:    emailImageGateway + "QmThumb",
+		Items:        []emailItem{{Title: "Shoes <red>", Quantity: 2}},
+		Total:        "25.00 EUR",
+	}
+	msg, err := composeEmail(conf, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, text, html := readEmail(t, msg)
+	if subject != "[OpenBazaar] Pedido recibido" {
+		t.Errorf("Wrong subject %q", subject)
+	}
+	if !strings.Contains(text, "Artículos:\n- Shoes <red> (Cantidad: 2)") || !strings.Contains(text, "Total: 25.00 EUR") {
+		t.Errorf("Wrong text part %q", text)
+	}
+	if !strings.Contains(html, `<img src="https://gateway.ob1.io/ob/images/QmThumb"`) || !strings.Contains(html, "Shoes &lt;red&gt;") || !strings.Contains(html, "<br>") {
+		t.Errorf("Wrong HTML part %q", html)
+	}
+
+	conf.Templates = map[string]repo.EmailTemplate{
+		"order": {Subject: "New order: {{.Notification.Title}}"},
+		"*":     {Subject: "Something happened"},
+	}
+	msg, err = composeEmail(conf, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, text, _ = readEmail(t, msg)
+	if subject != "New order: Shoes" || !strings.Contains(text, "Total: 25.00 EUR") {
+		t.Error("Custom template was not applied with the default body")
+	}
+	data.Event = "payment"
+	msg, err = composeEmail(conf, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject, _, _ = readEmail(t, msg); subject != "Something happened" {
+		t.Error("Default custom template was not applied")
+	}
+}
+
+// serveSMTP accepts one session from an SMTP client, returning the AUTH command and the message
+func serveSMTP(t *testing.T, l net.Listener, result chan []string) {
+	conn, err := l.Accept()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	var auth, data string
+	tp.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			break
+		}
+		cmd := strings.ToUpper(strings.Fields(line)[0])
+		switch {
+		case cmd == "EHLO":
+			tp.PrintfLine("250-localhost")
+			tp.PrintfLine("250 AUTH PLAIN LOGIN")
+		case strings.HasPrefix(strings.ToUpper(line), "AUTH LOGIN"):
+			tp.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte("Username:")))
+			user, _ := tp.ReadLine()
+			tp.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte("Password:")))
+			pass, _ := tp.ReadLine()
+			u, _ := base64.StdEncoding.DecodeString(user)
+			p, _ := base64.StdEncoding.DecodeString(pass)
+			auth = "LOGIN " + string(u) + ":" + string(p)
+			tp.PrintfLine("235 Authenticated")
+		case cmd == "AUTH":
+			auth = line
+			tp.PrintfLine("235 Authenticated")
+		case cmd == "DATA":
+			tp.PrintfLine("354 Go ahead")
+			b, _ := ioutil.ReadAll(bufio.NewReader(tp.DotReader()))
+			data = string(b)
+			tp.PrintfLine("250 Queued")
+		case cmd == "QUIT":
+			tp.PrintfLine("221 Bye")
+			result <- []string{auth, data}
+			return
+		default:
+			tp.PrintfLine("250 OK")
+		}
+	}
+	result <- []string{auth, data}
+}
+
+func TestSendEmail(t *testing.T) {
+	for _, method := range []string{repo.SMTPAuthLogin, repo.SMTPAuthPlain, repo.SMTPAuthNone} {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		result := make(chan []string, 1)
+		go serveSMTP(t, l, result)
+
+		conf := &repo.SMTPSettings{
+			ServerAddress:  l.Addr().String(),
+			Username:       "user",
+			Password:       "hunter2",
+			SenderEmail:    "node@example.com",
+			RecipientEmail: "me@example.com",
+			AuthMethod:     method,
+		}
+		err = sendEmail(conf, []byte("Subject: Test\r\n\r\nHello\r\n"))
+		l.Close()
+		if err != nil {
+			t.Fatalf("%s: %s", method, err)
+		}
+		r := <-result
+		switch method {
+		case repo.SMTPAuthLogin:
+			if r[0] != "LOGIN user:hunter2" {
+				t.Errorf("Wrong LOGIN authentication %q", r[0])
+			}
+		case repo.SMTPAuthPlain:
+			if r[0] != "AUTH PLAIN "+base64.StdEncoding.EncodeToString([]byte("\x00user\x00hunter2")) {
+				t.Errorf("Wrong PLAIN authentication %q", r[0])
+			}
+		case repo.SMTPAuthNone:
+			if r[0] != "" {
+				t.Error("Authenticated without an authentication method")
+			}
+		}
+		if !strings.Contains(r[1], "Hello") {
+			t.Errorf("%s: message was not delivered", method)
+		}
+	}
+}
+
+func TestSendEmailRequiresSTARTTLS(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	result := make(chan []string, 1)
+	go serveSMTP(t, l, result)
+
+	conf := &repo.SMTPSettings{ServerAddress: l.Addr().String(), Security: repo.SMTPSecuritySTARTTLS, AuthMethod: repo.SMTPAuthNone}
+	if err := sendEmail(conf, []byte("Hello")); err == nil {
+		t.Error("Expected an error from a server without STARTTLS")
+	}
+}
+
+func TestValidateSMTP(t *testing.T) {
+	valid := []repo.SMTPSettings{
+		{},
+		{Notifications: true, ServerAddress: "smtp.example.com:465", Username: "u", Password: "p", SenderEmail: "a@example.com", RecipientEmail: "b@example.com", Security: repo.SMTPSecurityTLS},
+		{Notifications: true, ServerAddress: "localhost:25", SenderEmail: "a@example.com", RecipientEmail: "b@example.com", AuthMethod: repo.SMTPAuthNone},
+		{Templates: map[string]repo.EmailTemplate{"order": {HTML: "<b>{{.Title}}</b>"}}},
+	}
+	for _, conf := range valid {
+		if err := validateSMTP(&conf); err != nil {
+			t.Errorf("%+v should be valid: %s", conf, err)
+		}
+	}
+	invalid := []repo.SMTPSettings{
+		{Notifications: true, ServerAddress: "localhost:25", SenderEmail: "a@example.com", RecipientEmail: "b@example.com"},
+		{Security: "ssl"},
+		{AuthMethod: "xoauth2"},
+		{Templates: map[string]repo.EmailTemplate{"order": {Text: "{{.Title"}}},
+	}
+	for _, conf := range invalid {
+		if err := validateSMTP(&conf); err == nil {
+			t.Errorf("%+v should be invalid", conf)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/ipfs/go-ipfs/core/corehttp"
 	"github.com/op/go-logging"
+	"google.golang.org/grpc"
 )
 
 var log = logging.MustGetLogger("api")
@@ -19,6 +20,10 @@ type Gateway struct {
 	handler    http.Handler
 	config     repo.APIConfig
 	shutdownCh chan struct{}
+
+	// The gRPC server and its listener, nil unless a gRPC address is configured
+	grpcServer   *grpc.Server
+	grpcListener net.Listener
 }
 
 // NewGateway instantiates a new `Gateway`
@@ -39,6 +44,7 @@ func NewGateway(n *core.OpenBazaarNode, authCookie http.Cookie, l net.Listener,
 
 	topMux.Handle("/ob/", jsonAPI)
 	topMux.Handle("/wallet/", jsonAPI)
+	topMux.Handle(apiVersionPrefix+"/", jsonAPI)
 	topMux.Handle("/ws", wsAPI)
 
 	mux := topMux
@@ -49,12 +55,24 @@ func NewGateway(n *core.OpenBazaarNode, authCookie http.Cookie, l net.Listener,
 		}
 	}
 
-	return &Gateway{
+	g := &Gateway{
 		listener:   l,
 		handler:    topMux,
 		config:     config,
 		shutdownCh: make(chan struct{}),
-	}, nil
+	}
+	if config.Enabled && config.GRPCAddr != "" {
+		g.grpcServer, err = newGRPCServer(jsonAPI, wsAPI.h, config)
+		if err != nil {
+			return nil, err
+		}
+		g.grpcListener, err = net.Listen("tcp", config.GRPCAddr)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("gRPC server listening on %s\n", g.grpcListener.Addr())
+	}
+	return g, nil
 }
 
 // Close shutsdown the Gateway listener
@@ -74,11 +92,21 @@ func (g *Gateway) Close() error {
 
 	// Shutdown the listener
 	close(g.shutdownCh)
+	if g.grpcServer != nil {
+		g.grpcServer.Stop()
+	}
 	return g.listener.Close()
 }
 
 // Serve begins listening on the configured address
 func (g *Gateway) Serve() error {
+	if g.grpcServer != nil {
+		go func() {
+			if err := g.grpcServer.Serve(g.grpcListener); err != nil {
+				log.Error(err)
+			}
+		}()
+	}
 	var err error
 	if g.config.SSL {
 		err = http.ListenAndServeTLS(g.listener.Addr().String(), g.config.SSLCert, g.config.SSLKey, g.handler)
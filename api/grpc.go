@@ -0,0 +1,451 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
+/* grpcServer implements the gRPC services. Each RPC calls the handler of the equivalent JSON
+   API route so both APIs share their validation and behaviour, and the same authentication
+   applies to both. */
+type grpcServer struct {
+	api *jsonAPIHandler
+	hub *hub
+}
+
+func newGRPCServer(api *jsonAPIHandler, h *hub, config repo.APIConfig) (*grpc.Server, error) {
+	var opts []grpc.ServerOption
+	if config.SSL {
+		creds, err := credentials.NewServerTLSFromFile(config.SSLCert, config.SSLKey)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	s := &grpcServer{api: api, hub: h}
+	srv := grpc.NewServer(opts...)
+	pb.RegisterListingServiceServer(srv, s)
+	pb.RegisterOrderServiceServer(srv, s)
+	pb.RegisterDisputeServiceServer(srv, s)
+	pb.RegisterWalletServiceServer(srv, s)
+	pb.RegisterChatServiceServer(srv, s)
+	pb.RegisterNotificationServiceServer(srv, s)
+	return srv, nil
+}
+
+func (s *grpcServer) GetListings(ctx context.Context, req *pb.ListingsRequest) (*pb.ListingIndex, error) {
+	query := url.Values{
+		"tags":         {strings.Join(req.Tags, ",")},
+		"categories":   {strings.Join(req.Categories, ",")},
+		"contractType": {req.ContractType},
+		"currency":     {req.Currency},
+		"sortBy":       {req.SortBy},
+		"offsetId":     {req.OffsetId},
+	}
+	if req.MinPrice > 0 {
+		query.Set("minPrice", strconv.FormatUint(req.MinPrice, 10))
+	}
+	if req.MaxPrice > 0 {
+		query.Set("maxPrice", strconv.FormatUint(req.MaxPrice, 10))
+	}
+	for key, ts := range map[string]*timestamp.Timestamp{"from": req.From, "to": req.To} {
+		if ts == nil {
+			continue
+		}
+		t, err := ptypes.Timestamp(ts)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		query.Set(key, t.Format(time.RFC3339Nano))
+	}
+	if req.Limit > 0 {
+		query.Set("limit", strconv.Itoa(int(req.Limit)))
+	}
+	resp := new(pb.ListingIndex)
+	return resp, s.call(ctx, "GET", joinPath("/ob/listings", req.PeerID), query, nil, resp, "listings")
+}
+
+func (s *grpcServer) GetListing(ctx context.Context, req *pb.ListingRequest) (*pb.SignedListing, error) {
+	resp := new(pb.SignedListing)
+	return resp, s.call(ctx, "GET", joinPath("/ob/listing", req.PeerID, req.Slug), nil, nil, resp, "")
+}
+
+func (s *grpcServer) CreateListing(ctx context.Context, req *pb.Listing) (*pb.ListingSlug, error) {
+	resp := new(pb.ListingSlug)
+	return resp, s.call(ctx, "POST", "/ob/listing", nil, req, resp, "")
+}
+
+func (s *grpcServer) UpdateListing(ctx context.Context, req *pb.Listing) (*pb.Empty, error) {
+	return new(pb.Empty), s.call(ctx, "PUT", "/ob/listing", nil, req, nil, "")
+}
+
+func (s *grpcServer) DeleteListing(ctx context.Context, req *pb.ListingSlug) (*pb.Empty, error) {
+	return new(pb.Empty), s.call(ctx, "DELETE", joinPath("/ob/listing", req.Slug), nil, nil, nil, "")
+}
+
+func (s *grpcServer) Purchase(ctx context.Context, req *pb.PurchaseRequest) (*pb.PurchaseResponse, error) {
+	resp := new(pb.PurchaseResponse)
+	return resp, s.call(ctx, "POST", "/ob/purchase", nil, req, resp, "")
+}
+
+func (s *grpcServer) GetOrder(ctx context.Context, req *pb.OrderRequest) (*pb.OrderRespApi, error) {
+	resp := new(pb.OrderRespApi)
+	return resp, s.call(ctx, "GET", joinPath("/ob/order", req.OrderId), nil, nil, resp, "")
+}
+
+func (s *grpcServer) ConfirmOrder(ctx context.Context, req *pb.OrderConfirmationRequest) (*pb.Empty, error) {
+	return new(pb.Empty), s.call(ctx, "POST", "/ob/orderconfirmation", nil, req, nil, "")
+}
+
+func (s *grpcServer) FulfillOrder(ctx context.Context, req *pb.OrderFulfillment) (*pb.Empty, error) {
+	return new(pb.Empty), s.call(ctx, "POST", "/ob/orderfulfillment", nil, req, nil, "")
+}
+
+func (s *grpcServer) CompleteOrder(ctx context.Context, req *pb.OrderCompletionRequest) (*pb.Empty, error) {
+	return new(pb.Empty), s.call(ctx, "POST", "/ob/ordercompletion", nil, req, nil, "")
+}
+
+func (s *grpcServer) CancelOrder(ctx context.Context, req *pb.OrderRequest) (*pb.Empty, error) {
+	return new(pb.Empty), s.call(ctx, "POST", "/ob/ordercancel", nil, req, nil, "")
+}
+
+func (s *grpcServer) RefundOrder(ctx context.Context, req *pb.OrderRequest) (*pb.Empty, error) {
+	return new(pb.Empty), s.call(ctx, "POST", "/ob/refund", nil, req, nil, "")
+}
+
+func (s *grpcServer) OpenDispute(ctx context.Context, req *pb.OpenDisputeRequest) (*pb.Empty, error) {
+	return new(pb.Empty), s.call(ctx, "POST", "/ob/opendispute", nil, req, nil, "")
+}
+
+func (s *grpcServer) CloseDispute(ctx context.Context, req *pb.CloseDisputeRequest) (*pb.Empty, error) {
+	return new(pb.Empty), s.call(ctx, "POST", "/ob/closedispute", nil, req, nil, "")
+}
+
+func (s *grpcServer) ReleaseFunds(ctx context.Context, req *pb.OrderRequest) (*pb.Empty, error) {
+	return new(pb.Empty), s.call(ctx, "POST", "/ob/releasefunds", nil, req, nil, "")
+}
+
+func (s *grpcServer) GetCase(ctx context.Context, req *pb.OrderRequest) (*pb.CaseRespApi, error) {
+	resp := new(pb.CaseRespApi)
+	return resp, s.call(ctx, "GET", joinPath("/ob/case", req.OrderId), nil, nil, resp, "")
+}
+
+func (s *grpcServer) GetAddress(ctx context.Context, req *pb.CoinRequest) (*pb.AddressResponse, error) {
+	resp := new(pb.AddressResponse)
+	return resp, s.call(ctx, "GET", "/wallet/address", url.Values{"coin": {req.Coin}}, nil, resp, "")
+}
+
+func (s *grpcServer) GetBalance(ctx context.Context, req *pb.CoinRequest) (*pb.BalanceResponse, error) {
+	resp := new(pb.BalanceResponse)
+	return resp, s.call(ctx, "GET", "/wallet/balance", url.Values{"coin": {req.Coin}}, nil, resp, "")
+}
+
+func (s *grpcServer) Spend(ctx context.Context, req *pb.SpendRequest) (*pb.SpendResponse, error) {
+	resp := new(pb.SpendResponse)
+	return resp, s.call(ctx, "POST", "/wallet/spend", nil, req, resp, "")
+}
+
+func (s *grpcServer) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.ChatMessageID, error) {
+	resp := new(pb.ChatMessageID)
+	return resp, s.call(ctx, "POST", "/ob/chat", nil, req, resp, "")
+}
+
+func (s *grpcServer) GetConversations(ctx context.Context, req *pb.Empty) (*pb.ChatConversations, error) {
+	resp := new(pb.ChatConversations)
+	return resp, s.call(ctx, "GET", "/ob/chatconversations", nil, nil, resp, "conversations")
+}
+
+func (s *grpcServer) GetMessages(ctx context.Context, req *pb.ChatMessagesRequest) (*pb.ChatMessages, error) {
+	query := url.Values{"subject": {req.Subject}, "offsetId": {req.OffsetId}}
+	if req.Limit > 0 {
+		query.Set("limit", strconv.Itoa(int(req.Limit)))
+	}
+	resp := new(pb.ChatMessages)
+	return resp, s.call(ctx, "GET", joinPath("/ob/chatmessages", req.PeerId), query, nil, resp, "messages")
+}
+
+func (s *grpcServer) MarkChatAsRead(ctx context.Context, req *pb.ChatMessagesRequest) (*pb.Empty, error) {
+	query := url.Values{"subject": {req.Subject}}
+	return new(pb.Empty), s.call(ctx, "POST", joinPath("/ob/markchatasread", req.PeerId), query, nil, nil, "")
+}
+
+/* Subscribe streams the messages broadcast over the websocket API. Like a websocket client,
+   a subscriber may first replay the recorded events after a sequence number. */
+func (s *grpcServer) Subscribe(req *pb.SubscribeRequest, stream pb.NotificationService_SubscribeServer) error {
+	if err := s.authorize(stream.Context(), "GET", "/ws"); err != nil {
+		return err
+	}
+	since := int64(-1)
+	if req.Replay {
+		if req.Since < 0 {
+			return status.Error(codes.InvalidArgument, "Since must not be negative")
+		}
+		since = req.Since
+	}
+	c := &connection{send: make(chan []byte, 256), h: s.hub, since: since, backlog: make(chan [][]byte, 1)}
+	s.hub.register <- c
+	defer func() { s.hub.unregister <- c }()
+
+	for _, m := range <-c.backlog {
+		if err := stream.Send(newEvent(m)); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case m, ok := <-c.send:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "Events were not received fast enough")
+			}
+			if err := stream.Send(newEvent(m)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func newEvent(m []byte) *pb.Event {
+	var seq struct {
+		Seq int64 `json:"seq"`
+	}
+	json.Unmarshal(m, &seq)
+	return &pb.Event{Seq: seq.Seq, Type: eventType(m), Payload: string(m)}
+}
+
+/* call serves an RPC with the handler of a JSON API route. The request is encoded the way the
+   handler decodes it, with jsonpb if it expects a protobuf message and encoding/json otherwise.
+   The response is decoded into resp, nested under field if the handler responds with an array
+   alongside the total from the X-Total-Count header if it's set. Error responses are returned as the equivalent gRPC status. */
+func (s *grpcServer) call(ctx context.Context, method, path string, query url.Values, req, resp proto.Message, field string) (err error) {
+	if err := s.authorize(ctx, method, path); err != nil {
+		return err
+	}
+	rt := findRoute(method, path)
+	if rt == nil {
+		return status.Errorf(codes.Unimplemented, "No route for %s %s", method, path)
+	}
+	body := new(bytes.Buffer)
+	if req != nil {
+		if rt.request != nil && reflect.PtrTo(reflect.TypeOf(rt.request)).Implements(protoMessageType) {
+			err = new(jsonpb.Marshaler).Marshal(body, req)
+		} else {
+			err = json.NewEncoder(body).Encode(req)
+		}
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	target := path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	r, err := http.NewRequest(method, target, body)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	r = r.WithContext(ctx)
+	r.Header.Set("Content-Type", "application/json")
+
+	w := &rpcResponseWriter{header: make(http.Header), code: http.StatusOK}
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("A panic occurred in the gRPC handler!")
+			log.Error(r)
+			debug.PrintStack()
+			err = status.Error(codes.Internal, "Internal error")
+		}
+	}()
+	rt.handler(s.api, w, r)
+
+	// Handlers answer 202 when an action waits on cold storage signatures, which is not an error
+	if w.code < 200 || w.code >= 300 {
+		var apiErr APIError
+		reason := strings.TrimSpace(w.body.String())
+		if json.Unmarshal(w.body.Bytes(), &apiErr) == nil && apiErr.Reason != "" {
+			reason = apiErr.Reason
+		}
+		return status.Error(grpcCode(w.code), reason)
+	}
+	if resp == nil {
+		return nil
+	}
+	b := w.body.Bytes()
+	if field != "" {
+		wrapped := map[string]json.RawMessage{field: b}
+		if total, err := strconv.Atoi(w.header.Get(totalCountHeader)); err == nil {
+			wrapped["total"] = json.RawMessage(strconv.Itoa(total))
+		}
+		b, err = json.Marshal(wrapped)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := u.Unmarshal(bytes.NewReader(b), resp); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// authorize applies the JSON API's access rules and rate limits to the peer and metadata of a call
+func (s *grpcServer) authorize(ctx context.Context, method, path string) error {
+	config := s.api.config
+	var host string
+	if p, ok := peer.FromContext(ctx); ok {
+		h, _, err := net.SplitHostPort(p.Addr.String())
+		if err == nil {
+			host = h
+		}
+	}
+	if len(config.AllowedIPs) > 0 && !config.AllowedIPs[host] {
+		return status.Error(codes.PermissionDenied, "Forbidden")
+	}
+	// The IP address is charged first so failed authentication attempts are throttled too
+	if s.api.limiter != nil {
+		if limit := s.api.limiter.allowIP(host, method, path); !limit.allowed {
+			return grpcRateLimitExceeded(ctx, limit)
+		}
+	}
+	credential, err := s.authenticate(ctx, method, path)
+	if err != nil {
+		return err
+	}
+	if s.api.limiter != nil {
+		if limit := s.api.limiter.allowCredential(host, credential, method, path); !limit.allowed {
+			return grpcRateLimitExceeded(ctx, limit)
+		}
+	}
+	return nil
+}
+
+// grpcRateLimitExceeded returns the error for a throttled call and sets when to retry it
+func grpcRateLimitExceeded(ctx context.Context, limit rateLimitResult) error {
+	retryAfter := int(math.Ceil(limit.retryAfter.Seconds()))
+	grpc.SetTrailer(ctx, metadata.Pairs("retry-after", strconv.Itoa(retryAfter)))
+	return status.Errorf(codes.ResourceExhausted, "Rate limit exceeded, retry after %d seconds", retryAfter)
+}
+
+// authenticate checks the credentials of an RPC and returns the credential for rate limiting
+func (s *grpcServer) authenticate(ctx context.Context, method, path string) (string, error) {
+	config := s.api.config
+	if !config.Authenticated {
+		return "", nil
+	}
+
+	// Credentials are sent the same way as to the JSON API, as authorization and cookie metadata
+	md, _ := metadata.FromIncomingContext(ctx)
+	r := &http.Request{Header: make(http.Header)}
+	for _, key := range []string{"authorization", "cookie"} {
+		for _, v := range md[key] {
+			r.Header.Add(key, v)
+		}
+	}
+	if token := bearerToken(r); token != "" {
+		apiToken, err := s.api.node.Datastore.APITokens().GetByHash(repo.HashAPIToken(token))
+		if err != nil {
+			return "", status.Error(codes.Unauthenticated, "Forbidden")
+		}
+		if scope := requiredScope(method, path); !apiToken.HasScope(scope) {
+			return "", status.Error(codes.PermissionDenied, fmt.Sprintf("Forbidden: token does not have the %s scope", scope))
+		}
+		return "token:" + apiToken.Hash, nil
+	}
+	if config.Username == "" || config.Password == "" {
+		cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
+		if err != nil || config.Cookie.Value != cookie.Value {
+			return "", status.Error(codes.Unauthenticated, "Forbidden")
+		}
+		return "cookie", nil
+	}
+	username, password, ok := r.BasicAuth()
+	h := sha256.Sum256([]byte(password))
+	password = hex.EncodeToString(h[:])
+	if !ok || username != config.Username || strings.ToLower(password) != strings.ToLower(config.Password) {
+		return "", status.Error(codes.Unauthenticated, "Forbidden")
+	}
+	return "user:" + username, nil
+}
+
+// grpcCode returns the gRPC status code equivalent to an HTTP status code
+func grpcCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusMethodNotAllowed, http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	}
+	return codes.Internal
+}
+
+// joinPath appends the non-empty path parameters to a route's path
+func joinPath(p string, params ...string) string {
+	for _, param := range params {
+		if param != "" {
+			p += "/" + url.PathEscape(param)
+		}
+	}
+	return p
+}
+
+// rpcResponseWriter records the response of a JSON API handler called by an RPC
+type rpcResponseWriter struct {
+	header http.Header
+	code   int
+	body   bytes.Buffer
+}
+
+func (w *rpcResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *rpcResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *rpcResponseWriter) WriteHeader(code int) {
+	w.code = code
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestJoinPath(t *testing.T) {
+	tests := []struct {
+		params   []string
+		expected string
+	}{
+		{nil, "/ob/listing"},
+		{[]string{""}, "/ob/listing"},
+		{[]string{"", "my-listing"}, "/ob/listing/my-listing"},
+		{[]string{"QmPeer", "my-listing"}, "/ob/listing/QmPeer/my-listing"},
+		{[]string{"a/b%20c"}, "/ob/listing/a%2Fb%2520c"},
+	}
+	for _, test := range tests {
+		if p := joinPath("/ob/listing", test.params...); p != test.expected {
+			t.Errorf("Expected %s, got %s", test.expected, p)
+		}
+	}
+}
+
+func TestGRPCCode(t *testing.T) {
+	tests := map[int]codes.Code{
+		http.StatusBadRequest:          codes.InvalidArgument,
+		http.StatusNotFound:            codes.NotFound,
+		http.StatusConflict:            codes.AlreadyExists,
+		http.StatusMethodNotAllowed:    codes.FailedPrecondition,
+		http.StatusInternalServerError: codes.Internal,
+	}
+	for httpStatus, expected := range tests {
+		if c := grpcCode(httpStatus); c != expected {
+			t.Errorf("HTTP %d: expected %s, got %s", httpStatus, expected, c)
+		}
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	e := newEvent([]byte(`{"notification": {"type": "payment"}, "seq": 12}`))
+	if e.Seq != 12 || e.Type != "payment" {
+		t.Errorf("Unexpected event %v", e)
+	}
+	e = newEvent([]byte(`{"status": "publishing"}`))
+	if e.Seq != 0 || e.Type != "status" || e.Payload != `{"status": "publishing"}` {
+		t.Errorf("Unexpected event %v", e)
+	}
+}
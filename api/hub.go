@@ -1,5 +1,21 @@
 package api
 
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// The most events replayed to a reconnecting client
+	maxReplayEvents = 1000
+
+	// How often old events are pruned from the event log
+	eventLogPruneInterval = time.Hour
+)
+
 type hub struct {
 	// Registered connections
 	connections map[*connection]bool
@@ -12,21 +28,37 @@ type hub struct {
 
 	// Unregister requests from connections
 	unregister chan *connection
+
+	// Every broadcast is recorded here so clients can replay the events they missed.
+	// Nil if events aren't recorded.
+	eventLog repo.EventLog
+
+	// Retention of the event log. Zero disables the limit.
+	maxAge    time.Duration
+	maxEvents int
 }
 
-func newHub() *hub {
+func newHub(eventLog repo.EventLog, maxAge time.Duration, maxEvents int) *hub {
 	return &hub{
 		Broadcast:   make(chan []byte),
 		register:    make(chan *connection),
 		unregister:  make(chan *connection),
 		connections: make(map[*connection]bool),
+		eventLog:    eventLog,
+		maxAge:      maxAge,
+		maxEvents:   maxEvents,
 	}
 }
 
 func (h *hub) run() {
+	prune := time.NewTicker(eventLogPruneInterval)
+	defer prune.Stop()
+	h.prune()
 	for {
 		select {
 		case c := <-h.register:
+			// Replaying here means nothing is broadcast between the replay and registering
+			c.backlog <- h.replay(c.since)
 			h.connections[c] = true
 			log.Debug("Registered new websocket connection")
 		case c := <-h.unregister:
@@ -36,6 +68,7 @@ func (h *hub) run() {
 			}
 			log.Debug("Unregistered websocket connection")
 		case m := <-h.Broadcast:
+			m = h.record(m)
 			for c := range h.connections {
 				select {
 				case c.send <- m:
@@ -44,6 +77,110 @@ func (h *hub) run() {
 					close(c.send)
 				}
 			}
+		case <-prune.C:
+			h.prune()
 		}
 	}
 }
+
+// record appends a message to the event log and returns it with its sequence number
+func (h *hub) record(m []byte) []byte {
+	if h.eventLog == nil {
+		return m
+	}
+	seq, err := h.eventLog.Put(repo.Event{Type: eventType(m), Payload: m, Timestamp: time.Now()})
+	if err != nil {
+		log.Error(err)
+		return m
+	}
+	return withSeq(m, seq)
+}
+
+/* replay returns the events after since followed by a replayComplete message. The message
+   tells the client the last sequence number replayed, whether older events it asked for
+   have been pruned (gap) and whether there are more to fetch by reconnecting (more). A
+   negative since means the client didn't ask for a replay. */
+func (h *hub) replay(since int64) [][]byte {
+	if since < 0 || h.eventLog == nil {
+		return nil
+	}
+	events, err := h.eventLog.GetSince(since, maxReplayEvents)
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+	var ret [][]byte
+	latest := since
+	for _, e := range events {
+		ret = append(ret, withSeq(e.Payload, e.Seq))
+		latest = e.Seq
+	}
+	type replayComplete struct {
+		Since     int64 `json:"since"`
+		LatestSeq int64 `json:"latestSeq"`
+		Gap       bool  `json:"gap"`
+		More      bool  `json:"more"`
+	}
+	complete, _ := json.MarshalIndent(struct {
+		ReplayComplete replayComplete `json:"replayComplete"`
+	}{replayComplete{
+		Since:     since,
+		LatestSeq: latest,
+		Gap:       len(events) > 0 && events[0].Seq > since+1,
+		More:      len(events) == maxReplayEvents,
+	}}, "", "    ")
+	return append(ret, complete)
+}
+
+func (h *hub) prune() {
+	if h.eventLog == nil {
+		return
+	}
+	var before time.Time
+	if h.maxAge > 0 {
+		before = time.Now().Add(-h.maxAge)
+	}
+	if err := h.eventLog.Prune(before, h.maxEvents); err != nil {
+		log.Error(err)
+	}
+}
+
+/* eventType returns the kind of a broadcast message. Messages are objects with a single key
+   naming the kind, such as "message" or "wallet", besides the sequence number once recorded.
+   Notifications are named by their type. */
+func eventType(m []byte) string {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(m, &obj); err != nil {
+		return ""
+	}
+	delete(obj, "seq")
+	if len(obj) != 1 {
+		return ""
+	}
+	for key, value := range obj {
+		if key == "notification" {
+			var n struct {
+				Type string `json:"type"`
+			}
+			if err := json.Unmarshal(value, &n); err == nil && n.Type != "" {
+				return n.Type
+			}
+		}
+		return key
+	}
+	return ""
+}
+
+// withSeq adds the sequence number to a JSON object message. Other messages are returned as is.
+func withSeq(m []byte, seq int64) []byte {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(m, &obj); err != nil || obj == nil {
+		return m
+	}
+	obj["seq"] = json.RawMessage(strconv.FormatInt(seq, 10))
+	ret, err := json.MarshalIndent(obj, "", "    ")
+	if err != nil {
+		return m
+	}
+	return ret
+}
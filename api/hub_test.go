@@ -0,0 +1,95 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type memoryEventLog struct {
+	events []repo.Event
+}
+
+func (m *memoryEventLog) Put(e repo.Event) (int64, error) {
+	e.Seq = int64(len(m.events) + 1)
+	m.events = append(m.events, e)
+	return e.Seq, nil
+}
+
+func (m *memoryEventLog) GetSince(seq int64, limit int) ([]repo.Event, error) {
+	var ret []repo.Event
+	for _, e := range m.events {
+		if e.Seq > seq && len(ret) < limit {
+			ret = append(ret, e)
+		}
+	}
+	return ret, nil
+}
+
+func (m *memoryEventLog) Prune(before time.Time, keep int) error {
+	return nil
+}
+
+func TestEventType(t *testing.T) {
+	for _, test := range []struct {
+		message  string
+		expected string
+	}{
+		{`{"notification": {"type": "order", "orderId": "abc"}}`, "order"},
+		{`{"message": {"peerId": "Qm"}}`, "message"},
+		{`{"wallet": {"txid": "abc"}}`, "wallet"},
+		{`{"status": "publishing"}`, "status"},
+		{`{"wallet": {"txid": "abc"}, "seq": 7}`, "wallet"},
+		{`{"wallet": {}, "status": "publishing"}`, ""},
+		{`not json`, ""},
+	} {
+		if e := eventType([]byte(test.message)); e != test.expected {
+			t.Errorf("Expected event type %q, got %q", test.expected, e)
+		}
+	}
+}
+
+func TestHubReplay(t *testing.T) {
+	h := newHub(&memoryEventLog{}, 0, 0)
+	for _, m := range []string{`{"status": "one"}`, `{"status": "two"}`, `{"status": "three"}`} {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(h.record([]byte(m)), &obj); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := obj["seq"]; !ok {
+			t.Error("Recorded message is missing its sequence number")
+		}
+	}
+
+	if h.replay(-1) != nil {
+		t.Error("Replayed events to a client which didn't ask")
+	}
+	replayed := h.replay(1)
+	if len(replayed) != 3 {
+		t.Fatalf("Expected 2 events and replayComplete, got %d messages", len(replayed))
+	}
+	var first struct {
+		Status string `json:"status"`
+		Seq    int64  `json:"seq"`
+	}
+	if err := json.Unmarshal(replayed[0], &first); err != nil {
+		t.Fatal(err)
+	}
+	if first.Status != "two" || first.Seq != 2 {
+		t.Error("Replayed the wrong event")
+	}
+	var complete struct {
+		ReplayComplete struct {
+			LatestSeq int64 `json:"latestSeq"`
+			Gap       bool  `json:"gap"`
+		} `json:"replayComplete"`
+	}
+	if err := json.Unmarshal(replayed[2], &complete); err != nil {
+		t.Fatal(err)
+	}
+	if complete.ReplayComplete.LatestSeq != 3 || complete.ReplayComplete.Gap {
+		t.Error("replayComplete has the wrong values")
+	}
+}
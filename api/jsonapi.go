@@ -6,6 +6,8 @@ import (
 	"encoding/json"
 	"fmt"
 	mh "gx/ipfs/QmU9a9NV9RdPNwZQDYd5uKsm6N6LJLSvLbywDDYFbaaC6P/go-multihash"
+	"math"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -13,6 +15,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -31,11 +34,12 @@ import (
 
 	"github.com/OpenBazaar/jsonpb"
 	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/spvwallet"
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
-	"github.com/OpenBazaar/spvwallet"
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -61,8 +65,9 @@ type JsonAPIConfig struct {
 }
 
 type jsonAPIHandler struct {
-	config JsonAPIConfig
-	node   *core.OpenBazaarNode
+	config  JsonAPIConfig
+	node    *core.OpenBazaarNode
+	limiter *rateLimiter
 }
 
 func newJsonAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config repo.APIConfig) (*jsonAPIHandler, error) {
@@ -81,7 +86,8 @@ func newJsonAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config
 			Username:      config.Username,
 			Password:      config.Password,
 		},
-		node: node,
+		node:    node,
+		limiter: newRateLimiter(config.RateLimits),
 	}
 	return i, nil
 }
@@ -92,6 +98,11 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		log.Error(err)
 		return
 	}
+	// Versioned paths are served by the same handlers as the legacy paths
+	if strings.HasPrefix(u.Path, apiVersionPrefix+"/") {
+		u.Path = strings.TrimPrefix(u.Path, apiVersionPrefix)
+		r.URL.Path = u.Path
+	}
 	if !i.config.Enabled && !gatewayAllowedPath(u.Path, r.Method) {
 		w.WriteHeader(http.StatusForbidden)
 		fmt.Fprint(w, "403 - Forbidden")
@@ -116,8 +127,39 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.Header()[k] = v.([]string)
 	}
 
+	/* Charge the IP address before checking the credentials so failed attempts are throttled too.
+	   Preflight requests are left alone since they never reach a handler. */
+	var host string
+	var limit rateLimitResult
+	if i.limiter != nil && r.Method != "OPTIONS" {
+		host, _, err = net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		limit = i.limiter.allowIP(host, r.Method, u.Path)
+		if !limit.allowed {
+			rateLimitExceeded(w, limit)
+			return
+		}
+	}
+
+	// The credential a request authenticated with, which has its own rate limit
+	var credential string
 	if i.config.Authenticated {
-		if i.config.Username == "" || i.config.Password == "" {
+		if token := bearerToken(r); token != "" {
+			apiToken, err := i.node.Datastore.APITokens().GetByHash(repo.HashAPIToken(token))
+			if err != nil {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, "403 - Forbidden")
+				return
+			}
+			if scope := requiredScope(r.Method, u.Path); !apiToken.HasScope(scope) {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprintf(w, "403 - Forbidden: token does not have the %s scope", scope)
+				return
+			}
+			credential = "token:" + apiToken.Hash
+		} else if i.config.Username == "" || i.config.Password == "" {
 			cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
 			if err != nil {
 				w.WriteHeader(http.StatusForbidden)
@@ -129,6 +171,7 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				fmt.Fprint(w, "403 - Forbidden")
 				return
 			}
+			credential = "cookie"
 		} else {
 
 			if r.Method == "OPTIONS" {
@@ -145,6 +188,7 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				fmt.Fprint(w, "403 - Forbidden")
 				return
 			}
+			credential = "user:" + username
 		}
 	}
 
@@ -152,6 +196,17 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method == "OPTIONS" {
 		return
 	}
+	if i.limiter != nil {
+		limit = limit.and(i.limiter.allowCredential(host, credential, r.Method, u.Path))
+		if !limit.allowed {
+			rateLimitExceeded(w, limit)
+			return
+		}
+		if limit.limit > 0 {
+			w.Header().Set("X-RateLimit-Limit", strconv.Itoa(limit.limit))
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(limit.remaining))
+		}
+	}
 	r.Header.Del("Cookie")
 	r.Header.Del("Authorization")
 	dump, err := httputil.DumpRequest(r, false)
@@ -168,32 +223,33 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	w.Header().Add("Content-Type", "application/json")
-	switch r.Method {
-	case "GET":
-		get(i, u.String(), w, r)
-	case "POST":
-		post(i, u.String(), w, r)
-	case "PUT":
-		put(i, u.String(), w, r)
-	case "DELETE":
-		deleter(i, u.String(), w, r)
-	case "PATCH":
-		patch(i, u.String(), w, r)
-	}
+	dispatch(i, u.Path, w, r)
+}
+
+// rateLimitExceeded responds to a throttled request with how long to wait before retrying
+func rateLimitExceeded(w http.ResponseWriter, limit rateLimitResult) {
+	retryAfter := int(math.Ceil(limit.retryAfter.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	ErrorResponse(w, http.StatusTooManyRequests, fmt.Sprintf("Rate limit exceeded, retry after %d seconds", retryAfter))
 }
 
 func ErrorResponse(w http.ResponseWriter, errorCode int, reason string) {
-	type ApiError struct {
-		Success bool   `json:"success"`
-		Reason  string `json:"reason"`
-	}
 	reason = strings.Replace(reason, `"`, `'`, -1)
-	err := ApiError{false, reason}
+	err := APIError{false, reason}
 	resp, _ := json.MarshalIndent(err, "", "    ")
 	w.WriteHeader(errorCode)
 	fmt.Fprint(w, string(resp))
 }
 
+// Return the API token from a bearer Authorization header
+func bearerToken(r *http.Request) string {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+}
+
 func SanitizedResponse(w http.ResponseWriter, response string) {
 	ret, err := SanitizeJSON([]byte(response))
 	if err != nil {
@@ -212,6 +268,17 @@ func SanitizedResponseM(w http.ResponseWriter, response string, m proto.Message)
 	fmt.Fprint(w, string(out))
 }
 
+// Tell the client an order action is waiting on signatures from cold storage
+func signingRequestExported(w http.ResponseWriter, err error) bool {
+	e, ok := err.(core.SigningRequestExportedError)
+	if !ok {
+		return false
+	}
+	w.WriteHeader(http.StatusAccepted)
+	SanitizedResponse(w, fmt.Sprintf(`{"signingRequest": "%s"}`, e.Id))
+	return true
+}
+
 func (i *jsonAPIHandler) POSTProfile(w http.ResponseWriter, r *http.Request) {
 
 	// If the profile is already set tell them to use PUT
@@ -385,11 +452,8 @@ func (i *jsonAPIHandler) PATCHProfile(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTAvatar(w http.ResponseWriter, r *http.Request) {
-	type ImgData struct {
-		Avatar string `json:"avatar"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	data := new(ImgData)
+	data := new(AvatarRequest)
 	err := decoder.Decode(&data)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -423,11 +487,8 @@ func (i *jsonAPIHandler) POSTAvatar(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTHeader(w http.ResponseWriter, r *http.Request) {
-	type ImgData struct {
-		Header string `json:"header"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	data := new(ImgData)
+	data := new(HeaderRequest)
 	err := decoder.Decode(&data)
 
 	if err != nil {
@@ -461,29 +522,21 @@ func (i *jsonAPIHandler) POSTHeader(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTImage(w http.ResponseWriter, r *http.Request) {
-	type ImgData struct {
-		Filename string `json:"filename"`
-		Image    string `json:"image"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var images []ImgData
+	var images []ImageUpload
 	err := decoder.Decode(&images)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	type retImage struct {
-		Filename string           `json:"filename"`
-		Hashes   pb.Profile_Image `json:"hashes"`
-	}
-	var retData []retImage
+	var retData []UploadedImage
 	for _, img := range images {
 		hashes, err := i.node.SetProductImages(img.Image, img.Filename)
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		rtimg := retImage{img.Filename, *hashes}
+		rtimg := UploadedImage{img.Filename, *hashes}
 		retData = append(retData, rtimg)
 	}
 	jsonHashes, err := json.MarshalIndent(retData, "", "    ")
@@ -685,13 +738,7 @@ func (i *jsonAPIHandler) POSTPurchase(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	type purchaseReturn struct {
-		PaymentAddress string `json:"paymentAddress"`
-		Amount         uint64 `json:"amount"`
-		VendorOnline   bool   `json:"vendorOnline"`
-		OrderId        string `json:"orderId"`
-	}
-	ret := purchaseReturn{paymentAddr, amount, online, orderId}
+	ret := PurchaseResponse{paymentAddr, amount, online, orderId}
 	b, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -701,8 +748,39 @@ func (i *jsonAPIHandler) POSTPurchase(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
+func (i *jsonAPIHandler) POSTPayLightning(w http.ResponseWriter, r *http.Request) {
+	_, orderId := path.Split(r.URL.Path)
+	if _, _, _, _, _, err := i.node.Datastore.Purchases().GetByOrderId(orderId); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Purchase not found")
+		return
+	}
+	err := i.node.PayLightningOrder(orderId)
+	if err == core.ErrLightningDisabled {
+		ErrorResponse(w, http.StatusMethodNotAllowed, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
 func (i *jsonAPIHandler) GETStatus(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
+	if peerId == "" || peerId == "status" {
+		// Without a peer ID this node's status is returned along with the throttling counters
+		resp := StatusResponse{Status: "online", RateLimits: &RateLimitStatus{ThrottledRoutes: map[string]uint64{}}}
+		if i.limiter != nil {
+			resp.RateLimits = i.limiter.status()
+		}
+		ret, err := json.MarshalIndent(resp, "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(ret))
+		return
+	}
 	status, err := i.node.GetPeerStatus(peerId)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -727,12 +805,8 @@ func (i *jsonAPIHandler) GETPeers(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
-	type PeerId struct {
-		ID string `json:"id"`
-	}
-
 	decoder := json.NewDecoder(r.Body)
-	var pid PeerId
+	var pid PeerIDRequest
 	err := decoder.Decode(&pid)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -747,11 +821,8 @@ func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTUnfollow(w http.ResponseWriter, r *http.Request) {
-	type PeerId struct {
-		ID string `json:"id"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var pid PeerId
+	var pid PeerIDRequest
 	err := decoder.Decode(&pid)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -765,8 +836,22 @@ func (i *jsonAPIHandler) POSTUnfollow(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
+// Returns the wallet for the coin or the primary wallet if no coin is given. Writes an error response on failure.
+func (i *jsonAPIHandler) walletForCoin(w http.ResponseWriter, coin string) (wallet.Wallet, bool) {
+	wal, err := i.node.WalletForCurrency(coin)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	return wal, true
+}
+
 func (i *jsonAPIHandler) GETAddress(w http.ResponseWriter, r *http.Request) {
-	addr := i.node.Wallet.CurrentAddress(wallet.EXTERNAL)
+	wal, ok := i.walletForCoin(w, r.URL.Query().Get("coin"))
+	if !ok {
+		return
+	}
+	addr := wal.CurrentAddress(wallet.EXTERNAL)
 	SanitizedResponse(w, fmt.Sprintf(`{"address": "%s"}`, addr.EncodeAddress()))
 }
 
@@ -800,24 +885,26 @@ func (i *jsonAPIHandler) GETBalance(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}*/
-	confirmed, unconfirmed := i.node.Wallet.Balance()
+	wal, ok := i.walletForCoin(w, r.URL.Query().Get("coin"))
+	if !ok {
+		return
+	}
+	confirmed, unconfirmed := wal.Balance()
 	SanitizedResponse(w, fmt.Sprintf(`{"confirmed": %d, "unconfirmed": %d}`, int(confirmed), int(unconfirmed)))
 }
 
 func (i *jsonAPIHandler) POSTSpendCoins(w http.ResponseWriter, r *http.Request) {
-	type Send struct {
-		Address  string `json:"address"`
-		Amount   int64  `json:"amount"`
-		FeeLevel string `json:"feeLevel"`
-		Memo     string `json:"memo"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var snd Send
+	var snd SpendRequest
 	err := decoder.Decode(&snd)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	wal, ok := i.walletForCoin(w, snd.Coin)
+	if !ok {
+		return
+	}
 	var feeLevel wallet.FeeLevel
 	switch strings.ToUpper(snd.FeeLevel) {
 	case "PRIORITY":
@@ -829,20 +916,25 @@ func (i *jsonAPIHandler) POSTSpendCoins(w http.ResponseWriter, r *http.Request)
 	default:
 		feeLevel = wallet.NORMAL
 	}
-	addr, err := i.node.Wallet.DecodeAddress(snd.Address)
+	addr, err := wal.DecodeAddress(snd.Address)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, "ERROR_INVALID_ADDRESS")
 		return
 	}
-	txid, err := i.node.Wallet.Spend(snd.Amount, addr, feeLevel)
+	txid, err := i.node.SpendCoins(snd.Coin, snd.Amount, addr, feeLevel, snd.CoinControl)
 	if err != nil {
 		switch {
+		case signingRequestExported(w, err):
+			return
 		case err == wallet.ErrorInsuffientFunds:
 			ErrorResponse(w, http.StatusBadRequest, `ERROR_INSUFFICIENT_FUNDS`)
 			return
 		case err == wallet.ErrorDustAmount:
 			ErrorResponse(w, http.StatusBadRequest, `ERROR_DUST_AMOUNT`)
 			return
+		case err == core.ErrUtxoNotFound || err == bitcoin.ErrCoinControlUnsupported:
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		default:
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -878,21 +970,13 @@ func (i *jsonAPIHandler) POSTSpendCoins(w http.ResponseWriter, r *http.Request)
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	type response struct {
-		Txid               string    `json:"txid"`
-		Amount             int64     `json:"amount"`
-		ConfirmedBalance   int64     `json:"confirmedBalance"`
-		UnconfirmedBalance int64     `json:"unconfirmedBalance"`
-		Timestamp          time.Time `json:"timestamp"`
-		Memo               string    `json:"memo"`
-	}
-	confirmed, unconfirmed := i.node.Wallet.Balance()
-	txn, err := i.node.Wallet.GetTransaction(*txid)
+	confirmed, unconfirmed := wal.Balance()
+	txn, err := wal.GetTransaction(*txid)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	resp := &response{
+	resp := &SpendResponse{
 		Txid:               txid.String(),
 		ConfirmedBalance:   confirmed,
 		UnconfirmedBalance: unconfirmed,
@@ -909,173 +993,457 @@ func (i *jsonAPIHandler) POSTSpendCoins(w http.ResponseWriter, r *http.Request)
 	return
 }
 
-func (i *jsonAPIHandler) GETConfig(w http.ResponseWriter, r *http.Request) {
-	type cfg struct {
-		PeerId         string `json:"peerID"`
-		CryptoCurrency string `json:"cryptoCurrency"`
-		Testnet        bool   `json:"testnet"`
-		Tor            bool   `json:"tor"`
+func (i *jsonAPIHandler) GETSigningRequests(w http.ResponseWriter, r *http.Request) {
+	reqs, err := i.node.ListSigningRequests()
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
 	}
-
-	testnet := false
-	if i.node.Wallet.Params().Name != chaincfg.MainNetParams.Name {
-		testnet = true
+	ser, err := json.MarshalIndent(reqs, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
 	}
-	var usingTor bool
-	if i.node.TorDialer != nil {
-		usingTor = true
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) GETSigningRequest(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	req, err := i.node.GetSigningRequest(id)
+	if err == core.ErrSigningRequestNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
 	}
-	c := cfg{i.node.IpfsNode.Identity.Pretty(), strings.ToUpper(i.node.Wallet.CurrencyCode()), testnet, usingTor}
-	ser, err := json.MarshalIndent(c, "", "    ")
+	ser, err := json.MarshalIndent(req, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	w.Header().Set("Content-Disposition", "attachment; filename="+id+".json")
 	SanitizedResponse(w, string(ser))
 }
 
-func (i *jsonAPIHandler) POSTSettings(w http.ResponseWriter, r *http.Request) {
-	var settings repo.SettingsData
+func (i *jsonAPIHandler) POSTSignatures(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&settings)
+	var resp core.SigningResponse
+	err := decoder.Decode(&resp)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if err = validateSMTPSettings(settings); err != nil {
+	err = i.node.ImportSignatures(&resp)
+	if err == core.ErrSigningRequestNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		if signingRequestExported(w, err) {
+			return
+		}
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	_, err = i.node.Datastore.Settings().Get()
-	if err == nil {
-		ErrorResponse(w, http.StatusConflict, "Settings is already set. Use PUT.")
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) POSTSpendMany(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var snd SpendManyRequest
+	err := decoder.Decode(&snd)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	if settings.MisPaymentBuffer == nil {
-		i := float32(1)
-		settings.MisPaymentBuffer = &i
+	wal, ok := i.walletForCoin(w, snd.Coin)
+	if !ok {
+		return
 	}
-	if settings.BlockedNodes != nil {
-		var blockedIds []peer.ID
-		for _, pid := range *settings.BlockedNodes {
-			id, err := peer.IDB58Decode(pid)
-			if err != nil {
-				continue
-			}
-			blockedIds = append(blockedIds, id)
-		}
-		i.node.BanManager.SetBlockedIds(blockedIds)
+	var feeLevel wallet.FeeLevel
+	switch strings.ToUpper(snd.FeeLevel) {
+	case "PRIORITY":
+		feeLevel = wallet.PRIOIRTY
+	case "NORMAL":
+		feeLevel = wallet.NORMAL
+	case "ECONOMIC":
+		feeLevel = wallet.ECONOMIC
+	default:
+		feeLevel = wallet.NORMAL
 	}
-	if settings.StoreModerators != nil {
-		go i.node.NotifyModerators(*settings.StoreModerators)
-		if err := i.node.SetModeratorsOnListings(*settings.StoreModerators); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		}
-		if err := i.node.SeedNode(); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	txid, err := i.node.SpendMany(snd.Coin, snd.Outputs, snd.Memo, feeLevel)
+	if err != nil {
+		switch {
+		case signingRequestExported(w, err):
+			return
+		case err == wallet.ErrorInsuffientFunds:
+			ErrorResponse(w, http.StatusBadRequest, `ERROR_INSUFFICIENT_FUNDS`)
+			return
+		case err == wallet.ErrorDustAmount:
+			ErrorResponse(w, http.StatusBadRequest, `ERROR_DUST_AMOUNT`)
+			return
+		case err == bitcoin.ErrBatchSpendUnsupported:
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		case txid == nil:
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		default:
+			log.Errorf("Error saving batch payout memos: %s", err.Error())
 		}
 	}
-	err = i.node.Datastore.Settings().Put(settings)
+	confirmed, unconfirmed := wal.Balance()
+	txn, err := wal.GetTransaction(*txid)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	settings.Version = &i.node.UserAgent
-	ser, err := json.MarshalIndent(settings, "", "    ")
+	resp := &SpendResponse{
+		Txid:               txid.String(),
+		ConfirmedBalance:   confirmed,
+		UnconfirmedBalance: unconfirmed,
+		Amount:             -(txn.Value),
+		Timestamp:          txn.Timestamp,
+		Memo:               snd.Memo,
+	}
+	ser, err := json.MarshalIndent(resp, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 	SanitizedResponse(w, string(ser))
-	return
 }
 
-func (i *jsonAPIHandler) PUTSettings(w http.ResponseWriter, r *http.Request) {
-	var settings repo.SettingsData
+func (i *jsonAPIHandler) POSTEstimateSpendMany(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&settings)
+	var snd SpendManyRequest
+	err := decoder.Decode(&snd)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if err = validateSMTPSettings(settings); err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+	var feeLevel wallet.FeeLevel
+	switch strings.ToUpper(snd.FeeLevel) {
+	case "PRIORITY":
+		feeLevel = wallet.PRIOIRTY
+	case "NORMAL":
+		feeLevel = wallet.NORMAL
+	case "ECONOMIC":
+		feeLevel = wallet.ECONOMIC
+	default:
+		ErrorResponse(w, http.StatusBadRequest, "Unknown feeLevel")
 		return
 	}
-	_, err = i.node.Datastore.Settings().Get()
+	fee, err := i.node.EstimateSpendManyFee(snd.Coin, snd.Outputs, feeLevel)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, "Settings is not yet set. Use POST.")
-		return
-	}
-	if settings.BlockedNodes != nil {
-		var blockedIds []peer.ID
-		for _, pid := range *settings.BlockedNodes {
-			id, err := peer.IDB58Decode(pid)
-			if err != nil {
-				continue
-			}
-			blockedIds = append(blockedIds, id)
-		}
-		i.node.BanManager.SetBlockedIds(blockedIds)
-	}
-	if settings.StoreModerators != nil {
-		go i.node.NotifyModerators(*settings.StoreModerators)
-		if err := i.node.SetModeratorsOnListings(*settings.StoreModerators); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		}
-		if err := i.node.SeedNode(); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		switch {
+		case err == wallet.ErrorInsuffientFunds:
+			ErrorResponse(w, http.StatusBadRequest, `ERROR_INSUFFICIENT_FUNDS`)
+			return
+		case err == wallet.ErrorDustAmount:
+			ErrorResponse(w, http.StatusBadRequest, `ERROR_DUST_AMOUNT`)
+			return
+		default:
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		}
 	}
+	SanitizedResponse(w, fmt.Sprintf(`{"estimatedFee": %d}`, fee))
+}
 
-	err = i.node.Datastore.Settings().Put(settings)
+func (i *jsonAPIHandler) GETUtxos(w http.ResponseWriter, r *http.Request) {
+	utxos, err := i.node.ListUtxos(r.URL.Query().Get("coin"))
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, `{}`)
-	return
+	ret, err := json.MarshalIndent(utxos, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
 }
 
-func (i *jsonAPIHandler) GETSettings(w http.ResponseWriter, r *http.Request) {
-	settings, err := i.node.Datastore.Settings().Get()
+func (i *jsonAPIHandler) GETSweeps(w http.ResponseWriter, r *http.Request) {
+	sweeps, err := i.node.Datastore.Sweeps().GetAll(strings.ToUpper(r.URL.Query().Get("coin")))
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	settings.Version = &i.node.UserAgent
-	settingsJson, err := json.MarshalIndent(&settings, "", "    ")
+	ret, err := json.MarshalIndent(sweeps, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, string(settingsJson))
+	SanitizedResponse(w, string(ret))
 }
 
-func (i *jsonAPIHandler) PATCHSettings(w http.ResponseWriter, r *http.Request) {
-	var settings repo.SettingsData
-	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&settings)
-	if err != nil {
-		switch err.Error() {
-		case "Not Found":
-			ErrorResponse(w, http.StatusNotFound, err.Error())
-		default:
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
-		}
+func (i *jsonAPIHandler) GETExport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	format := strings.ToLower(query.Get("format"))
+	if format == "" {
+		format = "csv"
+	}
+	if format != "csv" && format != "ofx" {
+		ErrorResponse(w, http.StatusBadRequest, core.ErrUnknownExportFormat.Error())
 		return
 	}
-	if err = validateSMTPSettings(settings); err != nil {
+	from, to, err := core.ParseAccountingPeriod(query.Get("from"), query.Get("to"))
+	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if settings.StoreModerators != nil {
-		go i.node.NotifyModerators(*settings.StoreModerators)
-		if err := i.node.SetModeratorsOnListings(*settings.StoreModerators); err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		}
-		if err := i.node.SeedNode(); err != nil {
+	if _, ok := i.walletForCoin(w, query.Get("coin")); !ok {
+		return
+	}
+	entries, err := i.node.AccountingEntries(query.Get("coin"), query.Get("currency"), from, to)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	var buf bytes.Buffer
+	if err := core.WriteAccountingExport(&buf, format, entries); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if format == "ofx" {
+		w.Header().Set("Content-Type", "application/x-ofx")
+	} else {
+		w.Header().Set("Content-Type", "text/csv")
+	}
+	w.Header().Set("Content-Disposition", "attachment; filename=transactions."+format)
+	w.Write(buf.Bytes())
+}
+
+func (i *jsonAPIHandler) GETProfitAndLoss(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	from, to, err := core.ParseAccountingPeriod(query.Get("from"), query.Get("to"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	period := strings.ToLower(query.Get("period"))
+	if _, err := core.SummarizeProfitAndLoss(nil, period); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	pnl, err := i.node.ProfitAndLoss(strings.ToUpper(query.Get("currency")), from, to, period)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(pnl, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTUtxo(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var m UtxoMetadata
+	err := decoder.Decode(&m)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	err = i.node.SetUtxoMetadata(m.Coin, repo.UtxoMeta{Outpoint: m.Outpoint, Label: m.Label, Frozen: m.Frozen})
+	if err == core.ErrUtxoNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETConfig(w http.ResponseWriter, r *http.Request) {
+	testnet := false
+	if i.node.Wallet.Params().Name != chaincfg.MainNetParams.Name {
+		testnet = true
+	}
+	var usingTor bool
+	if i.node.TorDialer != nil {
+		usingTor = true
+	}
+	c := ConfigResponse{i.node.IpfsNode.Identity.Pretty(), strings.ToUpper(i.node.Wallet.CurrencyCode()), i.node.AcceptedCurrencies(), testnet, usingTor}
+	ser, err := json.MarshalIndent(c, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) POSTSettings(w http.ResponseWriter, r *http.Request) {
+	var settings repo.SettingsData
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&settings)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err = validateSMTPSettings(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err = validatePushSettings(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err = validateNotificationPreferences(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	_, err = i.node.Datastore.Settings().Get()
+	if err == nil {
+		ErrorResponse(w, http.StatusConflict, "Settings is already set. Use PUT.")
+		return
+	}
+
+	if settings.MisPaymentBuffer == nil {
+		i := float32(1)
+		settings.MisPaymentBuffer = &i
+	}
+	if settings.BlockedNodes != nil {
+		var blockedIds []peer.ID
+		for _, pid := range *settings.BlockedNodes {
+			id, err := peer.IDB58Decode(pid)
+			if err != nil {
+				continue
+			}
+			blockedIds = append(blockedIds, id)
+		}
+		i.node.BanManager.SetBlockedIds(blockedIds)
+	}
+	if settings.StoreModerators != nil {
+		go i.node.NotifyModerators(*settings.StoreModerators)
+		if err := i.node.SetModeratorsOnListings(*settings.StoreModerators); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		}
+		if err := i.node.SeedNode(); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		}
+	}
+	err = i.node.Datastore.Settings().Put(settings)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	settings.Version = &i.node.UserAgent
+	ser, err := json.MarshalIndent(settings, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+	return
+}
+
+func (i *jsonAPIHandler) PUTSettings(w http.ResponseWriter, r *http.Request) {
+	var settings repo.SettingsData
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&settings)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err = validateSMTPSettings(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err = validatePushSettings(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err = validateNotificationPreferences(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	_, err = i.node.Datastore.Settings().Get()
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Settings is not yet set. Use POST.")
+		return
+	}
+	if settings.BlockedNodes != nil {
+		var blockedIds []peer.ID
+		for _, pid := range *settings.BlockedNodes {
+			id, err := peer.IDB58Decode(pid)
+			if err != nil {
+				continue
+			}
+			blockedIds = append(blockedIds, id)
+		}
+		i.node.BanManager.SetBlockedIds(blockedIds)
+	}
+	if settings.StoreModerators != nil {
+		go i.node.NotifyModerators(*settings.StoreModerators)
+		if err := i.node.SetModeratorsOnListings(*settings.StoreModerators); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		}
+		if err := i.node.SeedNode(); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	err = i.node.Datastore.Settings().Put(settings)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+	return
+}
+
+func (i *jsonAPIHandler) GETSettings(w http.ResponseWriter, r *http.Request) {
+	settings, err := i.node.Datastore.Settings().Get()
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	settings.Version = &i.node.UserAgent
+	settingsJson, err := json.MarshalIndent(&settings, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(settingsJson))
+}
+
+func (i *jsonAPIHandler) PATCHSettings(w http.ResponseWriter, r *http.Request) {
+	var settings repo.SettingsData
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&settings)
+	if err != nil {
+		switch err.Error() {
+		case "Not Found":
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+		default:
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+		}
+		return
+	}
+	if err = validateSMTPSettings(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err = validatePushSettings(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err = validateNotificationPreferences(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if settings.StoreModerators != nil {
+		go i.node.NotifyModerators(*settings.StoreModerators)
+		if err := i.node.SetModeratorsOnListings(*settings.StoreModerators); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		}
+		if err := i.node.SeedNode(); err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		}
 	}
@@ -1116,13 +1484,24 @@ func (i *jsonAPIHandler) GETClosestPeers(w http.ResponseWriter, r *http.Request)
 
 func (i *jsonAPIHandler) GETExchangeRate(w http.ResponseWriter, r *http.Request) {
 	_, currencyCode := path.Split(r.URL.Path)
+	sources, withSources := i.node.ExchangeRates.(bitcoin.ExchangeRateSources)
+	withSources = withSources && r.URL.Query().Get("sources") == "true"
 	if currencyCode == "" || strings.ToLower(currencyCode) == "exchangerate" {
 		currencyMap, err := i.node.ExchangeRates.GetAllRates(true)
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		exchangeRateJson, err := json.MarshalIndent(currencyMap, "", "    ")
+		var rates interface{} = currencyMap
+		if withSources {
+			m := make(map[string]ExchangeRateSources)
+			for code, rate := range currencyMap {
+				names, updated := sources.GetSources(code)
+				m[code] = ExchangeRateSources{rate, names, updated}
+			}
+			rates = m
+		}
+		exchangeRateJson, err := json.MarshalIndent(rates, "", "    ")
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -1135,24 +1514,29 @@ func (i *jsonAPIHandler) GETExchangeRate(w http.ResponseWriter, r *http.Request)
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		if withSources {
+			names, updated := sources.GetSources(strings.ToUpper(currencyCode))
+			ret, err := json.MarshalIndent(ExchangeRateSources{rate, names, updated}, "", "    ")
+			if err != nil {
+				ErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			SanitizedResponse(w, string(ret))
+			return
+		}
 		fmt.Fprintf(w, `%.2f`, rate)
 	}
 }
 
 func (i *jsonAPIHandler) GETFollowers(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePage(r.URL.Query())
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	_, peerId := path.Split(r.URL.Path)
 	if peerId == "" || strings.ToLower(peerId) == "followers" || peerId == i.node.IpfsNode.Identity.Pretty() {
-		offset := r.URL.Query().Get("offsetId")
-		limit := r.URL.Query().Get("limit")
-		if limit == "" {
-			limit = "-1"
-		}
-		l, err := strconv.ParseInt(limit, 10, 32)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-		followers, err := i.node.Datastore.Followers().Get(offset, int(l))
+		followers, err := i.node.Datastore.Followers().Get(page.OffsetID, page.Limit)
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -1169,6 +1553,7 @@ func (i *jsonAPIHandler) GETFollowers(w http.ResponseWriter, r *http.Request) {
 		if string(ret) == "null" {
 			ret = []byte("[]")
 		}
+		w.Header().Set(totalCountHeader, strconv.Itoa(i.node.Datastore.Followers().Count()))
 		SanitizedResponse(w, string(ret))
 	} else {
 		pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
@@ -1192,7 +1577,8 @@ func (i *jsonAPIHandler) GETFollowers(w http.ResponseWriter, r *http.Request) {
 		for _, f := range followers {
 			followList = append(followList, f.PeerId)
 		}
-		ret, err := json.MarshalIndent(followList, "", "    ")
+		start, end := page.Bounds(followList)
+		ret, err := json.MarshalIndent(followList[start:end], "", "    ")
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -1201,24 +1587,20 @@ func (i *jsonAPIHandler) GETFollowers(w http.ResponseWriter, r *http.Request) {
 			ret = []byte("[]")
 		}
 		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
+		w.Header().Set(totalCountHeader, strconv.Itoa(len(followList)))
 		SanitizedResponse(w, string(ret))
 	}
 }
 
 func (i *jsonAPIHandler) GETFollowing(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePage(r.URL.Query())
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	_, peerId := path.Split(r.URL.Path)
 	if peerId == "" || strings.ToLower(peerId) == "following" || peerId == i.node.IpfsNode.Identity.Pretty() {
-		offset := r.URL.Query().Get("offsetId")
-		limit := r.URL.Query().Get("limit")
-		if limit == "" {
-			limit = "-1"
-		}
-		l, err := strconv.ParseInt(limit, 10, 32)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-		followers, err := i.node.Datastore.Following().Get(offset, int(l))
+		followers, err := i.node.Datastore.Following().Get(page.OffsetID, page.Limit)
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -1227,6 +1609,7 @@ func (i *jsonAPIHandler) GETFollowing(w http.ResponseWriter, r *http.Request) {
 		if string(ret) == "null" {
 			ret = []byte("[]")
 		}
+		w.Header().Set(totalCountHeader, strconv.Itoa(i.node.Datastore.Following().Count()))
 		SanitizedResponse(w, string(ret))
 	} else {
 		pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
@@ -1240,26 +1623,37 @@ func (i *jsonAPIHandler) GETFollowing(w http.ResponseWriter, r *http.Request) {
 			ErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
-		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
-		SanitizedResponse(w, string(followBytes))
-	}
-}
-
-func (i *jsonAPIHandler) GETInventory(w http.ResponseWriter, r *http.Request) {
-	type inv struct {
-		Slug     string `json:"slug"`
-		Variant  int    `json:"variant"`
-		Quantity int    `json:"quantity"`
-	}
-	var invList []inv
-	inventory, err := i.node.Datastore.Inventory().GetAll()
+		var following []string
+		err = json.Unmarshal(followBytes, &following)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		start, end := page.Bounds(following)
+		ret, err := json.MarshalIndent(following[start:end], "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if string(ret) == "null" {
+			ret = []byte("[]")
+		}
+		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
+		w.Header().Set(totalCountHeader, strconv.Itoa(len(following)))
+		SanitizedResponse(w, string(ret))
+	}
+}
+
+func (i *jsonAPIHandler) GETInventory(w http.ResponseWriter, r *http.Request) {
+	var invList []InventoryEntry
+	inventory, err := i.node.Datastore.Inventory().GetAll()
 	if err != nil {
 		fmt.Fprint(w, `[]`)
 		return
 	}
 	for slug, m := range inventory {
 		for variant, count := range m {
-			i := inv{slug, variant, count}
+			i := InventoryEntry{slug, variant, count}
 			invList = append(invList, i)
 		}
 	}
@@ -1273,13 +1667,8 @@ func (i *jsonAPIHandler) GETInventory(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTInventory(w http.ResponseWriter, r *http.Request) {
-	type inv struct {
-		Slug     string `json:"slug"`
-		Variant  int    `json:"variant"`
-		Quantity int    `json:"quantity"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var invList []inv
+	var invList []InventoryEntry
 	err := decoder.Decode(&invList)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -1366,14 +1755,19 @@ func (i *jsonAPIHandler) DELETEModerator(w http.ResponseWriter, r *http.Request)
 }
 
 func (i *jsonAPIHandler) GETListings(w http.ResponseWriter, r *http.Request) {
+	query, err := parseListingQuery(r.URL.Query())
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var listingsBytes []byte
 	_, peerId := path.Split(r.URL.Path)
 	if peerId == "" || strings.ToLower(peerId) == "listings" || peerId == i.node.IpfsNode.Identity.Pretty() {
-		listingsBytes, err := i.node.GetListings()
+		listingsBytes, err = i.node.GetListings()
 		if err != nil {
 			ErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
-		SanitizedResponse(w, string(listingsBytes))
 	} else {
 		pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
 		if err != nil {
@@ -1381,14 +1775,36 @@ func (i *jsonAPIHandler) GETListings(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		peerId = pid.Pretty()
-		listingsBytes, err := i.node.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerId, "listings.json")), time.Minute)
+		listingsBytes, err = i.node.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerId, "listings.json")), time.Minute)
 		if err != nil {
 			ErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
-		SanitizedResponse(w, string(listingsBytes))
 		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
 	}
+
+	var index []core.ListingData
+	err = json.Unmarshal(listingsBytes, &index)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	listings, total, err := core.QueryListings(index, query)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	slugs := make([]string, len(listings))
+	for n, l := range listings {
+		slugs[n] = l.Slug
+	}
+	ret, err := indexPage(listingsBytes, slugs)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set(totalCountHeader, strconv.Itoa(total))
+	SanitizedResponse(w, string(ret))
 }
 
 func (i *jsonAPIHandler) GETListing(w http.ResponseWriter, r *http.Request) {
@@ -1557,12 +1973,8 @@ func (i *jsonAPIHandler) GETIsFollowing(w http.ResponseWriter, r *http.Request)
 }
 
 func (i *jsonAPIHandler) POSTOrderConfirmation(w http.ResponseWriter, r *http.Request) {
-	type orderConf struct {
-		OrderId string `json:"orderId"`
-		Reject  bool   `json:"reject"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var conf orderConf
+	var conf OrderConfirmationRequest
 	err := decoder.Decode(&conf)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -1584,12 +1996,18 @@ func (i *jsonAPIHandler) POSTOrderConfirmation(w http.ResponseWriter, r *http.Re
 	if !conf.Reject {
 		err := i.node.ConfirmOfflineOrder(contract, records)
 		if err != nil {
+			if signingRequestExported(w, err) {
+				return
+			}
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 	} else {
 		err := i.node.RejectOfflineOrder(contract, records)
 		if err != nil {
+			if signingRequestExported(w, err) {
+				return
+			}
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
@@ -1599,17 +2017,14 @@ func (i *jsonAPIHandler) POSTOrderConfirmation(w http.ResponseWriter, r *http.Re
 }
 
 func (i *jsonAPIHandler) POSTOrderCancel(w http.ResponseWriter, r *http.Request) {
-	type orderCancel struct {
-		OrderId string `json:"orderId"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var can orderCancel
+	var can OrderRequest
 	err := decoder.Decode(&can)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	contract, state, _, records, _, err := i.node.Datastore.Purchases().GetByOrderId(can.OrderId)
+	contract, state, _, records, _, err := i.node.Datastore.Purchases().GetByOrderId(can.OrderID)
 	if err != nil {
 		ErrorResponse(w, http.StatusNotFound, "order not found")
 		return
@@ -1629,12 +2044,16 @@ func (i *jsonAPIHandler) POSTOrderCancel(w http.ResponseWriter, r *http.Request)
 }
 
 func (i *jsonAPIHandler) POSTResyncBlockchain(w http.ResponseWriter, r *http.Request) {
+	wal, ok := i.walletForCoin(w, r.URL.Query().Get("coin"))
+	if !ok {
+		return
+	}
 	creationDate, err := i.node.Datastore.Config().GetCreationDate()
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	i.node.Wallet.ReSyncBlockchain(creationDate)
+	wal.ReSyncBlockchain(creationDate)
 	SanitizedResponse(w, `{}`)
 	return
 }
@@ -1670,6 +2089,9 @@ func (i *jsonAPIHandler) GETOrder(w http.ResponseWriter, r *http.Request) {
 	}
 	resp.PaymentAddressTransactions = paymentTxs
 	resp.RefundAddressTransaction = refundTx
+	if isSale {
+		resp.CanAccelerate = i.node.CanAccelerateSalePayment(contract, records)
+	}
 
 	unread, err := i.node.Datastore.Chat().GetUnreadCount(orderId)
 	if err != nil {
@@ -1705,7 +2127,7 @@ func (i *jsonAPIHandler) POSTShutdown(w http.ResponseWriter, r *http.Request) {
 			core.Node.Datastore.Close()
 			repoLockFile := filepath.Join(core.Node.RepoPath, lockfile.LockFile)
 			os.Remove(repoLockFile)
-			core.Node.Wallet.Close()
+			core.Node.CloseWallets()
 			core.Node.IpfsNode.Close()
 		}
 		os.Exit(1)
@@ -1716,17 +2138,14 @@ func (i *jsonAPIHandler) POSTShutdown(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
-	type orderCancel struct {
-		OrderId string `json:"orderId"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var can orderCancel
+	var can OrderRequest
 	err := decoder.Decode(&can)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	contract, state, _, records, _, err := i.node.Datastore.Sales().GetByOrderId(can.OrderId)
+	contract, state, _, records, _, err := i.node.Datastore.Sales().GetByOrderId(can.OrderID)
 	if err != nil {
 		ErrorResponse(w, http.StatusNotFound, "order not found")
 		return
@@ -1736,7 +2155,14 @@ func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	err = i.node.RefundOrder(contract, records)
+	if err == core.ErrLightningRefund {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err != nil {
+		if signingRequestExported(w, err) {
+			return
+		}
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -1925,6 +2351,9 @@ func (i *jsonAPIHandler) POSTOrderFulfill(w http.ResponseWriter, r *http.Request
 	}
 	err = i.node.FulfillOrder(&fulfill, contract, records)
 	if err != nil {
+		if signingRequestExported(w, err) {
+			return
+		}
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -1992,12 +2421,8 @@ func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Reques
 }
 
 func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request) {
-	type dispute struct {
-		OrderID string `json:"orderId"`
-		Claim   string `json:"claim"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var d dispute
+	var d OpenDisputeRequest
 	err := decoder.Decode(&d)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -2040,14 +2465,8 @@ func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request)
 }
 
 func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request) {
-	type dispute struct {
-		OrderID          string  `json:"orderId"`
-		Resolution       string  `json:"resolution"`
-		BuyerPercentage  float32 `json:"buyerPercentage"`
-		VendorPercentage float32 `json:"vendorPercentage"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var d dispute
+	var d CloseDisputeRequest
 	err := decoder.Decode(&d)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -2115,11 +2534,8 @@ func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTReleaseFunds(w http.ResponseWriter, r *http.Request) {
-	type release struct {
-		OrderID string `json:"orderId"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var rel release
+	var rel OrderRequest
 	err := decoder.Decode(&rel)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -2139,6 +2555,9 @@ func (i *jsonAPIHandler) POSTReleaseFunds(w http.ResponseWriter, r *http.Request
 	if state == pb.OrderState_DECIDED {
 		err = i.node.ReleaseFunds(contract, records)
 		if err != nil {
+			if signingRequestExported(w, err) {
+				return
+			}
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
@@ -2151,11 +2570,8 @@ func (i *jsonAPIHandler) POSTReleaseFunds(w http.ResponseWriter, r *http.Request
 }
 
 func (i *jsonAPIHandler) POSTReleaseEscrow(w http.ResponseWriter, r *http.Request) {
-	type release struct {
-		OrderID string `json:"orderId"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var rel release
+	var rel OrderRequest
 	err := decoder.Decode(&rel)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -2178,6 +2594,9 @@ func (i *jsonAPIHandler) POSTReleaseEscrow(w http.ResponseWriter, r *http.Reques
 	if isSale && state == pb.OrderState_FULFILLED {
 		err = i.node.ReleaseFundsAfterTimeout(contract, records)
 		if err != nil {
+			if signingRequestExported(w, err) {
+				return
+			}
 			if err == core.EscrowTimeLockedError {
 				ErrorResponse(w, http.StatusUnauthorized, err.Error())
 				return
@@ -2335,17 +2754,12 @@ func (i *jsonAPIHandler) GETChatMessages(w http.ResponseWriter, r *http.Request)
 	if strings.ToLower(peerId) == "chatmessages" {
 		peerId = ""
 	}
-	limit := r.URL.Query().Get("limit")
-	if limit == "" {
-		limit = "-1"
-	}
-	l, err := strconv.Atoi(limit)
+	page, err := parsePage(r.URL.Query())
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	offsetId := r.URL.Query().Get("offsetId")
-	messages := i.node.Datastore.Chat().GetMessages(peerId, r.URL.Query().Get("subject"), offsetId, int(l))
+	messages := i.node.Datastore.Chat().GetMessages(peerId, r.URL.Query().Get("subject"), page.OffsetID, page.Limit)
 
 	ret, err := json.MarshalIndent(messages, "", "    ")
 	if err != nil {
@@ -2427,32 +2841,14 @@ func (i *jsonAPIHandler) DELETEChatConversation(w http.ResponseWriter, r *http.R
 }
 
 func (i *jsonAPIHandler) GETNotifications(w http.ResponseWriter, r *http.Request) {
-	limit := r.URL.Query().Get("limit")
-	if limit == "" {
-		limit = "-1"
-	}
-	l, err := strconv.Atoi(limit)
+	page, err := parsePage(r.URL.Query())
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	offsetId := r.URL.Query().Get("offsetId")
-	filter := r.URL.Query().Get("filter")
-
-	types := strings.Split(filter, ",")
-	var filters []string
-	for _, t := range types {
-		if t != "" {
-			filters = append(filters, t)
-		}
-	}
+	filters := parseList(r.URL.Query(), "filter")
 
-	type notifData struct {
-		Unread        int                          `json:"unread"`
-		Total         int                          `json:"total"`
-		Notifications []notifications.Notification `json:"notifications"`
-	}
-	notifs, total, err := i.node.Datastore.Notifications().GetAll(offsetId, int(l), filters)
+	notifs, total, err := i.node.Datastore.Notifications().GetAll(page.OffsetID, page.Limit, filters)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -2463,7 +2859,7 @@ func (i *jsonAPIHandler) GETNotifications(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	ret, err := json.MarshalIndent(notifData{unread, total, notifs}, "", "    ")
+	ret, err := json.MarshalIndent(NotificationsResponse{unread, total, notifs}, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -2472,6 +2868,7 @@ func (i *jsonAPIHandler) GETNotifications(w http.ResponseWriter, r *http.Request
 	if strings.Contains(retString, "null") {
 		retString = strings.Replace(retString, "null", "[]", -1)
 	}
+	w.Header().Set(totalCountHeader, strconv.Itoa(total))
 	SanitizedResponse(w, retString)
 	return
 }
@@ -2674,30 +3071,16 @@ func (i *jsonAPIHandler) POSTFetchProfiles(w http.ResponseWriter, r *http.Reques
 }
 
 func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request) {
-	l := r.URL.Query().Get("limit")
-	if l == "" {
-		l = "-1"
+	wal, ok := i.walletForCoin(w, r.URL.Query().Get("coin"))
+	if !ok {
+		return
 	}
-	limit, err := strconv.Atoi(l)
+	query, err := parseWalletTransactionQuery(r.URL.Query())
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	offsetID := r.URL.Query().Get("offsetId")
-	type Tx struct {
-		Txid          string    `json:"txid"`
-		Value         int64     `json:"value"`
-		Address       string    `json:"address"`
-		Status        string    `json:"status"`
-		Memo          string    `json:"memo"`
-		Timestamp     time.Time `json:"timestamp"`
-		Confirmations int32     `json:"confirmations"`
-		Height        int32     `json:"height"`
-		OrderId       string    `json:"orderId"`
-		Thumbnail     string    `json:"thumbnail"`
-		CanBumpFee    bool      `json:"canBumpFee"`
-	}
-	transactions, err := i.node.Wallet.Transactions()
+	transactions, err := wal.Transactions()
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -2707,9 +3090,8 @@ func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request)
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	height, _ := i.node.Wallet.ChainTip()
-	var txs []Tx
-	passedOffset := false
+	height, _ := wal.ChainTip()
+	var txs []WalletTransaction
 	for i := len(transactions) - 1; i >= 0; i-- {
 		t := transactions[i]
 		var confirmations int32
@@ -2732,7 +3114,7 @@ func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request)
 			status = "CONFIRMED"
 			confirmations = confs
 		}
-		tx := Tx{
+		tx := WalletTransaction{
 			Txid:          t.Txid,
 			Value:         t.Value,
 			Timestamp:     t.Timestamp,
@@ -2752,26 +3134,28 @@ func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request)
 		if status == "DEAD" {
 			tx.CanBumpFee = false
 		}
-		if offsetID == "" || passedOffset {
+		if query.Matches(status, t.Timestamp) {
 			txs = append(txs, tx)
 		}
-		if t.Txid == offsetID {
-			passedOffset = true
-		}
-		if len(txs) >= limit && limit != -1 {
-			break
-		}
 	}
-	type txWithCount struct {
-		Transactions []Tx `json:"transactions"`
-		Count        int  `json:"count"`
+	switch query.SortBy {
+	case repo.SortDateAsc:
+		sort.SliceStable(txs, func(i, j int) bool { return txs[i].Timestamp.Before(txs[j].Timestamp) })
+	case repo.SortDateDesc:
+		sort.SliceStable(txs, func(i, j int) bool { return txs[i].Timestamp.After(txs[j].Timestamp) })
+	}
+	txids := make([]string, len(txs))
+	for i, tx := range txs {
+		txids[i] = tx.Txid
 	}
-	txns := txWithCount{txs, len(transactions)}
+	start, end := query.Bounds(txids)
+	txns := TransactionsResponse{txs[start:end], len(txs)}
 	ret, err := json.MarshalIndent(txns, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	w.Header().Set(totalCountHeader, strconv.Itoa(len(txs)))
 	SanitizedResponse(w, string(ret))
 }
 
@@ -2793,11 +3177,7 @@ func (i *jsonAPIHandler) GETPurchases(w http.ResponseWriter, r *http.Request) {
 		}
 		purchases[n].UnreadChatMessages = unread
 	}
-	type purchasesResponse struct {
-		QueryCount int             `json:"queryCount"`
-		Purchases  []repo.Purchase `json:"purchases"`
-	}
-	pr := purchasesResponse{queryCount, purchases}
+	pr := PurchasesResponse{queryCount, purchases}
 	ret, err := json.MarshalIndent(pr, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -2806,6 +3186,7 @@ func (i *jsonAPIHandler) GETPurchases(w http.ResponseWriter, r *http.Request) {
 	if string(ret) == "null" {
 		ret = []byte("[]")
 	}
+	w.Header().Set(totalCountHeader, strconv.Itoa(queryCount))
 	SanitizedResponse(w, string(ret))
 	return
 }
@@ -2828,11 +3209,7 @@ func (i *jsonAPIHandler) GETSales(w http.ResponseWriter, r *http.Request) {
 		}
 		sales[n].UnreadChatMessages = unread
 	}
-	type salesResponse struct {
-		QueryCount int         `json:"queryCount"`
-		Sales      []repo.Sale `json:"sales"`
-	}
-	sr := salesResponse{queryCount, sales}
+	sr := SalesResponse{queryCount, sales}
 
 	ret, err := json.MarshalIndent(sr, "", "    ")
 	if err != nil {
@@ -2842,6 +3219,7 @@ func (i *jsonAPIHandler) GETSales(w http.ResponseWriter, r *http.Request) {
 	if string(ret) == "null" {
 		ret = []byte("[]")
 	}
+	w.Header().Set(totalCountHeader, strconv.Itoa(queryCount))
 	SanitizedResponse(w, string(ret))
 	return
 }
@@ -2864,11 +3242,7 @@ func (i *jsonAPIHandler) GETCases(w http.ResponseWriter, r *http.Request) {
 		}
 		cases[n].UnreadChatMessages = unread
 	}
-	type casesResponse struct {
-		QueryCount int         `json:"queryCount"`
-		Cases      []repo.Case `json:"cases"`
-	}
-	cr := casesResponse{queryCount, cases}
+	cr := CasesResponse{queryCount, cases}
 	ret, err := json.MarshalIndent(cr, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -2877,6 +3251,7 @@ func (i *jsonAPIHandler) GETCases(w http.ResponseWriter, r *http.Request) {
 	if string(ret) == "null" {
 		ret = []byte("[]")
 	}
+	w.Header().Set(totalCountHeader, strconv.Itoa(queryCount))
 	SanitizedResponse(w, string(ret))
 	return
 }
@@ -2901,11 +3276,7 @@ func (i *jsonAPIHandler) POSTPurchases(w http.ResponseWriter, r *http.Request) {
 		}
 		purchases[n].UnreadChatMessages = unread
 	}
-	type purchasesResponse struct {
-		QueryCount int             `json:"queryCount"`
-		Purchases  []repo.Purchase `json:"purchases"`
-	}
-	pr := purchasesResponse{queryCount, purchases}
+	pr := PurchasesResponse{queryCount, purchases}
 	ret, err := json.MarshalIndent(pr, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -2914,6 +3285,7 @@ func (i *jsonAPIHandler) POSTPurchases(w http.ResponseWriter, r *http.Request) {
 	if string(ret) == "null" {
 		ret = []byte("[]")
 	}
+	w.Header().Set(totalCountHeader, strconv.Itoa(queryCount))
 	SanitizedResponse(w, string(ret))
 	return
 }
@@ -2938,11 +3310,7 @@ func (i *jsonAPIHandler) POSTSales(w http.ResponseWriter, r *http.Request) {
 		}
 		sales[n].UnreadChatMessages = unread
 	}
-	type salesResponse struct {
-		QueryCount int         `json:"queryCount"`
-		Sales      []repo.Sale `json:"sales"`
-	}
-	sr := salesResponse{queryCount, sales}
+	sr := SalesResponse{queryCount, sales}
 
 	ret, err := json.MarshalIndent(sr, "", "    ")
 	if err != nil {
@@ -2952,6 +3320,7 @@ func (i *jsonAPIHandler) POSTSales(w http.ResponseWriter, r *http.Request) {
 	if string(ret) == "null" {
 		ret = []byte("[]")
 	}
+	w.Header().Set(totalCountHeader, strconv.Itoa(queryCount))
 	SanitizedResponse(w, string(ret))
 	return
 }
@@ -2976,11 +3345,7 @@ func (i *jsonAPIHandler) POSTCases(w http.ResponseWriter, r *http.Request) {
 		}
 		cases[n].UnreadChatMessages = unread
 	}
-	type casesResponse struct {
-		QueryCount int         `json:"queryCount"`
-		Cases      []repo.Case `json:"cases"`
-	}
-	cr := casesResponse{queryCount, cases}
+	cr := CasesResponse{queryCount, cases}
 	ret, err := json.MarshalIndent(cr, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -2989,6 +3354,7 @@ func (i *jsonAPIHandler) POSTCases(w http.ResponseWriter, r *http.Request) {
 	if string(ret) == "null" {
 		ret = []byte("[]")
 	}
+	w.Header().Set(totalCountHeader, strconv.Itoa(queryCount))
 	SanitizedResponse(w, string(ret))
 	return
 }
@@ -3056,13 +3422,17 @@ func (i *jsonAPIHandler) DELETEBlockNode(w http.ResponseWriter, r *http.Request)
 }
 
 func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
+	wal, ok := i.walletForCoin(w, r.URL.Query().Get("coin"))
+	if !ok {
+		return
+	}
 	_, txid := path.Split(r.URL.Path)
 	txHash, err := chainhash.NewHashFromStr(txid)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	newTxid, err := i.node.Wallet.BumpFee(*txHash)
+	newTxid, err := wal.BumpFee(*txHash)
 	if err != nil {
 		if err == spvwallet.BumpFeeAlreadyConfirmedError {
 			ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -3096,21 +3466,13 @@ func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	type response struct {
-		Txid               string    `json:"txid"`
-		Amount             int64     `json:"amount"`
-		ConfirmedBalance   int64     `json:"confirmedBalance"`
-		UnconfirmedBalance int64     `json:"unconfirmedBalance"`
-		Timestamp          time.Time `json:"timestamp"`
-		Memo               string    `json:"memo"`
-	}
-	confirmed, unconfirmed := i.node.Wallet.Balance()
-	txn, err := i.node.Wallet.GetTransaction(*newTxid)
+	confirmed, unconfirmed := wal.Balance()
+	txn, err := wal.GetTransaction(*newTxid)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	resp := &response{
+	resp := &SpendResponse{
 		Txid:               newTxid.String(),
 		ConfirmedBalance:   confirmed,
 		UnconfirmedBalance: unconfirmed,
@@ -3126,7 +3488,32 @@ func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(ser))
 }
 
+func (i *jsonAPIHandler) POSTAcceleratePayment(w http.ResponseWriter, r *http.Request) {
+	_, orderId := path.Split(r.URL.Path)
+	if _, _, _, _, _, err := i.node.Datastore.Sales().GetByOrderId(orderId); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Sale not found")
+		return
+	}
+	txid, err := i.node.AccelerateSalePayment(orderId)
+	switch {
+	case err == core.ErrAccelerateModerated || err == core.ErrAccelerateColdStorage:
+		ErrorResponse(w, http.StatusMethodNotAllowed, err.Error())
+		return
+	case err == core.ErrNoStuckPayment:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	case err != nil:
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, fmt.Sprintf(`{"txid": "%s"}`, txid.String()))
+}
+
 func (i *jsonAPIHandler) GETEstimateFee(w http.ResponseWriter, r *http.Request) {
+	wal, ok := i.walletForCoin(w, r.URL.Query().Get("coin"))
+	if !ok {
+		return
+	}
 	fl := r.URL.Query().Get("feeLevel")
 	amt := r.URL.Query().Get("amount")
 	amount, err := strconv.Atoi(amt)
@@ -3148,7 +3535,7 @@ func (i *jsonAPIHandler) GETEstimateFee(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	fee, err := i.node.Wallet.EstimateSpendFee(int64(amount), feeLevel)
+	fee, err := wal.EstimateSpendFee(int64(amount), feeLevel)
 	if err != nil {
 		switch {
 		case err == wallet.ErrorInsuffientFunds:
@@ -3167,9 +3554,13 @@ func (i *jsonAPIHandler) GETEstimateFee(w http.ResponseWriter, r *http.Request)
 }
 
 func (i *jsonAPIHandler) GETFees(w http.ResponseWriter, r *http.Request) {
-	priority := i.node.Wallet.GetFeePerByte(wallet.PRIOIRTY)
-	normal := i.node.Wallet.GetFeePerByte(wallet.NORMAL)
-	economic := i.node.Wallet.GetFeePerByte(wallet.ECONOMIC)
+	wal, ok := i.walletForCoin(w, r.URL.Query().Get("coin"))
+	if !ok {
+		return
+	}
+	priority := wal.GetFeePerByte(wallet.PRIOIRTY)
+	normal := wal.GetFeePerByte(wallet.NORMAL)
+	economic := wal.GetFeePerByte(wallet.ECONOMIC)
 	fmt.Fprintf(w, `{"priority": %d, "normal": %d, "economic": %d}`, int(priority), int(normal), int(economic))
 	return
 }
@@ -3192,6 +3583,11 @@ func (i *jsonAPIHandler) POSTEstimateTotal(w http.ResponseWriter, r *http.Reques
 }
 
 func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
+	page, err := parsePage(r.URL.Query())
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	urlPath, slug := path.Split(r.URL.Path)
 	_, peerId := path.Split(urlPath[:len(urlPath)-1])
 
@@ -3215,12 +3611,13 @@ func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		w.Header().Set(totalCountHeader, "0")
 		SanitizedResponse(w, string(ret))
 		return
 	}
 
 	var ratingList []core.SavedRating
-	err := json.Unmarshal(indexBytes, &ratingList)
+	err = json.Unmarshal(indexBytes, &ratingList)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -3234,33 +3631,46 @@ func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 		}
+		// The count and average cover every rating while the hashes are paged
+		total := len(rating.Ratings)
+		start, end := page.Bounds(rating.Ratings)
+		rating.Ratings = rating.Ratings[start:end]
+		if rating.Ratings == nil {
+			rating.Ratings = []string{}
+		}
 		ret, err := json.MarshalIndent(rating, "", "    ")
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		w.Header().Set(totalCountHeader, strconv.Itoa(total))
 		SanitizedResponse(w, string(ret))
 	} else {
-		type resp struct {
-			Count   int      `json:"count"`
-			Average float32  `json:"average"`
-			Ratings []string `json:"ratings"`
-		}
-		ratingRet := new(resp)
+		ratingRet := new(RatingsResponse)
 		total := float32(0)
 		count := 0
 		for _, r := range ratingList {
 			total += r.Average * float32(r.Count)
 			count += r.Count
 			ratingRet.Ratings = append(ratingRet.Ratings, r.Ratings...)
+			for ratingHash, replyHash := range r.Replies {
+				if ratingRet.Replies == nil {
+					ratingRet.Replies = make(map[string]string)
+				}
+				ratingRet.Replies[ratingHash] = replyHash
+			}
 		}
 		ratingRet.Count = count
 		ratingRet.Average = total / float32(count)
+		hashCount := len(ratingRet.Ratings)
+		start, end := page.Bounds(ratingRet.Ratings)
+		ratingRet.Ratings = ratingRet.Ratings[start:end]
 		ret, err := json.MarshalIndent(ratingRet, "", "    ")
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		w.Header().Set(totalCountHeader, strconv.Itoa(hashCount))
 		SanitizedResponse(w, string(ret))
 	}
 }
@@ -3285,7 +3695,25 @@ func (i *jsonAPIHandler) GETRating(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusExpectationFailed, err.Error())
 		return
 	}
-	ret, err := json.MarshalIndent(rating, "", "    ")
+	resp := RatingResponse{Rating: rating}
+
+	/* Our own reply and amendment are read from disk. Those of another vendor take an IPNS lookup
+	   each, which can time out when they don't exist, so they are only fetched when asked for. */
+	vendorID := rating.RatingData.VendorID.PeerID
+	if vendorID == i.node.IpfsNode.Identity.Pretty() || r.URL.Query().Get("replies") == "true" {
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			resp.Reply, _ = i.node.GetRatingReply(vendorID, ratingID)
+		}()
+		go func() {
+			defer wg.Done()
+			resp.Amendment, _ = i.node.GetRatingAmendment(vendorID, ratingID)
+		}()
+		wg.Wait()
+	}
+	ret, err := json.MarshalIndent(resp, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -3450,13 +3878,7 @@ func (i *jsonAPIHandler) POSTImportListings(w http.ResponseWriter, r *http.Reque
 }
 
 func (i *jsonAPIHandler) GETHealthCheck(w http.ResponseWriter, r *http.Request) {
-	type resp struct {
-		Database bool `json:"database"`
-		IPFSRoot bool `json:"ipfsRoot"`
-		Peers    bool `json:"peers"`
-	}
-
-	re := resp{true, true, true}
+	re := HealthCheckResponse{true, true, true}
 	pingErr := i.node.Datastore.Ping()
 	if pingErr != nil {
 		re.Database = false
@@ -3509,12 +3931,12 @@ func (i *jsonAPIHandler) POSTPurgeCache(w http.ResponseWriter, r *http.Request)
 }
 
 func (i *jsonAPIHandler) GETWalletStatus(w http.ResponseWriter, r *http.Request) {
-	height, hash := i.node.Wallet.ChainTip()
-	type status struct {
-		Height   uint32 `json:"height"`
-		BestHash string `json:"bestHash"`
+	wal, ok := i.walletForCoin(w, r.URL.Query().Get("coin"))
+	if !ok {
+		return
 	}
-	hh := status{height, hash.String()}
+	height, hash := wal.ChainTip()
+	hh := WalletStatusResponse{height, hash.String()}
 	ret, err := json.MarshalIndent(&hh, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -3563,11 +3985,6 @@ func (i *jsonAPIHandler) GETIPNS(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	type KeyAndRecord struct {
-		Pubkey           string `json:"pubkey"`
-		SerializedRecord string `json:"serializedRecord"`
-	}
-
 	entry := new(ipnspb.IpnsEntry)
 	err = proto.Unmarshal(val.([]byte), entry)
 	if err != nil {
@@ -3579,7 +3996,7 @@ func (i *jsonAPIHandler) GETIPNS(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	ret := KeyAndRecord{hex.EncodeToString(keyBytes), hex.EncodeToString(b)}
+	ret := IPNSRecordResponse{hex.EncodeToString(keyBytes), hex.EncodeToString(b)}
 	retBytes, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -3598,7 +4015,35 @@ func (i *jsonAPIHandler) POSTTestEmailNotifications(w http.ResponseWriter, r *ht
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	notifier := smtpNotifier{&settings}
+	if err = validateSMTP(&settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	notifier := smtpNotifier{settings: &settings, node: i.node}
+	err = notifier.notify(notifications.TestNotification{})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	SanitizedResponse(w, "{}")
+}
+
+func (i *jsonAPIHandler) POSTTestPushNotifications(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var settings repo.PushSettings
+	err := decoder.Decode(&settings)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err = validatePushNotifier(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// The test is sent whichever events are chosen
+	settings.Events = nil
+	notifier := pushNotifier{settings}
 	err = notifier.notify(notifications.TestNotification{})
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -3808,14 +4253,19 @@ func (i *jsonAPIHandler) DELETEPost(w http.ResponseWriter, r *http.Request) {
 
 // GET a list of posts (self or peer)
 func (i *jsonAPIHandler) GETPosts(w http.ResponseWriter, r *http.Request) {
+	query, err := parsePostQuery(r.URL.Query())
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var postsBytes []byte
 	_, peerId := path.Split(r.URL.Path)
 	if peerId == "" || strings.ToLower(peerId) == "posts" || peerId == i.node.IpfsNode.Identity.Pretty() {
-		postsBytes, err := i.node.GetPosts()
+		postsBytes, err = i.node.GetPosts()
 		if err != nil {
 			ErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
-		SanitizedResponse(w, string(postsBytes))
 	} else {
 		pid, err := i.node.NameSystem.Resolve(context.Background(), peerId)
 		if err != nil {
@@ -3823,14 +4273,32 @@ func (i *jsonAPIHandler) GETPosts(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		peerId = pid.Pretty()
-		postsBytes, err := i.node.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerId, "posts.json")), time.Minute)
+		postsBytes, err = i.node.IPNSResolveThenCat(ipnspath.FromString(path.Join(peerId, "posts.json")), time.Minute)
 		if err != nil {
 			ErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
-		SanitizedResponse(w, string(postsBytes))
 		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
 	}
+
+	var index []core.PostData
+	err = json.Unmarshal(postsBytes, &index)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	posts, total := core.QueryPosts(index, query)
+	slugs := make([]string, len(posts))
+	for n, p := range posts {
+		slugs[n] = p.Slug
+	}
+	ret, err := indexPage(postsBytes, slugs)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set(totalCountHeader, strconv.Itoa(total))
+	SanitizedResponse(w, string(ret))
 }
 
 // GET a post (self or peer)
@@ -3920,3 +4388,449 @@ func (i *jsonAPIHandler) GETPost(w http.ResponseWriter, r *http.Request) {
 		SanitizedResponseM(w, out, new(pb.SignedPost))
 	}
 }
+
+func (i *jsonAPIHandler) GETModeratorDirectory(w http.ResponseWriter, r *http.Request) {
+	if i.node.ModeratorDirectory == nil {
+		ErrorResponse(w, http.StatusServiceUnavailable, "moderator directory is not running")
+		return
+	}
+	splitList := func(s string) []string {
+		var ret []string
+		for _, v := range strings.Split(s, ",") {
+			if v != "" {
+				ret = append(ret, strings.TrimSpace(v))
+			}
+		}
+		return ret
+	}
+	q := r.URL.Query()
+	filter := core.ModeratorFilter{
+		Currencies: splitList(q.Get("currencies")),
+		Languages:  splitList(q.Get("languages")),
+	}
+	for _, ft := range splitList(q.Get("feeTypes")) {
+		val, ok := pb.Moderator_Fee_FeeType_value[strings.ToUpper(ft)]
+		if !ok {
+			ErrorResponse(w, http.StatusBadRequest, "unknown fee type "+ft)
+			return
+		}
+		filter.FeeTypes = append(filter.FeeTypes, pb.Moderator_Fee_FeeType(val))
+	}
+	if s := q.Get("maxPercentage"); s != "" {
+		f, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.MaxPercentage = float32(f)
+	}
+	if s := q.Get("maxFixedFee"); s != "" {
+		amt, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		currency := q.Get("maxFixedFeeCurrency")
+		if currency == "" {
+			currency = i.node.Wallet.CurrencyCode()
+		}
+		filter.MaxFixedFee = &pb.Moderator_Price{CurrencyCode: currency, Amount: amt}
+	}
+	if s := q.Get("minRating"); s != "" {
+		f, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.MinRating = float32(f)
+	}
+	if s := q.Get("minRatingCount"); s != "" {
+		c, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.MinRatingCount = uint32(c)
+	}
+	if s := q.Get("minResponseRate"); s != "" {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		filter.MinResponseRate = f
+	}
+	filter.OnlineOnly, _ = strconv.ParseBool(q.Get("online"))
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	results := []ModeratorDirectoryEntry{}
+	for _, e := range i.node.ModeratorDirectory.Search(filter) {
+		profile, err := m.MarshalToString(&e.Profile)
+		if err != nil {
+			continue
+		}
+		var lastSeen string
+		if !e.LastSeen.IsZero() {
+			lastSeen = e.LastSeen.Format(time.RFC3339)
+		}
+		results = append(results, ModeratorDirectoryEntry{e.PeerID, e.Online, lastSeen, e.ResponseRate(), json.RawMessage(profile)})
+	}
+	ret, err := json.MarshalIndent(results, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTEndorseResolution(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var e OrderRequest
+	err := decoder.Decode(&e)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = i.node.EndorsePanelResolution(e.OrderID)
+	if err != nil && (err == core.ErrCaseNotFound || err == core.ErrPanelResolutionNotFound) {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+	return
+}
+
+func (i *jsonAPIHandler) GETPanelResolution(w http.ResponseWriter, r *http.Request) {
+	_, orderId := path.Split(r.URL.Path)
+	rc, err := i.node.GetPanelResolution(orderId)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(rc)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, out)
+	return
+}
+
+func (i *jsonAPIHandler) POSTRatingReply(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var rr RatingReplyRequest
+	err := decoder.Decode(&rr)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	reply, err := i.node.ReplyToRating(rr.RatingHash, rr.Reply)
+	if err != nil && err == core.ErrRatingNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(reply)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, out)
+	return
+}
+
+func (i *jsonAPIHandler) POSTAmendRating(w http.ResponseWriter, r *http.Request) {
+	checkRatingValue := func(val int) bool {
+		if val < core.RatingMin || val > core.RatingMax {
+			ErrorResponse(w, http.StatusBadRequest, "rating values must be between 1 and 5")
+			return false
+		}
+		return true
+	}
+	decoder := json.NewDecoder(r.Body)
+	var ra core.OrderRatingAmendment
+	err := decoder.Decode(&ra)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	contract, state, _, _, _, err := i.node.Datastore.Purchases().GetByOrderId(ra.OrderId)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "order not found")
+		return
+	}
+	if state != pb.OrderState_COMPLETED {
+		ErrorResponse(w, http.StatusBadRequest, "order must be completed to amend the rating")
+		return
+	}
+	if ra.Slug == "" {
+		ErrorResponse(w, http.StatusBadRequest, "rating must contain the slug")
+		return
+	}
+	if !ra.Retract {
+		for _, val := range []int{ra.Overall, ra.Quality, ra.Description, ra.DeliverySpeed, ra.CustomerService} {
+			if !checkRatingValue(val) {
+				return
+			}
+		}
+		if len(ra.Review) > core.ReviewMaxCharacters {
+			ErrorResponse(w, http.StatusBadRequest, "too many characters in review")
+			return
+		}
+	}
+	amendment, err := i.node.AmendRating(&ra, contract)
+	if err != nil && err == core.ErrRatingNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil && (err == core.ErrRatingAmendmentWindowClosed || err == core.ErrOrderNotCompleted) {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(amendment)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, out)
+	return
+}
+
+func (i *jsonAPIHandler) GETAggregateRatings(w http.ResponseWriter, r *http.Request) {
+	urlPath, slug := path.Split(r.URL.Path)
+	_, peerId := path.Split(urlPath[:len(urlPath)-1])
+
+	if peerId == "aggregateratings" {
+		peerId = slug
+		slug = ""
+	}
+	if peerId == "" {
+		ErrorResponse(w, http.StatusBadRequest, "Peer ID must be provided")
+		return
+	}
+	agg, err := i.node.AggregateRatings(peerId, slug)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if agg.Ratings == nil {
+		agg.Ratings = []core.AggregatedRating{}
+	}
+	ret, err := json.MarshalIndent(agg, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETAPITokens(w http.ResponseWriter, r *http.Request) {
+	tokens, err := i.node.Datastore.APITokens().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if tokens == nil {
+		tokens = []repo.APIToken{}
+	}
+	ret, err := json.MarshalIndent(tokens, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTAPIToken(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var req APITokenRequest
+	err := decoder.Decode(&req)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	apiToken, token, err := repo.NewAPIToken(req.Name, req.Scopes)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := i.node.Datastore.APITokens().Put(apiToken); err == repo.ErrAPITokenExists {
+		ErrorResponse(w, http.StatusConflict, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(APITokenResponse{apiToken, token}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) DELETEAPIToken(w http.ResponseWriter, r *http.Request) {
+	_, name := path.Split(r.URL.Path)
+	if name == "" || name == "apitokens" {
+		ErrorResponse(w, http.StatusBadRequest, "Token name must be provided")
+		return
+	}
+	if err := i.node.Datastore.APITokens().Delete(name); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Token not found")
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETWebhooks(w http.ResponseWriter, r *http.Request) {
+	hooks, err := i.node.Datastore.Webhooks().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if hooks == nil {
+		hooks = []repo.Webhook{}
+	}
+	ret, err := json.MarshalIndent(hooks, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTWebhook(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var req WebhookRequest
+	err := decoder.Decode(&req)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		ErrorResponse(w, http.StatusBadRequest, "Webhook URL must be an absolute http or https URL")
+		return
+	}
+	for _, e := range req.Events {
+		if strings.TrimSpace(e) == "" || strings.Contains(e, ",") {
+			ErrorResponse(w, http.StatusBadRequest, "Invalid event type")
+			return
+		}
+	}
+	if req.Events == nil {
+		req.Events = []string{}
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if req.Secret == "" {
+		req.Secret = hex.EncodeToString(b)
+	}
+	hook := repo.Webhook{
+		ID:      hex.EncodeToString(b[:8]),
+		URL:     u.String(),
+		Events:  req.Events,
+		Secret:  req.Secret,
+		Created: time.Now(),
+	}
+	if err := i.node.Datastore.Webhooks().Put(hook); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(WebhookResponse{hook, hook.Secret}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) DELETEWebhook(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	if id == "" || id == "webhooks" {
+		ErrorResponse(w, http.StatusBadRequest, "Webhook ID must be provided")
+		return
+	}
+	if err := i.node.Datastore.Webhooks().Delete(id); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Webhook not found")
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	if id == "" || id == "webhookdeliveries" {
+		ErrorResponse(w, http.StatusBadRequest, "Webhook ID must be provided")
+		return
+	}
+	if _, err := i.node.Datastore.Webhooks().Get(id); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Webhook not found")
+		return
+	}
+	limit := 50
+	if l := r.URL.Query().Get("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n <= 0 {
+			ErrorResponse(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+		limit = n
+	}
+	deliveries, err := i.node.Datastore.Webhooks().GetDeliveries(id, limit)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if deliveries == nil {
+		deliveries = []repo.WebhookDelivery{}
+	}
+	ret, err := json.MarshalIndent(deliveries, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
@@ -725,6 +725,66 @@ const spendJSON = `{
 	"feeLevel": "NORMAL"
 }`
 
+const saleNotFoundJSON = `{
+	"success": false,
+	"reason": "Sale not found"
+}`
+
+const unknownExportFormatJSON = `{
+	"success": false,
+	"reason": "Unknown export format. Use csv or ofx."
+}`
+
+const invalidExportDateJSON = `{
+	"success": false,
+	"reason": "Invalid from date: yesterday"
+}`
+
+const unknownPeriodJSON = `{
+	"success": false,
+	"reason": "Unknown period. Use day, month, year or leave it empty for the whole range."
+}`
+
+const apiTokenJSON = `{
+	"name": "analytics",
+	"scopes": ["read-only"]
+}`
+
+const apiTokenExistsJSON = `{
+	"success": false,
+	"reason": "A token with this name already exists"
+}`
+
+const apiTokenBadScopeJSON = `{
+	"name": "staff",
+	"scopes": ["everything"]
+}`
+
+const apiTokenBadScopeResponseJSON = `{
+	"success": false,
+	"reason": "Unknown API token scope everything"
+}`
+
+const apiTokenNotFoundJSON = `{
+	"success": false,
+	"reason": "Token not found"
+}`
+
+const webhookBadURLJSON = `{
+	"url": "erp.example.com/hooks",
+	"events": ["order", "payment"]
+}`
+
+const webhookBadURLResponseJSON = `{
+	"success": false,
+	"reason": "Webhook URL must be an absolute http or https URL"
+}`
+
+const webhookNotFoundJSON = `{
+	"success": false,
+	"reason": "Webhook not found"
+}`
+
 const insuffientFundsJSON = `{
 	"success": false,
 	"reason": "ERROR_INSUFFICIENT_FUNDS"
@@ -1,9 +1,12 @@
 package api
 
 import (
+	"encoding/json"
 	"net/http"
 	"os"
 	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
 )
 
 func TestMain(m *testing.M) {
@@ -155,6 +158,18 @@ func TestListings(t *testing.T) {
 		// TODO: Add support for improved JSON matching to since contracts
 		// change each test run due to signatures
 		{"GET", "/ob/listings", "", 200, anyResponseJSON},
+		{"GET", "/ob/listings?tags=clothing&contractType=physical_good", "", 200, anyResponseJSON},
+
+		// Filters and pages which exclude the listing
+		{"GET", "/ob/listings?tags=shoes", "", 200, `[]`},
+		{"GET", "/ob/listings?categories=clothing&currency=usd&maxPrice=100", "", 200, `[]`},
+		{"GET", "/ob/listings?currency=usd", "", 200, `[]`},
+		{"GET", "/ob/listings?from=2999-01-01T00:00:00Z", "", 200, `[]`},
+		{"GET", "/ob/listings?offsetId=ron-swanson-tshirt", "", 200, `[]`},
+		{"GET", "/ob/listings?limit=0", "", 200, `[]`},
+		{"GET", "/ob/listings?sortBy=colour", "", 400, anyResponseJSON},
+		{"GET", "/ob/listings?minPrice=cheap", "", 400, anyResponseJSON},
+		{"GET", "/ob/listings?minPrice=100", "", 400, anyResponseJSON},
 
 		// TODO: This returns `inventoryJSONResponse` but slices are unordered
 		// so they don't get considered equal. Figure out a way to fix that.
@@ -180,7 +195,7 @@ func TestListings(t *testing.T) {
 
 func TestStatus(t *testing.T) {
 	runAPITests(t, apiTests{
-		{"GET", "/ob/status", "", 400, anyResponseJSON},
+		{"GET", "/ob/status", "", 200, anyResponseJSON},
 		{"GET", "/ob/status/QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", "", 200, anyResponseJSON},
 	})
 }
@@ -191,10 +206,103 @@ func TestWallet(t *testing.T) {
 		{"GET", "/wallet/balance", "", 200, walletBalanceJSONResponse},
 		{"GET", "/wallet/mnemonic", "", 200, walletMneumonicJSONResponse},
 		{"POST", "/wallet/spend", spendJSON, 400, insuffientFundsJSON},
+		{"POST", "/ob/acceleratepayment/QmNotAnOrder", "", 404, saleNotFoundJSON},
+		{"GET", "/wallet/export?format=xls", "", 400, unknownExportFormatJSON},
+		{"GET", "/wallet/export?from=yesterday", "", 400, invalidExportDateJSON},
+		{"GET", "/ob/profitandloss?period=week", "", 400, unknownPeriodJSON},
 		// TODO: Test successful spend on regnet with coins
 	})
 }
 
+func TestAPITokens(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/apitokens", apiTokenJSON, 200, anyResponseJSON},
+		{"POST", "/ob/apitokens", apiTokenJSON, 409, apiTokenExistsJSON},
+		{"POST", "/ob/apitokens", apiTokenBadScopeJSON, 400, apiTokenBadScopeResponseJSON},
+		{"GET", "/ob/apitokens", "", 200, anyResponseJSON},
+		{"DELETE", "/ob/apitokens/analytics", "", 200, "{}"},
+		{"DELETE", "/ob/apitokens/analytics", "", 404, apiTokenNotFoundJSON},
+	})
+}
+
+func TestWebhooks(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/webhooks", webhookBadURLJSON, 400, webhookBadURLResponseJSON},
+		{"GET", "/ob/webhooks", "", 200, "[]"},
+		{"GET", "/ob/webhookdeliveries/a1b2", "", 404, webhookNotFoundJSON},
+		{"DELETE", "/ob/webhooks/a1b2", "", 404, webhookNotFoundJSON},
+	})
+}
+
+func TestAPITokenScopes(t *testing.T) {
+	req, err := buildRequest("POST", "/ob/apitokens", `{"name": "fulfillment", "scopes": ["read-only", "orders"]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := request(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var created struct {
+		Token string `json:"token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil || created.Token == "" {
+		t.Fatal("Failed to create token")
+	}
+	resp.Body.Close()
+	defer runAPITest(t, apiTest{"DELETE", "/ob/apitokens/fulfillment", "", 200, "{}"})
+
+	for _, test := range []struct {
+		method string
+		path   string
+		token  string
+		status int
+	}{
+		{"GET", "/ob/config", created.Token, 200},
+		{"GET", "/wallet/mnemonic", created.Token, 403},
+		{"POST", "/wallet/spend", created.Token, 403},
+		{"POST", "/ob/apitokens", created.Token, 403},
+		{"GET", "/ob/config", "notatoken", 403},
+	} {
+		req, err := http.NewRequest(test.method, testURIRoot+test.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+test.token)
+		resp, err := request(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != test.status {
+			t.Errorf("%s %s: wanted status %d, got %d", test.method, test.path, test.status, resp.StatusCode)
+		}
+	}
+}
+
+func TestRequiredScope(t *testing.T) {
+	for _, test := range []struct {
+		method string
+		path   string
+		scope  string
+	}{
+		{"GET", "/ob/listings", repo.ScopeReadOnly},
+		{"GET", "/wallet/mnemonic", repo.ScopeAdmin},
+		{"POST", "/ob/purchase", repo.ScopeWalletSpend},
+		{"POST", "/ob/purchases", repo.ScopeReadOnly},
+		{"POST", "/ob/orderfulfillment", repo.ScopeOrders},
+		{"PUT", "/ob/listing", repo.ScopeListings},
+		{"POST", "/ob/chat", repo.ScopeChat},
+		{"POST", "/wallet/spendmany", repo.ScopeWalletSpend},
+		{"POST", "/ob/shutdown", repo.ScopeAdmin},
+		{"DELETE", "/ob/apitokens/analytics", repo.ScopeAdmin},
+	} {
+		if scope := requiredScope(test.method, test.path); scope != test.scope {
+			t.Errorf("%s %s requires %s, expected %s", test.method, test.path, scope, test.scope)
+		}
+	}
+}
+
 func TestConfig(t *testing.T) {
 	runAPITests(t, apiTests{
 		// TODO: Need better JSON matching
@@ -202,6 +310,15 @@ func TestConfig(t *testing.T) {
 	})
 }
 
+func TestVersionedRoutes(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/v1/ob/config", "", 200, anyResponseJSON},
+		{"GET", "/v1/ob/openapi", "", 200, anyResponseJSON},
+		{"GET", "/v1/ob/nothing", "", 404, notFoundJSON},
+		{"DELETE", "/ob/config", "", 404, notFoundJSON},
+	})
+}
+
 func TestPeers(t *testing.T) {
 	// Follow, Unfollow
 	runAPITests(t, apiTests{
@@ -237,6 +354,9 @@ func TestPosts(t *testing.T) {
 		{"POST", "/ob/post", postUpdateJSON, 409, AlreadyExistsUsePUTJSON("Post")},
 
 		{"GET", "/ob/posts", "", 200, anyResponseJSON},
+		{"GET", "/ob/posts?tags=nothing", "", 200, `[]`},
+		{"GET", "/ob/posts?offsetId=test1", "", 200, `[]`},
+		{"GET", "/ob/posts?from=yesterday", "", 400, anyResponseJSON},
 
 		// Update/Get Post
 		{"PUT", "/ob/post", postUpdateJSON, 200, `{}`},
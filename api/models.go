@@ -0,0 +1,285 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// Request and response bodies of the JSON API. The route registry refers to them so they
+// can be described in the OpenAPI document.
+
+type APIError struct {
+	Success bool   `json:"success"`
+	Reason  string `json:"reason"`
+}
+
+type AvatarRequest struct {
+	Avatar string `json:"avatar"`
+}
+
+type HeaderRequest struct {
+	Header string `json:"header"`
+}
+
+type ImageUpload struct {
+	Filename string `json:"filename"`
+	Image    string `json:"image"`
+}
+
+type UploadedImage struct {
+	Filename string           `json:"filename"`
+	Hashes   pb.Profile_Image `json:"hashes"`
+}
+
+type PurchaseResponse struct {
+	PaymentAddress string `json:"paymentAddress"`
+	Amount         uint64 `json:"amount"`
+	VendorOnline   bool   `json:"vendorOnline"`
+	OrderId        string `json:"orderId"`
+}
+
+type PeerIDRequest struct {
+	ID string `json:"id"`
+}
+
+type SpendRequest struct {
+	Address  string `json:"address"`
+	Amount   int64  `json:"amount"`
+	FeeLevel string `json:"feeLevel"`
+	Memo     string `json:"memo"`
+	Coin     string `json:"coin"`
+	core.CoinControl
+}
+
+type SpendManyRequest struct {
+	Outputs  []core.PayoutOutput `json:"outputs"`
+	FeeLevel string              `json:"feeLevel"`
+	Memo     string              `json:"memo"`
+	Coin     string              `json:"coin"`
+}
+
+type SpendResponse struct {
+	Txid               string    `json:"txid"`
+	Amount             int64     `json:"amount"`
+	ConfirmedBalance   int64     `json:"confirmedBalance"`
+	UnconfirmedBalance int64     `json:"unconfirmedBalance"`
+	Timestamp          time.Time `json:"timestamp"`
+	Memo               string    `json:"memo"`
+}
+
+type UtxoMetadata struct {
+	Outpoint string `json:"outpoint"`
+	Label    string `json:"label"`
+	Frozen   bool   `json:"frozen"`
+	Coin     string `json:"coin"`
+}
+
+type ConfigResponse struct {
+	PeerId         string   `json:"peerID"`
+	CryptoCurrency string   `json:"cryptoCurrency"`
+	Wallets        []string `json:"wallets"`
+	Testnet        bool     `json:"testnet"`
+	Tor            bool     `json:"tor"`
+}
+
+type ExchangeRateSources struct {
+	Rate    float64   `json:"rate"`
+	Sources []string  `json:"sources"`
+	Updated time.Time `json:"updated"`
+}
+
+type InventoryEntry struct {
+	Slug     string `json:"slug"`
+	Variant  int    `json:"variant"`
+	Quantity int    `json:"quantity"`
+}
+
+type OrderConfirmationRequest struct {
+	OrderId string `json:"orderId"`
+	Reject  bool   `json:"reject"`
+}
+
+// OrderRequest is the body of the order actions which only need the order ID
+type OrderRequest struct {
+	OrderID string `json:"orderId"`
+}
+
+type OpenDisputeRequest struct {
+	OrderID string `json:"orderId"`
+	Claim   string `json:"claim"`
+}
+
+type CloseDisputeRequest struct {
+	OrderID          string  `json:"orderId"`
+	Resolution       string  `json:"resolution"`
+	BuyerPercentage  float32 `json:"buyerPercentage"`
+	VendorPercentage float32 `json:"vendorPercentage"`
+}
+
+type NotificationsResponse struct {
+	Unread        int                          `json:"unread"`
+	Total         int                          `json:"total"`
+	Notifications []notifications.Notification `json:"notifications"`
+}
+
+type WalletTransaction struct {
+	Txid          string    `json:"txid"`
+	Value         int64     `json:"value"`
+	Address       string    `json:"address"`
+	Status        string    `json:"status"`
+	Memo          string    `json:"memo"`
+	Timestamp     time.Time `json:"timestamp"`
+	Confirmations int32     `json:"confirmations"`
+	Height        int32     `json:"height"`
+	OrderId       string    `json:"orderId"`
+	Thumbnail     string    `json:"thumbnail"`
+	CanBumpFee    bool      `json:"canBumpFee"`
+}
+
+type TransactionsResponse struct {
+	Transactions []WalletTransaction `json:"transactions"`
+	Count        int                 `json:"count"`
+}
+
+type PurchasesResponse struct {
+	QueryCount int             `json:"queryCount"`
+	Purchases  []repo.Purchase `json:"purchases"`
+}
+
+type SalesResponse struct {
+	QueryCount int         `json:"queryCount"`
+	Sales      []repo.Sale `json:"sales"`
+}
+
+type CasesResponse struct {
+	QueryCount int         `json:"queryCount"`
+	Cases      []repo.Case `json:"cases"`
+}
+
+type RatingsResponse struct {
+	Count   int               `json:"count"`
+	Average float32           `json:"average"`
+	Ratings []string          `json:"ratings"`
+	Replies map[string]string `json:"replies,omitempty"`
+}
+
+type RatingResponse struct {
+	*pb.Rating
+	Reply     *pb.RatingReply     `json:"reply,omitempty"`
+	Amendment *pb.RatingAmendment `json:"amendment,omitempty"`
+}
+
+type HealthCheckResponse struct {
+	Database bool `json:"database"`
+	IPFSRoot bool `json:"ipfsRoot"`
+	Peers    bool `json:"peers"`
+}
+
+type WalletStatusResponse struct {
+	Height   uint32 `json:"height"`
+	BestHash string `json:"bestHash"`
+}
+
+type IPNSRecordResponse struct {
+	Pubkey           string `json:"pubkey"`
+	SerializedRecord string `json:"serializedRecord"`
+}
+
+type ModeratorDirectoryEntry struct {
+	PeerId       string          `json:"peerId"`
+	Online       bool            `json:"online"`
+	LastSeen     string          `json:"lastSeen"`
+	ResponseRate float64         `json:"responseRate"`
+	Profile      json.RawMessage `json:"profile"`
+}
+
+type RatingReplyRequest struct {
+	RatingHash string `json:"ratingHash"`
+	Reply      string `json:"reply"`
+}
+
+type APITokenRequest struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+type APITokenResponse struct {
+	repo.APIToken
+	Token string `json:"token"`
+}
+
+type WebhookRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret"`
+}
+
+type WebhookResponse struct {
+	repo.Webhook
+	Secret string `json:"secret"`
+}
+
+// The handlers format these small responses directly. They're declared to describe them.
+
+type StatusResponse struct {
+	Status     string           `json:"status"`
+	RateLimits *RateLimitStatus `json:"rateLimits,omitempty"`
+}
+
+type RateLimitStatus struct {
+	Enabled               bool              `json:"enabled"`
+	Allowed               uint64            `json:"allowed"`
+	Throttled             uint64            `json:"throttled"`
+	ThrottledByIP         uint64            `json:"throttledByIP"`
+	ThrottledByCredential uint64            `json:"throttledByCredential"`
+	ThrottledRoutes       map[string]uint64 `json:"throttledRoutes"`
+	Clients               int               `json:"clients"`
+}
+
+type AddressResponse struct {
+	Address string `json:"address"`
+}
+
+type MnemonicResponse struct {
+	Mnemonic string `json:"mnemonic"`
+}
+
+type BalanceResponse struct {
+	Confirmed   int64 `json:"confirmed"`
+	Unconfirmed int64 `json:"unconfirmed"`
+}
+
+type EstimatedFeeResponse struct {
+	EstimatedFee uint64 `json:"estimatedFee"`
+}
+
+type FeesResponse struct {
+	Priority uint64 `json:"priority"`
+	Normal   uint64 `json:"normal"`
+	Economic uint64 `json:"economic"`
+}
+
+type SlugResponse struct {
+	Slug string `json:"slug"`
+}
+
+type FollowsMeResponse struct {
+	FollowsMe bool `json:"followsMe"`
+}
+
+type IsFollowingResponse struct {
+	IsFollowing bool `json:"isFollowing"`
+}
+
+type MessageIDResponse struct {
+	MessageID string `json:"messageId"`
+}
+
+type TxidResponse struct {
+	Txid string `json:"txid"`
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	mh "gx/ipfs/QmU9a9NV9RdPNwZQDYd5uKsm6N6LJLSvLbywDDYFbaaC6P/go-multihash"
+	"reflect"
+	"strings"
 	"time"
 )
 
@@ -154,6 +156,32 @@ type DisputeCloseNotification struct {
 	Buyer            string    `json:"buyer"`
 }
 
+type PanelResolutionNotification struct {
+	ID           string `json:"notificationId"`
+	Type         string `json:"type"`
+	OrderId      string `json:"orderId"`
+	ProposedBy   string `json:"proposedBy"`
+	Endorsements int    `json:"endorsements"`
+	Complete     bool   `json:"complete"`
+}
+
+type RatingAmendmentNotification struct {
+	ID        string `json:"notificationId"`
+	Type      string `json:"type"`
+	OrderId   string `json:"orderId"`
+	Slug      string `json:"slug"`
+	Retracted bool   `json:"retracted"`
+}
+
+type SweepNotification struct {
+	ID      string `json:"notificationId"`
+	Type    string `json:"type"`
+	Coin    string `json:"coin"`
+	Txid    string `json:"txid"`
+	Address string `json:"address"`
+	Amount  int64  `json:"amount"`
+}
+
 type DisputeAcceptedNotification struct {
 	ID               string    `json:"notificationId"`
 	Type             string    `json:"type"`
@@ -227,6 +255,19 @@ type IncomingTransaction struct {
 
 type TestNotification struct{}
 
+// DigestNotification collects the notifications held back during quiet hours
+type DigestNotification struct {
+	Notifications []interface{}
+	Dropped       int // held notifications which didn't fit in the digest
+}
+
+// SummaryNotification lists the orders, disputes and escrows waiting on the user
+type SummaryNotification struct {
+	UnconfirmedOrders []string
+	OpenDisputes      []string
+	ReleasableEscrows []string
+}
+
 func NewID() string {
 	b := make([]byte, 32)
 	rand.Read(b)
@@ -277,6 +318,18 @@ func wrap(i interface{}) interface{} {
 		n := i.(DisputeCloseNotification)
 		n.Type = "disputeClose"
 		return notificationWrapper{n}
+	case PanelResolutionNotification:
+		n := i.(PanelResolutionNotification)
+		n.Type = "panelResolution"
+		return notificationWrapper{n}
+	case RatingAmendmentNotification:
+		n := i.(RatingAmendmentNotification)
+		n.Type = "ratingAmendment"
+		return notificationWrapper{n}
+	case SweepNotification:
+		n := i.(SweepNotification)
+		n.Type = "sweep"
+		return notificationWrapper{n}
 	case DisputeAcceptedNotification:
 		n := i.(DisputeAcceptedNotification)
 		n.Type = "disputeAccepted"
@@ -319,11 +372,41 @@ func Serialize(i interface{}) []byte {
 	return b
 }
 
+/* EventType returns the name external subscribers use for the kind of event i represents.
+   Notifications are named by their type field, chat messages are "chat" and incoming
+   wallet transactions are "transaction". Anything else returns an empty string. */
+func EventType(i interface{}) string {
+	switch w := wrap(i).(type) {
+	case notificationWrapper:
+		v := reflect.ValueOf(w.Notification)
+		if v.Kind() == reflect.Struct {
+			if f := v.FieldByName("Type"); f.IsValid() && f.Kind() == reflect.String {
+				return f.String()
+			}
+		}
+	case messageWrapper:
+		return "chat"
+	case walletWrapper:
+		return "transaction"
+	}
+	return ""
+}
+
+// Describe returns an English title and body for a notification, or empty strings if it shouldn't be sent out
 func Describe(i interface{}) (string, string) {
+	return describe(i, func(s string) string { return s })
+}
+
+// DescribeIn describes a notification like Describe, translated into the language if there's a translation
+func DescribeIn(language string, i interface{}) (string, string) {
+	return describe(i, func(s string) string { return Translate(language, s) })
+}
+
+func describe(i interface{}, tr func(string) string) (string, string) {
 	var head, body string
 	switch i.(type) {
 	case OrderNotification:
-		head = "Order received"
+		head = tr("Order received")
 
 		n := i.(OrderNotification)
 		var buyer string
@@ -332,75 +415,142 @@ func Describe(i interface{}) (string, string) {
 		} else {
 			buyer = n.BuyerID
 		}
-		form := "You received an order \"%s\".\n\nOrder ID: %s\nBuyer: %s\nThumbnail: %s\n"
+		form := tr("You received an order \"%s\".\n\nOrder ID: %s\nBuyer: %s\nThumbnail: %s\n")
 		body = fmt.Sprintf(form, n.Title, n.OrderId, buyer, n.Thumbnail.Small)
 
 	case PaymentNotification:
-		head = "Payment received"
+		head = tr("Payment received")
 
 		n := i.(PaymentNotification)
-		form := "Payment for order \"%s\" received (total %d)."
+		form := tr("Payment for order \"%s\" received (total %d).")
 		body = fmt.Sprintf(form, n.OrderId, n.FundingTotal)
 
 	case OrderConfirmationNotification:
-		head = "Order confirmed"
+		head = tr("Order confirmed")
 
 		n := i.(OrderConfirmationNotification)
-		form := "Order \"%s\" has been confirmed."
+		form := tr("Order \"%s\" has been confirmed.")
 		body = fmt.Sprintf(form, n.OrderId)
 
 	case OrderCancelNotification:
-		head = "Order cancelled"
+		head = tr("Order cancelled")
 
 		n := i.(OrderCancelNotification)
-		form := "Order \"%s\" has been cancelled."
+		form := tr("Order \"%s\" has been cancelled.")
 		body = fmt.Sprintf(form, n.OrderId)
 
 	case RefundNotification:
-		head = "Payment refunded"
+		head = tr("Payment refunded")
 
 		n := i.(RefundNotification)
-		form := "Payment refund for order \"%s\" received."
+		form := tr("Payment refund for order \"%s\" received.")
 		body = fmt.Sprintf(form, n.OrderId)
 
 	case FulfillmentNotification:
-		head = "Order fulfilled"
+		head = tr("Order fulfilled")
 
 		n := i.(FulfillmentNotification)
-		form := "Order \"%s\" was marked as fulfilled."
+		form := tr("Order \"%s\" was marked as fulfilled.")
 		body = fmt.Sprintf(form, n.OrderId)
 
 	case CompletionNotification:
-		head = "Order completed"
+		head = tr("Order completed")
 
 		n := i.(CompletionNotification)
-		form := "Order \"%s\" was marked as completed."
+		form := tr("Order \"%s\" was marked as completed.")
 		body = fmt.Sprintf(form, n.OrderId)
 
 	case DisputeOpenNotification:
-		head = "Dispute opened"
+		head = tr("Dispute opened")
 
 		n := i.(DisputeOpenNotification)
-		form := "Dispute around order \"%s\" was opened."
+		form := tr("Dispute around order \"%s\" was opened.")
 		body = fmt.Sprintf(form, n.OrderId)
 
 	case DisputeUpdateNotification:
-		head = "Dispute updated"
+		head = tr("Dispute updated")
 
 		n := i.(DisputeUpdateNotification)
-		form := "Dispute around order \"%s\" was updated."
+		form := tr("Dispute around order \"%s\" was updated.")
 		body = fmt.Sprintf(form, n.OrderId)
 
 	case DisputeCloseNotification:
-		head = "Dispute closed"
+		head = tr("Dispute closed")
 
 		n := i.(DisputeCloseNotification)
-		form := "Dispute around order \"%s\" was closed."
+		form := tr("Dispute around order \"%s\" was closed.")
+		body = fmt.Sprintf(form, n.OrderId)
+
+	case PanelResolutionNotification:
+		head = tr("Panel resolution proposed")
+
+		n := i.(PanelResolutionNotification)
+		form := tr("A resolution for the dispute around order \"%s\" needs your endorsement.")
+		if n.Complete {
+			head = tr("Panel resolution accepted")
+			form = tr("The panel has agreed on a resolution for the dispute around order \"%s\".")
+		}
 		body = fmt.Sprintf(form, n.OrderId)
 
+	case RatingAmendmentNotification:
+		head = tr("Rating amended")
+
+		n := i.(RatingAmendmentNotification)
+		form := tr("The buyer amended their rating of \"%s\" for order \"%s\".")
+		if n.Retracted {
+			head = tr("Rating retracted")
+			form = tr("The buyer retracted their rating of \"%s\" for order \"%s\".")
+		}
+		body = fmt.Sprintf(form, n.Slug, n.OrderId)
+
+	case SweepNotification:
+		head = tr("Funds swept")
+
+		n := i.(SweepNotification)
+		form := tr("%d of your %s balance was swept to %s.\n\nTransaction: %s\n")
+		body = fmt.Sprintf(form, n.Amount, n.Coin, n.Address, n.Txid)
+
 	case TestNotification:
-		head = "SMTP Notification Test"
-		body = "Hello World"
+		head = tr("Notification Test")
+		body = tr("Hello World")
+
+	case DigestNotification:
+		n := i.(DigestNotification)
+		var parts []string
+		for _, held := range n.Notifications {
+			h, b := describe(held, tr)
+			if h != "" {
+				parts = append(parts, h+"\n"+b)
+			}
+		}
+		count := len(parts) + n.Dropped
+		if n.Dropped > 0 {
+			parts = append(parts, fmt.Sprintf(tr("...and %d more."), n.Dropped))
+		}
+		if count > 0 {
+			head = fmt.Sprintf(tr("%d notifications during quiet hours"), count)
+			body = strings.Join(parts, "\n\n")
+		}
+
+	case SummaryNotification:
+		n := i.(SummaryNotification)
+		var parts []string
+		for _, section := range []struct {
+			title string
+			items []string
+		}{
+			{"Orders awaiting confirmation", n.UnconfirmedOrders},
+			{"Open disputes", n.OpenDisputes},
+			{"Escrows ready to release", n.ReleasableEscrows},
+		} {
+			if len(section.items) > 0 {
+				parts = append(parts, fmt.Sprintf("%s (%d):\n- %s", tr(section.title), len(section.items), strings.Join(section.items, "\n- ")))
+			}
+		}
+		if len(parts) > 0 {
+			head = tr("Daily summary")
+			body = strings.Join(parts, "\n\n")
+		}
 	}
 
 	return head, body
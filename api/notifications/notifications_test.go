@@ -2,6 +2,7 @@ package notifications
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -16,3 +17,82 @@ func TestSerialization(t *testing.T) {
 		t.Error("Incorrect serialization")
 	}
 }
+
+func TestEventType(t *testing.T) {
+	tests := []struct {
+		n        interface{}
+		expected string
+	}{
+		{OrderNotification{}, "order"},
+		{PaymentNotification{}, "payment"},
+		{FulfillmentNotification{}, "fulfillment"},
+		{DisputeOpenNotification{}, "disputeOpen"},
+		{ChatMessage{}, "chat"},
+		{IncomingTransaction{}, "transaction"},
+		{ChatTyping{}, ""},
+		{StatusNotification{"some status string"}, ""},
+	}
+	for _, test := range tests {
+		if e := EventType(test.n); e != test.expected {
+			t.Errorf("Expected event type %q, got %q", test.expected, e)
+		}
+	}
+}
+
+func TestDescribeDigest(t *testing.T) {
+	digest := DigestNotification{
+		Notifications: []interface{}{PaymentNotification{OrderId: "QmOrder"}, ChatTyping{}},
+		Dropped:       3,
+	}
+	head, body := Describe(digest)
+	if head != "4 notifications during quiet hours" || !strings.Contains(body, "Payment received") || !strings.Contains(body, "and 3 more") {
+		t.Errorf("Wrong digest description %q: %q", head, body)
+	}
+	if head, _ := Describe(DigestNotification{}); head != "" {
+		t.Error("An empty digest should not be described")
+	}
+}
+
+func TestDescribeSummary(t *testing.T) {
+	head, body := Describe(SummaryNotification{OpenDisputes: []string{"Shoes (QmOrder)"}})
+	if head != "Daily summary" || !strings.Contains(body, "Open disputes (1):\n- Shoes (QmOrder)") || strings.Contains(body, "confirmation") {
+		t.Errorf("Wrong summary description %q: %q", head, body)
+	}
+	if head, _ := Describe(SummaryNotification{}); head != "" {
+		t.Error("An empty summary should not be described")
+	}
+}
+
+func TestDescribeIn(t *testing.T) {
+	n := OrderConfirmationNotification{OrderId: "QmOrder"}
+	head, body := DescribeIn("es-MX", n)
+	if head != "Pedido confirmado" || body != "El pedido \"QmOrder\" ha sido confirmado." {
+		t.Errorf("Wrong translation %q: %q", head, body)
+	}
+	if head, _ := DescribeIn("xx", n); head != "Order confirmed" {
+		t.Error("Unknown languages should fall back to English")
+	}
+	if head, _ := Describe(n); head != "Order confirmed" {
+		t.Error("Describe should be in English")
+	}
+}
+
+func TestTranslationVerbs(t *testing.T) {
+	verbs := func(s string) string {
+		var v []string
+		for i := 0; i < len(s)-1; i++ {
+			if s[i] == '%' {
+				v = append(v, s[i:i+2])
+				i++
+			}
+		}
+		return strings.Join(v, "")
+	}
+	for language, strs := range translations {
+		for english, translated := range strs {
+			if verbs(english) != verbs(translated) {
+				t.Errorf("%s translation of %q changes the format verbs", language, english)
+			}
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package notifications
+
+import "strings"
+
+/* translations holds the notification strings in each supported language, keyed by the English
+   string. Format strings keep their verbs in the same order as the English. Strings without a
+   translation are sent in English. */
+var translations = map[string]map[string]string{
+	"de": {
+		"Order received": "Bestellung erhalten",
+		"You received an order \"%s\".\n\nOrder ID: %s\nBuyer: %s\nThumbnail: %s\n": "Du hast eine Bestellung für \"%s\" erhalten.\n\nBestellnummer: %s\nKäufer: %s\nVorschaubild: %s\n",
+		"Payment received": "Zahlung erhalten",
+		"Payment for order \"%s\" received (total %d).": "Zahlung für Bestellung \"%s\" erhalten (gesamt %d).",
+		"Order confirmed":                           "Bestellung bestätigt",
+		"Order \"%s\" has been confirmed.":          "Bestellung \"%s\" wurde bestätigt.",
+		"Order cancelled":                           "Bestellung storniert",
+		"Order \"%s\" has been cancelled.":          "Bestellung \"%s\" wurde storniert.",
+		"Payment refunded":                          "Zahlung erstattet",
+		"Payment refund for order \"%s\" received.": "Erstattung für Bestellung \"%s\" erhalten.",
+		"Order fulfilled":                           "Bestellung ausgeführt",
+		"Order \"%s\" was marked as fulfilled.":     "Bestellung \"%s\" wurde als ausgeführt markiert.",
+		"Order completed":                           "Bestellung abgeschlossen",
+		"Order \"%s\" was marked as completed.":     "Bestellung \"%s\" wurde als abgeschlossen markiert.",
+		"Dispute opened":                            "Streitfall eröffnet",
+		"Dispute around order \"%s\" was opened.":   "Ein Streitfall zur Bestellung \"%s\" wurde eröffnet.",
+		"Dispute updated":                           "Streitfall aktualisiert",
+		"Dispute around order \"%s\" was updated.":  "Der Streitfall zur Bestellung \"%s\" wurde aktualisiert.",
+		"Dispute closed":                            "Streitfall geschlossen",
+		"Dispute around order \"%s\" was closed.":   "Der Streitfall zur Bestellung \"%s\" wurde geschlossen.",
+		"Panel resolution proposed":                 "Lösung des Gremiums vorgeschlagen",
+		"A resolution for the dispute around order \"%s\" needs your endorsement.": "Eine Lösung für den Streitfall zur Bestellung \"%s\" braucht deine Zustimmung.",
+		"Panel resolution accepted": "Lösung des Gremiums angenommen",
+		"The panel has agreed on a resolution for the dispute around order \"%s\".": "Das Gremium hat sich auf eine Lösung für den Streitfall zur Bestellung \"%s\" geeinigt.",
+		"Rating amended": "Bewertung geändert",
+		"The buyer amended their rating of \"%s\" for order \"%s\".": "Der Käufer hat seine Bewertung von \"%s\" für Bestellung \"%s\" geändert.",
+		"Rating retracted": "Bewertung zurückgezogen",
+		"The buyer retracted their rating of \"%s\" for order \"%s\".": "Der Käufer hat seine Bewertung von \"%s\" für Bestellung \"%s\" zurückgezogen.",
+		"Funds swept": "Guthaben übertragen",
+		"%d of your %s balance was swept to %s.\n\nTransaction: %s\n": "%d deines %s-Guthabens wurden an %s übertragen.\n\nTransaktion: %s\n",
+		"Notification Test":                   "Testbenachrichtigung",
+		"Hello World":                         "Hallo Welt",
+		"...and %d more.":                     "...und %d weitere.",
+		"%d notifications during quiet hours": "%d Benachrichtigungen während der Ruhezeit",
+		"Orders awaiting confirmation":        "Bestellungen, die auf Bestätigung warten",
+		"Open disputes":                       "Offene Streitfälle",
+		"Escrows ready to release":            "Freigabebereite Treuhandzahlungen",
+		"Daily summary":                       "Tägliche Zusammenfassung",
+		"Items":                               "Artikel",
+		"Quantity":                            "Menge",
+		"Total":                               "Gesamt",
+	},
+	"es": {
+		"Order received": "Pedido recibido",
+		"You received an order \"%s\".\n\nOrder ID: %s\nBuyer: %s\nThumbnail: %s\n": "Has recibido un pedido de \"%s\".\n\nID del pedido: %s\nComprador: %s\nMiniatura: %s\n",
+		"Payment received": "Pago recibido",
+		"Payment for order \"%s\" received (total %d).": "Pago del pedido \"%s\" recibido (total %d).",
+		"Order confirmed":                           "Pedido confirmado",
+		"Order \"%s\" has been confirmed.":          "El pedido \"%s\" ha sido confirmado.",
+		"Order cancelled":                           "Pedido cancelado",
+		"Order \"%s\" has been cancelled.":          "El pedido \"%s\" ha sido cancelado.",
+		"Payment refunded":                          "Pago reembolsado",
+		"Payment refund for order \"%s\" received.": "Reembolso del pedido \"%s\" recibido.",
+		"Order fulfilled":                           "Pedido enviado",
+		"Order \"%s\" was marked as fulfilled.":     "El pedido \"%s\" se marcó como enviado.",
+		"Order completed":                           "Pedido completado",
+		"Order \"%s\" was marked as completed.":     "El pedido \"%s\" se marcó como completado.",
+		"Dispute opened":                            "Disputa abierta",
+		"Dispute around order \"%s\" was opened.":   "Se abrió una disputa sobre el pedido \"%s\".",
+		"Dispute updated":                           "Disputa actualizada",
+		"Dispute around order \"%s\" was updated.":  "La disputa sobre el pedido \"%s\" se actualizó.",
+		"Dispute closed":                            "Disputa cerrada",
+		"Dispute around order \"%s\" was closed.":   "La disputa sobre el pedido \"%s\" se cerró.",
+		"Panel resolution proposed":                 "Resolución del panel propuesta",
+		"A resolution for the dispute around order \"%s\" needs your endorsement.": "Una resolución de la disputa sobre el pedido \"%s\" necesita tu aprobación.",
+		"Panel resolution accepted": "Resolución del panel aceptada",
+		"The panel has agreed on a resolution for the dispute around order \"%s\".": "El panel acordó una resolución de la disputa sobre el pedido \"%s\".",
+		"Rating amended": "Valoración modificada",
+		"The buyer amended their rating of \"%s\" for order \"%s\".": "El comprador modificó su valoración de \"%s\" del pedido \"%s\".",
+		"Rating retracted": "Valoración retirada",
+		"The buyer retracted their rating of \"%s\" for order \"%s\".": "El comprador retiró su valoración de \"%s\" del pedido \"%s\".",
+		"Funds swept": "Fondos transferidos",
+		"%d of your %s balance was swept to %s.\n\nTransaction: %s\n": "%d de tu saldo de %s se transfirió a %s.\n\nTransacción: %s\n",
+		"Notification Test":                   "Notificación de prueba",
+		"Hello World":                         "Hola mundo",
+		"...and %d more.":                     "...y %d más.",
+		"%d notifications during quiet hours": "%d notificaciones durante las horas de silencio",
+		"Orders awaiting confirmation":        "Pedidos pendientes de confirmación",
+		"Open disputes":                       "Disputas abiertas",
+		"Escrows ready to release":            "Depósitos listos para liberar",
+		"Daily summary":                       "Resumen diario",
+		"Items":                               "Artículos",
+		"Quantity":                            "Cantidad",
+		"Total":                               "Total",
+	},
+	"fr": {
+		"Order received": "Commande reçue",
+		"You received an order \"%s\".\n\nOrder ID: %s\nBuyer: %s\nThumbnail: %s\n": "Vous avez reçu une commande pour \"%s\".\n\nNuméro de commande : %s\nAcheteur : %s\nMiniature : %s\n",
+		"Payment received": "Paiement reçu",
+		"Payment for order \"%s\" received (total %d).": "Paiement de la commande \"%s\" reçu (total %d).",
+		"Order confirmed":                           "Commande confirmée",
+		"Order \"%s\" has been confirmed.":          "La commande \"%s\" a été confirmée.",
+		"Order cancelled":                           "Commande annulée",
+		"Order \"%s\" has been cancelled.":          "La commande \"%s\" a été annulée.",
+		"Payment refunded":                          "Paiement remboursé",
+		"Payment refund for order \"%s\" received.": "Remboursement de la commande \"%s\" reçu.",
+		"Order fulfilled":                           "Commande expédiée",
+		"Order \"%s\" was marked as fulfilled.":     "La commande \"%s\" a été marquée comme expédiée.",
+		"Order completed":                           "Commande terminée",
+		"Order \"%s\" was marked as completed.":     "La commande \"%s\" a été marquée comme terminée.",
+		"Dispute opened":                            "Litige ouvert",
+		"Dispute around order \"%s\" was opened.":   "Un litige concernant la commande \"%s\" a été ouvert.",
+		"Dispute updated":                           "Litige mis à jour",
+		"Dispute around order \"%s\" was updated.":  "Le litige concernant la commande \"%s\" a été mis à jour.",
+		"Dispute closed":                            "Litige clos",
+		"Dispute around order \"%s\" was closed.":   "Le litige concernant la commande \"%s\" a été clos.",
+		"Panel resolution proposed":                 "Résolution du panel proposée",
+		"A resolution for the dispute around order \"%s\" needs your endorsement.": "Une résolution du litige concernant la commande \"%s\" attend votre approbation.",
+		"Panel resolution accepted": "Résolution du panel acceptée",
+		"The panel has agreed on a resolution for the dispute around order \"%s\".": "Le panel s'est accordé sur une résolution du litige concernant la commande \"%s\".",
+		"Rating amended": "Évaluation modifiée",
+		"The buyer amended their rating of \"%s\" for order \"%s\".": "L'acheteur a modifié son évaluation de \"%s\" pour la commande \"%s\".",
+		"Rating retracted": "Évaluation retirée",
+		"The buyer retracted their rating of \"%s\" for order \"%s\".": "L'acheteur a retiré son évaluation de \"%s\" pour la commande \"%s\".",
+		"Funds swept": "Fonds transférés",
+		"%d of your %s balance was swept to %s.\n\nTransaction: %s\n": "%d de votre solde %s a été transféré vers %s.\n\nTransaction : %s\n",
+		"Notification Test":                   "Notification de test",
+		"Hello World":                         "Bonjour le monde",
+		"...and %d more.":                     "...et %d de plus.",
+		"%d notifications during quiet hours": "%d notifications pendant les heures calmes",
+		"Orders awaiting confirmation":        "Commandes en attente de confirmation",
+		"Open disputes":                       "Litiges ouverts",
+		"Escrows ready to release":            "Séquestres prêts à être libérés",
+		"Daily summary":                       "Résumé quotidien",
+		"Items":                               "Articles",
+		"Quantity":                            "Quantité",
+		"Total":                               "Total",
+	},
+}
+
+/* Translate returns s in the language, given as a tag such as "es" or "pt-BR". A regional tag
+   falls back to its base language and then to English. */
+func Translate(language, s string) string {
+	language = strings.ToLower(strings.Replace(language, "_", "-", -1))
+	for language != "" {
+		if t, ok := translations[language][s]; ok {
+			return t
+		}
+		i := strings.LastIndex(language, "-")
+		if i < 0 {
+			break
+		}
+		language = language[:i]
+	}
+	return s
+}
@@ -1,11 +1,9 @@
 package api
 
 import (
-	"fmt"
-	"net/smtp"
-	"strings"
+	"sync"
+	"time"
 
-	"errors"
 	"github.com/OpenBazaar/openbazaar-go/api/notifications"
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/repo"
@@ -17,11 +15,20 @@ import (
 // each received object.
 type notificationManager struct {
 	node *core.OpenBazaarNode
+	now  func() time.Time
+
+	// Email and push notifications held during quiet hours, by channel
+	lock        sync.Mutex
+	held        map[string][]interface{}
+	dropped     map[string]int
+	lastSummary time.Time
 }
 
 func manageNotifications(node *core.OpenBazaarNode, out chan []byte) chan interface{} {
-	manager := &notificationManager{node: node}
+	manager := newNotificationManager(node)
 	nodeBroadcast := make(chan interface{})
+	go retryWebhooks(node.Datastore.Webhooks())
+	go manager.run()
 	go func() {
 		for {
 			n := <-nodeBroadcast
@@ -29,6 +36,9 @@ func manageNotifications(node *core.OpenBazaarNode, out chan []byte) chan interf
 			// enough to let us send any data to the websocket. You can technically do that by
 			// sending over a []byte as the serialize function ignores []bytes but it's kind of hacky.
 			manager.sendNotification(n)
+			if !allowedInUI(manager.preferences(), n) {
+				continue
+			}
 			sanitized, err := SanitizeJSON(notifications.Serialize(n))
 			if err != nil {
 				log.Error(err)
@@ -40,75 +50,103 @@ func manageNotifications(node *core.OpenBazaarNode, out chan []byte) chan interf
 	return nodeBroadcast
 }
 
+func newNotificationManager(node *core.OpenBazaarNode) *notificationManager {
+	return &notificationManager{
+		node:    node,
+		now:     time.Now,
+		held:    make(map[string][]interface{}),
+		dropped: make(map[string]int),
+	}
+}
+
 type notifier interface {
 	notify(n interface{}) error
+	channel() string
 }
 
-// Send notification via all supported notifier mechanisms
+/* Send notification via all supported notifier mechanisms the user's preferences allow for its
+   type. During quiet hours notifications for email and push are held for the next digest. */
 func (m *notificationManager) sendNotification(n interface{}) {
+	prefs := m.preferences()
+	event := notificationEvent(n)
+	quiet := prefs.Quiet(m.now())
+
+	// Several notifiers can share a channel and the digest goes to each of them, so hold it once
+	held := make(map[string]bool)
 	for _, notifier := range m.getNotifiers() {
+		c := notifier.channel()
+		if !prefs.Allows(event, c) {
+			continue
+		}
+		if quiet && (c == repo.NotificationChannelEmail || c == repo.NotificationChannelPush) {
+			held[c] = true
+			continue
+		}
 		if err := notifier.notify(n); err != nil {
 			log.Errorf("Notification failed: %s", err.Error())
 		}
 	}
+	for c := range held {
+		m.hold(c, n)
+	}
 }
 
-// Create list of notifiers based on settings data (currently only SMTP is available)
-// TODO: should be extended to include new notifiers in the list
-func (m *notificationManager) getNotifiers() []notifier {
+func (m *notificationManager) preferences() *repo.NotificationPreferences {
 	settings, err := m.node.Datastore.Settings().Get()
-	notifiers := []notifier{}
 	if err != nil {
-		return notifiers
-	}
-
-	// SMTP notifier
-	conf := settings.SMTPSettings
-	if conf != nil && conf.Notifications {
-		notifiers = append(notifiers, &smtpNotifier{settings: conf})
+		return nil
 	}
-	return notifiers
+	return settings.NotificationPreferences
 }
 
-// Notifier implementations
-type smtpNotifier struct {
-	settings *repo.SMTPSettings
+/* The preferences only filter notifications. Other broadcasts, such as the responses to async
+   API calls and status messages, always reach the websocket. */
+func allowedInUI(prefs *repo.NotificationPreferences, n interface{}) bool {
+	event := notificationEvent(n)
+	return event == "" || prefs.Allows(event, repo.NotificationChannelUI)
 }
 
-func (notifier *smtpNotifier) notify(n interface{}) error {
-	template := strings.Join([]string{
-		"From: %s",
-		"To: %s",
-		"MIME-Version: 1.0",
-		"Content-Type: text/html; charset=UTF-8",
-		"Subject: [OpenBazaar] %s\r\n",
-		"%s\r\n",
-	}, "\r\n")
-	head, body := notifications.Describe(n)
-	if head == "" || body == "" {
-		return nil
+// notificationEvent names the type of n for the notification preferences
+func notificationEvent(n interface{}) string {
+	switch n.(type) {
+	case notifications.ChatTyping:
+		return "typing"
+	case notifications.ChatRead:
+		return "chatRead"
 	}
-	conf := notifier.settings
-	data := fmt.Sprintf(template, conf.SenderEmail, conf.RecipientEmail, head, body)
-	return sendEmail(notifier.settings, []byte(data))
+	return notifications.EventType(n)
 }
 
-// Send email using PLAIN authentication to the server
-func sendEmail(conf *repo.SMTPSettings, body []byte) error {
-	hostAndPort := strings.Split(conf.ServerAddress, ":")
-	host := conf.ServerAddress
-	if len(hostAndPort) == 2 {
-		host = hostAndPort[0]
+// Create list of notifiers based on settings data and webhook subscriptions
+func (m *notificationManager) getNotifiers() []notifier {
+	notifiers := []notifier{}
+
+	// SMTP notifier
+	settings, err := m.node.Datastore.Settings().Get()
+	if err == nil && settings.SMTPSettings != nil && settings.SMTPSettings.Notifications {
+		notifiers = append(notifiers, &smtpNotifier{settings: settings.SMTPSettings, node: m.node})
+	}
+
+	// Push notifiers
+	if err == nil && settings.PushNotifications != nil {
+		for _, p := range *settings.PushNotifications {
+			if p.Notifications {
+				notifiers = append(notifiers, &pushNotifier{settings: p})
+			}
+		}
 	}
-	auth := smtp.PlainAuth("", conf.Username, conf.Password, host)
-	recipients := []string{conf.RecipientEmail}
-	return smtp.SendMail(conf.ServerAddress, auth, conf.SenderEmail, recipients, body)
-}
 
-func validateSMTPSettings(s repo.SettingsData) error {
-	if s.SMTPSettings != nil && s.SMTPSettings.Notifications &&
-		(s.SMTPSettings.Password == "" || s.SMTPSettings.Username == "" || s.SMTPSettings.RecipientEmail == "" || s.SMTPSettings.SenderEmail == "" || s.SMTPSettings.ServerAddress == "") {
-		return errors.New("SMTP fields must be set if notifications are turned on")
+	// Webhook notifiers
+	hooks, err := m.node.Datastore.Webhooks().GetAll()
+	if err != nil {
+		log.Error(err)
 	}
-	return nil
+	for _, hook := range hooks {
+		notifiers = append(notifiers, &webhookNotifier{db: m.node.Datastore.Webhooks(), hook: hook})
+	}
+	return notifiers
+}
+
+func validateNotificationPreferences(s repo.SettingsData) error {
+	return s.NotificationPreferences.Validate()
 }
@@ -0,0 +1,265 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+/* openAPIDocument describes the registered routes as an OpenAPI 3 document. Paths are given
+   relative to the versioned prefix. Schemas are generated from the request and response
+   types, using the field names jsonpb gives protobuf messages. */
+func openAPIDocument() map[string]interface{} {
+	g := &schemaGenerator{schemas: make(map[string]interface{})}
+	paths := make(map[string]map[string]interface{})
+	for _, rt := range routes {
+		params := rt.params
+		if len(params) == 0 {
+			params = []string{""}
+		}
+		name := handlerName(rt.handler)
+		for _, p := range params {
+			opID := name
+			if len(params) > 1 && p != "" {
+				opID += "By" + strings.Join(pathParams(p), "And")
+			}
+			op := map[string]interface{}{
+				"operationId": opID,
+				"summary":     rt.summary,
+				"tags":        []string{strings.Split(strings.TrimPrefix(rt.path, "/"), "/")[0]},
+			}
+			var parameters []map[string]interface{}
+			for _, name := range pathParams(p) {
+				parameters = append(parameters, map[string]interface{}{
+					"name":     name,
+					"in":       "path",
+					"required": true,
+					"schema":   map[string]interface{}{"type": "string"},
+				})
+			}
+			for _, name := range rt.query {
+				parameters = append(parameters, map[string]interface{}{
+					"name":   name,
+					"in":     "query",
+					"schema": map[string]interface{}{"type": "string"},
+				})
+			}
+			if len(parameters) > 0 {
+				op["parameters"] = parameters
+			}
+			if rt.request != nil {
+				op["requestBody"] = map[string]interface{}{
+					"required": true,
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{"schema": g.schema(reflect.TypeOf(rt.request))},
+					},
+				}
+			}
+			var content map[string]interface{}
+			switch {
+			case rt.produces != "":
+				content = map[string]interface{}{rt.produces: map[string]interface{}{}}
+			case rt.response != nil:
+				content = map[string]interface{}{"application/json": map[string]interface{}{"schema": g.schema(reflect.TypeOf(rt.response))}}
+			default:
+				content = map[string]interface{}{"application/json": map[string]interface{}{"schema": map[string]interface{}{"type": "object"}}}
+			}
+			ok := map[string]interface{}{"description": "OK", "content": content}
+			for _, name := range rt.query {
+				if name == "limit" {
+					ok["headers"] = map[string]interface{}{
+						totalCountHeader: map[string]interface{}{
+							"description": "The number of items matching the filters",
+							"schema":      map[string]interface{}{"type": "integer"},
+						},
+					}
+				}
+			}
+			op["responses"] = map[string]interface{}{
+				"200": ok,
+				"default": map[string]interface{}{
+					"description": "Error",
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{"schema": g.schema(reflect.TypeOf(APIError{}))},
+					},
+				},
+			}
+			if paths[rt.path+p] == nil {
+				paths[rt.path+p] = make(map[string]interface{})
+			}
+			paths[rt.path+p][strings.ToLower(rt.method)] = op
+		}
+	}
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   "OpenBazaar API",
+			"version": core.VERSION,
+		},
+		"servers": []map[string]interface{}{{"url": apiVersionPrefix}},
+		"paths":   paths,
+		"components": map[string]interface{}{
+			"schemas": g.schemas,
+			"securitySchemes": map[string]interface{}{
+				"bearer": map[string]interface{}{"type": "http", "scheme": "bearer"},
+				"basic":  map[string]interface{}{"type": "http", "scheme": "basic"},
+				"cookie": map[string]interface{}{"type": "apiKey", "in": "cookie", "name": "OpenBazaar_Auth_Cookie"},
+			},
+		},
+		"security": []map[string][]string{{"bearer": {}}, {"basic": {}}, {"cookie": {}}},
+	}
+}
+
+// handlerName returns the name of a jsonAPIHandler method, such as GETStatus
+func handlerName(handler func(*jsonAPIHandler, http.ResponseWriter, *http.Request)) string {
+	name := runtime.FuncForPC(reflect.ValueOf(handler).Pointer()).Name()
+	name = name[strings.LastIndex(name, ".")+1:]
+	return strings.TrimSuffix(name, "-fm")
+}
+
+// pathParams returns the parameter names in a path such as /{peerId}/{slug}
+func pathParams(p string) []string {
+	var names []string
+	for _, segment := range strings.Split(p, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			names = append(names, segment[1:len(segment)-1])
+		}
+	}
+	return names
+}
+
+type schemaGenerator struct {
+	schemas map[string]interface{}
+}
+
+// schema returns the schema of t. Named structs are added to the components and referenced.
+func (g *schemaGenerator) schema(t reflect.Type) map[string]interface{} {
+	switch t {
+	case timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case rawMessageType:
+		return map[string]interface{}{}
+	}
+	// Enums and well known types as jsonpb marshals them
+	if _, ok := reflect.PtrTo(t).MethodByName("EnumDescriptor"); ok && t.Kind() == reflect.Int32 {
+		return map[string]interface{}{"type": "string"}
+	}
+	if t.Kind() == reflect.Struct && t.Name() == "Timestamp" && strings.HasSuffix(t.PkgPath(), "ptypes/timestamp") {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.schema(t.Elem())
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float32:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": g.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": g.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.object(t)
+		}
+		name := schemaName(t)
+		if _, ok := g.schemas[name]; !ok {
+			// Add a placeholder first so recursive types refer to themselves
+			g.schemas[name] = map[string]interface{}{}
+			g.schemas[name] = g.object(t)
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	}
+	return map[string]interface{}{}
+}
+
+func (g *schemaGenerator) object(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	g.addFields(t, properties)
+	return map[string]interface{}{"type": "object", "properties": properties}
+}
+
+func (g *schemaGenerator) addFields(t reflect.Type, properties map[string]interface{}) {
+	for n := 0; n < t.NumField(); n++ {
+		f := t.Field(n)
+		if f.PkgPath != "" || strings.HasPrefix(f.Name, "XXX_") || f.Type.Kind() == reflect.Interface {
+			continue
+		}
+		name, skip := fieldName(f)
+		if skip {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		// Embedded structs are flattened like encoding/json does
+		if f.Anonymous && f.Tag.Get("json") == "" && ft.Kind() == reflect.Struct {
+			g.addFields(ft, properties)
+			continue
+		}
+		properties[name] = g.schema(f.Type)
+	}
+}
+
+/* fieldName returns the name of a field in JSON. Protobuf fields use their json= name like
+   jsonpb does, other fields follow the json tag. */
+func fieldName(f reflect.StructField) (string, bool) {
+	for _, opt := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(opt, "json=") {
+			return strings.TrimPrefix(opt, "json="), false
+		}
+	}
+	for _, opt := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(opt, "name=") {
+			return strings.TrimPrefix(opt, "name="), false
+		}
+	}
+	tag := strings.Split(f.Tag.Get("json"), ",")[0]
+	if tag == "-" {
+		return "", true
+	}
+	if tag != "" {
+		return tag, false
+	}
+	return f.Name, false
+}
+
+// schemaName qualifies the names of types from other packages, such as pb.Listing
+func schemaName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if pkg == reflect.TypeOf(APIError{}).PkgPath() {
+		return t.Name()
+	}
+	return pkg[strings.LastIndex(pkg, "/")+1:] + "." + t.Name()
+}
+
+func (i *jsonAPIHandler) GETOpenAPI(w http.ResponseWriter, r *http.Request) {
+	ret, err := json.MarshalIndent(openAPIDocument(), "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
@@ -0,0 +1,224 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	defaultPushTitleTemplate = "{{.Title}}"
+	defaultPushBodyTemplate  = "{{.Body}}"
+
+	// Gotify only shows notifications on phones from this priority up
+	gotifyPriority = 5
+)
+
+var pushClient = &http.Client{Timeout: time.Second * 10}
+
+// pushNotifier sends notifications to an HTTP push service such as ntfy, Gotify or a Matrix room
+type pushNotifier struct {
+	settings repo.PushSettings
+}
+
+// pushMessage is the data the title and body templates are executed with
+type pushMessage struct {
+	Event        string
+	Title        string
+	Body         string
+	Notification interface{}
+}
+
+func (notifier *pushNotifier) channel() string {
+	return repo.NotificationChannelPush
+}
+
+func (notifier *pushNotifier) notify(n interface{}) error {
+	event := notifications.EventType(n)
+	if event != "" && !pushSubscribed(notifier.settings, event) {
+		return nil
+	}
+	if digest, ok := n.(notifications.DigestNotification); ok {
+		var subscribed []interface{}
+		for _, held := range digest.Notifications {
+			if pushSubscribed(notifier.settings, notifications.EventType(held)) {
+				subscribed = append(subscribed, held)
+			}
+		}
+		digest.Notifications = subscribed
+		n = digest
+	}
+	head, body := notifications.Describe(n)
+	if head == "" || body == "" {
+		return nil
+	}
+	msg := pushMessage{Event: event, Title: head, Body: body, Notification: n}
+	title, err := executePushTemplate(notifier.settings.TitleTemplate, defaultPushTitleTemplate, msg)
+	if err != nil {
+		return err
+	}
+	text, err := executePushTemplate(notifier.settings.BodyTemplate, defaultPushBodyTemplate, msg)
+	if err != nil {
+		return err
+	}
+	req, err := newPushRequest(notifier.settings, event, title, text)
+	if err != nil {
+		return err
+	}
+	resp, err := pushClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("Push service responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func pushSubscribed(settings repo.PushSettings, event string) bool {
+	if len(settings.Events) == 0 {
+		return true
+	}
+	for _, e := range settings.Events {
+		if e == event || e == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func executePushTemplate(text, fallback string, msg pushMessage) (string, error) {
+	if text == "" {
+		text = fallback
+	}
+	t, err := template.New("push").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, msg); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// newPushRequest builds the request each service expects for a message
+func newPushRequest(settings repo.PushSettings, event, title, body string) (*http.Request, error) {
+	var (
+		req *http.Request
+		err error
+	)
+	base := strings.TrimSuffix(settings.URL, "/")
+	switch settings.Service {
+	case repo.PushServiceNtfy:
+		req, err = http.NewRequest("POST", settings.URL, strings.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Title", title)
+		if event != "" {
+			req.Header.Set("Tags", event)
+		}
+	case repo.PushServiceGotify:
+		req, err = newJSONRequest("POST", base+"/message", map[string]interface{}{
+			"title":    title,
+			"message":  body,
+			"priority": gotifyPriority,
+		})
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-Gotify-Key", settings.Token)
+		return req, nil
+	case repo.PushServiceMatrix:
+		// Matrix needs a transaction ID which is unique for each message sent
+		txnID := "ob" + strconv.FormatInt(time.Now().UnixNano(), 10)
+		u := base + "/_matrix/client/r0/rooms/" + url.PathEscape(settings.Room) + "/send/m.room.message/" + txnID
+		req, err = newJSONRequest("PUT", u, map[string]interface{}{
+			"msgtype": "m.text",
+			"body":    title + "\n\n" + body,
+		})
+	case repo.PushServiceJSON:
+		req, err = newJSONRequest("POST", settings.URL, map[string]interface{}{
+			"event": event,
+			"title": title,
+			"body":  body,
+		})
+	default:
+		return nil, fmt.Errorf("Unknown push service %s", settings.Service)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if settings.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+settings.Token)
+	}
+	return req, nil
+}
+
+func newJSONRequest(method, url string, body interface{}) (*http.Request, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(method, url, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+func validatePushSettings(s repo.SettingsData) error {
+	if s.PushNotifications == nil {
+		return nil
+	}
+	for _, p := range *s.PushNotifications {
+		if err := validatePushNotifier(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePushNotifier(p repo.PushSettings) error {
+	known := false
+	for _, s := range repo.PushServices {
+		if p.Service == s {
+			known = true
+		}
+	}
+	if !known {
+		return fmt.Errorf("Push service must be one of %s", strings.Join(repo.PushServices, ", "))
+	}
+	u, err := url.Parse(p.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("Push notifications require an http or https URL")
+	}
+	if (p.Service == repo.PushServiceGotify || p.Service == repo.PushServiceMatrix) && p.Token == "" {
+		return fmt.Errorf("%s push notifications require a token", p.Service)
+	}
+	if p.Service == repo.PushServiceMatrix && p.Room == "" {
+		return errors.New("matrix push notifications require a room")
+	}
+	for _, t := range []string{p.TitleTemplate, p.BodyTemplate} {
+		if _, err := template.New("push").Parse(t); err != nil {
+			return fmt.Errorf("Invalid push notification template: %s", err)
+		}
+	}
+	return nil
+}
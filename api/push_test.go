@@ -0,0 +1,154 @@
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type pushRequest struct {
+	method string
+	path   string
+	header http.Header
+	body   []byte
+}
+
+func newPushServer(status int) (*httptest.Server, *[]pushRequest) {
+	var requests []pushRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, pushRequest{r.Method, r.URL.Path, r.Header, body})
+		w.WriteHeader(status)
+	}))
+	return ts, &requests
+}
+
+var testPayment = notifications.PaymentNotification{OrderId: "QmOrder", FundingTotal: 100}
+
+func TestPushNotifierServices(t *testing.T) {
+	ts, requests := newPushServer(http.StatusOK)
+	defer ts.Close()
+
+	ntfy := pushNotifier{repo.PushSettings{Service: repo.PushServiceNtfy, URL: ts.URL + "/orders", Token: "tk"}}
+	if err := ntfy.notify(testPayment); err != nil {
+		t.Fatal(err)
+	}
+	r := (*requests)[0]
+	if r.method != "POST" || r.path != "/orders" || r.header.Get("Title") != "Payment received" || r.header.Get("Tags") != "payment" {
+		t.Error("ntfy received the wrong request")
+	}
+	if r.header.Get("Authorization") != "Bearer tk" || !strings.Contains(string(r.body), "QmOrder") {
+		t.Error("ntfy received the wrong request")
+	}
+
+	gotify := pushNotifier{repo.PushSettings{Service: repo.PushServiceGotify, URL: ts.URL, Token: "app"}}
+	if err := gotify.notify(testPayment); err != nil {
+		t.Fatal(err)
+	}
+	r = (*requests)[1]
+	var msg map[string]interface{}
+	if err := json.Unmarshal(r.body, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if r.path != "/message" || r.header.Get("X-Gotify-Key") != "app" || msg["title"] != "Payment received" {
+		t.Error("Gotify received the wrong request")
+	}
+
+	matrix := pushNotifier{repo.PushSettings{Service: repo.PushServiceMatrix, URL: ts.URL, Token: "mx", Room: "!room:example.org"}}
+	if err := matrix.notify(testPayment); err != nil {
+		t.Fatal(err)
+	}
+	r = (*requests)[2]
+	if r.method != "PUT" || !strings.HasPrefix(r.path, "/_matrix/client/r0/rooms/!room:example.org/send/m.room.message/") || r.header.Get("Authorization") != "Bearer mx" {
+		t.Error("Matrix received the wrong request")
+	}
+
+	generic := pushNotifier{repo.PushSettings{Service: repo.PushServiceJSON, URL: ts.URL}}
+	if err := generic.notify(testPayment); err != nil {
+		t.Fatal(err)
+	}
+	r = (*requests)[3]
+	msg = nil
+	if err := json.Unmarshal(r.body, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg["event"] != "payment" || msg["title"] != "Payment received" || r.header.Get("Authorization") != "" {
+		t.Error("JSON push received the wrong request")
+	}
+}
+
+func TestPushNotifierEventsAndTemplates(t *testing.T) {
+	ts, requests := newPushServer(http.StatusOK)
+	defer ts.Close()
+
+	n := pushNotifier{repo.PushSettings{
+		Service:       repo.PushServiceJSON,
+		URL:           ts.URL,
+		Events:        []string{"order"},
+		TitleTemplate: "[{{.Event}}] {{.Title}}",
+		BodyTemplate:  "{{.Notification.OrderId}}",
+	}}
+	if err := n.notify(testPayment); err != nil {
+		t.Fatal(err)
+	}
+	if len(*requests) != 0 {
+		t.Error("Unsubscribed event was pushed")
+	}
+
+	n.settings.Events = []string{"payment"}
+	if err := n.notify(testPayment); err != nil {
+		t.Fatal(err)
+	}
+	var msg map[string]interface{}
+	if err := json.Unmarshal((*requests)[0].body, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg["title"] != "[payment] Payment received" || msg["body"] != "QmOrder" {
+		t.Error("Push templates were not applied")
+	}
+}
+
+func TestPushNotifierError(t *testing.T) {
+	ts, _ := newPushServer(http.StatusUnauthorized)
+	defer ts.Close()
+
+	n := pushNotifier{repo.PushSettings{Service: repo.PushServiceNtfy, URL: ts.URL}}
+	if err := n.notify(notifications.TestNotification{}); err == nil {
+		t.Error("Expected an error for a non-2xx response")
+	}
+}
+
+func TestValidatePushSettings(t *testing.T) {
+	valid := []repo.PushSettings{
+		{Service: repo.PushServiceNtfy, URL: "https://ntfy.sh/topic"},
+		{Service: repo.PushServiceGotify, URL: "http://localhost:8080", Token: "app"},
+		{Service: repo.PushServiceMatrix, URL: "https://matrix.org", Token: "mx", Room: "!room:matrix.org"},
+		{Service: repo.PushServiceJSON, URL: "http://localhost", TitleTemplate: "{{.Title}}"},
+	}
+	for _, p := range valid {
+		if err := validatePushNotifier(p); err != nil {
+			t.Errorf("%s push settings should be valid: %s", p.Service, err)
+		}
+	}
+	invalid := []repo.PushSettings{
+		{Service: "pigeon", URL: "https://ntfy.sh/topic"},
+		{Service: repo.PushServiceNtfy, URL: "ftp://ntfy.sh/topic"},
+		{Service: repo.PushServiceGotify, URL: "http://localhost:8080"},
+		{Service: repo.PushServiceMatrix, URL: "https://matrix.org", Token: "mx"},
+		{Service: repo.PushServiceJSON, URL: "http://localhost", BodyTemplate: "{{.Body"},
+	}
+	for _, p := range invalid {
+		if err := validatePushNotifier(p); err == nil {
+			t.Errorf("Push settings %+v should be invalid", p)
+		}
+	}
+	if err := validatePushSettings(repo.SettingsData{PushNotifications: &invalid}); err == nil {
+		t.Error("Settings with invalid push notifiers should be invalid")
+	}
+}
@@ -0,0 +1,246 @@
+package api
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type routeCost struct {
+	method string
+	prefix string
+	cost   int
+}
+
+/* defaultRouteCosts sets how many tokens a request takes from the rate limit buckets for the
+   routes which do expensive work such as resolving IPNS names or fetching from other peers.
+   The longest matching prefix applies and requests to other routes cost one token. */
+var defaultRouteCosts = []routeCost{
+	{"POST", "/ob/fetchprofiles", 10},
+	{"POST", "/ob/fetchratings", 10},
+	{"POST", "/ob/publish", 20},
+	{"GET", "/ob/listings/", 5},
+	{"GET", "/ob/profile/", 5},
+	{"GET", "/ob/followers/", 3},
+	{"GET", "/ob/following/", 3},
+	{"GET", "/ob/posts/", 5},
+	{"GET", "/ob/ipns/", 5},
+	{"GET", "/ob/resolve/", 5},
+	{"GET", "/ob/status/", 2},
+}
+
+const rateLimitSweepInterval = time.Minute
+
+// tokenBucket holds the tokens left for one IP address or credential
+type tokenBucket struct {
+	limit  repo.RateLimit
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) rate() float64 {
+	return float64(b.limit.RequestsPerMinute) / 60
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens = math.Min(float64(b.limit.Burst), b.tokens+now.Sub(b.last).Seconds()*b.rate())
+	b.last = now
+}
+
+/* rateLimiter throttles API requests with a token bucket for each client IP address and another
+   for each credential. A request must fit in both buckets and takes the cost of its route from
+   each of them, the IP bucket first. Buckets which have refilled are dropped about once a minute. */
+type rateLimiter struct {
+	perIP         repo.RateLimit
+	perCredential repo.RateLimit
+	exemptIPs     map[string]bool
+	costs         []routeCost
+	now           func() time.Time
+
+	lock      sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+
+	allowed               uint64
+	throttled             uint64
+	throttledByIP         uint64
+	throttledByCredential uint64
+	throttledRoutes       map[string]uint64
+}
+
+// rateLimitResult is the outcome of a request against the rate limits
+type rateLimitResult struct {
+	allowed    bool
+	limit      int
+	remaining  int
+	retryAfter time.Duration
+}
+
+// newRateLimiter returns a limiter for the config, or nil if rate limiting is disabled
+func newRateLimiter(config repo.RateLimitConfig) *rateLimiter {
+	if !config.Enabled {
+		return nil
+	}
+	exemptIPs := make(map[string]bool)
+	for _, ip := range config.ExemptIPs {
+		exemptIPs[ip] = true
+	}
+	costs := make([]routeCost, len(defaultRouteCosts))
+	copy(costs, defaultRouteCosts)
+	for route, cost := range config.Costs {
+		f := strings.Fields(route)
+		rc := routeCost{strings.ToUpper(f[0]), f[1], cost}
+		overridden := false
+		for i, c := range costs {
+			if c.method == rc.method && c.prefix == rc.prefix {
+				costs[i] = rc
+				overridden = true
+			}
+		}
+		if !overridden {
+			costs = append(costs, rc)
+		}
+	}
+	return &rateLimiter{
+		perIP:           config.PerIP,
+		perCredential:   config.PerCredential,
+		exemptIPs:       exemptIPs,
+		costs:           costs,
+		now:             time.Now,
+		buckets:         make(map[string]*tokenBucket),
+		throttledRoutes: make(map[string]uint64),
+	}
+}
+
+func (l *rateLimiter) cost(method, path string) int {
+	cost := 1
+	longest := 0
+	for _, c := range l.costs {
+		if c.method == method && strings.HasPrefix(path, c.prefix) && len(c.prefix) > longest {
+			cost = c.cost
+			longest = len(c.prefix)
+		}
+	}
+	return cost
+}
+
+func (l *rateLimiter) bucket(key string, limit repo.RateLimit, now time.Time) *tokenBucket {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{limit: limit, tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+	b.refill(now)
+	return b
+}
+
+/* allowIP takes the cost of a request from the bucket for the client IP address. It is called
+   before the request is authenticated so failed attempts count against the limit too. */
+func (l *rateLimiter) allowIP(ip, method, path string) rateLimitResult {
+	if l.exemptIPs[ip] {
+		return rateLimitResult{allowed: true, remaining: math.MaxInt32}
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	result := l.take("ip:"+ip, l.perIP, method, path)
+	if !result.allowed {
+		l.throttledByIP++
+	}
+	return result
+}
+
+/* allowCredential takes the cost of an authenticated request from the bucket for the credential
+   it authenticated with, if any, and counts the request as allowed if it fits. */
+func (l *rateLimiter) allowCredential(ip, credential, method, path string) rateLimitResult {
+	if l.exemptIPs[ip] {
+		return rateLimitResult{allowed: true, remaining: math.MaxInt32}
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	result := rateLimitResult{allowed: true, remaining: math.MaxInt32}
+	if credential != "" {
+		result = l.take("credential:"+credential, l.perCredential, method, path)
+	}
+	if !result.allowed {
+		l.throttledByCredential++
+		return result
+	}
+	l.allowed++
+	return result
+}
+
+/* take removes the cost of the request from the bucket if it has enough tokens, otherwise the
+   result says how long to wait before retrying. Must be called with the lock held. */
+func (l *rateLimiter) take(key string, limit repo.RateLimit, method, path string) rateLimitResult {
+	now := l.now()
+	if now.Sub(l.lastSweep) >= rateLimitSweepInterval {
+		l.sweep(now)
+	}
+	b := l.bucket(key, limit, now)
+
+	// A request costing more than the burst could never be served so it takes the whole bucket
+	cost := math.Min(float64(l.cost(method, path)), float64(b.limit.Burst))
+	if b.tokens < cost {
+		l.throttled++
+		l.throttledRoutes[routeKey(method, path)]++
+		return rateLimitResult{retryAfter: time.Duration((cost - b.tokens) / b.rate() * float64(time.Second))}
+	}
+	b.tokens -= cost
+	return rateLimitResult{allowed: true, limit: b.limit.Burst, remaining: int(b.tokens)}
+}
+
+// and combines the results of two buckets, reporting the one with the fewest tokens left
+func (r rateLimitResult) and(o rateLimitResult) rateLimitResult {
+	combined := r
+	if o.remaining < r.remaining {
+		combined.limit = o.limit
+		combined.remaining = o.remaining
+	}
+	combined.allowed = r.allowed && o.allowed
+	if o.retryAfter > combined.retryAfter {
+		combined.retryAfter = o.retryAfter
+	}
+	return combined
+}
+
+// sweep drops the buckets which have refilled since they're the same as new ones
+func (l *rateLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// status returns the throttling counters
+func (l *rateLimiter) status() *RateLimitStatus {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	routes := make(map[string]uint64)
+	for route, n := range l.throttledRoutes {
+		routes[route] = n
+	}
+	return &RateLimitStatus{
+		Enabled:               true,
+		Allowed:               l.allowed,
+		Throttled:             l.throttled,
+		ThrottledByIP:         l.throttledByIP,
+		ThrottledByCredential: l.throttledByCredential,
+		ThrottledRoutes:       routes,
+		Clients:               len(l.buckets),
+	}
+}
+
+/* routeKey names the route serving a request for the throttling counters. Paths matching no
+   route share one counter so clients can't grow the counters without limit. */
+func routeKey(method, path string) string {
+	if rt := findRoute(method, path); rt != nil {
+		return rt.method + " " + rt.path
+	}
+	return method + " <unknown>"
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	l := newRateLimiter(repo.RateLimitConfig{
+		Enabled:       true,
+		PerIP:         repo.RateLimit{RequestsPerMinute: 60, Burst: 10},
+		PerCredential: repo.RateLimit{RequestsPerMinute: 120, Burst: 5},
+		ExemptIPs:     []string{"127.0.0.1"},
+		Costs:         map[string]int{"POST /ob/fetchprofiles": 4, "GET /ob/config": 0},
+	})
+	l.now = func() time.Time { return now }
+
+	// allow charges a request the way ServeHTTP does, the IP address first
+	allow := func(ip, credential, method, path string) rateLimitResult {
+		res := l.allowIP(ip, method, path)
+		if !res.allowed {
+			return res
+		}
+		return res.and(l.allowCredential(ip, credential, method, path))
+	}
+
+	// The IP bucket allows ten requests costing one token
+	for n := 0; n < 10; n++ {
+		if res := allow("1.2.3.4", "", "GET", "/ob/profile"); !res.allowed || res.remaining != 9-n {
+			t.Fatalf("Request %d: unexpected result %+v", n, res)
+		}
+	}
+	res := allow("1.2.3.4", "", "GET", "/ob/profile")
+	if res.allowed || res.retryAfter != time.Second {
+		t.Fatalf("Expected to be throttled for a second, got %+v", res)
+	}
+	// Free routes and exempt IPs are always allowed
+	if !allow("1.2.3.4", "", "GET", "/ob/config").allowed {
+		t.Error("Expected a free route to be allowed")
+	}
+	if !allow("127.0.0.1", "", "POST", "/ob/publish").allowed {
+		t.Error("Expected an exempt IP to be allowed")
+	}
+	now = now.Add(2 * time.Second)
+	if !allow("1.2.3.4", "", "GET", "/ob/profile").allowed {
+		t.Error("Expected tokens to be refilled")
+	}
+
+	// The credential bucket applies across IPs and the cost is capped at the burst
+	if res := allow("5.6.7.8", "user:test", "POST", "/ob/fetchprofiles"); !res.allowed || res.limit != 5 || res.remaining != 1 {
+		t.Fatalf("Unexpected result %+v", res)
+	}
+	if res := allow("9.9.9.9", "user:test", "POST", "/ob/fetchprofiles"); res.allowed || res.retryAfter != 1500*time.Millisecond {
+		t.Fatalf("Expected to be throttled by the credential, got %+v", res)
+	}
+	// The throttled request still took four tokens from the IP bucket
+	if res := allow("9.9.9.9", "user:test", "POST", "/ob/publish"); res.allowed || res.retryAfter != 4*time.Second {
+		t.Fatalf("Expected to be throttled by the IP, got %+v", res)
+	}
+	now = now.Add(4 * time.Second)
+	if !allow("9.9.9.9", "user:test", "POST", "/ob/publish").allowed {
+		t.Error("Expected a route costing more than the burst to take the whole bucket")
+	}
+
+	s := l.status()
+	if s.Allowed != 14 || s.Throttled != 3 || s.ThrottledByIP != 2 || s.ThrottledByCredential != 1 {
+		t.Errorf("Unexpected counters %+v", s)
+	}
+	if s.ThrottledRoutes["GET /ob/profile"] != 1 || s.ThrottledRoutes["POST /ob/fetchprofiles"] != 1 || s.ThrottledRoutes["POST /ob/publish"] != 1 {
+		t.Errorf("Unexpected route counters %v", s.ThrottledRoutes)
+	}
+
+	// Refilled buckets are dropped
+	now = now.Add(time.Minute)
+	allow("1.2.3.4", "", "GET", "/ob/profile")
+	if s := l.status(); s.Clients != 1 {
+		t.Errorf("Expected 1 client after the sweep, got %d", s.Clients)
+	}
+}
+
+func TestRouteKey(t *testing.T) {
+	if k := routeKey("GET", "/ob/profile"); k != "GET /ob/profile" {
+		t.Errorf("Wrong key for a route: %s", k)
+	}
+	if k := routeKey("GET", "/no/such/route/1"); k != "GET <unknown>" || routeKey("GET", "/no/such/route/2") != k {
+		t.Errorf("Unknown paths should share a key, got %s", k)
+	}
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	if newRateLimiter(repo.RateLimitConfig{}) != nil {
+		t.Error("Expected no limiter when rate limiting is disabled")
+	}
+}
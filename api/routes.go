@@ -0,0 +1,221 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// The registered routes are served under this prefix as well as at their legacy paths
+const apiVersionPrefix = "/v1"
+
+/* route registers a handler for requests to path and to any path below it. Params lists the
+   path parameters the handler reads from the rest of the path, one entry per form the route
+   accepts, and query the query parameters it reads. Request and response are the types of
+   the JSON bodies, nil if there is none. Handlers which don't respond with JSON set produces. */
+type route struct {
+	method   string
+	path     string
+	params   []string
+	query    []string
+	summary  string
+	request  interface{}
+	response interface{}
+	produces string
+	handler  func(*jsonAPIHandler, http.ResponseWriter, *http.Request)
+}
+
+var (
+	withPeerID     = []string{"/{peerId}"}
+	optionalPeerID = []string{"", "/{peerId}"}
+	coinQuery      = []string{"coin"}
+	searchQuery    = []string{"state", "search", "sortBy", "limit"}
+	pageQuery      = []string{"offsetId", "limit"}
+	listingQuery   = []string{"tags", "categories", "contractType", "currency", "minPrice", "maxPrice", "from", "to", "sortBy", "offsetId", "limit"}
+	postQuery      = []string{"tags", "from", "to", "sortBy", "offsetId", "limit"}
+)
+
+// Routes are registered in init as the OpenAPI handler refers to them
+var routes []route
+
+func init() {
+	routes = []route{
+		// Node
+		{method: "GET", path: "/ob/status", params: optionalPeerID, summary: "Get whether this node or a peer is online", response: StatusResponse{}, handler: (*jsonAPIHandler).GETStatus},
+		{method: "GET", path: "/ob/peers", summary: "List connected peers", response: []string{}, handler: (*jsonAPIHandler).GETPeers},
+		{method: "GET", path: "/ob/closestpeers", params: withPeerID, summary: "List the peers closest to a peer ID", response: []string{}, handler: (*jsonAPIHandler).GETClosestPeers},
+		{method: "GET", path: "/ob/peerinfo", params: withPeerID, summary: "Get the addresses of a peer", handler: (*jsonAPIHandler).GETPeerInfo},
+		{method: "GET", path: "/ob/config", summary: "Get the node configuration", response: ConfigResponse{}, handler: (*jsonAPIHandler).GETConfig},
+		{method: "GET", path: "/ob/healthcheck", summary: "Check the database, IPFS root and peer connections", response: HealthCheckResponse{}, handler: (*jsonAPIHandler).GETHealthCheck},
+		{method: "GET", path: "/ob/resolve", params: []string{"/{name}"}, summary: "Resolve a handle to a peer ID", produces: "text/plain", handler: (*jsonAPIHandler).GETResolve},
+		{method: "GET", path: "/ob/ipns", params: withPeerID, summary: "Get the IPNS record of a peer", response: IPNSRecordResponse{}, handler: (*jsonAPIHandler).GETIPNS},
+		{method: "POST", path: "/ob/publish", summary: "Publish the node's data to IPNS", handler: (*jsonAPIHandler).POSTPublish},
+		{method: "POST", path: "/ob/purgecache", summary: "Remove cached data of other peers", handler: (*jsonAPIHandler).POSTPurgeCache},
+		{method: "POST", path: "/ob/shutdown", summary: "Shut down the node", handler: (*jsonAPIHandler).POSTShutdown},
+		{method: "POST", path: "/ob/blocknode", params: withPeerID, summary: "Block a peer", handler: (*jsonAPIHandler).POSTBlockNode},
+		{method: "DELETE", path: "/ob/blocknode", params: withPeerID, summary: "Unblock a peer", handler: (*jsonAPIHandler).DELETEBlockNode},
+		{method: "GET", path: "/ob/openapi", summary: "Get the OpenAPI description of this API", handler: (*jsonAPIHandler).GETOpenAPI},
+
+		// Settings
+		{method: "GET", path: "/ob/settings", summary: "Get the settings", response: repo.SettingsData{}, handler: (*jsonAPIHandler).GETSettings},
+		{method: "POST", path: "/ob/settings", summary: "Create the settings", request: repo.SettingsData{}, response: repo.SettingsData{}, handler: (*jsonAPIHandler).POSTSettings},
+		{method: "PUT", path: "/ob/settings", summary: "Replace the settings", request: repo.SettingsData{}, handler: (*jsonAPIHandler).PUTSettings},
+		{method: "PATCH", path: "/ob/settings", summary: "Update some of the settings", request: repo.SettingsData{}, handler: (*jsonAPIHandler).PATCHSettings},
+		{method: "POST", path: "/ob/testemailnotifications", summary: "Send a test email notification", request: repo.SMTPSettings{}, handler: (*jsonAPIHandler).POSTTestEmailNotifications},
+		{method: "POST", path: "/ob/testpushnotifications", summary: "Send a test push notification", request: repo.PushSettings{}, handler: (*jsonAPIHandler).POSTTestPushNotifications},
+		{method: "GET", path: "/ob/exchangerate", params: []string{"", "/{currency}"}, query: []string{"coin", "sources"}, summary: "Get exchange rates", response: map[string]float64{}, handler: (*jsonAPIHandler).GETExchangeRate},
+		{method: "GET", path: "/ob/apitokens", summary: "List API tokens", response: []repo.APIToken{}, handler: (*jsonAPIHandler).GETAPITokens},
+		{method: "POST", path: "/ob/apitokens", summary: "Create an API token", request: APITokenRequest{}, response: APITokenResponse{}, handler: (*jsonAPIHandler).POSTAPIToken},
+		{method: "DELETE", path: "/ob/apitokens", params: []string{"/{name}"}, summary: "Revoke an API token", handler: (*jsonAPIHandler).DELETEAPIToken},
+		{method: "GET", path: "/ob/webhooks", summary: "List webhooks", response: []repo.Webhook{}, handler: (*jsonAPIHandler).GETWebhooks},
+		{method: "POST", path: "/ob/webhooks", summary: "Create a webhook", request: WebhookRequest{}, response: WebhookResponse{}, handler: (*jsonAPIHandler).POSTWebhook},
+		{method: "DELETE", path: "/ob/webhooks", params: []string{"/{id}"}, summary: "Delete a webhook", handler: (*jsonAPIHandler).DELETEWebhook},
+		{method: "GET", path: "/ob/webhookdeliveries", params: []string{"/{id}"}, query: []string{"limit"}, summary: "List the recent deliveries to a webhook", response: []repo.WebhookDelivery{}, handler: (*jsonAPIHandler).GETWebhookDeliveries},
+
+		// Profile
+		{method: "GET", path: "/ob/profile", params: optionalPeerID, query: []string{"usecache"}, summary: "Get the profile of this node or a peer", response: pb.Profile{}, handler: (*jsonAPIHandler).GETProfile},
+		{method: "POST", path: "/ob/profile", summary: "Create the profile", request: pb.Profile{}, response: pb.Profile{}, handler: (*jsonAPIHandler).POSTProfile},
+		{method: "PUT", path: "/ob/profile", summary: "Replace the profile", request: pb.Profile{}, response: pb.Profile{}, handler: (*jsonAPIHandler).PUTProfile},
+		{method: "PATCH", path: "/ob/profile", summary: "Update some of the profile", request: pb.Profile{}, handler: (*jsonAPIHandler).PATCHProfile},
+		{method: "POST", path: "/ob/fetchprofiles", query: []string{"async", "usecache"}, summary: "Fetch the profiles of peers", request: []string{}, response: []pb.Profile{}, handler: (*jsonAPIHandler).POSTFetchProfiles},
+		{method: "PUT", path: "/ob/moderator", summary: "Become a moderator", request: pb.Moderator{}, handler: (*jsonAPIHandler).PUTModerator},
+		{method: "DELETE", path: "/ob/moderator", summary: "Stop being a moderator", handler: (*jsonAPIHandler).DELETEModerator},
+		{method: "GET", path: "/ob/moderators", query: []string{"async", "include"}, summary: "Find moderators", response: []string{}, handler: (*jsonAPIHandler).GETModerators},
+		{method: "GET", path: "/ob/moderatordirectory", summary: "List known moderators with their availability", response: []ModeratorDirectoryEntry{}, handler: (*jsonAPIHandler).GETModeratorDirectory},
+
+		// Images
+		{method: "POST", path: "/ob/images", summary: "Upload images", request: []ImageUpload{}, response: []UploadedImage{}, handler: (*jsonAPIHandler).POSTImage},
+		{method: "POST", path: "/ob/avatar", summary: "Set the avatar", request: AvatarRequest{}, response: pb.Profile_Image{}, handler: (*jsonAPIHandler).POSTAvatar},
+		{method: "POST", path: "/ob/header", summary: "Set the header image", request: HeaderRequest{}, response: pb.Profile_Image{}, handler: (*jsonAPIHandler).POSTHeader},
+		{method: "GET", path: "/ob/image", params: []string{"/{hash}"}, summary: "Get an image", produces: "image/*", handler: (*jsonAPIHandler).GETImage},
+		{method: "GET", path: "/ob/avatar", params: []string{"/{peerId}/{size}"}, query: []string{"usecache"}, summary: "Get the avatar of a peer", produces: "image/*", handler: (*jsonAPIHandler).GETAvatar},
+		{method: "GET", path: "/ob/header", params: []string{"/{peerId}/{size}"}, query: []string{"usecache"}, summary: "Get the header image of a peer", produces: "image/*", handler: (*jsonAPIHandler).GETHeader},
+
+		// Social
+		{method: "POST", path: "/ob/follow", summary: "Follow a peer", request: PeerIDRequest{}, handler: (*jsonAPIHandler).POSTFollow},
+		{method: "POST", path: "/ob/unfollow", summary: "Unfollow a peer", request: PeerIDRequest{}, handler: (*jsonAPIHandler).POSTUnfollow},
+		{method: "GET", path: "/ob/followers", params: optionalPeerID, query: pageQuery, summary: "List the followers of this node or a peer", response: []string{}, handler: (*jsonAPIHandler).GETFollowers},
+		{method: "GET", path: "/ob/following", params: optionalPeerID, query: pageQuery, summary: "List the peers this node or a peer follows", response: []string{}, handler: (*jsonAPIHandler).GETFollowing},
+		{method: "GET", path: "/ob/followsme", params: withPeerID, summary: "Get whether a peer follows this node", response: FollowsMeResponse{}, handler: (*jsonAPIHandler).GETFollowsMe},
+		{method: "GET", path: "/ob/isfollowing", params: withPeerID, summary: "Get whether this node follows a peer", response: IsFollowingResponse{}, handler: (*jsonAPIHandler).GETIsFollowing},
+		{method: "GET", path: "/ob/posts", params: optionalPeerID, query: postQuery, summary: "List the posts of this node or a peer", handler: (*jsonAPIHandler).GETPosts},
+		{method: "GET", path: "/ob/post", params: []string{"/{slug}", "/{peerId}/{slug}"}, summary: "Get a post", response: pb.SignedPost{}, handler: (*jsonAPIHandler).GETPost},
+		{method: "POST", path: "/ob/post", summary: "Create a post", request: pb.Post{}, response: SlugResponse{}, handler: (*jsonAPIHandler).POSTPost},
+		{method: "PUT", path: "/ob/post", summary: "Update a post", request: pb.Post{}, handler: (*jsonAPIHandler).PUTPost},
+		{method: "DELETE", path: "/ob/post", params: []string{"/{slug}"}, summary: "Delete a post", handler: (*jsonAPIHandler).DELETEPost},
+
+		// Listings
+		{method: "GET", path: "/ob/listings", params: optionalPeerID, query: listingQuery, summary: "List the listings of this node or a peer", response: []core.ListingData{}, handler: (*jsonAPIHandler).GETListings},
+		{method: "GET", path: "/ob/listing", params: []string{"/{slug}", "/{peerId}/{slug}"}, summary: "Get a listing", response: pb.SignedListing{}, handler: (*jsonAPIHandler).GETListing},
+		{method: "POST", path: "/ob/listing", summary: "Create a listing", request: pb.Listing{}, response: SlugResponse{}, handler: (*jsonAPIHandler).POSTListing},
+		{method: "PUT", path: "/ob/listing", summary: "Update a listing", request: pb.Listing{}, handler: (*jsonAPIHandler).PUTListing},
+		{method: "DELETE", path: "/ob/listing", params: []string{"/{slug}"}, summary: "Delete a listing", handler: (*jsonAPIHandler).DELETEListing},
+		{method: "POST", path: "/ob/importlistings", summary: "Import listings from a CSV file uploaded as the multipart field file", handler: (*jsonAPIHandler).POSTImportListings},
+		{method: "GET", path: "/ob/inventory", summary: "Get the inventory of the listings", response: []InventoryEntry{}, handler: (*jsonAPIHandler).GETInventory},
+		{method: "POST", path: "/ob/inventory", summary: "Set the inventory of listings", request: []InventoryEntry{}, handler: (*jsonAPIHandler).POSTInventory},
+
+		// Orders
+		{method: "POST", path: "/ob/purchase", summary: "Purchase a listing", request: core.PurchaseData{}, response: PurchaseResponse{}, handler: (*jsonAPIHandler).POSTPurchase},
+		{method: "POST", path: "/ob/estimatetotal", summary: "Estimate the total of a purchase", request: core.PurchaseData{}, produces: "text/plain", handler: (*jsonAPIHandler).POSTEstimateTotal},
+		{method: "POST", path: "/ob/paylightning", params: []string{"/{orderId}"}, summary: "Pay the Lightning invoice of an order", handler: (*jsonAPIHandler).POSTPayLightning},
+		{method: "GET", path: "/ob/order", params: []string{"/{orderId}"}, summary: "Get an order", response: pb.OrderRespApi{}, handler: (*jsonAPIHandler).GETOrder},
+		{method: "GET", path: "/ob/purchases", query: searchQuery, summary: "List purchases", response: PurchasesResponse{}, handler: (*jsonAPIHandler).GETPurchases},
+		{method: "POST", path: "/ob/purchases", summary: "Search purchases", request: TransactionQuery{}, response: PurchasesResponse{}, handler: (*jsonAPIHandler).POSTPurchases},
+		{method: "GET", path: "/ob/sales", query: searchQuery, summary: "List sales", response: SalesResponse{}, handler: (*jsonAPIHandler).GETSales},
+		{method: "POST", path: "/ob/sales", summary: "Search sales", request: TransactionQuery{}, response: SalesResponse{}, handler: (*jsonAPIHandler).POSTSales},
+		{method: "POST", path: "/ob/orderconfirmation", summary: "Confirm or reject an order", request: OrderConfirmationRequest{}, handler: (*jsonAPIHandler).POSTOrderConfirmation},
+		{method: "POST", path: "/ob/ordercancel", summary: "Cancel an order", request: OrderRequest{}, handler: (*jsonAPIHandler).POSTOrderCancel},
+		{method: "POST", path: "/ob/orderfulfillment", summary: "Fulfill an order", request: pb.OrderFulfillment{}, handler: (*jsonAPIHandler).POSTOrderFulfill},
+		{method: "POST", path: "/ob/ordercompletion", summary: "Complete an order and rate it", request: core.OrderRatings{}, handler: (*jsonAPIHandler).POSTOrderComplete},
+		{method: "POST", path: "/ob/refund", summary: "Refund an order", request: OrderRequest{}, handler: (*jsonAPIHandler).POSTRefund},
+		{method: "POST", path: "/ob/acceleratepayment", params: []string{"/{orderId}"}, summary: "Bump the fee of an order payment", response: TxidResponse{}, handler: (*jsonAPIHandler).POSTAcceleratePayment},
+		{method: "POST", path: "/ob/releasefunds", summary: "Release the funds of a closed dispute", request: OrderRequest{}, handler: (*jsonAPIHandler).POSTReleaseFunds},
+		{method: "POST", path: "/ob/releaseescrow", summary: "Release escrowed funds after the timeout", request: OrderRequest{}, handler: (*jsonAPIHandler).POSTReleaseEscrow},
+
+		// Disputes
+		{method: "POST", path: "/ob/opendispute", summary: "Open a dispute", request: OpenDisputeRequest{}, handler: (*jsonAPIHandler).POSTOpenDispute},
+		{method: "POST", path: "/ob/closedispute", summary: "Close a dispute as the moderator", request: CloseDisputeRequest{}, handler: (*jsonAPIHandler).POSTCloseDispute},
+		{method: "POST", path: "/ob/endorseresolution", summary: "Endorse a moderator panel resolution", request: OrderRequest{}, handler: (*jsonAPIHandler).POSTEndorseResolution},
+		{method: "GET", path: "/ob/panelresolution", params: []string{"/{orderId}"}, summary: "Get the panel resolution of a dispute", response: pb.RicardianContract{}, handler: (*jsonAPIHandler).GETPanelResolution},
+		{method: "GET", path: "/ob/case", params: []string{"/{orderId}"}, summary: "Get a dispute case", response: pb.CaseRespApi{}, handler: (*jsonAPIHandler).GETCase},
+		{method: "GET", path: "/ob/cases", query: searchQuery, summary: "List dispute cases", response: CasesResponse{}, handler: (*jsonAPIHandler).GETCases},
+		{method: "POST", path: "/ob/cases", summary: "Search dispute cases", request: TransactionQuery{}, response: CasesResponse{}, handler: (*jsonAPIHandler).POSTCases},
+
+		// Ratings
+		{method: "GET", path: "/ob/ratings", params: []string{"/{peerId}", "/{peerId}/{slug}"}, query: pageQuery, summary: "List the ratings of a listing", response: RatingsResponse{}, handler: (*jsonAPIHandler).GETRatings},
+		{method: "GET", path: "/ob/rating", params: []string{"/{ratingId}"}, query: []string{"replies"}, summary: "Get a rating", response: RatingResponse{}, handler: (*jsonAPIHandler).GETRating},
+		{method: "POST", path: "/ob/fetchratings", query: []string{"async"}, summary: "Fetch ratings", request: []string{}, response: []pb.Rating{}, handler: (*jsonAPIHandler).POSTFetchRatings},
+		{method: "GET", path: "/ob/aggregateratings", params: []string{"/{peerId}/{slug}"}, summary: "Get the aggregated ratings of a vendor or listing", response: core.RatingAggregate{}, handler: (*jsonAPIHandler).GETAggregateRatings},
+		{method: "POST", path: "/ob/ratingreply", summary: "Reply to a rating", request: RatingReplyRequest{}, response: pb.RatingReply{}, handler: (*jsonAPIHandler).POSTRatingReply},
+		{method: "POST", path: "/ob/amendrating", summary: "Amend or retract a rating", request: core.OrderRatingAmendment{}, response: pb.RatingAmendment{}, handler: (*jsonAPIHandler).POSTAmendRating},
+
+		// Chat
+		{method: "POST", path: "/ob/chat", summary: "Send a chat message", request: repo.ChatMessage{}, response: MessageIDResponse{}, handler: (*jsonAPIHandler).POSTChat},
+		{method: "POST", path: "/ob/groupchat", summary: "Send a group chat message", request: repo.GroupChatMessage{}, response: MessageIDResponse{}, handler: (*jsonAPIHandler).POSTGroupChat},
+		{method: "GET", path: "/ob/chatmessages", params: withPeerID, query: []string{"subject", "offsetId", "limit"}, summary: "List the chat messages with a peer", response: []repo.ChatMessage{}, handler: (*jsonAPIHandler).GETChatMessages},
+		{method: "GET", path: "/ob/chatconversations", summary: "List chat conversations", response: []repo.ChatConversation{}, handler: (*jsonAPIHandler).GETChatConversations},
+		{method: "POST", path: "/ob/markchatasread", params: withPeerID, query: []string{"subject"}, summary: "Mark the chat messages with a peer as read", handler: (*jsonAPIHandler).POSTMarkChatAsRead},
+		{method: "DELETE", path: "/ob/chatmessage", params: []string{"/{messageId}"}, summary: "Delete a chat message", handler: (*jsonAPIHandler).DELETEChatMessage},
+		{method: "DELETE", path: "/ob/chatconversation", params: withPeerID, summary: "Delete the chat conversation with a peer", handler: (*jsonAPIHandler).DELETEChatConversation},
+
+		// Notifications
+		{method: "GET", path: "/ob/notifications", query: []string{"offsetId", "limit", "filter"}, summary: "List notifications", response: NotificationsResponse{}, handler: (*jsonAPIHandler).GETNotifications},
+		{method: "POST", path: "/ob/marknotificationasread", params: []string{"/{notificationId}"}, summary: "Mark a notification as read", handler: (*jsonAPIHandler).POSTMarkNotificationAsRead},
+		{method: "POST", path: "/ob/marknotificationsasread", summary: "Mark all notifications as read", handler: (*jsonAPIHandler).POSTMarkNotificationsAsRead},
+		{method: "DELETE", path: "/ob/notifications", params: []string{"/{notificationId}"}, summary: "Delete a notification", handler: (*jsonAPIHandler).DELETENotification},
+
+		// Accounting
+		{method: "GET", path: "/ob/profitandloss", query: []string{"currency", "from", "to", "period"}, summary: "Get the profit and loss of the wallets", response: core.ProfitAndLoss{}, handler: (*jsonAPIHandler).GETProfitAndLoss},
+		{method: "GET", path: "/wallet/export", query: []string{"coin", "currency", "format", "from", "to"}, summary: "Export wallet transactions as CSV or OFX", produces: "text/csv", handler: (*jsonAPIHandler).GETExport},
+
+		// Wallet
+		{method: "GET", path: "/wallet/address", query: coinQuery, summary: "Get a new receiving address", response: AddressResponse{}, handler: (*jsonAPIHandler).GETAddress},
+		{method: "GET", path: "/wallet/mnemonic", summary: "Get the wallet mnemonic", response: MnemonicResponse{}, handler: (*jsonAPIHandler).GETMnemonic},
+		{method: "GET", path: "/wallet/balance", query: coinQuery, summary: "Get the wallet balance", response: BalanceResponse{}, handler: (*jsonAPIHandler).GETBalance},
+		{method: "GET", path: "/wallet/status", query: coinQuery, summary: "Get the wallet sync status", response: WalletStatusResponse{}, handler: (*jsonAPIHandler).GETWalletStatus},
+		{method: "GET", path: "/wallet/transactions", query: []string{"coin", "status", "from", "to", "sortBy", "offsetId", "limit"}, summary: "List wallet transactions", response: TransactionsResponse{}, handler: (*jsonAPIHandler).GETTransactions},
+		{method: "POST", path: "/wallet/spend", summary: "Send coins", request: SpendRequest{}, response: SpendResponse{}, handler: (*jsonAPIHandler).POSTSpendCoins},
+		{method: "POST", path: "/wallet/spendmany", summary: "Send coins to several addresses", request: SpendManyRequest{}, response: SpendResponse{}, handler: (*jsonAPIHandler).POSTSpendMany},
+		{method: "POST", path: "/wallet/estimatespendmany", summary: "Estimate the fee of sending coins to several addresses", request: SpendManyRequest{}, response: EstimatedFeeResponse{}, handler: (*jsonAPIHandler).POSTEstimateSpendMany},
+		{method: "POST", path: "/wallet/bumpfee", params: []string{"/{txid}"}, query: coinQuery, summary: "Bump the fee of a transaction", response: SpendResponse{}, handler: (*jsonAPIHandler).POSTBumpFee},
+		{method: "GET", path: "/wallet/estimatefee", query: []string{"coin", "feeLevel", "amount"}, summary: "Estimate the fee of sending coins", response: EstimatedFeeResponse{}, handler: (*jsonAPIHandler).GETEstimateFee},
+		{method: "GET", path: "/wallet/fees", query: coinQuery, summary: "Get the fee rates", response: FeesResponse{}, handler: (*jsonAPIHandler).GETFees},
+		{method: "POST", path: "/wallet/resyncblockchain", query: coinQuery, summary: "Rescan the blockchain", handler: (*jsonAPIHandler).POSTResyncBlockchain},
+		{method: "GET", path: "/wallet/utxos", query: coinQuery, summary: "List unspent outputs", response: []core.UtxoInfo{}, handler: (*jsonAPIHandler).GETUtxos},
+		{method: "POST", path: "/wallet/utxo", summary: "Label or freeze an unspent output", request: UtxoMetadata{}, handler: (*jsonAPIHandler).POSTUtxo},
+		{method: "GET", path: "/wallet/sweeps", query: coinQuery, summary: "List automatic sweeps", response: []repo.Sweep{}, handler: (*jsonAPIHandler).GETSweeps},
+		{method: "GET", path: "/wallet/signingrequests", summary: "List cold storage signing requests", response: []core.SigningRequest{}, handler: (*jsonAPIHandler).GETSigningRequests},
+		{method: "GET", path: "/wallet/signingrequest", params: []string{"/{id}"}, summary: "Get a cold storage signing request", response: core.SigningRequest{}, handler: (*jsonAPIHandler).GETSigningRequest},
+		{method: "POST", path: "/wallet/signatures", summary: "Submit cold storage signatures", request: core.SigningResponse{}, handler: (*jsonAPIHandler).POSTSignatures},
+	}
+}
+
+// findRoute returns the route for a request, matching whole path segments so that
+// /ob/marknotificationasread and /ob/marknotificationsasread can't be confused
+func findRoute(method, path string) *route {
+	var found *route
+	for n, r := range routes {
+		if r.method != method {
+			continue
+		}
+		if path != r.path && !strings.HasPrefix(path, r.path+"/") {
+			continue
+		}
+		if found == nil || len(r.path) > len(found.path) {
+			found = &routes[n]
+		}
+	}
+	return found
+}
+
+func dispatch(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request) {
+	rt := findRoute(r.Method, path)
+	if rt == nil {
+		ErrorResponse(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	rt.handler(i, w, r)
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFindRoute(t *testing.T) {
+	tests := []struct {
+		method  string
+		path    string
+		handler string
+	}{
+		{"GET", "/ob/profile", "GETProfile"},
+		{"GET", "/ob/profile/QmYHNbN4xCNsNyWqhWZJBjSfXgxk5pwuQnXcTkHtzyDcS9", "GETProfile"},
+		{"POST", "/ob/profile", "POSTProfile"},
+		{"PUT", "/ob/profile", "PUTProfile"},
+		{"POST", "/ob/marknotificationasread/1", "POSTMarkNotificationAsRead"},
+		{"POST", "/ob/marknotificationsasread", "POSTMarkNotificationsAsRead"},
+		{"GET", "/ob/openapi", "GETOpenAPI"},
+	}
+	for _, test := range tests {
+		rt := findRoute(test.method, test.path)
+		if rt == nil {
+			t.Errorf("%s %s: no route found", test.method, test.path)
+			continue
+		}
+		if name := handlerName(rt.handler); name != test.handler {
+			t.Errorf("%s %s: routed to %s, expected %s", test.method, test.path, name, test.handler)
+		}
+	}
+
+	for _, path := range []string{"/ob/profiles", "/ob/prof", "/ob/nothing"} {
+		if rt := findRoute("GET", path); rt != nil {
+			t.Errorf("GET %s: routed to %s, expected no route", path, handlerName(rt.handler))
+		}
+	}
+	if rt := findRoute("DELETE", "/ob/config"); rt != nil {
+		t.Errorf("DELETE /ob/config: routed to %s, expected no route", handlerName(rt.handler))
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	b, err := json.Marshal(openAPIDocument())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		OpenAPI    string                                `json:"openapi"`
+		Paths      map[string]map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]json.RawMessage `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenAPI != "3.0.0" {
+		t.Errorf("Unexpected OpenAPI version %s", doc.OpenAPI)
+	}
+
+	operations := make(map[string]bool)
+	for path, methods := range doc.Paths {
+		for method, raw := range methods {
+			var op struct {
+				OperationID string `json:"operationId"`
+			}
+			if err := json.Unmarshal(raw, &op); err != nil {
+				t.Fatal(err)
+			}
+			if operations[op.OperationID] {
+				t.Errorf("Duplicate operation ID %s at %s %s", op.OperationID, method, path)
+			}
+			operations[op.OperationID] = true
+		}
+	}
+	for _, path := range []string{"/ob/config", "/ob/profile", "/ob/profile/{peerId}", "/ob/ratings/{peerId}/{slug}"} {
+		if _, ok := doc.Paths[path]; !ok {
+			t.Errorf("Path %s missing", path)
+		}
+	}
+	if _, ok := doc.Paths["/ob/profile"]["get"]; !ok {
+		t.Error("GET /ob/profile missing")
+	}
+
+	// Protobuf messages use the jsonpb field names
+	profile, ok := doc.Components.Schemas["pb.Profile"]
+	if !ok {
+		t.Fatal("pb.Profile schema missing")
+	}
+	for _, field := range []string{"peerID", "shortDescription", "avatarHashes"} {
+		if _, ok := profile.Properties[field]; !ok {
+			t.Errorf("pb.Profile field %s missing", field)
+		}
+	}
+	// Embedded structs are flattened and json:"-" fields skipped
+	webhook, ok := doc.Components.Schemas["WebhookResponse"]
+	if !ok {
+		t.Fatal("WebhookResponse schema missing")
+	}
+	for _, field := range []string{"id", "url", "events", "secret"} {
+		if _, ok := webhook.Properties[field]; !ok {
+			t.Errorf("WebhookResponse field %s missing", field)
+		}
+	}
+}
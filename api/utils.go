@@ -1,12 +1,19 @@
 package api
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// List endpoints report the number of items matching their filters in this header
+const totalCountHeader = "X-Total-Count"
+
 type TransactionQuery struct {
 	OrderStates     []int    `json:"states"`
 	SearchTerm      string   `json:"search"`
@@ -58,3 +65,129 @@ func convertOrderStates(states []int) []pb.OrderState {
 	}
 	return orderStates
 }
+
+// parsePage reads the offsetId and limit query parameters. Without a limit, or with -1, every item is returned.
+func parsePage(q url.Values) (repo.Page, error) {
+	page := repo.Page{OffsetID: q.Get("offsetId"), Limit: -1}
+	if l := q.Get("limit"); l != "" {
+		limit, err := strconv.Atoi(l)
+		if err != nil {
+			return page, fmt.Errorf("invalid limit %s", l)
+		}
+		if limit >= 0 {
+			page.Limit = limit
+		}
+	}
+	return page, nil
+}
+
+// parseList reads a comma separated query parameter
+func parseList(q url.Values, key string) []string {
+	var list []string
+	for _, v := range strings.Split(q.Get(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// parseTimeRange reads the from and to query parameters, which are RFC 3339 times
+func parseTimeRange(q url.Values) (repo.TimeRange, error) {
+	var r repo.TimeRange
+	for key, t := range map[string]*time.Time{"from": &r.From, "to": &r.To} {
+		v := q.Get(key)
+		if v == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return r, fmt.Errorf("invalid %s time %s", key, v)
+		}
+		*t = parsed
+	}
+	return r, nil
+}
+
+func parseSortBy(q url.Values, sorts []string) (string, error) {
+	sortBy := strings.ToLower(q.Get("sortBy"))
+	if sortBy == "" {
+		return "", nil
+	}
+	for _, s := range sorts {
+		if sortBy == s {
+			return sortBy, nil
+		}
+	}
+	return "", fmt.Errorf("cannot sort by %s, expected one of %s", sortBy, strings.Join(sorts, ", "))
+}
+
+func parseListingQuery(q url.Values) (query repo.ListingQuery, err error) {
+	query.Tags = parseList(q, "tags")
+	query.Categories = parseList(q, "categories")
+	query.ContractType = q.Get("contractType")
+	query.Currency = q.Get("currency")
+	for key, price := range map[string]*uint64{"minPrice": &query.MinPrice, "maxPrice": &query.MaxPrice} {
+		if v := q.Get(key); v != "" {
+			if *price, err = strconv.ParseUint(v, 10, 64); err != nil {
+				return query, fmt.Errorf("invalid %s %s", key, v)
+			}
+		}
+	}
+	if query.Created, err = parseTimeRange(q); err != nil {
+		return query, err
+	}
+	if query.SortBy, err = parseSortBy(q, repo.ListingSorts); err != nil {
+		return query, err
+	}
+	query.Page, err = parsePage(q)
+	return query, err
+}
+
+func parsePostQuery(q url.Values) (query repo.PostQuery, err error) {
+	query.Tags = parseList(q, "tags")
+	if query.Posted, err = parseTimeRange(q); err != nil {
+		return query, err
+	}
+	if query.SortBy, err = parseSortBy(q, repo.PostSorts); err != nil {
+		return query, err
+	}
+	query.Page, err = parsePage(q)
+	return query, err
+}
+
+func parseWalletTransactionQuery(q url.Values) (query repo.WalletTransactionQuery, err error) {
+	query.Statuses = parseList(q, "status")
+	if query.Date, err = parseTimeRange(q); err != nil {
+		return query, err
+	}
+	if query.SortBy, err = parseSortBy(q, repo.WalletTransactionSorts); err != nil {
+		return query, err
+	}
+	query.Page, err = parsePage(q)
+	return query, err
+}
+
+/* indexPage returns the entries of a JSON index with the given slugs, in that order. The entries
+   are copied as they are so fields this node doesn't know about are kept. */
+func indexPage(index []byte, slugs []string) ([]byte, error) {
+	var entries []json.RawMessage
+	if err := json.Unmarshal(index, &entries); err != nil {
+		return nil, err
+	}
+	bySlug := make(map[string]json.RawMessage)
+	for _, e := range entries {
+		var entry struct {
+			Slug string `json:"slug"`
+		}
+		if err := json.Unmarshal(e, &entry); err != nil {
+			return nil, err
+		}
+		bySlug[entry.Slug] = e
+	}
+	page := []json.RawMessage{}
+	for _, slug := range slugs {
+		page = append(page, bySlug[slug])
+	}
+	return json.MarshalIndent(page, "", "    ")
+}
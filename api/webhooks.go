@@ -0,0 +1,180 @@
+package api
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const (
+	// Number of times a delivery is attempted before it's marked as failed
+	webhookMaxAttempts = 8
+
+	// Delay before the first retry. It doubles after each failed attempt.
+	webhookRetryBackoff = time.Minute
+
+	// How often pending deliveries are checked for retries
+	webhookRetryInterval = time.Second * 30
+
+	// How long finished deliveries are kept in the log
+	webhookLogRetention = time.Hour * 24 * 30
+)
+
+var webhookClient = &http.Client{Timeout: time.Second * 10}
+
+// webhookNotifier posts notifications to a single webhook subscription
+type webhookNotifier struct {
+	db   repo.Webhooks
+	hook repo.Webhook
+}
+
+func (notifier *webhookNotifier) channel() string {
+	return repo.NotificationChannelWebhook
+}
+
+func (notifier *webhookNotifier) notify(n interface{}) error {
+	event := notifications.EventType(n)
+	if event == "" || !webhookSubscribed(notifier.hook, event) {
+		return nil
+	}
+	now := time.Now()
+	d := repo.WebhookDelivery{
+		WebhookID:   notifier.hook.ID,
+		Event:       event,
+		Payload:     notifications.Serialize(n),
+		Status:      repo.WebhookDeliveryPending,
+		Created:     now,
+		NextAttempt: now.Add(webhookRetryBackoff),
+	}
+	id, err := notifier.db.PutDelivery(d)
+	if err != nil {
+		return err
+	}
+	d.ID = id
+	go deliverWebhook(notifier.db, notifier.hook, d)
+	return nil
+}
+
+func webhookSubscribed(hook repo.Webhook, event string) bool {
+	if len(hook.Events) == 0 {
+		return true
+	}
+	for _, e := range hook.Events {
+		if e == event || e == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// Attempt a delivery and record the result, scheduling a retry if it failed
+func deliverWebhook(db repo.Webhooks, hook repo.Webhook, d repo.WebhookDelivery) {
+	d.Attempts++
+	d.LastAttempt = time.Now()
+	d.ResponseCode, d.Error = 0, ""
+	code, err := postWebhook(hook, d)
+	d.ResponseCode = code
+	switch {
+	case err == nil:
+		d.Status = repo.WebhookDeliveryDelivered
+		d.NextAttempt = time.Time{}
+	case d.Attempts >= webhookMaxAttempts:
+		d.Status = repo.WebhookDeliveryFailed
+		d.Error = err.Error()
+		d.NextAttempt = time.Time{}
+	default:
+		d.Error = err.Error()
+		d.NextAttempt = d.LastAttempt.Add(webhookRetryBackoff << uint(d.Attempts-1))
+	}
+	if err != nil {
+		log.Warningf("Webhook delivery %d to %s failed (attempt %d): %s", d.ID, hook.URL, d.Attempts, err)
+	}
+	if err := db.UpdateDelivery(d); err != nil {
+		log.Error(err)
+	}
+}
+
+/* postWebhook sends the payload to the webhook URL. The body is signed with HMAC-SHA256 using
+   the webhook's secret so receivers can check it came from this node. Any response other than
+   a 2xx is treated as a failure. */
+func postWebhook(hook repo.Webhook, d repo.WebhookDelivery) (int, error) {
+	req, err := http.NewRequest("POST", hook.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "OpenBazaar-Webhook")
+	req.Header.Set("X-OpenBazaar-Event", d.Event)
+	req.Header.Set("X-OpenBazaar-Delivery", strconv.FormatInt(d.ID, 10))
+	req.Header.Set("X-OpenBazaar-Signature", "sha256="+signWebhookPayload(hook.Secret, d.Payload))
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("Webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+func signWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryWebhooks periodically retries pending deliveries and prunes old entries from the log
+func retryWebhooks(db repo.Webhooks) {
+	t := time.NewTicker(webhookRetryInterval)
+	defer t.Stop()
+	for range t.C {
+		retryPendingWebhooks(db, time.Now())
+		if err := db.PruneDeliveries(time.Now().Add(-webhookLogRetention)); err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+/* Retry the deliveries due by now. A delivery whose webhook no longer exists is marked as
+   failed so it isn't picked up again. */
+func retryPendingWebhooks(db repo.Webhooks, now time.Time) {
+	pending, err := db.GetPendingDeliveries(now)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	hooks := make(map[string]repo.Webhook)
+	for _, d := range pending {
+		hook, ok := hooks[d.WebhookID]
+		if !ok {
+			hook, err = db.Get(d.WebhookID)
+			if err == sql.ErrNoRows {
+				d.Status = repo.WebhookDeliveryFailed
+				d.Error = "Webhook was deleted"
+				d.NextAttempt = time.Time{}
+				if err := db.UpdateDelivery(d); err != nil {
+					log.Error(err)
+				}
+				continue
+			} else if err != nil {
+				log.Error(err)
+				continue
+			}
+			hooks[d.WebhookID] = hook
+		}
+		deliverWebhook(db, hook, d)
+	}
+}
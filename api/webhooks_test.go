@@ -0,0 +1,122 @@
+package api
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestPostWebhook(t *testing.T) {
+	payload := []byte(`{"notification": {"type": "order"}}`)
+	var signature, event string
+	var body []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get("X-OpenBazaar-Signature")
+		event = r.Header.Get("X-OpenBazaar-Event")
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer ts.Close()
+
+	hook := repo.Webhook{ID: "a1b2", URL: ts.URL, Secret: "hunter2"}
+	code, err := postWebhook(hook, repo.WebhookDelivery{ID: 1, Event: "order", Payload: payload})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code != 200 || event != "order" || string(body) != string(payload) {
+		t.Error("Webhook received the wrong request")
+	}
+	if signature != "sha256="+signWebhookPayload("hunter2", payload) || signature == "sha256="+signWebhookPayload("wrong", payload) {
+		t.Error("Webhook received the wrong signature")
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	hook.URL = failing.URL
+	code, err = postWebhook(hook, repo.WebhookDelivery{ID: 2, Event: "order", Payload: payload})
+	if err == nil || code != http.StatusServiceUnavailable {
+		t.Error("Expected an error for a non-2xx response")
+	}
+}
+
+func TestWebhookSubscribed(t *testing.T) {
+	if !webhookSubscribed(repo.Webhook{}, "order") {
+		t.Error("A webhook with no events should receive everything")
+	}
+	hook := repo.Webhook{Events: []string{"order", "payment"}}
+	if !webhookSubscribed(hook, "payment") || webhookSubscribed(hook, "chat") {
+		t.Error("Webhook event filter is wrong")
+	}
+}
+
+// An in memory webhook store. Only the methods used by retries are implemented.
+type memoryWebhooks struct {
+	repo.Webhooks
+	hooks      map[string]repo.Webhook
+	deliveries map[int64]repo.WebhookDelivery
+}
+
+func (m *memoryWebhooks) Get(id string) (repo.Webhook, error) {
+	hook, ok := m.hooks[id]
+	if !ok {
+		return repo.Webhook{}, sql.ErrNoRows
+	}
+	return hook, nil
+}
+
+func (m *memoryWebhooks) UpdateDelivery(d repo.WebhookDelivery) error {
+	m.deliveries[d.ID] = d
+	return nil
+}
+
+func (m *memoryWebhooks) GetPendingDeliveries(before time.Time) ([]repo.WebhookDelivery, error) {
+	var ret []repo.WebhookDelivery
+	for id := int64(1); id <= int64(len(m.deliveries)); id++ {
+		d := m.deliveries[id]
+		if d.Status == repo.WebhookDeliveryPending && !d.NextAttempt.After(before) {
+			ret = append(ret, d)
+		}
+	}
+	return ret, nil
+}
+
+func TestRetryPendingWebhooks(t *testing.T) {
+	received := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received++
+	}))
+	defer ts.Close()
+
+	now := time.Now()
+	db := &memoryWebhooks{
+		hooks: map[string]repo.Webhook{"a1b2": {ID: "a1b2", URL: ts.URL}},
+		deliveries: map[int64]repo.WebhookDelivery{
+			1: {ID: 1, WebhookID: "a1b2", Event: "order", Status: repo.WebhookDeliveryPending, Attempts: 1, NextAttempt: now},
+			2: {ID: 2, WebhookID: "deleted", Event: "order", Status: repo.WebhookDeliveryPending, Attempts: 1, NextAttempt: now},
+			3: {ID: 3, WebhookID: "a1b2", Event: "order", Status: repo.WebhookDeliveryPending, Attempts: 1, NextAttempt: now.Add(time.Hour)},
+		},
+	}
+	retryPendingWebhooks(db, now)
+
+	if received != 1 || db.deliveries[1].Status != repo.WebhookDeliveryDelivered || db.deliveries[1].Attempts != 2 {
+		t.Errorf("Due delivery was not retried: %+v", db.deliveries[1])
+	}
+	if d := db.deliveries[2]; d.Status != repo.WebhookDeliveryFailed || d.Attempts != 1 || !d.NextAttempt.IsZero() {
+		t.Errorf("Delivery to a deleted webhook was not marked failed: %+v", d)
+	}
+	if db.deliveries[3].Status != repo.WebhookDeliveryPending {
+		t.Error("Delivery which isn't due yet was retried")
+	}
+
+	// Nothing is left to retry for the deleted webhook
+	pending, _ := db.GetPendingDeliveries(now.Add(time.Hour))
+	if len(pending) != 1 || pending[0].ID != 3 {
+		t.Errorf("Wrong deliveries left pending: %+v", pending)
+	}
+}
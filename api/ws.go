@@ -9,6 +9,7 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/ipfs/go-ipfs/commands"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -21,6 +22,15 @@ type connection struct {
 
 	// The hub
 	h *hub
+
+	// Whether messages from the client are relayed
+	canSend bool
+
+	// The sequence number the client wants to replay events after, or -1 for no replay
+	since int64
+
+	// The replayed events, sent by the hub when the connection is registered
+	backlog chan [][]byte
 }
 
 func (c *connection) reader() {
@@ -30,6 +40,9 @@ func (c *connection) reader() {
 			break
 		}
 		log.Debugf("Incoming websocket message: %s", string(message))
+		if !c.canSend {
+			continue
+		}
 
 		// Just echo for now until we set up the API
 		c.h.Broadcast <- message
@@ -38,6 +51,13 @@ func (c *connection) reader() {
 }
 
 func (c *connection) writer() {
+	for _, message := range <-c.backlog {
+		err := c.ws.WriteMessage(websocket.TextMessage, message)
+		if err != nil {
+			c.ws.Close()
+			return
+		}
+	}
 	for message := range c.send {
 		err := c.ws.WriteMessage(websocket.TextMessage, message)
 		if err != nil {
@@ -65,10 +85,11 @@ type wsHandler struct {
 	cookie        http.Cookie
 	username      string
 	password      string
+	datastore     repo.Datastore
 }
 
 func newWSAPIHandler(node *core.OpenBazaarNode, ctx commands.Context, authCookie http.Cookie, config repo.APIConfig) (*wsHandler, error) {
-	hub := newHub()
+	hub := newHub(node.Datastore.EventLog(), node.EventLogMaxAge, node.EventLogMaxEvents)
 	go hub.run()
 	allowedIps := make(map[string]bool)
 	for _, ip := range config.AllowedIPs {
@@ -84,11 +105,23 @@ func newWSAPIHandler(node *core.OpenBazaarNode, ctx commands.Context, authCookie
 		cookie:        authCookie,
 		username:      config.Username,
 		password:      config.Password,
+		datastore:     node.Datastore,
 	}
 	return &handler, nil
 }
 
 func (wsh wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Clients reconnecting with since=<seq> are sent the events they missed
+	since := int64(-1)
+	if s := r.URL.Query().Get("since"); s != "" {
+		seq, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || seq < 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "400 - Invalid since")
+			return
+		}
+		since = seq
+	}
 	ws, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Error("Error upgrading to websockets:", err)
@@ -107,8 +140,22 @@ func (wsh wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
+	canSend := true
 	if wsh.authenticated {
-		if wsh.username == "" || wsh.password == "" {
+		// Browsers can't set headers on websockets so the token may also be a query parameter
+		token := bearerToken(r)
+		if token == "" {
+			token = r.URL.Query().Get("token")
+		}
+		if token != "" {
+			apiToken, err := wsh.datastore.APITokens().GetByHash(repo.HashAPIToken(token))
+			if err != nil || !(apiToken.HasScope(repo.ScopeReadOnly) || apiToken.HasScope(repo.ScopeChat)) {
+				w.WriteHeader(http.StatusForbidden)
+				fmt.Fprint(w, "403 - Forbidden")
+				return
+			}
+			canSend = apiToken.HasScope(repo.ScopeChat)
+		} else if wsh.username == "" || wsh.password == "" {
 			cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
 			if err != nil {
 				w.WriteHeader(http.StatusForbidden)
@@ -131,7 +178,7 @@ func (wsh wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 	}
-	c := &connection{send: make(chan []byte, 256), ws: ws, h: wsh.h}
+	c := &connection{send: make(chan []byte, 256), ws: ws, h: wsh.h, canSend: canSend, since: since, backlog: make(chan [][]byte, 1)}
 	c.h.register <- c
 	defer func() { c.h.unregister <- c }()
 	go c.writer()
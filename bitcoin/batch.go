@@ -0,0 +1,110 @@
+package bitcoin
+
+import (
+	"errors"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/spvwallet"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	btc "github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcwallet/wallet/txrules"
+)
+
+var ErrBatchSpendUnsupported = errors.New("Wallet does not support paying several addresses in one transaction")
+
+// One output of a batch spend
+type SpendOutput struct {
+	Address btc.Address
+	Amount  int64
+}
+
+// BatchSpender is implemented by wallets which can pay several addresses in one transaction with a single change output
+type BatchSpender interface {
+	SpendMany(outs []SpendOutput, feeLevel wallet.FeeLevel) (*chainhash.Hash, error)
+	EstimateSpendManyFee(outs []SpendOutput, feeLevel wallet.FeeLevel) (uint64, error)
+}
+
+/* SpendMany pays all of the outputs in one transaction. Wallets without their own coin
+   database are passed the coins to spend from. */
+func SpendMany(w wallet.Wallet, utxos []wallet.Utxo, outs []SpendOutput, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
+	if len(outs) == 0 {
+		return nil, errors.New("No outputs to pay")
+	}
+	switch sw := w.(type) {
+	case BatchSpender:
+		return sw.SpendMany(outs, feeLevel)
+	case *spvwallet.SPVWallet:
+		tx, err := buildSPVBatchTx(sw, utxos, outs, feeLevel)
+		if err != nil {
+			return nil, err
+		}
+		if err := sw.Broadcast(tx); err != nil {
+			return nil, err
+		}
+		txid := tx.TxHash()
+		return &txid, nil
+	}
+	return nil, ErrBatchSpendUnsupported
+}
+
+// EstimateSpendManyFee returns the fee a SpendMany of the outputs would pay
+func EstimateSpendManyFee(w wallet.Wallet, utxos []wallet.Utxo, outs []SpendOutput, feeLevel wallet.FeeLevel) (uint64, error) {
+	if len(outs) == 0 {
+		return 0, errors.New("No outputs to pay")
+	}
+	switch sw := w.(type) {
+	case BatchSpender:
+		return sw.EstimateSpendManyFee(outs, feeLevel)
+	case *spvwallet.SPVWallet:
+		// The fee doesn't depend on the signatures so a watch only wallet can estimate it too
+		outputs, err := SpendOutputs(sw, outs)
+		if err != nil {
+			return 0, err
+		}
+		tx, _, err := BuildUnsignedSpendTransaction(sw, utxos, false, outputs, feeLevel)
+		if err != nil {
+			return 0, err
+		}
+		values := make(map[wire.OutPoint]int64)
+		for _, u := range utxos {
+			values[u.Op] = u.Value
+		}
+		var inval, outval int64
+		for _, in := range tx.TxIn {
+			inval += values[in.PreviousOutPoint]
+		}
+		for _, out := range tx.TxOut {
+			outval += out.Value
+		}
+		if inval < outval {
+			return 0, errors.New("Error building transaction: inputs less than outputs")
+		}
+		return uint64(inval - outval), nil
+	}
+	return 0, ErrBatchSpendUnsupported
+}
+
+func buildSPVBatchTx(w *spvwallet.SPVWallet, utxos []wallet.Utxo, outs []SpendOutput, feeLevel wallet.FeeLevel) (*wire.MsgTx, error) {
+	outputs, err := SpendOutputs(w, outs)
+	if err != nil {
+		return nil, err
+	}
+	return BuildSpendTransaction(w, w.GetKey, utxos, false, outputs, feeLevel)
+}
+
+// SpendOutputs returns the transaction outputs paying the amounts to the addresses
+func SpendOutputs(w wallet.Wallet, outs []SpendOutput) ([]*wire.TxOut, error) {
+	var outputs []*wire.TxOut
+	for _, o := range outs {
+		script, err := w.AddressToScript(o.Address)
+		if err != nil {
+			return nil, err
+		}
+		if txrules.IsDustAmount(btc.Amount(o.Amount), len(script), txrules.DefaultRelayFeePerKb) {
+			return nil, wallet.ErrorDustAmount
+		}
+		outputs = append(outputs, wire.NewTxOut(o.Amount, script))
+	}
+	return outputs, nil
+}
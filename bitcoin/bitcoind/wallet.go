@@ -10,7 +10,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/OpenBazaar/spvwallet"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/spvwallet"
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
@@ -54,6 +55,7 @@ type BitcoindWallet struct {
 	useTor           bool
 	addrsToWatch     []btc.Address
 	initChan         chan struct{}
+	frozen           func(op wire.OutPoint) bool
 }
 
 var connCfg *btcrpcclient.ConnConfig = &btcrpcclient.ConnConfig{
@@ -405,6 +407,9 @@ func (w *BitcoindWallet) gatherCoins() (map[coinset.Coin]*hd.ExtendedKey, error)
 		if err != nil {
 			return m, err
 		}
+		if w.frozen != nil && w.frozen(*wire.NewOutPoint(txhash, u.Vout)) {
+			continue
+		}
 		addr, err := btc.DecodeAddress(u.Address, w.params)
 		if err != nil {
 			return m, err
@@ -432,6 +437,11 @@ func (w *BitcoindWallet) gatherCoins() (map[coinset.Coin]*hd.ExtendedKey, error)
 	return m, nil
 }
 
+// SetFrozenCoins makes the wallet leave the coins the node reports frozen out of its spends
+func (w *BitcoindWallet) SetFrozenCoins(frozen func(op wire.OutPoint) bool) {
+	w.frozen = frozen
+}
+
 func (w *BitcoindWallet) Spend(amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
 	<-w.initChan
 	tx, err := w.buildTx(amount, addr, feeLevel)
@@ -441,10 +451,24 @@ func (w *BitcoindWallet) Spend(amount int64, addr btc.Address, feeLevel wallet.F
 	return w.rpcClient.SendRawTransaction(tx, false)
 }
 
+func (w *BitcoindWallet) Broadcast(tx *wire.MsgTx) error {
+	<-w.initChan
+	_, err := w.rpcClient.SendRawTransaction(tx, false)
+	return err
+}
+
 func (w *BitcoindWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*wire.MsgTx, error) {
-	script, _ := txscript.PayToAddrScript(addr)
-	if txrules.IsDustAmount(btc.Amount(amount), len(script), txrules.DefaultRelayFeePerKb) {
-		return nil, wallet.ErrorDustAmount
+	return w.buildBatchTx([]bitcoin.SpendOutput{{Address: addr, Amount: amount}}, feeLevel)
+}
+
+func (w *BitcoindWallet) buildBatchTx(outs []bitcoin.SpendOutput, feeLevel wallet.FeeLevel) (*wire.MsgTx, error) {
+	var outputs []*wire.TxOut
+	for _, o := range outs {
+		script, _ := txscript.PayToAddrScript(o.Address)
+		if txrules.IsDustAmount(btc.Amount(o.Amount), len(script), txrules.DefaultRelayFeePerKb) {
+			return nil, wallet.ErrorDustAmount
+		}
+		outputs = append(outputs, wire.NewTxOut(o.Amount, script))
 	}
 
 	var additionalPrevScripts map[wire.OutPoint][]byte
@@ -492,9 +516,6 @@ func (w *BitcoindWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet
 	// Get the fee per kilobyte
 	feePerKB := int64(w.GetFeePerByte(feeLevel)) * 1000
 
-	// outputs
-	out := wire.NewTxOut(amount, script)
-
 	// Create change source
 	changeSource := func() ([]byte, error) {
 		addr := w.CurrentAddress(wallet.INTERNAL)
@@ -505,7 +526,6 @@ func (w *BitcoindWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet
 		return script, nil
 	}
 
-	outputs := []*wire.TxOut{out}
 	authoredTx, err := spvwallet.NewUnsignedTransaction(outputs, btc.Amount(feePerKB), inputSource, changeSource)
 	if err != nil {
 		return nil, err
@@ -641,6 +661,29 @@ func (w *BitcoindWallet) EstimateSpendFee(amount int64, feeLevel wallet.FeeLevel
 	if err != nil {
 		return 0, err
 	}
+	return w.txFee(tx)
+}
+
+func (w *BitcoindWallet) SpendMany(outs []bitcoin.SpendOutput, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
+	<-w.initChan
+	tx, err := w.buildBatchTx(outs, feeLevel)
+	if err != nil {
+		return nil, err
+	}
+	return w.rpcClient.SendRawTransaction(tx, false)
+}
+
+func (w *BitcoindWallet) EstimateSpendManyFee(outs []bitcoin.SpendOutput, feeLevel wallet.FeeLevel) (uint64, error) {
+	<-w.initChan
+	tx, err := w.buildBatchTx(outs, feeLevel)
+	if err != nil {
+		return 0, err
+	}
+	return w.txFee(tx)
+}
+
+// Returns the fee paid by a transaction spending our coins
+func (w *BitcoindWallet) txFee(tx *wire.MsgTx) (uint64, error) {
 	var outval int64
 	for _, output := range tx.TxOut {
 		outval += output.Value
@@ -1047,3 +1090,21 @@ func DefaultSocksPort(controlPort int) int {
 	}
 	return socksPort
 }
+
+func (w *BitcoindWallet) MultisignMany(ins []wallet.TransactionInput, outs []wallet.TransactionOutput, sigs [][]wallet.Signature, redeemScript []byte, feePerByte uint64, broadcast bool) ([]byte, error) {
+	<-w.initChan
+	tx, err := bitcoin.BuildMultisigTransaction(ins, outs, sigs, redeemScript, feePerByte)
+	if err != nil {
+		return nil, err
+	}
+	// broadcast
+	if broadcast {
+		_, err = w.rpcClient.SendRawTransaction(tx, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	tx.BtcEncode(&buf, wire.ProtocolVersion, wire.WitnessEncoding)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,158 @@
+package bitcoin
+
+import (
+	"errors"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/spvwallet"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	btc "github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/coinset"
+	"github.com/btcsuite/btcutil/txsort"
+	"github.com/btcsuite/btcwallet/wallet/txauthor"
+	"github.com/btcsuite/btcwallet/wallet/txrules"
+)
+
+var ErrCoinControlUnsupported = errors.New("Wallet does not support spending selected coins")
+
+// CoinControlSpender is implemented by wallets which can spend from a caller supplied set of coins
+type CoinControlSpender interface {
+	SpendCoins(utxos []wallet.Utxo, spendAll bool, amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*chainhash.Hash, error)
+}
+
+/* CoinFreezer is implemented by wallets which pick the coins for their own spends. The node
+   passes a function telling them which coins the user froze so every spend leaves them alone. */
+type CoinFreezer interface {
+	SetFrozenCoins(frozen func(op wire.OutPoint) bool)
+}
+
+// SupportsCoinControl returns whether the wallet can spend from a caller supplied set of coins
+func SupportsCoinControl(w wallet.Wallet) bool {
+	switch w.(type) {
+	case CoinControlSpender:
+		return true
+	case *spvwallet.SPVWallet:
+		return true
+	}
+	return false
+}
+
+/* SpendCoins sends the amount to the address using only the given coins as inputs. If
+   spendAll is set every coin is spent, otherwise the coins are selected from the set the
+   same way the wallet selects from all of its coins. Change goes to an internal address. */
+func SpendCoins(w wallet.Wallet, utxos []wallet.Utxo, spendAll bool, amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
+	switch sw := w.(type) {
+	case CoinControlSpender:
+		return sw.SpendCoins(utxos, spendAll, amount, addr, feeLevel)
+	case *spvwallet.SPVWallet:
+		script, err := w.AddressToScript(addr)
+		if err != nil {
+			return nil, err
+		}
+		if txrules.IsDustAmount(btc.Amount(amount), len(script), txrules.DefaultRelayFeePerKb) {
+			return nil, wallet.ErrorDustAmount
+		}
+		tx, err := BuildSpendTransaction(w, sw.GetKey, utxos, spendAll, []*wire.TxOut{wire.NewTxOut(amount, script)}, feeLevel)
+		if err != nil {
+			return nil, err
+		}
+		if err := sw.Broadcast(tx); err != nil {
+			return nil, err
+		}
+		txid := tx.TxHash()
+		return &txid, nil
+	}
+	return nil, ErrCoinControlUnsupported
+}
+
+/* BuildSpendTransaction builds and signs a P2PKH spend of the given coins to the outputs.
+   The fee and change handling matches the wallet's own spends so the transactions look
+   the same on chain. */
+func BuildSpendTransaction(w wallet.Wallet, getKey func(btc.Address) (*btcec.PrivateKey, error), utxos []wallet.Utxo, spendAll bool, outputs []*wire.TxOut, feeLevel wallet.FeeLevel) (*wire.MsgTx, error) {
+	tx, prevScripts, err := BuildUnsignedSpendTransaction(w, utxos, spendAll, outputs, feeLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign tx
+	keyClosure := txscript.KeyClosure(func(addr btc.Address) (*btcec.PrivateKey, bool, error) {
+		key, err := getKey(addr)
+		if err != nil {
+			return nil, false, err
+		}
+		return key, true, nil
+	})
+	scriptClosure := txscript.ScriptClosure(func(addr btc.Address) ([]byte, error) {
+		return []byte{}, nil
+	})
+	for i, txIn := range tx.TxIn {
+		script, err := txscript.SignTxOutput(w.Params(), tx, i, prevScripts[i], txscript.SigHashAll, keyClosure, scriptClosure, txIn.SignatureScript)
+		if err != nil {
+			return nil, errors.New("Failed to sign transaction")
+		}
+		txIn.SignatureScript = script
+	}
+	return tx, nil
+}
+
+/* BuildUnsignedSpendTransaction builds the spend BuildSpendTransaction would sign. It also
+   returns the scripts of the outputs being spent, in input order. */
+func BuildUnsignedSpendTransaction(w wallet.Wallet, utxos []wallet.Utxo, spendAll bool, outputs []*wire.TxOut, feeLevel wallet.FeeLevel) (*wire.MsgTx, [][]byte, error) {
+	height, _ := w.ChainTip()
+	prevScripts := make(map[wire.OutPoint][]byte)
+	var coins []coinset.Coin
+	for _, u := range utxos {
+		if u.WatchOnly {
+			continue
+		}
+		var confirmations int32
+		if u.AtHeight > 0 {
+			confirmations = int32(height) - u.AtHeight
+		}
+		coins = append(coins, spvwallet.NewCoin(u.Op.Hash.CloneBytes(), u.Op.Index, btc.Amount(u.Value), int64(confirmations), u.ScriptPubkey))
+		prevScripts[u.Op] = u.ScriptPubkey
+	}
+	if len(coins) == 0 {
+		return nil, nil, wallet.ErrorInsuffientFunds
+	}
+
+	inputSource := func(target btc.Amount) (total btc.Amount, inputs []*wire.TxIn, scripts [][]byte, err error) {
+		selected := coins
+		if !spendAll {
+			coinSelector := coinset.MaxValueAgeCoinSelector{MaxInputs: 10000, MinChangeAmount: btc.Amount(0)}
+			set, err := coinSelector.CoinSelect(target, coins)
+			if err != nil {
+				return total, inputs, scripts, wallet.ErrorInsuffientFunds
+			}
+			selected = set.Coins()
+		}
+		for _, c := range selected {
+			total += c.Value()
+			in := wire.NewTxIn(wire.NewOutPoint(c.Hash(), c.Index()), []byte{}, [][]byte{})
+			in.Sequence = 0 // Opt-in RBF so we can bump fees
+			inputs = append(inputs, in)
+		}
+		return total, inputs, scripts, nil
+	}
+	changeSource := func() ([]byte, error) {
+		return w.AddressToScript(w.CurrentAddress(wallet.INTERNAL))
+	}
+
+	feePerKB := int64(w.GetFeePerByte(feeLevel)) * 1000
+	authoredTx, err := spvwallet.NewUnsignedTransaction(outputs, btc.Amount(feePerKB), txauthor.InputSource(inputSource), changeSource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// BIP 69 sorting
+	txsort.InPlaceSort(authoredTx.Tx)
+
+	var scripts [][]byte
+	for _, txIn := range authoredTx.Tx.TxIn {
+		scripts = append(scripts, prevScripts[txIn.PreviousOutPoint])
+	}
+	return authoredTx.Tx, scripts, nil
+}
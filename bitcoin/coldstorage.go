@@ -0,0 +1,249 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/spvwallet"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcutil/txsort"
+)
+
+var (
+	ErrBroadcastUnsupported = errors.New("Wallet does not support broadcasting transactions signed elsewhere")
+	ErrWatchOnly            = errors.New("The wallet is watch only. Its private keys are in cold storage.")
+)
+
+// MasterPrivateKey returns the wallet's master private key, or ErrWatchOnly if it only has public keys
+func MasterPrivateKey(w wallet.Wallet) (*hd.ExtendedKey, error) {
+	key := w.MasterPrivateKey()
+	if key == nil {
+		return nil, ErrWatchOnly
+	}
+	return key, nil
+}
+
+/* Bip44Account derives the BIP44 account key the spvwallet's addresses come from. Its public
+   key is all a watch only wallet needs. */
+func Bip44Account(mPrivKey *hd.ExtendedKey) (*hd.ExtendedKey, error) {
+	account := mPrivKey
+	for _, i := range []uint32{44, 0, 0} {
+		var err error
+		account, err = account.Child(hd.HardenedKeyStart + i)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return account, nil
+}
+
+// AccountChild derives the wallet key at the path from the BIP44 account key
+func AccountChild(account *hd.ExtendedKey, path wallet.KeyPath) (*hd.ExtendedKey, error) {
+	internal, external, err := spvwallet.AccountDerivation(account)
+	if err != nil {
+		return nil, err
+	}
+	switch path.Purpose {
+	case wallet.EXTERNAL:
+		return external.Child(uint32(path.Index))
+	case wallet.INTERNAL:
+		return internal.Child(uint32(path.Index))
+	}
+	return nil, errors.New("Unknown key purpose")
+}
+
+// P2PKHScript returns the script of the wallet address for the key
+func P2PKHScript(key *hd.ExtendedKey) ([]byte, error) {
+	addr, err := key.Address(&chaincfg.MainNetParams)
+	if err != nil {
+		return nil, err
+	}
+	return txscript.PayToAddrScript(addr)
+}
+
+/* SignP2PKHInputs signs every input of an unsigned wallet spend, each with the key of the
+   address it spends from. */
+func SignP2PKHInputs(tx *wire.MsgTx, keys []*hd.ExtendedKey) ([]wallet.Signature, error) {
+	if len(keys) != len(tx.TxIn) {
+		return nil, errors.New("Keys do not match the transaction inputs")
+	}
+	var sigs []wallet.Signature
+	for i := range tx.TxIn {
+		signingKey, err := keys[i].ECPrivKey()
+		if err != nil {
+			return nil, err
+		}
+		script, err := P2PKHScript(keys[i])
+		if err != nil {
+			return nil, err
+		}
+		sig, err := txscript.RawTxInSignature(tx, i, script, txscript.SigHashAll, signingKey)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, wallet.Signature{InputIndex: uint32(i), Signature: sig})
+	}
+	return sigs, nil
+}
+
+// AddP2PKHSignatures completes an unsigned wallet spend with the signatures made by SignP2PKHInputs
+func AddP2PKHSignatures(tx *wire.MsgTx, sigs []wallet.Signature, keys []*hd.ExtendedKey) error {
+	if len(keys) != len(tx.TxIn) {
+		return errors.New("Keys do not match the transaction inputs")
+	}
+	for i, txIn := range tx.TxIn {
+		var sig []byte
+		for _, s := range sigs {
+			if int(s.InputIndex) == i {
+				sig = s.Signature
+				break
+			}
+		}
+		if sig == nil {
+			return errors.New("Missing signature for input")
+		}
+		pub, err := keys[i].ECPubKey()
+		if err != nil {
+			return err
+		}
+		script, err := txscript.NewScriptBuilder().AddData(sig).AddData(pub.SerializeCompressed()).Script()
+		if err != nil {
+			return err
+		}
+		txIn.SignatureScript = script
+	}
+	return nil
+}
+
+// Broadcaster is implemented by wallets which can broadcast a transaction signed outside of the wallet
+type Broadcaster interface {
+	Broadcast(tx *wire.MsgTx) error
+}
+
+// Broadcast sends a fully signed transaction to the network through the wallet
+func Broadcast(w wallet.Wallet, tx *wire.MsgTx) error {
+	b, ok := w.(Broadcaster)
+	if !ok {
+		return ErrBroadcastUnsupported
+	}
+	return b.Broadcast(tx)
+}
+
+/* SignWitnessInputs signs every input of an unsigned escrow spend with the key. The values
+   are those of the inputs in the order the spend was requested in, which is how the wallet's
+   CreateMultisigSignature looks them up, so the signatures are interchangeable. */
+func SignWitnessInputs(tx *wire.MsgTx, values []int64, key *hd.ExtendedKey, redeemScript []byte) ([]wallet.Signature, error) {
+	if len(values) != len(tx.TxIn) {
+		return nil, errors.New("Input values do not match the transaction")
+	}
+	signingKey, err := key.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	var sigs []wallet.Signature
+	hashes := txscript.NewTxSigHashes(tx)
+	for i := range tx.TxIn {
+		sig, err := txscript.RawTxInWitnessSignature(tx, hashes, i, values[i], redeemScript, txscript.SigHashAll, signingKey)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, wallet.Signature{InputIndex: uint32(i), Signature: sig})
+	}
+	return sigs, nil
+}
+
+/* UnsignedSweepTransaction returns the spend of a one signature escrow to the script that the
+   wallet's SweepAddress would sign. */
+func UnsignedSweepTransaction(utxos []wallet.Utxo, script []byte, redeemScript []byte, feePerByte uint64) (*wire.MsgTx, error) {
+	if len(redeemScript) == 0 {
+		return nil, errors.New("Redeem script is empty")
+	}
+	var val int64
+	var inputs []*wire.TxIn
+	for _, u := range utxos {
+		val += u.Value
+		op := u.Op
+		inputs = append(inputs, wire.NewTxIn(&op, []byte{}, [][]byte{}))
+	}
+	out := wire.NewTxOut(val, script)
+
+	txType := spvwallet.P2SH_1of2_Multisig
+	locktime, err := spvwallet.LockTimeFromRedeemScript(redeemScript)
+	timeLocked := err == nil
+	if timeLocked {
+		txType = spvwallet.P2SH_Multisig_Timelock_1Sig
+	}
+	estimatedSize := spvwallet.EstimateSerializeSize(len(utxos), []*wire.TxOut{out}, false, txType)
+	out.Value = val - int64(estimatedSize)*int64(feePerByte)
+	if out.Value < 0 {
+		out.Value = 0
+	}
+
+	tx := &wire.MsgTx{
+		Version:  wire.TxVersion,
+		TxIn:     inputs,
+		TxOut:    []*wire.TxOut{out},
+		LockTime: 0,
+	}
+
+	// BIP 69 sorting
+	txsort.InPlaceSort(tx)
+
+	if timeLocked {
+		tx.Version = 2
+		for _, txIn := range tx.TxIn {
+			txIn.Sequence = locktime
+		}
+	}
+	return tx, nil
+}
+
+// AddSweepWitness completes an unsigned sweep with the signatures of its one signer
+func AddSweepWitness(tx *wire.MsgTx, sigs []wallet.Signature, redeemScript []byte) error {
+	timeLocked := redeemScript[0] == txscript.OP_IF
+	for i, txIn := range tx.TxIn {
+		var sig []byte
+		for _, s := range sigs {
+			if int(s.InputIndex) == i {
+				sig = s.Signature
+				break
+			}
+		}
+		if sig == nil {
+			return errors.New("Missing signature for input")
+		}
+		var witness wire.TxWitness
+		if timeLocked {
+			witness = wire.TxWitness{sig, []byte{}}
+		} else {
+			witness = wire.TxWitness{[]byte{}, sig}
+		}
+		txIn.Witness = append(witness, redeemScript)
+	}
+	return nil
+}
+
+// EncodeTransaction returns the hex serialization of the transaction
+func EncodeTransaction(tx *wire.MsgTx) string {
+	var buf bytes.Buffer
+	tx.BtcEncode(&buf, wire.ProtocolVersion, wire.WitnessEncoding)
+	return hex.EncodeToString(buf.Bytes())
+}
+
+// DecodeTransaction parses a transaction serialized by EncodeTransaction
+func DecodeTransaction(s string) (*wire.MsgTx, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	tx := wire.NewMsgTx(1)
+	if err := tx.BtcDecode(bytes.NewReader(b), wire.ProtocolVersion, wire.WitnessEncoding); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
@@ -3,12 +3,16 @@ package exchange
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/op/go-logging"
 	"golang.org/x/net/proxy"
+	"math"
 	"net"
 	"net/http"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -17,11 +21,35 @@ const SatoshiPerBTC = 100000000
 
 var log = logging.MustGetLogger("exchangeRates")
 
+// ProviderConfig is a ticker API and the name of the decoder for its response format
+type ProviderConfig struct {
+	Name    string
+	URL     string
+	Decoder string
+}
+
+var DefaultProviders = []ProviderConfig{
+	{"openbazaar", "https://ticker.openbazaar.org/api", "bitcoinaverage"},
+	{"bitpay", "https://bitpay.com/api/rates", "bitpay"},
+	{"blockchain", "https://blockchain.info/ticker", "blockchaininfo"},
+	{"bitcoincharts", "https://api.bitcoincharts.com/v1/weighted_prices.json", "bitcoincharts"},
+}
+
+const (
+	// Rates further than this fraction from the median are ignored
+	DefaultMaxDeviation = 0.1
+
+	// Providers which haven't responded for this long are ignored
+	DefaultMaxAge = time.Hour
+)
+
 type ExchangeRateProvider struct {
+	name     string
 	fetchUrl string
 	cache    map[string]float64
 	client   *http.Client
 	decoder  ExchangeRateDecoder
+	fetched  time.Time
 }
 
 type ExchangeRateDecoder interface {
@@ -33,32 +61,72 @@ type BitcoinAverageDecoder struct{}
 type BitPayDecoder struct{}
 type BlockchainInfoDecoder struct{}
 type BitcoinChartsDecoder struct{}
+type SimpleDecoder struct{}
+
+// The decoders providers can be configured with
+var decoders = map[string]ExchangeRateDecoder{
+	"bitcoinaverage": BitcoinAverageDecoder{},
+	"bitpay":         BitPayDecoder{},
+	"blockchaininfo": BlockchainInfoDecoder{},
+	"bitcoincharts":  BitcoinChartsDecoder{},
+	"simple":         SimpleDecoder{},
+}
 
+/* BitcoinPriceFetcher polls every provider and sets the rate of each currency to the median
+   of the providers which responded recently. Rates too far from the median are left out so a
+   single bad provider can't skew prices. */
 type BitcoinPriceFetcher struct {
 	sync.Mutex
-	cache     map[string]float64
-	providers []*ExchangeRateProvider
+	cache        map[string]float64
+	sources      map[string][]string
+	updated      time.Time
+	providers    []*ExchangeRateProvider
+	maxDeviation float64
+	maxAge       time.Duration
 }
 
+// NewBitcoinPriceFetcher returns a fetcher using the default providers
 func NewBitcoinPriceFetcher(dialer proxy.Dialer) *BitcoinPriceFetcher {
-	b := BitcoinPriceFetcher{
-		cache: make(map[string]float64),
-	}
+	b, _ := NewBitcoinPriceFetcherWithProviders(dialer, DefaultProviders, DefaultMaxDeviation, DefaultMaxAge)
+	return b
+}
+
+func NewBitcoinPriceFetcherWithProviders(dialer proxy.Dialer, providers []ProviderConfig, maxDeviation float64, maxAge time.Duration) (*BitcoinPriceFetcher, error) {
 	dial := net.Dial
 	if dialer != nil {
 		dial = dialer.Dial
 	}
 	tbTransport := &http.Transport{Dial: dial}
 	client := &http.Client{Transport: tbTransport, Timeout: time.Minute}
-
-	b.providers = []*ExchangeRateProvider{
-		{"https://ticker.openbazaar.org/api", b.cache, client, BitcoinAverageDecoder{}},
-		{"https://bitpay.com/api/rates", b.cache, client, BitPayDecoder{}},
-		{"https://blockchain.info/ticker", b.cache, client, BlockchainInfoDecoder{}},
-		{"https://api.bitcoincharts.com/v1/weighted_prices.json", b.cache, client, BitcoinChartsDecoder{}},
+	b, err := newBitcoinPriceFetcher(client, providers, maxDeviation, maxAge)
+	if err != nil {
+		return nil, err
 	}
 	go b.run()
-	return &b
+	return b, nil
+}
+
+func newBitcoinPriceFetcher(client *http.Client, providers []ProviderConfig, maxDeviation float64, maxAge time.Duration) (*BitcoinPriceFetcher, error) {
+	b := &BitcoinPriceFetcher{
+		cache:        make(map[string]float64),
+		sources:      make(map[string][]string),
+		maxDeviation: maxDeviation,
+		maxAge:       maxAge,
+	}
+	for _, p := range providers {
+		decoder, ok := decoders[strings.ToLower(p.Decoder)]
+		if !ok {
+			return nil, fmt.Errorf("Unknown exchange rate decoder %s", p.Decoder)
+		}
+		b.providers = append(b.providers, &ExchangeRateProvider{
+			name:     p.Name,
+			fetchUrl: p.URL,
+			cache:    make(map[string]float64),
+			client:   client,
+			decoder:  decoder,
+		})
+	}
+	return b, nil
 }
 
 func (b *BitcoinPriceFetcher) GetExchangeRate(currencyCode string) (float64, error) {
@@ -91,7 +159,18 @@ func (b *BitcoinPriceFetcher) GetAllRates(cacheOK bool) (map[string]float64, err
 	}
 	b.Lock()
 	defer b.Unlock()
-	return b.cache, nil
+	rates := make(map[string]float64)
+	for code, rate := range b.cache {
+		rates[code] = rate
+	}
+	return rates, nil
+}
+
+// GetSources returns the providers the currency's rate was taken from and when it was updated
+func (b *BitcoinPriceFetcher) GetSources(currencyCode string) ([]string, time.Time) {
+	b.Lock()
+	defer b.Unlock()
+	return b.sources[currencyCode], b.updated
 }
 
 func (b *BitcoinPriceFetcher) UnitsPerCoin() int {
@@ -99,16 +178,100 @@ func (b *BitcoinPriceFetcher) UnitsPerCoin() int {
 }
 
 func (b *BitcoinPriceFetcher) fetchCurrentRates() error {
+	var wg sync.WaitGroup
+	for _, provider := range b.providers {
+		wg.Add(1)
+		go func(provider *ExchangeRateProvider) {
+			defer wg.Done()
+			provider.fetch()
+		}(provider)
+	}
+	wg.Wait()
+
 	b.Lock()
 	defer b.Unlock()
+	if !b.aggregate(time.Now()) {
+		log.Error("Failed to fetch bitcoin exchange rates")
+		return errors.New("All exchange rate API queries failed")
+	}
+	return nil
+}
+
+/* aggregate rebuilds the cache from the providers fetched within maxAge. It returns false and
+   keeps the old rates when none of the providers are fresh. */
+func (b *BitcoinPriceFetcher) aggregate(now time.Time) bool {
+	quotes := make(map[string][]quote)
 	for _, provider := range b.providers {
-		err := provider.fetch()
-		if err == nil {
-			return nil
+		if provider.fetched.IsZero() || now.Sub(provider.fetched) > b.maxAge {
+			continue
+		}
+		for code, rate := range provider.cache {
+			if rate > 0 {
+				quotes[code] = append(quotes[code], quote{provider.name, rate})
+			}
+		}
+	}
+	if len(quotes) == 0 {
+		return false
+	}
+	cache := make(map[string]float64)
+	sources := make(map[string][]string)
+	for code, q := range quotes {
+		rate, names := medianRate(q, b.maxDeviation)
+		if len(names) == 0 {
+			// The providers disagree too much to tell which is right so keep the last good rate
+			log.Warningf("Exchange rate providers disagree on %s, keeping the previous rate", code)
+			if previous, ok := b.cache[code]; ok {
+				cache[code] = previous
+				sources[code] = b.sources[code]
+			}
+			continue
+		}
+		cache[code] = rate
+		sources[code] = names
+	}
+	b.cache = cache
+	b.sources = sources
+	b.updated = now
+	return true
+}
+
+type quote struct {
+	provider string
+	rate     float64
+}
+
+func median(rates []float64) float64 {
+	sort.Float64s(rates)
+	mid := len(rates) / 2
+	if len(rates)%2 == 0 {
+		return (rates[mid-1] + rates[mid]) / 2
+	}
+	return rates[mid]
+}
+
+/* medianRate returns the median of the quotes after dropping those further than maxDeviation
+   from the median of all of them, along with the providers that were used. It returns no
+   providers when every quote was dropped. */
+func medianRate(quotes []quote, maxDeviation float64) (float64, []string) {
+	var all []float64
+	for _, q := range quotes {
+		all = append(all, q.rate)
+	}
+	m := median(all)
+	var kept []float64
+	var names []string
+	for _, q := range quotes {
+		if math.Abs(q.rate-m)/m <= maxDeviation {
+			kept = append(kept, q.rate)
+			names = append(names, q.provider)
 		}
 	}
-	log.Error("Failed to fetch bitcoin exchange rates")
-	return errors.New("All exchange rate API queries failed")
+	if len(kept) == 0 {
+		return 0, nil
+	}
+	sort.Strings(names)
+	return median(kept), names
 }
 
 func (provider *ExchangeRateProvider) fetch() (err error) {
@@ -121,6 +284,7 @@ func (provider *ExchangeRateProvider) fetch() (err error) {
 		log.Error("Failed to fetch from "+provider.fetchUrl, err)
 		return err
 	}
+	defer resp.Body.Close()
 	decoder := json.NewDecoder(resp.Body)
 	var dataMap interface{}
 	err = decoder.Decode(&dataMap)
@@ -128,7 +292,14 @@ func (provider *ExchangeRateProvider) fetch() (err error) {
 		log.Error("Failed to decode JSON from "+provider.fetchUrl, err)
 		return err
 	}
-	return provider.decoder.decode(dataMap, provider.cache)
+	cache := make(map[string]float64)
+	if err := provider.decoder.decode(dataMap, cache); err != nil {
+		log.Error("Failed to decode rates from "+provider.fetchUrl, err)
+		return err
+	}
+	provider.cache = cache
+	provider.fetched = time.Now()
+	return nil
 }
 
 func (b *BitcoinPriceFetcher) run() {
@@ -216,3 +387,24 @@ func (b BitcoinChartsDecoder) decode(dat interface{}, cache map[string]float64)
 	}
 	return nil
 }
+
+// SimpleDecoder reads a flat object of currency codes to rates, as served by a self-hosted ticker
+func (b SimpleDecoder) decode(dat interface{}, cache map[string]float64) (err error) {
+	data, ok := dat.(map[string]interface{})
+	if !ok {
+		return errors.New(reflect.TypeOf(b).Name() + ".decode: Type assertion failed")
+	}
+	for k, v := range data {
+		switch price := v.(type) {
+		case float64:
+			cache[k] = price
+		case string:
+			p, err := strconv.ParseFloat(price, 64)
+			if err != nil {
+				return err
+			}
+			cache[k] = p
+		}
+	}
+	return nil
+}
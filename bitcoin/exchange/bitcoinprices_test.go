@@ -6,36 +6,154 @@ import (
 	"io"
 	gonet "net"
 	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 )
 
 func setupBitcoinPriceFetcher() (b BitcoinPriceFetcher) {
-	b = BitcoinPriceFetcher{
-		cache: make(map[string]float64),
-	}
 	client := &http.Client{Transport: &http.Transport{Dial: gonet.Dial}, Timeout: time.Minute}
-	b.providers = []*ExchangeRateProvider{
-		{"https://ticker.openbazaar.org/api", b.cache, client, BitcoinAverageDecoder{}},
-		{"https://bitpay.com/api/rates", b.cache, client, BitPayDecoder{}},
-		{"https://blockchain.info/ticker", b.cache, client, BlockchainInfoDecoder{}},
-		{"https://api.bitcoincharts.com/v1/weighted_prices.json", b.cache, client, BitcoinChartsDecoder{}},
+	f, _ := newBitcoinPriceFetcher(client, DefaultProviders, DefaultMaxDeviation, DefaultMaxAge)
+	return *f
+}
+
+// Serves a fixed ticker response in place of a provider
+func tickerStandIn(response string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(response))
+	}))
+}
+
+func setupStandInFetcher(t *testing.T, responses ...string) (*BitcoinPriceFetcher, []*httptest.Server) {
+	var servers []*httptest.Server
+	var providers []ProviderConfig
+	for i, response := range responses {
+		server := tickerStandIn(response)
+		servers = append(servers, server)
+		providers = append(providers, ProviderConfig{
+			Name:    "provider" + strconv.Itoa(i),
+			URL:     server.URL,
+			Decoder: "simple",
+		})
+	}
+	b, err := newBitcoinPriceFetcher(http.DefaultClient, providers, 0.1, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b, servers
+}
+
+func TestMedianExchangeRate(t *testing.T) {
+	b, servers := setupStandInFetcher(t,
+		`{"USD": 6000, "EUR": 5000}`,
+		`{"USD": 6100, "EUR": "5100"}`,
+		`{"USD": 6200}`,
+		`{"USD": 60000}`,
+		`not json`,
+	)
+	for _, s := range servers {
+		defer s.Close()
+	}
+	if err := b.fetchCurrentRates(); err != nil {
+		t.Fatal(err)
+	}
+	usd, err := b.GetExchangeRate("USD")
+	if err != nil || usd != 6100 {
+		t.Error("Outlier was not rejected from the median", usd, err)
+	}
+	sources, updated := b.GetSources("USD")
+	if len(sources) != 3 || sources[0] != "provider0" || sources[2] != "provider2" || updated.IsZero() {
+		t.Error("Wrong sources for USD", sources)
+	}
+	eur, err := b.GetExchangeRate("EUR")
+	if err != nil || eur != 5050 {
+		t.Error("Wrong median of an even number of rates", eur, err)
+	}
+}
+
+func TestDisagreeingExchangeRates(t *testing.T) {
+	b, servers := setupStandInFetcher(t, `{"USD": 100}`, `{"USD": 200}`)
+	for _, s := range servers {
+		defer s.Close()
+	}
+	if err := b.fetchCurrentRates(); err != nil {
+		t.Fatal(err)
+	}
+	if usd, err := b.GetExchangeRate("USD"); err == nil {
+		t.Error("Published a rate when both providers were rejected", usd)
+	}
+
+	b.providers[1].cache = map[string]float64{"USD": 105}
+	if !b.aggregate(time.Now()) {
+		t.Fatal("Failed to aggregate agreeing providers")
+	}
+	if usd, _ := b.GetExchangeRate("USD"); usd != 102.5 {
+		t.Error("Wrong median of agreeing providers", usd)
+	}
+	b.providers[1].cache = map[string]float64{"USD": 200}
+	if !b.aggregate(time.Now()) {
+		t.Fatal("Failed to aggregate")
+	}
+	if usd, _ := b.GetExchangeRate("USD"); usd != 102.5 {
+		t.Error("Previous rate was not kept when the providers disagree", usd)
+	}
+	if sources, _ := b.GetSources("USD"); len(sources) != 2 {
+		t.Error("Previous sources were not kept", sources)
+	}
+}
+
+func TestStaleExchangeRates(t *testing.T) {
+	b, servers := setupStandInFetcher(t, `{"USD": 6000}`, `{"USD": 6200}`)
+	for _, s := range servers {
+		defer s.Close()
+	}
+	if err := b.fetchCurrentRates(); err != nil {
+		t.Fatal(err)
+	}
+	b.providers[0].fetched = time.Now().Add(-time.Hour * 2)
+	if !b.aggregate(time.Now()) {
+		t.Fatal("Failed to aggregate the fresh provider")
+	}
+	if usd, _ := b.GetExchangeRate("USD"); usd != 6200 {
+		t.Error("Stale provider was used", usd)
+	}
+	b.providers[1].fetched = time.Now().Add(-time.Hour * 2)
+	if b.aggregate(time.Now()) {
+		t.Error("Aggregated rates when every provider is stale")
+	}
+
+	servers[0].Close()
+	servers[1].Close()
+	if err := b.fetchCurrentRates(); err == nil {
+		t.Error("Fetched rates with every provider down")
+	}
+}
+
+func TestUnknownDecoder(t *testing.T) {
+	_, err := newBitcoinPriceFetcher(http.DefaultClient, []ProviderConfig{{Name: "x", URL: "http://localhost", Decoder: "xml"}}, DefaultMaxDeviation, DefaultMaxAge)
+	if err == nil {
+		t.Error("Accepted an unknown decoder")
 	}
-	return b
 }
 
 func TestFetchCurrentRates(t *testing.T) {
-	b := setupBitcoinPriceFetcher()
+	b, servers := setupStandInFetcher(t, `{"USD": 6000}`)
+	defer servers[0].Close()
 	err := b.fetchCurrentRates()
 	if err != nil {
 		t.Error("Failed to fetch bitcoin exchange rates")
 	}
+	if usd, _ := b.GetExchangeRate("USD"); usd != 6000 {
+		t.Error("Fetched the wrong rate", usd)
+	}
 }
 
 func TestGetLatestRate(t *testing.T) {
-	b := setupBitcoinPriceFetcher()
+	b, servers := setupStandInFetcher(t, `{"USD": 6000}`)
+	defer servers[0].Close()
 	price, err := b.GetLatestRate("USD")
-	if err != nil || price == 0 {
+	if err != nil || price != 6000 {
 		t.Error("Incorrect return at GetLatestRate (price, err)", price, err)
 	}
 	b.cache["USD"] = 650.00
@@ -65,6 +183,10 @@ func TestGetAllRates(t *testing.T) {
 	if !ok || eur != 600.00 {
 		t.Error("Failed to fetch exchange rates from cache")
 	}
+	priceMap["USD"] = 1
+	if usd, _ := b.GetExchangeRate("USD"); usd != 650.00 {
+		t.Error("Changing the returned rates changed the cache")
+	}
 }
 
 func TestGetExchangeRate(t *testing.T) {
@@ -1,5 +1,7 @@
 package bitcoin
 
+import "time"
+
 type ExchangeRates interface {
 
 	/* Fetch the exchange rate for the given currency
@@ -17,3 +19,10 @@ type ExchangeRates interface {
 	   to the smaller currency unit. */
 	UnitsPerCoin() int
 }
+
+// ExchangeRateSources is implemented by exchange rates aggregated from several providers
+type ExchangeRateSources interface {
+
+	// Return the providers the currency's rate was taken from and when the rates were updated
+	GetSources(currencyCode string) ([]string, time.Time)
+}
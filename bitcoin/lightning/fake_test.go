@@ -0,0 +1,73 @@
+package lightning
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+)
+
+func TestFakeNode_PayInvoice(t *testing.T) {
+	network := NewFakeNetwork()
+	vendor := network.NewNode()
+	buyer := network.NewNode()
+
+	var incoming, outgoing []Settlement
+	vendor.AddSettlementListener(func(s Settlement) { incoming = append(incoming, s) })
+	buyer.AddSettlementListener(func(s Settlement) { outgoing = append(outgoing, s) })
+
+	inv, err := vendor.CreateInvoice(1500, "Order", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := buyer.DecodeInvoice(inv.PaymentRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.PaymentHash != inv.PaymentHash || decoded.Amount != 1500 || decoded.Memo != "Order" {
+		t.Error("Decoded invoice does not match the created invoice")
+	}
+
+	preimage, err := buyer.PayInvoice(inv.PaymentRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256(preimage)
+	if !bytes.Equal(hash[:], mustDecodeHex(t, inv.PaymentHash)) {
+		t.Error("Preimage does not hash to the payment hash")
+	}
+	if len(incoming) != 1 || incoming[0].Outgoing || incoming[0].PaymentHash != inv.PaymentHash || incoming[0].Amount != 1500 {
+		t.Error("Payee did not receive the incoming settlement")
+	}
+	if len(outgoing) != 1 || !outgoing[0].Outgoing || outgoing[0].PaymentHash != inv.PaymentHash {
+		t.Error("Payer did not receive the outgoing settlement")
+	}
+
+	if _, err := buyer.PayInvoice(inv.PaymentRequest); err != ErrInvoiceSettled {
+		t.Error("Paid a settled invoice twice")
+	}
+	if _, err := buyer.PayInvoice("lnfake00"); err != ErrInvoiceNotFound {
+		t.Error("Paid an unknown invoice")
+	}
+}
+
+func TestFakeNode_ExpiredInvoice(t *testing.T) {
+	network := NewFakeNetwork()
+	vendor := network.NewNode()
+	inv, err := vendor.CreateInvoice(1500, "", -time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := network.NewNode().PayInvoice(inv.PaymentRequest); err != ErrInvoiceExpired {
+		t.Error("Paid an expired invoice")
+	}
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
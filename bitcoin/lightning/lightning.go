@@ -0,0 +1,55 @@
+package lightning
+
+import (
+	"errors"
+	"time"
+
+	"github.com/op/go-logging"
+)
+
+var log = logging.MustGetLogger("lightning")
+
+var (
+	ErrInvoiceNotFound = errors.New("Invoice not found")
+	ErrInvoiceSettled  = errors.New("Invoice is already settled")
+	ErrInvoiceExpired  = errors.New("Invoice has expired")
+)
+
+// A BOLT11 payment request and the details we need from it
+type Invoice struct {
+	PaymentRequest string
+	PaymentHash    string // hex
+	Amount         int64  // satoshis
+	Memo           string
+	Expiry         time.Time
+}
+
+/* Settlement is passed to settlement listeners when an invoice is paid. Incoming settlements
+   are invoices we created being paid, outgoing ones are invoices we paid. */
+type Settlement struct {
+	PaymentHash string
+	Amount      int64
+	Outgoing    bool
+	SettledAt   time.Time
+}
+
+// Backend is a Lightning node the server creates and pays invoices with
+type Backend interface {
+	// Start watching for settled invoices
+	Start()
+
+	// Create an invoice for the amount in satoshis
+	CreateInvoice(amount int64, memo string, expiry time.Duration) (*Invoice, error)
+
+	// Decode a payment request
+	DecodeInvoice(paymentRequest string) (*Invoice, error)
+
+	// Pay the payment request and return the preimage
+	PayInvoice(paymentRequest string) ([]byte, error)
+
+	// Add a callback for settled invoices
+	AddSettlementListener(func(Settlement))
+
+	// Stop watching for settled invoices
+	Close()
+}
@@ -0,0 +1,264 @@
+package lightning
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// How long to wait before resubscribing to invoices after the connection to lnd drops
+const lndResubscribeDelay = time.Second * 10
+
+// LndBackend talks to an lnd node over its REST API
+type LndBackend struct {
+	host     string
+	macaroon string
+	client   *http.Client
+
+	lock        sync.Mutex
+	listeners   []func(Settlement)
+	settleIndex uint64
+	done        chan struct{}
+}
+
+/* NewLndBackend returns a backend for the lnd REST API at host. The TLS certificate lnd
+   generated is pinned and the macaroon authenticates every request. */
+func NewLndBackend(host, tlsCertPath, macaroonPath string) (*LndBackend, error) {
+	cert, err := ioutil.ReadFile(tlsCertPath)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(cert) {
+		return nil, errors.New("Invalid lnd TLS certificate")
+	}
+	mac, err := ioutil.ReadFile(macaroonPath)
+	if err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}
+	return &LndBackend{
+		host:     host,
+		macaroon: hex.EncodeToString(mac),
+		client:   &http.Client{Transport: transport},
+		done:     make(chan struct{}),
+	}, nil
+}
+
+func (l *LndBackend) Start() {
+	go l.subscribe()
+}
+
+func (l *LndBackend) Close() {
+	close(l.done)
+}
+
+func (l *LndBackend) request(method, path string, body interface{}) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, "https://"+l.host+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Grpc-Metadata-macaroon", l.macaroon)
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		var e struct {
+			Error string `json:"error"`
+		}
+		json.NewDecoder(resp.Body).Decode(&e)
+		return nil, fmt.Errorf("lnd returned %s: %s", resp.Status, e.Error)
+	}
+	return resp, nil
+}
+
+func (l *LndBackend) call(method, path string, body, ret interface{}) error {
+	resp, err := l.request(method, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(ret)
+}
+
+func (l *LndBackend) CreateInvoice(amount int64, memo string, expiry time.Duration) (*Invoice, error) {
+	req := map[string]string{
+		"value":  strconv.FormatInt(amount, 10),
+		"memo":   memo,
+		"expiry": strconv.FormatInt(int64(expiry.Seconds()), 10),
+	}
+	var resp struct {
+		RHash          string `json:"r_hash"`
+		PaymentRequest string `json:"payment_request"`
+	}
+	if err := l.call("POST", "/v1/invoices", req, &resp); err != nil {
+		return nil, err
+	}
+	hash, err := base64.StdEncoding.DecodeString(resp.RHash)
+	if err != nil {
+		return nil, err
+	}
+	return &Invoice{
+		PaymentRequest: resp.PaymentRequest,
+		PaymentHash:    hex.EncodeToString(hash),
+		Amount:         amount,
+		Memo:           memo,
+		Expiry:         time.Now().Add(expiry),
+	}, nil
+}
+
+func (l *LndBackend) DecodeInvoice(paymentRequest string) (*Invoice, error) {
+	var resp struct {
+		PaymentHash string `json:"payment_hash"`
+		NumSatoshis string `json:"num_satoshis"`
+		Timestamp   string `json:"timestamp"`
+		Expiry      string `json:"expiry"`
+		Description string `json:"description"`
+	}
+	if err := l.call("GET", "/v1/payreq/"+paymentRequest, nil, &resp); err != nil {
+		return nil, err
+	}
+	amount, _ := strconv.ParseInt(resp.NumSatoshis, 10, 64)
+	timestamp, _ := strconv.ParseInt(resp.Timestamp, 10, 64)
+	expiry, _ := strconv.ParseInt(resp.Expiry, 10, 64)
+	return &Invoice{
+		PaymentRequest: paymentRequest,
+		PaymentHash:    resp.PaymentHash,
+		Amount:         amount,
+		Memo:           resp.Description,
+		Expiry:         time.Unix(timestamp+expiry, 0),
+	}, nil
+}
+
+func (l *LndBackend) PayInvoice(paymentRequest string) ([]byte, error) {
+	inv, err := l.DecodeInvoice(paymentRequest)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		PaymentError    string `json:"payment_error"`
+		PaymentPreimage string `json:"payment_preimage"`
+	}
+	if err := l.call("POST", "/v1/channels/transactions", map[string]string{"payment_request": paymentRequest}, &resp); err != nil {
+		return nil, err
+	}
+	if resp.PaymentError != "" {
+		return nil, errors.New(resp.PaymentError)
+	}
+	preimage, err := base64.StdEncoding.DecodeString(resp.PaymentPreimage)
+	if err != nil {
+		return nil, err
+	}
+	l.notify(Settlement{PaymentHash: inv.PaymentHash, Amount: inv.Amount, Outgoing: true, SettledAt: time.Now()})
+	return preimage, nil
+}
+
+func (l *LndBackend) AddSettlementListener(cb func(Settlement)) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.listeners = append(l.listeners, cb)
+}
+
+func (l *LndBackend) notify(s Settlement) {
+	l.lock.Lock()
+	listeners := l.listeners
+	l.lock.Unlock()
+	for _, cb := range listeners {
+		cb(s)
+	}
+}
+
+/* subscribe streams invoice updates from lnd until the backend is closed. Settlements missed
+   while disconnected are replayed from the last settle index we saw. Listeners must ignore
+   settlements they have already processed. */
+func (l *LndBackend) subscribe() {
+	for {
+		if err := l.readInvoices(); err != nil {
+			log.Errorf("Lost lnd invoice subscription: %s", err.Error())
+		}
+		select {
+		case <-l.done:
+			return
+		case <-time.After(lndResubscribeDelay):
+		}
+	}
+}
+
+func (l *LndBackend) readInvoices() error {
+	l.lock.Lock()
+	index := l.settleIndex
+	l.lock.Unlock()
+	if index == 0 {
+		index = 1
+	}
+	resp, err := l.request("GET", "/v1/invoices/subscribe?settle_index="+strconv.FormatUint(index, 10), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Unblock the decoder on Close, and stop waiting for it once this subscription has ended
+	finished := make(chan struct{})
+	defer close(finished)
+	go func() {
+		select {
+		case <-l.done:
+			resp.Body.Close()
+		case <-finished:
+		}
+	}()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var update struct {
+			Result struct {
+				RHash       string `json:"r_hash"`
+				State       string `json:"state"`
+				AmtPaidSat  string `json:"amt_paid_sat"`
+				SettleDate  string `json:"settle_date"`
+				SettleIndex string `json:"settle_index"`
+			} `json:"result"`
+		}
+		if err := decoder.Decode(&update); err != nil {
+			return err
+		}
+		inv := update.Result
+		if inv.State != "SETTLED" {
+			continue
+		}
+		hash, err := base64.StdEncoding.DecodeString(inv.RHash)
+		if err != nil {
+			continue
+		}
+		amount, _ := strconv.ParseInt(inv.AmtPaidSat, 10, 64)
+		settleDate, _ := strconv.ParseInt(inv.SettleDate, 10, 64)
+		settleIndex, _ := strconv.ParseUint(inv.SettleIndex, 10, 64)
+		l.lock.Lock()
+		if settleIndex > l.settleIndex {
+			l.settleIndex = settleIndex
+		}
+		l.lock.Unlock()
+		l.notify(Settlement{PaymentHash: hex.EncodeToString(hash), Amount: amount, SettledAt: time.Unix(settleDate, 0)})
+	}
+}
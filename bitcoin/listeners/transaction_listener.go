@@ -2,10 +2,12 @@ package bitcoin
 
 import (
 	"encoding/hex"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/lightning"
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
@@ -33,6 +35,14 @@ func NewTransactionListener(db repo.Datastore, broadcast chan interface{}, walle
 func (l *TransactionListener) OnTransactionReceived(cb wallet.TransactionCallback) {
 	l.Lock()
 	defer l.Unlock()
+
+	// Outputs spent by this transaction no longer fund their order, as when a payment is accelerated
+	spent := make(map[string]bool)
+	for _, input := range cb.Inputs {
+		if outpointHash, err := chainhash.NewHash(input.OutpointHash); err == nil {
+			spent[outpointHash.String()+":"+strconv.Itoa(int(input.OutpointIndex))] = true
+		}
+	}
 	for _, output := range cb.Outputs {
 		addr, err := l.wallet.ScriptToAddress(output.ScriptPubKey)
 		if err != nil {
@@ -40,12 +50,12 @@ func (l *TransactionListener) OnTransactionReceived(cb wallet.TransactionCallbac
 		}
 		contract, state, funded, records, err := l.db.Sales().GetByPaymentAddress(addr)
 		if err == nil {
-			l.processSalePayment(cb.Txid, output, contract, state, funded, records)
+			l.processSalePayment(cb.Txid, output, contract, state, funded, records, spent)
 			continue
 		}
 		contract, state, funded, records, err = l.db.Purchases().GetByPaymentAddress(addr)
 		if err == nil {
-			l.processPurchasePayment(cb.Txid, output, contract, state, funded, records)
+			l.processPurchasePayment(cb.Txid, output, contract, state, funded, records, spent)
 			continue
 		}
 	}
@@ -99,6 +109,7 @@ func (l *TransactionListener) OnTransactionReceived(cb wallet.TransactionCallbac
 			ScriptPubKey: hex.EncodeToString(input.LinkedScriptPubKey),
 		}
 		records = append(records, record)
+		l.linkOrder(chainHash.String(), orderId, contract)
 		if isForSale {
 			l.db.Sales().UpdateFunding(orderId, funded, records)
 			// This is a dispute payout. We should set the order state.
@@ -158,26 +169,105 @@ func (l *TransactionListener) OnTransactionReceived(cb wallet.TransactionCallbac
 			}
 		}
 	}
+	if cb.Value > 0 {
+		l.processCasePayout(cb.Txid, spent)
+	}
+}
 
+// Save the order a transaction spending an escrow belongs to, unless it was already saved with its own metadata
+func (l *TransactionListener) linkOrder(txid string, orderId string, contract *pb.RicardianContract) {
+	if _, err := l.db.TxMetadata().Get(txid); err == nil {
+		return
+	}
+	var thumbnail string
+	var title string
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnail = contract.VendorListings[0].Item.Images[0].Tiny
+		title = contract.VendorListings[0].Item.Title
+	}
+	l.db.TxMetadata().Put(repo.Metadata{Txid: txid, Memo: title, OrderId: orderId, Thumbnail: thumbnail})
 }
 
-func (l *TransactionListener) processSalePayment(txid []byte, output wallet.TransactionOutput, contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord) {
+// A payment into our wallet that spends the escrow of a case we resolved is our moderator fee
+func (l *TransactionListener) processCasePayout(txid []byte, spent map[string]bool) {
+	chainHash, err := chainhash.NewHash(txid)
+	if err != nil {
+		return
+	}
+	if _, err := l.db.TxMetadata().Get(chainHash.String()); err == nil {
+		return
+	}
+	cases, _, err := l.db.Cases().GetAll([]pb.OrderState{pb.OrderState_RESOLVED}, "", false, false, -1, []string{})
+	if err != nil {
+		return
+	}
+	for _, c := range cases {
+		buyerContract, vendorContract, _, _, _, _, _, _, _, resolution, err := l.db.Cases().GetCaseMetadata(c.CaseId)
+		if err != nil || resolution == nil || resolution.Payout == nil {
+			continue
+		}
+		for _, in := range resolution.Payout.Inputs {
+			if !spent[in.Hash+":"+strconv.Itoa(int(in.Index))] {
+				continue
+			}
+			contract := buyerContract
+			if contract == nil {
+				contract = vendorContract
+			}
+			if contract == nil {
+				contract = new(pb.RicardianContract)
+			}
+			l.linkOrder(chainHash.String(), c.CaseId, contract)
+			return
+		}
+	}
+}
+
+func (l *TransactionListener) processSalePayment(txid []byte, output wallet.TransactionOutput, contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord, spent map[string]bool) {
 	chainHash, err := chainhash.NewHash(txid)
 	if err != nil {
 		return
 	}
 	funding := output.Value
 	for _, r := range records {
-		funding += r.Value
 		// If we have already seen this transaction for some reason, just return
 		if r.Txid == chainHash.String() {
 			return
 		}
+		if r.Value > 0 && spent[r.Txid+":"+strconv.Itoa(int(r.Index))] {
+			continue
+		}
+		funding += r.Value
 	}
 	orderId, err := calcOrderId(contract.BuyerOrder)
 	if err != nil {
 		return
 	}
+	record := &wallet.TransactionRecord{
+		Timestamp:    time.Now(),
+		Txid:         chainHash.String(),
+		Index:        output.Index,
+		Value:        output.Value,
+		ScriptPubKey: hex.EncodeToString(output.ScriptPubKey),
+	}
+	l.fundSale(orderId, contract, state, funded, funding, records, record)
+
+	// Save tx metadata
+	var thumbnail string
+	var title string
+	if contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnail = contract.VendorListings[0].Item.Images[0].Tiny
+		title = contract.VendorListings[0].Item.Title
+	}
+	bumpable := false
+	if contract.BuyerOrder.Payment.Method != pb.Order_Payment_MODERATED {
+		bumpable = true
+	}
+	l.db.TxMetadata().Put(repo.Metadata{chainHash.String(), "", title, orderId, thumbnail, bumpable, nil})
+}
+
+// Record a payment to a sale and move the order on once the payments add up to its total
+func (l *TransactionListener) fundSale(orderId string, contract *pb.RicardianContract, state pb.OrderState, funded bool, funding int64, records []*wallet.TransactionRecord, record *wallet.TransactionRecord) {
 	if !funded {
 		requestedAmount := int64(contract.BuyerOrder.Payment.Amount)
 		if funding >= requestedAmount {
@@ -207,47 +297,42 @@ func (l *TransactionListener) processSalePayment(txid []byte, output wallet.Tran
 		}
 	}
 
-	record := &wallet.TransactionRecord{
-		Timestamp:    time.Now(),
-		Txid:         chainHash.String(),
-		Index:        output.Index,
-		Value:        output.Value,
-		ScriptPubKey: hex.EncodeToString(output.ScriptPubKey),
-	}
 	records = append(records, record)
 	l.db.Sales().UpdateFunding(orderId, funded, records)
-
-	// Save tx metadata
-	var thumbnail string
-	var title string
-	if contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
-		thumbnail = contract.VendorListings[0].Item.Images[0].Tiny
-		title = contract.VendorListings[0].Item.Title
-	}
-	bumpable := false
-	if contract.BuyerOrder.Payment.Method != pb.Order_Payment_MODERATED {
-		bumpable = true
-	}
-	l.db.TxMetadata().Put(repo.Metadata{chainHash.String(), "", title, orderId, thumbnail, bumpable})
 }
 
-func (l *TransactionListener) processPurchasePayment(txid []byte, output wallet.TransactionOutput, contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord) {
+func (l *TransactionListener) processPurchasePayment(txid []byte, output wallet.TransactionOutput, contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord, spent map[string]bool) {
 	chainHash, err := chainhash.NewHash(txid)
 	if err != nil {
 		return
 	}
 	funding := output.Value
 	for _, r := range records {
-		funding += r.Value
 		// If we have already seen this transaction for some reason, just return
 		if r.Txid == chainHash.String() {
 			return
 		}
+		if r.Value > 0 && spent[r.Txid+":"+strconv.Itoa(int(r.Index))] {
+			continue
+		}
+		funding += r.Value
 	}
 	orderId, err := calcOrderId(contract.BuyerOrder)
 	if err != nil {
 		return
 	}
+	record := &wallet.TransactionRecord{
+		Txid:         chainHash.String(),
+		Index:        output.Index,
+		Value:        output.Value,
+		ScriptPubKey: hex.EncodeToString(output.ScriptPubKey),
+		Timestamp:    time.Now(),
+	}
+	l.fundPurchase(orderId, contract, state, funded, funding, records, record)
+}
+
+// Record a payment for a purchase and move the order on once the payments add up to its total
+func (l *TransactionListener) fundPurchase(orderId string, contract *pb.RicardianContract, state pb.OrderState, funded bool, funding int64, records []*wallet.TransactionRecord, record *wallet.TransactionRecord) {
 	if !funded {
 		requestedAmount := int64(contract.BuyerOrder.Payment.Amount)
 		if funding >= requestedAmount {
@@ -269,17 +354,53 @@ func (l *TransactionListener) processPurchasePayment(txid []byte, output wallet.
 		l.db.Notifications().Put(n.ID, n, n.Type, time.Now())
 	}
 
-	record := &wallet.TransactionRecord{
-		Txid:         chainHash.String(),
-		Index:        output.Index,
-		Value:        output.Value,
-		ScriptPubKey: hex.EncodeToString(output.ScriptPubKey),
-		Timestamp:    time.Now(),
-	}
 	records = append(records, record)
 	l.db.Purchases().UpdateFunding(orderId, funded, records)
 }
 
+/* OnInvoiceSettled funds Lightning orders the same way payments to an order's address do.
+   The record of a settled invoice uses the payment hash in place of a txid. */
+func (l *TransactionListener) OnInvoiceSettled(s lightning.Settlement) {
+	l.Lock()
+	defer l.Unlock()
+	var (
+		contract *pb.RicardianContract
+		state    pb.OrderState
+		funded   bool
+		records  []*wallet.TransactionRecord
+		err      error
+	)
+	if s.Outgoing {
+		contract, state, funded, records, err = l.db.Purchases().GetByPaymentHash(s.PaymentHash)
+	} else {
+		contract, state, funded, records, err = l.db.Sales().GetByPaymentHash(s.PaymentHash)
+	}
+	if err != nil {
+		return
+	}
+	funding := s.Amount
+	for _, r := range records {
+		if r.Txid == s.PaymentHash {
+			return
+		}
+		funding += r.Value
+	}
+	orderId, err := calcOrderId(contract.BuyerOrder)
+	if err != nil {
+		return
+	}
+	record := &wallet.TransactionRecord{
+		Timestamp: s.SettledAt,
+		Txid:      s.PaymentHash,
+		Value:     s.Amount,
+	}
+	if s.Outgoing {
+		l.fundPurchase(orderId, contract, state, funded, funding, records, record)
+	} else {
+		l.fundSale(orderId, contract, state, funded, funding, records, record)
+	}
+}
+
 func (l *TransactionListener) adjustInventory(contract *pb.RicardianContract) {
 	for _, item := range contract.BuyerOrder.Items {
 		listing, err := core.ParseContractForListing(item.ListingHash, contract)
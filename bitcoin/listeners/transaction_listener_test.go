@@ -0,0 +1,122 @@
+package bitcoin
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	btc "github.com/btcsuite/btcutil"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+type listenerWallet struct {
+	wallet.Wallet
+}
+
+func (w *listenerWallet) ScriptToAddress(script []byte) (btc.Address, error) {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(script, &chaincfg.TestNet3Params)
+	if err != nil || len(addrs) == 0 {
+		return nil, err
+	}
+	return addrs[0], nil
+}
+
+func TestAcceleratedSalePayment(t *testing.T) {
+	dir, err := ioutil.TempDir("", "listener")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(path.Join(dir, "datastore"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	datastore, err := db.Create(dir, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := datastore.InitTables(""); err != nil {
+		t.Fatal(err)
+	}
+	l := NewTransactionListener(datastore, make(chan interface{}, 10), &listenerWallet{})
+
+	addr, err := btc.NewAddressScriptHashFromHash(make([]byte, 20), &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract := pb.RicardianContract{
+		VendorListings: []*pb.Listing{{Slug: "shoes", Item: &pb.Listing_Item{Title: "Shoes", Images: []*pb.Listing_Item_Image{{Tiny: "QmThumb"}}}}},
+		BuyerOrder: &pb.Order{
+			BuyerID:   &pb.ID{PeerID: "QmBuyer"},
+			Timestamp: &timestamp.Timestamp{Seconds: 1515000000},
+			Payment:   &pb.Order_Payment{Method: pb.Order_Payment_DIRECT, Amount: 150000, Address: addr.EncodeAddress()},
+		},
+	}
+	orderId, err := calcOrderId(contract.BuyerOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := datastore.Sales().Put(orderId, contract, pb.OrderState_AWAITING_PAYMENT, false); err != nil {
+		t.Fatal(err)
+	}
+
+	funding := func() (bool, int64, []*wallet.TransactionRecord) {
+		_, _, funded, records, _, err := datastore.Sales().GetByOrderId(orderId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var total int64
+		for _, r := range records {
+			total += r.Value
+		}
+		return funded, total, records
+	}
+
+	// A partial payment which is then accelerated by spending it back to the payment address
+	payment := chainhash.DoubleHashH([]byte("payment"))
+	l.OnTransactionReceived(wallet.TransactionCallback{
+		Txid:    payment.CloneBytes(),
+		Outputs: []wallet.TransactionOutput{{ScriptPubKey: script, Value: 100000, Index: 0}},
+	})
+	bump := chainhash.DoubleHashH([]byte("bump"))
+	l.OnTransactionReceived(wallet.TransactionCallback{
+		Txid:    bump.CloneBytes(),
+		Inputs:  []wallet.TransactionInput{{OutpointHash: payment.CloneBytes(), OutpointIndex: 0, LinkedScriptPubKey: script, Value: 100000}},
+		Outputs: []wallet.TransactionOutput{{ScriptPubKey: script, Value: 99000, Index: 0}},
+	})
+	funded, total, records := funding()
+	if funded || total != 99000 {
+		t.Errorf("The accelerated payment was counted twice: funded %v with %d", funded, total)
+	}
+	if len(records) != 3 || !records[0].Spent || records[1].Txid != bump.String() || records[2].Value != -100000 {
+		t.Errorf("Wrong records after the acceleration: %+v", records)
+	}
+
+	// The rest of the payment funds the order
+	rest := chainhash.DoubleHashH([]byte("rest"))
+	l.OnTransactionReceived(wallet.TransactionCallback{
+		Txid:    rest.CloneBytes(),
+		Outputs: []wallet.TransactionOutput{{ScriptPubKey: script, Value: 51000, Index: 1}},
+	})
+	funded, total, _ = funding()
+	if !funded || total != 150000 {
+		t.Errorf("Order was not funded by the rest of the payment: funded %v with %d", funded, total)
+	}
+	_, state, _, _, _, err := datastore.Sales().GetByOrderId(orderId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != pb.OrderState_PENDING {
+		t.Errorf("Expected the funded order to be pending, got %s", state)
+	}
+}
@@ -0,0 +1,190 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/spvwallet"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil/txsort"
+)
+
+var ErrMultisignManyUnsupported = errors.New("Wallet does not support spending from multisigs with more than two signers")
+
+// MultipartySigner is implemented by wallets which can combine more than two sets of signatures into a multisig spend
+type MultipartySigner interface {
+	MultisignMany(ins []wallet.TransactionInput, outs []wallet.TransactionOutput, sigs [][]wallet.Signature, redeemScript []byte, feePerByte uint64, broadcast bool) ([]byte, error)
+}
+
+// SupportsMultisignMany returns whether the wallet can spend from a multisig requiring more than two signatures
+func SupportsMultisignMany(w wallet.Wallet) bool {
+	switch w.(type) {
+	case MultipartySigner:
+		return true
+	case *spvwallet.SPVWallet:
+		return true
+	}
+	return false
+}
+
+/* MultisignMany spends from a multisig using the given signature sets. The sets must be
+   in the same order as the public keys in the redeem script. Two sets are passed straight
+   through to the wallet's Multisign so the existing escrows are unaffected. */
+func MultisignMany(w wallet.Wallet, ins []wallet.TransactionInput, outs []wallet.TransactionOutput, sigs [][]wallet.Signature, redeemScript []byte, feePerByte uint64, broadcast bool) ([]byte, error) {
+	if len(sigs) == 2 {
+		return w.Multisign(ins, outs, sigs[0], sigs[1], redeemScript, feePerByte, broadcast)
+	}
+	switch sw := w.(type) {
+	case MultipartySigner:
+		return sw.MultisignMany(ins, outs, sigs, redeemScript, feePerByte, broadcast)
+	case *spvwallet.SPVWallet:
+		tx, err := BuildMultisigTransaction(ins, outs, sigs, redeemScript, feePerByte)
+		if err != nil {
+			return nil, err
+		}
+		if broadcast {
+			if err := sw.Broadcast(tx); err != nil {
+				return nil, err
+			}
+		}
+		var buf bytes.Buffer
+		tx.BtcEncode(&buf, wire.ProtocolVersion, wire.WitnessEncoding)
+		return buf.Bytes(), nil
+	}
+	return nil, ErrMultisignManyUnsupported
+}
+
+/* BuildMultisigTransaction assembles a segwit multisig spend. The fee and sorting logic
+   mirrors the wallet's CreateMultisigSignature so the transaction matches the one the
+   signatures were made over. */
+func BuildMultisigTransaction(ins []wallet.TransactionInput, outs []wallet.TransactionOutput, sigs [][]wallet.Signature, redeemScript []byte, feePerByte uint64) (*wire.MsgTx, error) {
+	tx, err := UnsignedMultisigTransaction(ins, outs, redeemScript, feePerByte)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if time locked
+	timeLocked := redeemScript[0] == txscript.OP_IF
+
+	for i, input := range tx.TxIn {
+		witness := wire.TxWitness{[]byte{}}
+		for _, set := range sigs {
+			var s []byte
+			for _, sig := range set {
+				if int(sig.InputIndex) == i {
+					s = sig.Signature
+					break
+				}
+			}
+			witness = append(witness, s)
+		}
+		if timeLocked {
+			witness = append(witness, []byte{0x01})
+		}
+		witness = append(witness, redeemScript)
+		input.Witness = witness
+	}
+	return tx, nil
+}
+
+// UnsignedMultisigTransaction returns the multisig spend the signatures of CreateMultisigSignature are made over
+func UnsignedMultisigTransaction(ins []wallet.TransactionInput, outs []wallet.TransactionOutput, redeemScript []byte, feePerByte uint64) (*wire.MsgTx, error) {
+	if len(redeemScript) == 0 {
+		return nil, errors.New("Redeem script is empty")
+	}
+	tx := wire.NewMsgTx(1)
+	for _, in := range ins {
+		ch, err := chainhash.NewHashFromStr(hex.EncodeToString(in.OutpointHash))
+		if err != nil {
+			return nil, err
+		}
+		outpoint := wire.NewOutPoint(ch, in.OutpointIndex)
+		input := wire.NewTxIn(outpoint, []byte{}, [][]byte{})
+		tx.TxIn = append(tx.TxIn, input)
+	}
+	for _, out := range outs {
+		output := wire.NewTxOut(out.Value, out.ScriptPubKey)
+		tx.TxOut = append(tx.TxOut, output)
+	}
+
+	// Subtract fee
+	fee := EstimateMultisigSpendSize(len(ins), tx.TxOut, redeemScript) * int(feePerByte)
+	if len(tx.TxOut) > 0 {
+		feePerOutput := fee / len(tx.TxOut)
+		for _, output := range tx.TxOut {
+			output.Value -= int64(feePerOutput)
+		}
+	}
+
+	// BIP 69 sorting
+	txsort.InPlaceSort(tx)
+	return tx, nil
+}
+
+// Returns the number of signatures the redeem script requires, or zero if it isn't a multisig
+func requiredSignatures(redeemScript []byte) int {
+	script := redeemScript
+	if len(script) > 0 && script[0] == txscript.OP_IF {
+		script = script[1:]
+	}
+	if len(script) == 0 || script[0] < txscript.OP_1 || script[0] > txscript.OP_16 {
+		return 0
+	}
+	return int(script[0]-txscript.OP_1) + 1
+}
+
+/* EstimateMultisigSpendSize returns the size of a segwit spend of the redeem script with one
+   signature per required key. Two signature escrows use the wallets' own estimate so the
+   transaction matches the one their CreateMultisigSignature signs. */
+func EstimateMultisigSpendSize(inputCount int, txOuts []*wire.TxOut, redeemScript []byte) int {
+	_, err := spvwallet.LockTimeFromRedeemScript(redeemScript)
+	timeLocked := err == nil
+	m := requiredSignatures(redeemScript)
+	if m <= 2 {
+		txType := spvwallet.P2SH_2of3_Multisig
+		if timeLocked {
+			txType = spvwallet.P2SH_Multisig_Timelock_2Sigs
+		}
+		return spvwallet.EstimateSerializeSize(inputCount, txOuts, false, txType)
+	}
+
+	// Witness items: the CHECKMULTISIG dummy, the signatures, the timeout branch flag and the script
+	witnessSize := 1 + 1 + m*(1+72) + wire.VarIntSerializeSize(uint64(len(redeemScript))) + len(redeemScript)
+	if timeLocked {
+		witnessSize += 1 + 1
+	}
+	inputSize := 32 + 4 + 1 + 4 + witnessSize/4
+	return 10 + wire.VarIntSerializeSize(uint64(inputCount)) +
+		wire.VarIntSerializeSize(uint64(len(txOuts))) +
+		inputCount*inputSize +
+		spvwallet.SumOutputSerializeSizes(txOuts)
+}
+
+// EstimateMultisigFee returns the fee for spending the inputs of the redeem script to the outputs
+func EstimateMultisigFee(ins []wallet.TransactionInput, outs []wallet.TransactionOutput, redeemScript []byte, feePerByte uint64) uint64 {
+	var txOuts []*wire.TxOut
+	for _, out := range outs {
+		txOuts = append(txOuts, wire.NewTxOut(out.Value, out.ScriptPubKey))
+	}
+	return uint64(EstimateMultisigSpendSize(len(ins), txOuts, redeemScript)) * feePerByte
+}
+
+/* DeductMultisigFee returns the outputs with the fee for spending the redeem script split
+   evenly between them, as the wallets do. Signing the result with a zero fee rate lets
+   escrows with more than two signers pay for their real size. */
+func DeductMultisigFee(ins []wallet.TransactionInput, outs []wallet.TransactionOutput, redeemScript []byte, feePerByte uint64) []wallet.TransactionOutput {
+	if len(outs) == 0 {
+		return outs
+	}
+	feePerOutput := int64(EstimateMultisigFee(ins, outs, redeemScript, feePerByte)) / int64(len(outs))
+	deducted := make([]wallet.TransactionOutput, len(outs))
+	for i, out := range outs {
+		out.Value -= feePerOutput
+		deducted[i] = out
+	}
+	return deducted
+}
@@ -3,6 +3,7 @@ package spvwallet
 import (
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcd/chaincfg"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/mitchellh/go-homedir"
 	"github.com/op/go-logging"
 	"golang.org/x/net/proxy"
@@ -21,6 +22,13 @@ type Config struct {
 	// Bip39 mnemonic string. If empty a new mnemonic will be created.
 	Mnemonic string
 
+	// The BIP44 account public key (m/44'/0'/0'). If set the wallet is watch only. It holds no
+	// private keys, the mnemonic is ignored and transactions must be signed elsewhere.
+	AccountPublicKey *hd.ExtendedKey
+
+	// The master public key a watch only wallet reports as its own
+	MasterPublicKey *hd.ExtendedKey
+
 	// The date the wallet was created.
 	// If before the earliest checkpoint the chain will be synced using the earliest checkpoint.
 	CreationDate time.Time
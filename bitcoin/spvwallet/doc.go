@@ -0,0 +1,4 @@
+/* Package spvwallet is github.com/OpenBazaar/spvwallet at revision 2a251b1 with watch only
+   wallets added for cold storage. A wallet configured with an account public key derives its
+   addresses from it and holds no private keys. Keep this copy in step with upstream fixes. */
+package spvwallet
@@ -22,6 +22,19 @@ func NewKeyManager(db wallet.Keys, params *chaincfg.Params, masterPrivKey *hd.Ex
 	if err != nil {
 		return nil, err
 	}
+	return newKeyManager(db, params, internal, external)
+}
+
+// NewWatchOnlyKeyManager derives the wallet's public keys from the BIP44 account public key
+func NewWatchOnlyKeyManager(db wallet.Keys, params *chaincfg.Params, accountPubKey *hd.ExtendedKey) (*KeyManager, error) {
+	internal, external, err := AccountDerivation(accountPubKey)
+	if err != nil {
+		return nil, err
+	}
+	return newKeyManager(db, params, internal, external)
+}
+
+func newKeyManager(db wallet.Keys, params *chaincfg.Params, internal, external *hd.ExtendedKey) (*KeyManager, error) {
 	km := &KeyManager{
 		datastore:   db,
 		params:      params,
@@ -51,6 +64,11 @@ func Bip44Derivation(masterPrivKey *hd.ExtendedKey) (internal, external *hd.Exte
 	if err != nil {
 		return nil, nil, err
 	}
+	return AccountDerivation(account)
+}
+
+// m / purpose' / coin_type' / account' / change
+func AccountDerivation(account *hd.ExtendedKey) (internal, external *hd.ExtendedKey, err error) {
 	// Change(0) = external
 	external, err = account.Child(0)
 	if err != nil {
@@ -0,0 +1,53 @@
+package spvwallet
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+)
+
+func TestNewWatchOnlyKeyManager(t *testing.T) {
+	mPrivKey, err := hd.NewMaster([]byte("watch only key manager test seed"), &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := mPrivKey
+	for _, i := range []uint32{44, 0, 0} {
+		account, err = account.Child(hd.HardenedKeyStart + i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	accountPubKey, err := account.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	km, err := NewKeyManager(&mockKeyStore{make(map[string]*keyStoreEntry)}, &chaincfg.TestNet3Params, mPrivKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	watchOnly, err := NewWatchOnlyKeyManager(&mockKeyStore{make(map[string]*keyStoreEntry)}, &chaincfg.TestNet3Params, accountPubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, purpose := range []wallet.KeyPurpose{wallet.EXTERNAL, wallet.INTERNAL} {
+		key, err := km.GetCurrentKey(purpose)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKey, err := watchOnly.GetCurrentKey(purpose)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pubKey.IsPrivate() {
+			t.Error("Watch only key manager returned a private key")
+		}
+		addr, _ := key.Address(&chaincfg.TestNet3Params)
+		watchOnlyAddr, _ := pubKey.Address(&chaincfg.TestNet3Params)
+		if addr.String() != watchOnlyAddr.String() {
+			t.Errorf("Watch only key manager derived %s instead of %s", watchOnlyAddr, addr)
+		}
+	}
+}
@@ -102,7 +102,12 @@ func (w *SPVWallet) gatherCoins() map[coinset.Coin]*hd.ExtendedKey {
 	return m
 }
 
+var ErrWatchOnly = errors.New("Watch only wallet cannot sign transactions")
+
 func (w *SPVWallet) Spend(amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
+	if w.masterPrivateKey == nil {
+		return nil, ErrWatchOnly
+	}
 	tx, err := w.buildTx(amount, addr, feeLevel, nil)
 	if err != nil {
 		return nil, err
@@ -642,6 +647,11 @@ func (w *SPVWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet.FeeL
 	// BIP 69 sorting
 	txsort.InPlaceSort(authoredTx.Tx)
 
+	// A watch only wallet only builds transactions to estimate their fee
+	if w.masterPrivateKey == nil {
+		return authoredTx.Tx, nil
+	}
+
 	// Sign tx
 	getKey := txscript.KeyClosure(func(addr btc.Address) (*btcec.PrivateKey, bool, error) {
 		addrStr := addr.EncodeAddress()
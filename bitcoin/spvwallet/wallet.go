@@ -55,27 +55,38 @@ func NewSPVWallet(config *Config) (*SPVWallet, error) {
 
 	log.SetBackend(logging.AddModuleLevel(config.Logger))
 
-	if config.Mnemonic == "" {
-		ent, err := b39.NewEntropy(128)
+	var mPrivKey, mPubKey *hd.ExtendedKey
+	var err error
+	if config.AccountPublicKey != nil {
+		// A watch only wallet has no private keys so the mnemonic is not used
+		if config.AccountPublicKey.IsPrivate() {
+			return nil, errors.New("Account key of a watch only wallet must be an extended public key")
+		}
+		config.Mnemonic = ""
+		mPubKey = config.MasterPublicKey
+	} else {
+		if config.Mnemonic == "" {
+			ent, err := b39.NewEntropy(128)
+			if err != nil {
+				return nil, err
+			}
+			mnemonic, err := b39.NewMnemonic(ent)
+			if err != nil {
+				return nil, err
+			}
+			config.Mnemonic = mnemonic
+			config.CreationDate = time.Now()
+		}
+		seed := b39.NewSeed(config.Mnemonic, "")
+
+		mPrivKey, err = hd.NewMaster(seed, config.Params)
 		if err != nil {
 			return nil, err
 		}
-		mnemonic, err := b39.NewMnemonic(ent)
+		mPubKey, err = mPrivKey.Neuter()
 		if err != nil {
 			return nil, err
 		}
-		config.Mnemonic = mnemonic
-		config.CreationDate = time.Now()
-	}
-	seed := b39.NewSeed(config.Mnemonic, "")
-
-	mPrivKey, err := hd.NewMaster(seed, config.Params)
-	if err != nil {
-		return nil, err
-	}
-	mPubKey, err := mPrivKey.Neuter()
-	if err != nil {
-		return nil, err
 	}
 	w := &SPVWallet{
 		repoPath:         config.RepoPath,
@@ -98,7 +109,14 @@ func NewSPVWallet(config *Config) (*SPVWallet, error) {
 		mutex:         new(sync.RWMutex),
 	}
 
-	w.keyManager, err = NewKeyManager(config.DB.Keys(), w.params, w.masterPrivateKey)
+	if config.AccountPublicKey != nil {
+		w.keyManager, err = NewWatchOnlyKeyManager(config.DB.Keys(), w.params, config.AccountPublicKey)
+	} else {
+		w.keyManager, err = NewKeyManager(config.DB.Keys(), w.params, w.masterPrivateKey)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	w.txstore, err = NewTxStore(w.params, config.DB, w.keyManager)
 	if err != nil {
@@ -9,8 +9,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin/bitcoind"
-	"github.com/OpenBazaar/spvwallet"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/spvwallet"
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -53,6 +54,7 @@ type ZcashdWallet struct {
 	useTor           bool
 	addrsToWatch     []btc.Address
 	initChan         chan struct{}
+	frozen           func(op wire.OutPoint) bool
 }
 
 var connCfg *btcrpcclient.ConnConfig = &btcrpcclient.ConnConfig{
@@ -450,6 +452,9 @@ func (w *ZcashdWallet) gatherCoins() (map[coinset.Coin]*hd.ExtendedKey, error) {
 		if err != nil {
 			return m, err
 		}
+		if w.frozen != nil && w.frozen(*wire.NewOutPoint(txhash, u.Vout)) {
+			continue
+		}
 		addr, err := DecodeAddress(u.Address, w.params)
 		if err != nil {
 			return m, err
@@ -477,6 +482,11 @@ func (w *ZcashdWallet) gatherCoins() (map[coinset.Coin]*hd.ExtendedKey, error) {
 	return m, nil
 }
 
+// SetFrozenCoins makes the wallet leave the coins the node reports frozen out of its spends
+func (w *ZcashdWallet) SetFrozenCoins(frozen func(op wire.OutPoint) bool) {
+	w.frozen = frozen
+}
+
 func (w *ZcashdWallet) Spend(amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
 	<-w.initChan
 	tx, err := w.buildTx(amount, addr, feeLevel)
@@ -487,9 +497,17 @@ func (w *ZcashdWallet) Spend(amount int64, addr btc.Address, feeLevel wallet.Fee
 }
 
 func (w *ZcashdWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*wire.MsgTx, error) {
-	script, _ := PayToAddrScript(addr)
-	if txrules.IsDustAmount(btc.Amount(amount), len(script), txrules.DefaultRelayFeePerKb) {
-		return nil, wallet.ErrorDustAmount
+	return w.buildBatchTx([]bitcoin.SpendOutput{{Address: addr, Amount: amount}}, feeLevel)
+}
+
+func (w *ZcashdWallet) buildBatchTx(outs []bitcoin.SpendOutput, feeLevel wallet.FeeLevel) (*wire.MsgTx, error) {
+	var outputs []*wire.TxOut
+	for _, o := range outs {
+		script, _ := PayToAddrScript(o.Address)
+		if txrules.IsDustAmount(btc.Amount(o.Amount), len(script), txrules.DefaultRelayFeePerKb) {
+			return nil, wallet.ErrorDustAmount
+		}
+		outputs = append(outputs, wire.NewTxOut(o.Amount, script))
 	}
 
 	var additionalPrevScripts map[wire.OutPoint][]byte
@@ -537,9 +555,6 @@ func (w *ZcashdWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet.F
 	// Get the fee per kilobyte
 	feePerKB := int64(w.GetFeePerByte(feeLevel)) * 1000
 
-	// outputs
-	out := wire.NewTxOut(amount, script)
-
 	// Create change source
 	changeSource := func() ([]byte, error) {
 		addr := w.CurrentAddress(wallet.INTERNAL)
@@ -550,7 +565,6 @@ func (w *ZcashdWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet.F
 		return script, nil
 	}
 
-	outputs := []*wire.TxOut{out}
 	authoredTx, err := spvwallet.NewUnsignedTransaction(outputs, btc.Amount(feePerKB), inputSource, changeSource)
 	if err != nil {
 		return nil, err
@@ -685,6 +699,29 @@ func (w *ZcashdWallet) EstimateSpendFee(amount int64, feeLevel wallet.FeeLevel)
 	if err != nil {
 		return 0, err
 	}
+	return w.txFee(tx)
+}
+
+func (w *ZcashdWallet) SpendMany(outs []bitcoin.SpendOutput, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
+	<-w.initChan
+	tx, err := w.buildBatchTx(outs, feeLevel)
+	if err != nil {
+		return nil, err
+	}
+	return w.rpcClient.SendRawTransaction(tx, false)
+}
+
+func (w *ZcashdWallet) EstimateSpendManyFee(outs []bitcoin.SpendOutput, feeLevel wallet.FeeLevel) (uint64, error) {
+	<-w.initChan
+	tx, err := w.buildBatchTx(outs, feeLevel)
+	if err != nil {
+		return 0, err
+	}
+	return w.txFee(tx)
+}
+
+// Returns the fee paid by a transaction spending our coins
+func (w *ZcashdWallet) txFee(tx *wire.MsgTx) (uint64, error) {
 	var outval int64
 	for _, output := range tx.TxOut {
 		outval += output.Value
@@ -17,8 +17,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin/bitcoind"
-	"github.com/OpenBazaar/spvwallet"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/spvwallet"
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -55,6 +56,7 @@ type ZendWallet struct {
 	useTor           bool
 	addrsToWatch     []btc.Address
 	initChan         chan struct{}
+	frozen           func(op wire.OutPoint) bool
 }
 
 var connCfg *btcrpcclient.ConnConfig = &btcrpcclient.ConnConfig{
@@ -460,6 +462,9 @@ func (w *ZendWallet) gatherCoins() (map[coinset.Coin]*hd.ExtendedKey, error) {
 		if err != nil {
 			return m, err
 		}
+		if w.frozen != nil && w.frozen(*wire.NewOutPoint(txhash, u.Vout)) {
+			continue
+		}
 		addr, err := DecodeAddress(u.Address, w.params)
 		if err != nil {
 			return m, err
@@ -487,6 +492,11 @@ func (w *ZendWallet) gatherCoins() (map[coinset.Coin]*hd.ExtendedKey, error) {
 	return m, nil
 }
 
+// SetFrozenCoins makes the wallet leave the coins the node reports frozen out of its spends
+func (w *ZendWallet) SetFrozenCoins(frozen func(op wire.OutPoint) bool) {
+	w.frozen = frozen
+}
+
 func (w *ZendWallet) Spend(amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
 	<-w.initChan
 	tx, err := w.buildTx(amount, addr, feeLevel)
@@ -497,6 +507,10 @@ func (w *ZendWallet) Spend(amount int64, addr btc.Address, feeLevel wallet.FeeLe
 }
 
 func (w *ZendWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*wire.MsgTx, error) {
+	return w.buildBatchTx([]bitcoin.SpendOutput{{Address: addr, Amount: amount}}, feeLevel)
+}
+
+func (w *ZendWallet) buildBatchTx(outs []bitcoin.SpendOutput, feeLevel wallet.FeeLevel) (*wire.MsgTx, error) {
 
 	blockHeight, _ := w.ChainTip()
 	blockNumber := int64(blockHeight) - 300
@@ -504,9 +518,13 @@ func (w *ZendWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet.Fee
 	if err != nil {
 		return nil, err
 	}
-	script, _ := PayToAddrScript(addr, blockHash.CloneBytes(), blockNumber)
-	if txrules.IsDustAmount(btc.Amount(amount), len(script), txrules.DefaultRelayFeePerKb) {
-		return nil, wallet.ErrorDustAmount
+	var outputs []*wire.TxOut
+	for _, o := range outs {
+		script, _ := PayToAddrScript(o.Address, blockHash.CloneBytes(), blockNumber)
+		if txrules.IsDustAmount(btc.Amount(o.Amount), len(script), txrules.DefaultRelayFeePerKb) {
+			return nil, wallet.ErrorDustAmount
+		}
+		outputs = append(outputs, wire.NewTxOut(o.Amount, script))
 	}
 
 	var additionalPrevScripts map[wire.OutPoint][]byte
@@ -554,11 +572,6 @@ func (w *ZendWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet.Fee
 	// Get the fee per kilobyte
 	feePerKB := int64(w.GetFeePerByte(feeLevel)) * 1000
 
-	// outputs
-	out := wire.NewTxOut(amount, script)
-
-	//
-
 	// Create change source
 	changeSource := func() ([]byte, error) {
 		addr := w.CurrentAddress(wallet.INTERNAL)
@@ -569,8 +582,6 @@ func (w *ZendWallet) buildTx(amount int64, addr btc.Address, feeLevel wallet.Fee
 		return script, nil
 	}
 
-	outputs := []*wire.TxOut{out}
-
 	authoredTx, err := NewUnsignedTransaction(outputs, btc.Amount(feePerKB), inputSource, changeSource)
 	if err != nil {
 		return nil, err
@@ -692,6 +703,29 @@ func (w *ZendWallet) EstimateSpendFee(amount int64, feeLevel wallet.FeeLevel) (u
 	if err != nil {
 		return 0, err
 	}
+	return w.txFee(tx)
+}
+
+func (w *ZendWallet) SpendMany(outs []bitcoin.SpendOutput, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
+	<-w.initChan
+	tx, err := w.buildBatchTx(outs, feeLevel)
+	if err != nil {
+		return nil, err
+	}
+	return w.rpcClient.SendRawTransaction(tx, false)
+}
+
+func (w *ZendWallet) EstimateSpendManyFee(outs []bitcoin.SpendOutput, feeLevel wallet.FeeLevel) (uint64, error) {
+	<-w.initChan
+	tx, err := w.buildBatchTx(outs, feeLevel)
+	if err != nil {
+		return 0, err
+	}
+	return w.txFee(tx)
+}
+
+// Returns the fee paid by a transaction spending our coins
+func (w *ZendWallet) txFee(tx *wire.MsgTx) (uint64, error) {
 	var outval int64
 	for _, output := range tx.TxOut {
 		outval += output.Value
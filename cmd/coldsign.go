@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+type ColdSign struct {
+	Testnet bool   `short:"t" long:"testnet" description:"use the test network"`
+	PeerID  string `short:"p" long:"peerid" description:"print the cold storage config values for the node with this peer ID"`
+	Request string `short:"r" long:"request" description:"sign the signing request in this file"`
+	Out     string `short:"o" long:"out" description:"write the signatures to this file instead of stdout"`
+}
+
+func (x *ColdSign) Execute(args []string) error {
+	if (x.PeerID == "") == (x.Request == "") {
+		return errors.New("Specify exactly one of --peerid or --request")
+	}
+	params := &chaincfg.MainNetParams
+	if x.Testnet {
+		params = &chaincfg.TestNet3Params
+	}
+	fmt.Print("Enter the cold storage mnemonic: ")
+	mnemonicBytes, err := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Println("")
+	if err != nil {
+		return err
+	}
+	mnemonic := strings.TrimSpace(string(mnemonicBytes))
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return errors.New("Invalid mnemonic")
+	}
+	mPrivKey, err := hd.NewMaster(bip39.NewSeed(mnemonic, ""), params)
+	if err != nil {
+		return err
+	}
+
+	if x.PeerID != "" {
+		mPubKey, err := mPrivKey.Neuter()
+		if err != nil {
+			return err
+		}
+		account, err := bitcoin.Bip44Account(mPrivKey)
+		if err != nil {
+			return err
+		}
+		accountPubKey, err := account.Neuter()
+		if err != nil {
+			return err
+		}
+		ecPrivKey, err := mPrivKey.ECPrivKey()
+		if err != nil {
+			return err
+		}
+		sig, err := ecPrivKey.Sign([]byte(x.PeerID))
+		if err != nil {
+			return err
+		}
+		fmt.Println("Set these in the ColdStorage section of the node's config:")
+		fmt.Printf("  \"MasterPublicKey\": \"%s\",\n", mPubKey.String())
+		fmt.Printf("  \"AccountPublicKey\": \"%s\",\n", accountPubKey.String())
+		fmt.Printf("  \"BitcoinSig\": \"%s\"\n", hex.EncodeToString(sig.Serialize()))
+		return nil
+	}
+
+	b, err := ioutil.ReadFile(x.Request)
+	if err != nil {
+		return err
+	}
+	req := new(core.SigningRequest)
+	if err := json.Unmarshal(b, req); err != nil {
+		return err
+	}
+	tx, err := bitcoin.DecodeTransaction(req.Transaction)
+	if err != nil {
+		return err
+	}
+
+	// Show what is being signed so it can be checked before the signatures leave this machine
+	if req.OrderId != "" {
+		fmt.Printf("Signing %s for order %s (%s)\n", req.Action, req.OrderId, req.Coin)
+	} else {
+		fmt.Printf("Signing %s (%s)\n", req.Action, req.Coin)
+	}
+	for _, out := range tx.TxOut {
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(out.PkScript, params)
+		if err != nil || len(addrs) == 0 {
+			fmt.Printf("  %d to script %s\n", out.Value, hex.EncodeToString(out.PkScript))
+			continue
+		}
+		fmt.Printf("  %d to %s\n", out.Value, addrs[0].EncodeAddress())
+	}
+
+	resp, err := core.SignRequest(req, mPrivKey)
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		return err
+	}
+	if x.Out == "" {
+		fmt.Println(string(out))
+		return nil
+	}
+	if err := ioutil.WriteFile(x.Out, out, os.FileMode(0644)); err != nil {
+		return err
+	}
+	fmt.Println("Signatures written to " + x.Out)
+	return nil
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+)
+
+type Export struct {
+	DataDir  string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet  bool   `short:"t" long:"testnet" description:"use the test network"`
+	Password string `short:"p" long:"password" description:"the encryption password if the database is encrypted"`
+	Coin     string `short:"c" long:"coin" description:"the coin of the wallet to export, defaults to the primary wallet"`
+	Format   string `short:"f" long:"format" description:"csv or ofx" default:"csv"`
+	From     string `long:"from" description:"only export transactions on or after this date (2006-01-02)"`
+	To       string `long:"to" description:"only export transactions on or before this date (2006-01-02)"`
+	Currency string `long:"currency" description:"the fiat currency to value transactions in, defaults to the local currency in the settings"`
+	Out      string `short:"o" long:"out" description:"write the export to this file instead of stdout"`
+}
+
+// The coin each wallet type holds
+var walletTypeCoins = map[string]string{
+	"spvwallet":   "BTC",
+	"bitcoind":    "BTC",
+	"bitcoincash": "BCH",
+	"zcashd":      "ZEC",
+	"zend":        "ZEN",
+}
+
+func (x *Export) Execute(args []string) error {
+	repoPath, err := repo.GetRepoPath(x.Testnet)
+	if err != nil {
+		return err
+	}
+	if x.DataDir != "" {
+		repoPath = x.DataDir
+	}
+	if !fsrepo.IsInitialized(repoPath) {
+		return errors.New("Repo is not initialized")
+	}
+	from, to, err := core.ParseAccountingPeriod(x.From, x.To)
+	if err != nil {
+		return err
+	}
+	configFile, err := ioutil.ReadFile(path.Join(repoPath, "config"))
+	if err != nil {
+		return err
+	}
+	walletCfg, err := repo.GetWalletConfig(configFile)
+	if err != nil {
+		return err
+	}
+	additionalWallets, err := repo.GetAdditionalWalletConfigs(configFile)
+	if err != nil {
+		return err
+	}
+
+	sqliteDB, err := db.Create(repoPath, x.Password, x.Testnet)
+	if err != nil {
+		return err
+	}
+	defer sqliteDB.Close()
+
+	// The primary wallet shares the node's database, additional wallets have their own
+	coin := strings.TrimPrefix(strings.ToUpper(x.Coin), "T")
+	walletDB := sqliteDB
+	walletType := strings.ToLower(walletCfg.Type)
+	if coin != "" && coin != walletTypeCoins[walletType] {
+		walletType = ""
+		for _, wCfg := range additionalWallets {
+			if walletTypeCoins[strings.ToLower(wCfg.Type)] == coin {
+				walletType = strings.ToLower(wCfg.Type)
+				break
+			}
+		}
+		if walletType == "" {
+			return fmt.Errorf("No wallet is configured for %s", x.Coin)
+		}
+		walletDB, err = db.Create(path.Join(repoPath, "wallets", walletType), x.Password, x.Testnet)
+		if err != nil {
+			return err
+		}
+		defer walletDB.Close()
+	}
+	code := walletTypeCoins[walletType]
+	if x.Testnet {
+		code = "T" + code
+	}
+
+	currency := x.Currency
+	if currency == "" {
+		currency = "USD"
+		settings, err := sqliteDB.Settings().Get()
+		if err == nil && settings.LocalCurrency != nil && *settings.LocalCurrency != "" {
+			currency = *settings.LocalCurrency
+		}
+	}
+	entries, err := core.AccountingEntries(sqliteDB, walletDB.Txns(), code, currency, from, to)
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if x.Out != "" {
+		out, err = os.Create(x.Out)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+	return core.WriteAccountingExport(out, x.Format, entries)
+}
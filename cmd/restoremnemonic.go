@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"syscall"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+type RestoreMnemonic struct {
+	DataDir  string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet  bool   `short:"t" long:"testnet" description:"use the test network"`
+	Password string `short:"p" long:"password" description:"the encryption password if the database is encrypted"`
+}
+
+/* Put back the mnemonic removed when cold storage was enabled so the node can run its own
+   wallet again. The mnemonic must be the one the node's identity was created from. */
+func (x *RestoreMnemonic) Execute(args []string) error {
+	repoPath, err := repo.GetRepoPath(x.Testnet)
+	if err != nil {
+		return err
+	}
+	if x.DataDir != "" {
+		repoPath = x.DataDir
+	}
+	if !fsrepo.IsInitialized(repoPath) {
+		return errors.New("Repo is not initialized")
+	}
+	sqliteDB, err := db.Create(repoPath, x.Password, x.Testnet)
+	if err != nil {
+		return err
+	}
+	defer sqliteDB.Close()
+	if sqliteDB.Config().IsEncrypted() {
+		return errors.New("Database is encrypted, enter the password with --password")
+	}
+	mn, err := sqliteDB.Config().GetMnemonic()
+	if err != nil {
+		return err
+	}
+	if mn != "" {
+		return errors.New("The node already has its mnemonic")
+	}
+
+	fmt.Print("Enter the node's mnemonic: ")
+	mnemonicBytes, err := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Println("")
+	if err != nil {
+		return err
+	}
+	mnemonic := strings.TrimSpace(string(mnemonicBytes))
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return errors.New("Invalid mnemonic")
+	}
+	identityKey, err := ipfs.IdentityKeyFromSeed(bip39.NewSeed(mnemonic, "Secret Passphrase"), 4096)
+	if err != nil {
+		return err
+	}
+	ours, err := sqliteDB.Config().GetIdentityKey()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(identityKey, ours) {
+		return errors.New("This is not the mnemonic the node was created with")
+	}
+	if err := sqliteDB.Config().SetMnemonic(mnemonic); err != nil {
+		return err
+	}
+	fmt.Println("Restored the mnemonic. Cold storage can now be disabled.")
+	return nil
+}
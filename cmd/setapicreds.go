@@ -3,9 +3,12 @@ package cmd
 import (
 	"bufio"
 	"crypto/sha256"
+	"database/sql"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
 	"github.com/ipfs/go-ipfs/repo/fsrepo"
 	"golang.org/x/crypto/ssh/terminal"
 	"io/ioutil"
@@ -16,8 +19,13 @@ import (
 )
 
 type SetAPICreds struct {
-	DataDir string `short:"d" long:"datadir" description:"specify the data directory to be used"`
-	Testnet bool   `short:"t" long:"testnet" description:"config file is for testnet node"`
+	DataDir    string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet    bool   `short:"t" long:"testnet" description:"config file is for testnet node"`
+	Token      string `long:"token" description:"create an API token with this name instead of setting the username and password"`
+	Scopes     string `long:"scopes" description:"comma separated scopes of the new token: read-only, orders, listings, chat, wallet-spend, admin"`
+	Revoke     string `long:"revoke" description:"delete the API token with this name"`
+	List       bool   `long:"list" description:"list the API tokens"`
+	DBPassword string `long:"dbpassword" description:"the database password if the database is encrypted"`
 }
 
 func (x *SetAPICreds) Execute(args []string) error {
@@ -29,6 +37,9 @@ func (x *SetAPICreds) Execute(args []string) error {
 	if x.DataDir != "" {
 		repoPath = x.DataDir
 	}
+	if x.Token != "" || x.Revoke != "" || x.List {
+		return x.manageTokens(repoPath)
+	}
 	r, err := fsrepo.Open(repoPath)
 	if err != nil {
 		log.Error(err)
@@ -91,3 +102,47 @@ func (x *SetAPICreds) Execute(args []string) error {
 	}
 	return nil
 }
+
+// Tokens are kept in the database so they can be managed while the node is running
+func (x *SetAPICreds) manageTokens(repoPath string) error {
+	sqliteDB, err := db.Create(repoPath, x.DBPassword, x.Testnet)
+	if err != nil {
+		return err
+	}
+	defer sqliteDB.Close()
+	switch {
+	case x.Token != "":
+		var scopes []string
+		for _, s := range strings.Split(x.Scopes, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				scopes = append(scopes, s)
+			}
+		}
+		apiToken, token, err := repo.NewAPIToken(x.Token, scopes)
+		if err != nil {
+			return err
+		}
+		if err := sqliteDB.APITokens().Put(apiToken); err != nil {
+			return err
+		}
+		fmt.Printf("Created API token %s with scopes %s\n", apiToken.Name, strings.Join(apiToken.Scopes, ", "))
+		fmt.Println("Send it in an \"Authorization: Bearer\" header. It will not be shown again:")
+		fmt.Println(token)
+	case x.Revoke != "":
+		if err := sqliteDB.APITokens().Delete(x.Revoke); err == sql.ErrNoRows {
+			return errors.New("Token not found")
+		} else if err != nil {
+			return err
+		}
+		fmt.Printf("Revoked API token %s\n", x.Revoke)
+	default:
+		tokens, err := sqliteDB.APITokens().GetAll()
+		if err != nil {
+			return err
+		}
+		for _, t := range tokens {
+			fmt.Printf("%s\t%s\t%s\n", t.Name, strings.Join(t.Scopes, ","), t.Created.Format("2006-01-02"))
+		}
+	}
+	return nil
+}
@@ -21,8 +21,10 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin/bitcoind"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin/exchange"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/lightning"
 	lis "github.com/OpenBazaar/openbazaar-go/bitcoin/listeners"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin/resync"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/spvwallet"
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	obns "github.com/OpenBazaar/openbazaar-go/namesys"
@@ -35,7 +37,6 @@ import (
 	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/OpenBazaar/openbazaar-go/storage/dropbox"
 	"github.com/OpenBazaar/openbazaar-go/storage/selfhosted"
-	"github.com/OpenBazaar/spvwallet"
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil/base58"
@@ -263,6 +264,11 @@ func (x *Start) Execute(args []string) error {
 		log.Error(err)
 		return err
 	}
+	additionalWallets, err := repo.GetAdditionalWalletConfigs(configFile)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
 	dataSharing, err := repo.GetDataSharing(configFile)
 	if err != nil {
 		log.Error(err)
@@ -283,6 +289,37 @@ func (x *Start) Execute(args []string) error {
 		log.Error(err)
 		return err
 	}
+	ratingAmendmentWindow, err := repo.GetRatingAmendmentWindow(configFile)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	autoSweepConfigs, err := repo.GetAutoSweepConfigs(configFile)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	coldStorageConfig, err := repo.GetColdStorageConfig(configFile)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	lightningConfig, err := repo.GetLightningConfig(configFile)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	exchangeRatesConfig, err := repo.GetExchangeRatesConfig(configFile)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	eventLogConfig, err := repo.GetEventLogConfig(configFile)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
 
 	// IPFS node setup
 	r, err := fsrepo.Open(repoPath)
@@ -527,9 +564,50 @@ func (x *Start) Execute(args []string) error {
 		walletCfg.Type = "zend"
 		walletCfg.Binary = x.ZenCash
 	}
+
+	/* With cold storage the private keys stay on the offline machine and the bitcoin spv
+	   wallet runs watch only from the account public key. The mnemonic is removed from the
+	   node once it is known to be the one the cold storage keys come from. */
+	var coldStorage *core.ColdStorage
+	if coldStorageConfig.Enabled {
+		if strings.ToLower(walletCfg.Type) != "spvwallet" || len(additionalWallets) > 0 {
+			return errors.New("Cold storage is only supported with a single spvwallet")
+		}
+		coldStorage, err = core.NewColdStorage(coldStorageConfig, repoPath, nd.Identity.Pretty())
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		if mn != "" {
+			match, err := coldStorage.MatchesMnemonic(mn, &params)
+			if err != nil {
+				log.Error(err)
+				return err
+			}
+			if !match {
+				return errors.New("The cold storage keys do not come from this node's mnemonic. Use the node's mnemonic on the offline machine or move the wallet's funds before enabling cold storage.")
+			}
+			if err := sqliteDB.Config().ClearMnemonic(); err != nil {
+				log.Error(err)
+				return err
+			}
+			mn = ""
+			log.Notice("The wallet keys are in cold storage. Removed the mnemonic from the node.")
+		}
+	} else if mn == "" {
+		// Without its mnemonic the wallet would start from new random keys on every run
+		return errors.New("The node's mnemonic was removed when cold storage was enabled. Restore it with the restoremnemonic command before disabling cold storage.")
+	}
+	newPriceFetcher := func() (*exchange.BitcoinPriceFetcher, error) {
+		return exchange.NewBitcoinPriceFetcherWithProviders(torDialer, exchangeRatesConfig.Providers, exchangeRatesConfig.MaxDeviation, exchangeRatesConfig.MaxAge)
+	}
 	var exchangeRates bitcoin.ExchangeRates
 	if !x.DisableExchangeRates {
-		exchangeRates = exchange.NewBitcoinPriceFetcher(torDialer)
+		exchangeRates, err = newPriceFetcher()
+		if err != nil {
+			log.Error(err)
+			return err
+		}
 	}
 	var w3 io.Writer
 	if x.NoLogFiles {
@@ -546,135 +624,185 @@ func (x *Start) Execute(args []string) error {
 	bitcoinFileFormatter := logging.NewBackendFormatter(bitcoinFile, fileLogFormat)
 	ml := logging.MultiLogger(bitcoinFileFormatter)
 
-	var resyncManager *resync.ResyncManager
-	var cryptoWallet wallet.Wallet
-	var walletTypeStr string
-	switch strings.ToLower(walletCfg.Type) {
-	case "spvwallet":
-		walletTypeStr = "bitcoin spv"
-		var tp net.Addr
-		if walletCfg.TrustedPeer != "" {
-			tp, err = net.ResolveTCPAddr("tcp", walletCfg.TrustedPeer)
+	/* Build a wallet from its config. Additional wallets get their own data directory and
+	   database so their keys and transactions are kept apart from the primary wallet's. */
+	newWallet := func(walletCfg *repo.WalletConfig, walletRepoPath string, walletDB *db.SQLiteDatastore, rates bitcoin.ExchangeRates) (wallet.Wallet, bitcoin.ExchangeRates, *resync.ResyncManager, string, error) {
+		var w wallet.Wallet
+		var rm *resync.ResyncManager
+		var name string
+		var err error
+		switch strings.ToLower(walletCfg.Type) {
+		case "spvwallet":
+			name = "bitcoin spv"
+			var tp net.Addr
+			if walletCfg.TrustedPeer != "" {
+				tp, err = net.ResolveTCPAddr("tcp", walletCfg.TrustedPeer)
+				if err != nil {
+					return nil, nil, nil, "", err
+				}
+			}
+			feeApi, err := url.Parse(walletCfg.FeeAPI)
 			if err != nil {
-				log.Error(err)
-				return err
+				return nil, nil, nil, "", err
 			}
+			spvwalletConfig := &spvwallet.Config{
+				Mnemonic:     mn,
+				Params:       &params,
+				MaxFee:       uint64(walletCfg.MaxFee),
+				LowFee:       uint64(walletCfg.LowFeeDefault),
+				MediumFee:    uint64(walletCfg.MediumFeeDefault),
+				HighFee:      uint64(walletCfg.HighFeeDefault),
+				FeeAPI:       *feeApi,
+				RepoPath:     walletRepoPath,
+				CreationDate: creationDate,
+				DB:           walletDB,
+				UserAgent:    "OpenBazaar",
+				TrustedPeer:  tp,
+				Proxy:        torDialer,
+				Logger:       ml,
+			}
+			if coldStorage != nil {
+				spvwalletConfig.AccountPublicKey = coldStorage.AccountPublicKey
+				spvwalletConfig.MasterPublicKey = coldStorage.MasterPublicKey
+			}
+			w, err = spvwallet.NewSPVWallet(spvwalletConfig)
+			if err != nil {
+				return nil, nil, nil, "", err
+			}
+			rm = resync.NewResyncManager(sqliteDB.Sales(), w)
+		case "bitcoincash":
+			name = "bitcoin cash spv"
+			var tp net.Addr
+			if walletCfg.TrustedPeer != "" {
+				tp, err = net.ResolveTCPAddr("tcp", walletCfg.TrustedPeer)
+				if err != nil {
+					return nil, nil, nil, "", err
+				}
+			}
+			feeApi, err := url.Parse(walletCfg.FeeAPI)
+			if err != nil {
+				return nil, nil, nil, "", err
+			}
+			rates = cashrates.NewBitcoinCashPriceFetcher(torDialer)
+			spvwalletConfig := &bitcoincash.Config{
+				Mnemonic:             mn,
+				Params:               &params,
+				MaxFee:               uint64(walletCfg.MaxFee),
+				LowFee:               uint64(walletCfg.LowFeeDefault),
+				MediumFee:            uint64(walletCfg.MediumFeeDefault),
+				HighFee:              uint64(walletCfg.HighFeeDefault),
+				FeeAPI:               *feeApi,
+				RepoPath:             walletRepoPath,
+				CreationDate:         creationDate,
+				DB:                   walletDB,
+				UserAgent:            "OpenBazaar",
+				TrustedPeer:          tp,
+				Proxy:                torDialer,
+				Logger:               ml,
+				ExchangeRateProvider: rates,
+			}
+			w, err = bitcoincash.NewSPVWallet(spvwalletConfig)
+			if err != nil {
+				return nil, nil, nil, "", err
+			}
+			rm = resync.NewResyncManager(sqliteDB.Sales(), w)
+		case "bitcoind":
+			name = "bitcoind"
+			if walletCfg.Binary == "" {
+				return nil, nil, nil, "", errors.New("The path to the bitcoind binary must be specified in the config file when using bitcoind")
+			}
+			usetor := false
+			if usingTor && !usingClearnet {
+				usetor = true
+			}
+			w, err = bitcoind.NewBitcoindWallet(mn, &params, walletRepoPath, walletCfg.TrustedPeer, walletCfg.Binary, usetor, controlPort)
+			if err != nil {
+				return nil, nil, nil, "", err
+			}
+		case "zcashd":
+			name = "zcashd"
+			if walletCfg.Binary == "" {
+				return nil, nil, nil, "", errors.New("The path to the zcashd binary must be specified in the config file when using zcashd")
+			}
+			usetor := false
+			if usingTor && !usingClearnet {
+				usetor = true
+			}
+			w, err = zcashd.NewZcashdWallet(mn, &params, walletRepoPath, walletCfg.TrustedPeer, walletCfg.Binary, usetor, controlPort)
+			if err != nil {
+				return nil, nil, nil, "", err
+			}
+			if !x.DisableExchangeRates {
+				rates = zcashd.NewZcashPriceFetcher(torDialer)
+			}
+			rm = resync.NewResyncManager(sqliteDB.Sales(), w)
+		case "zend":
+			name = "zend"
+			if walletCfg.Binary == "" {
+				return nil, nil, nil, "", errors.New("The path to the zend binary must be specified in the config file when using zend")
+			}
+			usetor := false
+			if usingTor && !usingClearnet {
+				usetor = true
+			}
+			w, err = zend.NewZendWallet(mn, &params, walletRepoPath, walletCfg.TrustedPeer, walletCfg.Binary, usetor, controlPort)
+			if err != nil {
+				return nil, nil, nil, "", err
+			}
+			if !x.DisableExchangeRates {
+				rates = zend.NewZenCashPriceFetcher(torDialer)
+			}
+			rm = resync.NewResyncManager(sqliteDB.Sales(), w)
+		default:
+			return nil, nil, nil, "", errors.New("Unknown wallet type")
 		}
-		feeApi, err := url.Parse(walletCfg.FeeAPI)
-		if err != nil {
-			log.Error(err)
-			return err
-		}
-		spvwalletConfig := &spvwallet.Config{
-			Mnemonic:     mn,
-			Params:       &params,
-			MaxFee:       uint64(walletCfg.MaxFee),
-			LowFee:       uint64(walletCfg.LowFeeDefault),
-			MediumFee:    uint64(walletCfg.MediumFeeDefault),
-			HighFee:      uint64(walletCfg.HighFeeDefault),
-			FeeAPI:       *feeApi,
-			RepoPath:     repoPath,
-			CreationDate: creationDate,
-			DB:           sqliteDB,
-			UserAgent:    "OpenBazaar",
-			TrustedPeer:  tp,
-			Proxy:        torDialer,
-			Logger:       ml,
+		return w, rates, rm, name, nil
+	}
+
+	cryptoWallet, exchangeRates, resyncManager, walletTypeStr, err := newWallet(walletCfg, repoPath, sqliteDB, exchangeRates)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	wallets := core.MultiWallet{strings.ToUpper(cryptoWallet.CurrencyCode()): cryptoWallet}
+	walletRates := map[string]bitcoin.ExchangeRates{strings.ToUpper(cryptoWallet.CurrencyCode()): exchangeRates}
+	walletDatastores := map[string]wallet.Datastore{strings.ToUpper(cryptoWallet.CurrencyCode()): sqliteDB}
+	walletResyncManagers := []*resync.ResyncManager{resyncManager}
+	walletCodes := []string{strings.ToUpper(cryptoWallet.CurrencyCode())}
+	walletTypeStrs := []string{walletTypeStr}
+	for _, wCfg := range additionalWallets {
+		if x.Regtest && (strings.ToLower(wCfg.Type) == "spvwallet" || strings.ToLower(wCfg.Type) == "bitcoincash") && wCfg.TrustedPeer == "" {
+			return errors.New("Trusted peer must be set if using regtest with the spvwallet")
 		}
-		cryptoWallet, err = spvwallet.NewSPVWallet(spvwalletConfig)
+		walletRepoPath := path.Join(repoPath, "wallets", strings.ToLower(wCfg.Type))
+		walletDB, err := initializeWalletDatastore(walletRepoPath, x.Password, mn, isTestnet, creationDate)
 		if err != nil {
 			log.Error(err)
 			return err
 		}
-		resyncManager = resync.NewResyncManager(sqliteDB.Sales(), cryptoWallet)
-	case "bitcoincash":
-		walletTypeStr = "bitcoin cash spv"
-		var tp net.Addr
-		if walletCfg.TrustedPeer != "" {
-			tp, err = net.ResolveTCPAddr("tcp", walletCfg.TrustedPeer)
+		var rates bitcoin.ExchangeRates
+		if !x.DisableExchangeRates {
+			rates, err = newPriceFetcher()
 			if err != nil {
 				log.Error(err)
 				return err
 			}
 		}
-		feeApi, err := url.Parse(walletCfg.FeeAPI)
-		if err != nil {
-			log.Error(err)
-			return err
-		}
-		exchangeRates = cashrates.NewBitcoinCashPriceFetcher(torDialer)
-		spvwalletConfig := &bitcoincash.Config{
-			Mnemonic:             mn,
-			Params:               &params,
-			MaxFee:               uint64(walletCfg.MaxFee),
-			LowFee:               uint64(walletCfg.LowFeeDefault),
-			MediumFee:            uint64(walletCfg.MediumFeeDefault),
-			HighFee:              uint64(walletCfg.HighFeeDefault),
-			FeeAPI:               *feeApi,
-			RepoPath:             repoPath,
-			CreationDate:         creationDate,
-			DB:                   sqliteDB,
-			UserAgent:            "OpenBazaar",
-			TrustedPeer:          tp,
-			Proxy:                torDialer,
-			Logger:               ml,
-			ExchangeRateProvider: exchangeRates,
-		}
-		cryptoWallet, err = bitcoincash.NewSPVWallet(spvwalletConfig)
+		w, rates, rm, name, err := newWallet(wCfg, walletRepoPath, walletDB, rates)
 		if err != nil {
 			log.Error(err)
 			return err
 		}
-		resyncManager = resync.NewResyncManager(sqliteDB.Sales(), cryptoWallet)
-	case "bitcoind":
-		walletTypeStr = "bitcoind"
-		if walletCfg.Binary == "" {
-			return errors.New("The path to the bitcoind binary must be specified in the config file when using bitcoind")
-		}
-		usetor := false
-		if usingTor && !usingClearnet {
-			usetor = true
-		}
-		cryptoWallet, err = bitcoind.NewBitcoindWallet(mn, &params, repoPath, walletCfg.TrustedPeer, walletCfg.Binary, usetor, controlPort)
-		if err != nil {
-			return err
-		}
-	case "zcashd":
-		walletTypeStr = "zcashd"
-		if walletCfg.Binary == "" {
-			return errors.New("The path to the zcashd binary must be specified in the config file when using zcashd")
-		}
-		usetor := false
-		if usingTor && !usingClearnet {
-			usetor = true
+		code := strings.ToUpper(w.CurrencyCode())
+		if _, ok := wallets[code]; ok {
+			return fmt.Errorf("More than one wallet configured for %s", code)
 		}
-		cryptoWallet, err = zcashd.NewZcashdWallet(mn, &params, repoPath, walletCfg.TrustedPeer, walletCfg.Binary, usetor, controlPort)
-		if err != nil {
-			return err
-		}
-		if !x.DisableExchangeRates {
-			exchangeRates = zcashd.NewZcashPriceFetcher(torDialer)
-		}
-		resyncManager = resync.NewResyncManager(sqliteDB.Sales(), cryptoWallet)
-	case "zend":
-		walletTypeStr = "zend"
-		if walletCfg.Binary == "" {
-			return errors.New("The path to the zend binary must be specified in the config file when using zend")
-		}
-		usetor := false
-		if usingTor && !usingClearnet {
-			usetor = true
-		}
-		cryptoWallet, err = zend.NewZendWallet(mn, &params, repoPath, walletCfg.TrustedPeer, walletCfg.Binary, usetor, controlPort)
-		if err != nil {
-			return err
-		}
-		if !x.DisableExchangeRates {
-			exchangeRates = zend.NewZenCashPriceFetcher(torDialer)
-		}
-		resyncManager = resync.NewResyncManager(sqliteDB.Sales(), cryptoWallet)
-	default:
-		log.Fatal("Unknown wallet type")
+		wallets[code] = w
+		walletRates[code] = rates
+		walletDatastores[code] = walletDB
+		walletResyncManagers = append(walletResyncManagers, rm)
+		walletCodes = append(walletCodes, code)
+		walletTypeStrs = append(walletTypeStrs, name)
 	}
 
 	// Push nodes
@@ -776,21 +904,38 @@ func (x *Start) Execute(args []string) error {
 
 	// OpenBazaar node setup
 	core.Node = &core.OpenBazaarNode{
-		Context:             ctx,
-		IpfsNode:            nd,
-		RootHash:            ipath.Path(e.Value).String(),
-		RepoPath:            repoPath,
-		Datastore:           sqliteDB,
-		Wallet:              cryptoWallet,
-		NameSystem:          ns,
-		ExchangeRates:       exchangeRates,
-		PushNodes:           pushNodes,
-		AcceptStoreRequests: dataSharing.AcceptStoreRequests,
-		TorDialer:           torDialer,
-		UserAgent:           core.USERAGENT,
-		BanManager:          bm,
-		IPNSBackupAPI:       cfg.Ipns.BackUpAPI,
+		Context:               ctx,
+		IpfsNode:              nd,
+		RootHash:              ipath.Path(e.Value).String(),
+		RepoPath:              repoPath,
+		Datastore:             sqliteDB,
+		Wallet:                cryptoWallet,
+		Wallets:               wallets,
+		WalletExchangeRates:   walletRates,
+		WalletDatastores:      walletDatastores,
+		NameSystem:            ns,
+		ExchangeRates:         exchangeRates,
+		PushNodes:             pushNodes,
+		AcceptStoreRequests:   dataSharing.AcceptStoreRequests,
+		TorDialer:             torDialer,
+		UserAgent:             core.USERAGENT,
+		BanManager:            bm,
+		IPNSBackupAPI:         cfg.Ipns.BackUpAPI,
+		RatingAmendmentWindow: ratingAmendmentWindow,
+		EventLogMaxAge:        eventLogConfig.MaxAge,
+		EventLogMaxEvents:     eventLogConfig.MaxEvents,
+	}
+	if lightningConfig.Backend == "lnd" {
+		core.Node.Lightning, err = lightning.NewLndBackend(lightningConfig.Host, lightningConfig.TLSCert, lightningConfig.Macaroon)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		core.Node.LightningMaxOrderAmount = lightningConfig.MaxOrderAmount
+		core.Node.LightningInvoiceExpiry = lightningConfig.InvoiceExpiry
 	}
+	core.Node.ColdStorage = coldStorage
+	core.Node.EnforceCoinFreezes()
 	core.PublishLock.Lock()
 
 	// Offline messaging storage
@@ -842,25 +987,54 @@ func (x *Start) Execute(args []string) error {
 		PR := rep.NewPointerRepublisher(nd, sqliteDB, core.Node.PushNodes, core.Node.IsModerator)
 		go PR.Run()
 		core.Node.PointerRepublisher = PR
+		MD := core.NewModeratorDirectory(core.Node)
+		go MD.Run()
+		core.Node.ModeratorDirectory = MD
 		if !x.DisableWallet {
 			// If the wallet doesn't allow resyncing from a specific height to scan for unpaid orders, wait for all messages to process before continuing.
 			if resyncManager == nil {
 				MR.Wait()
 			}
-			TL := lis.NewTransactionListener(core.Node.Datastore, core.Node.Broadcast, core.Node.Wallet)
 			WL := lis.NewWalletListener(core.Node.Datastore, core.Node.Broadcast)
-			cryptoWallet.AddTransactionListener(TL.OnTransactionReceived)
-			cryptoWallet.AddTransactionListener(WL.OnTransactionReceived)
-			log.Infof("Starting %s wallet\n", walletTypeStr)
-			su := bitcoin.NewStatusUpdater(cryptoWallet, core.Node.Broadcast, nd.Context())
-			go su.Start()
-			go cryptoWallet.Start()
-			if resyncManager != nil {
-				go resyncManager.Start()
-				go func() {
+			for i, code := range walletCodes {
+				w := core.Node.Wallets[code]
+				TL := lis.NewTransactionListener(core.Node.Datastore, core.Node.Broadcast, w)
+				w.AddTransactionListener(TL.OnTransactionReceived)
+				w.AddTransactionListener(WL.OnTransactionReceived)
+				coin := code
+				w.AddTransactionListener(func(wallet.TransactionCallback) {
+					if err := core.Node.RecordExchangeRate(coin); err != nil {
+						log.Warningf("Failed to record %s exchange rate: %s", coin, err.Error())
+					}
+				})
+				if core.Node.Lightning != nil && (code == "BTC" || code == "TBTC") {
+					core.Node.Lightning.AddSettlementListener(TL.OnInvoiceSettled)
+				}
+				log.Infof("Starting %s wallet\n", walletTypeStrs[i])
+				su := bitcoin.NewStatusUpdater(w, core.Node.Broadcast, nd.Context())
+				go su.Start()
+				go w.Start()
+			}
+			if core.Node.Lightning != nil {
+				core.Node.Lightning.Start()
+			}
+			if len(autoSweepConfigs) > 0 {
+				sweeper := core.NewAutoSweeper(core.Node, autoSweepConfigs, nd.Context())
+				go sweeper.Start()
+			}
+			if !x.DisableExchangeRates {
+				recorder := core.NewRateRecorder(core.Node, nd.Context())
+				go recorder.Start()
+			}
+			for _, rm := range walletResyncManagers {
+				if rm == nil {
+					continue
+				}
+				go rm.Start()
+				go func(rm *resync.ResyncManager) {
 					MR.Wait()
-					resyncManager.CheckUnfunded()
-				}()
+					rm.CheckUnfunded()
+				}(rm)
 			}
 		}
 		core.PublishLock.Unlock()
@@ -995,6 +1169,28 @@ func constructDHTRouting(ctx context.Context, host p2phost.Host, dstore ipfsrepo
 	return dhtRouting, nil
 }
 
+// Open the database for an additional wallet, creating it on first use
+func initializeWalletDatastore(walletRepoPath, password, mnemonic string, testnet bool, creationDate time.Time) (*db.SQLiteDatastore, error) {
+	if err := os.MkdirAll(path.Join(walletRepoPath, "datastore"), os.ModePerm); err != nil {
+		return nil, err
+	}
+	dbName := "mainnet.db"
+	if testnet {
+		dbName = "testnet.db"
+	}
+	_, ferr := os.Stat(path.Join(walletRepoPath, "datastore", dbName))
+	walletDB, err := db.Create(walletRepoPath, password, testnet)
+	if err != nil {
+		return nil, err
+	}
+	if os.IsNotExist(ferr) {
+		if err := walletDB.Config().Init(mnemonic, nil, password, creationDate); err != nil {
+			return nil, err
+		}
+	}
+	return walletDB, nil
+}
+
 func InitializeRepo(dataDir, password, mnemonic string, testnet bool, creationDate time.Time) (*db.SQLiteDatastore, error) {
 	// Database
 	sqliteDB, err := db.Create(dataDir, password, testnet)
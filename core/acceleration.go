@@ -0,0 +1,132 @@
+package core
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+var (
+	ErrAccelerateModerated   = errors.New("Moderated payments cannot be accelerated by the vendor alone")
+	ErrAccelerateColdStorage = errors.New("Payments to cold storage escrow keys cannot be accelerated")
+	ErrNoStuckPayment        = errors.New("Order has no unconfirmed payment to accelerate")
+)
+
+// Unspent outputs paying the order which are not yet confirmed
+func stuckPayments(wal wallet.Wallet, records []*wallet.TransactionRecord) ([]wallet.Utxo, error) {
+	var utxos []wallet.Utxo
+	for _, r := range records {
+		if r.Spent || r.Value <= 0 {
+			continue
+		}
+		hash, err := chainhash.NewHashFromStr(r.Txid)
+		if err != nil {
+			return nil, err
+		}
+		confirmations, _, err := wal.GetConfirmations(*hash)
+		if err != nil || confirmations > 0 {
+			continue
+		}
+		script, err := hex.DecodeString(r.ScriptPubKey)
+		if err != nil {
+			return nil, err
+		}
+		utxos = append(utxos, wallet.Utxo{Op: *wire.NewOutPoint(hash, r.Index), Value: r.Value, ScriptPubkey: script})
+	}
+	return utxos, nil
+}
+
+/* CanAccelerateSalePayment returns whether AccelerateSalePayment can be used on the sale. Only
+   payments we can spend without the buyer or a moderator can be accelerated. */
+func (n *OpenBazaarNode) CanAccelerateSalePayment(contract *pb.RicardianContract, records []*wallet.TransactionRecord) bool {
+	if contract.BuyerOrder == nil || contract.BuyerOrder.Payment == nil {
+		return false
+	}
+	switch contract.BuyerOrder.Payment.Method {
+	case pb.Order_Payment_MODERATED:
+		return false
+	case pb.Order_Payment_DIRECT:
+		if n.vendorKeysOffline(contract) {
+			return false
+		}
+	}
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return false
+	}
+	utxos, err := stuckPayments(wal, records)
+	return err == nil && len(utxos) > 0
+}
+
+/* AccelerateSalePayment gets an unconfirmed payment for a sale mined using child-pays-for-parent.
+   It spends the unconfirmed outputs with a fee high enough to cover both transactions. Payments
+   to our wallet are bumped by the wallet. Offline direct payments are sent back to the order's
+   payment address so the order can still be confirmed or refunded afterwards. */
+func (n *OpenBazaarNode) AccelerateSalePayment(orderId string) (*chainhash.Hash, error) {
+	contract, _, _, records, _, err := n.Datastore.Sales().GetByOrderId(orderId)
+	if err != nil {
+		return nil, err
+	}
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return nil, err
+	}
+	payment := contract.BuyerOrder.Payment
+	if payment.Method == pb.Order_Payment_MODERATED {
+		return nil, ErrAccelerateModerated
+	}
+	utxos, err := stuckPayments(wal, records)
+	if err != nil {
+		return nil, err
+	}
+	if len(utxos) == 0 {
+		return nil, ErrNoStuckPayment
+	}
+
+	var txid *chainhash.Hash
+	if payment.Method == pb.Order_Payment_DIRECT {
+		if n.vendorKeysOffline(contract) {
+			return nil, ErrAccelerateColdStorage
+		}
+		redeemScript, err := hex.DecodeString(payment.RedeemScript)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := wal.DecodeAddress(payment.Address)
+		if err != nil {
+			return nil, err
+		}
+		hdKey, err := n.escrowSigningKey(wal, contract)
+		if err != nil {
+			return nil, err
+		}
+		vendorKey, err := hdKey.Child(0)
+		if err != nil {
+			return nil, err
+		}
+		txid, err = wal.SweepAddress(utxos, &addr, vendorKey, &redeemScript, wallet.FEE_BUMP)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		txid, err = wal.BumpFee(utxos[0].Op.Hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := n.Datastore.TxMetadata().Put(repo.Metadata{
+		Txid:    txid.String(),
+		Memo:    fmt.Sprintf("Fee bump of %s", utxos[0].Op.Hash.String()),
+		OrderId: orderId,
+	}); err != nil {
+		log.Errorf("Error saving metadata for fee bump %s: %s", txid.String(), err.Error())
+	}
+	return txid, nil
+}
@@ -0,0 +1,187 @@
+package core
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	btc "github.com/btcsuite/btcutil"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// A wallet which records the child-pays-for-parent spends made with it
+type accelerationWallet struct {
+	wallet.Wallet
+	mPrivKey     *hd.ExtendedKey
+	confirmed    map[chainhash.Hash]bool
+	swept        []wallet.Utxo
+	sweptTo      btc.Address
+	sweepKey     *hd.ExtendedKey
+	redeemScript []byte
+	feeLevel     wallet.FeeLevel
+	bumped       []chainhash.Hash
+}
+
+func (w *accelerationWallet) CurrencyCode() string {
+	return "TBTC"
+}
+
+func (w *accelerationWallet) Params() *chaincfg.Params {
+	return &chaincfg.TestNet3Params
+}
+
+func (w *accelerationWallet) MasterPrivateKey() *hd.ExtendedKey {
+	return w.mPrivKey
+}
+
+func (w *accelerationWallet) DecodeAddress(addr string) (btc.Address, error) {
+	return btc.DecodeAddress(addr, &chaincfg.TestNet3Params)
+}
+
+func (w *accelerationWallet) GetConfirmations(txid chainhash.Hash) (uint32, uint32, error) {
+	if w.confirmed[txid] {
+		return 6, 100, nil
+	}
+	return 0, 0, nil
+}
+
+func (w *accelerationWallet) SweepAddress(utxos []wallet.Utxo, address *btc.Address, key *hd.ExtendedKey, redeemScript *[]byte, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
+	w.swept = utxos
+	w.sweptTo = *address
+	w.sweepKey = key
+	w.redeemScript = *redeemScript
+	w.feeLevel = feeLevel
+	hash := chainhash.DoubleHashH([]byte("sweep"))
+	return &hash, nil
+}
+
+func (w *accelerationWallet) BumpFee(txid chainhash.Hash) (*chainhash.Hash, error) {
+	w.bumped = append(w.bumped, txid)
+	hash := chainhash.DoubleHashH([]byte("bump"))
+	return &hash, nil
+}
+
+func TestAccelerateSalePayment(t *testing.T) {
+	mPrivKey, err := hd.NewMaster([]byte("acceleration test seed for the vendor"), &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wal := &accelerationWallet{mPrivKey: mPrivKey, confirmed: make(map[chainhash.Hash]bool)}
+	n, cleanup := newTestNode(t, wal)
+	defer cleanup()
+
+	paymentAddr, err := btc.NewAddressScriptHashFromHash(make([]byte, 20), &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chaincode := bytes.Repeat([]byte{0x01}, 32)
+	redeemScript := []byte{0x52, 0xae}
+	stuck := chainhash.DoubleHashH([]byte("stuck"))
+	confirmed := chainhash.DoubleHashH([]byte("confirmed"))
+	wal.confirmed[confirmed] = true
+
+	sale := func(orderId string, method pb.Order_Payment_Method, records []*wallet.TransactionRecord) {
+		contract := pb.RicardianContract{
+			VendorListings: []*pb.Listing{{
+				VendorID: &pb.ID{PeerID: "QmVendor"},
+				Item:     &pb.Listing_Item{Title: "Shoes", Images: []*pb.Listing_Item_Image{{Tiny: "QmThumb"}}},
+			}},
+			BuyerOrder: &pb.Order{
+				BuyerID:   &pb.ID{PeerID: "QmBuyer"},
+				Timestamp: &timestamp.Timestamp{Seconds: 1515000000},
+				Payment: &pb.Order_Payment{
+					Method:       method,
+					Coin:         "TBTC",
+					Amount:       100000,
+					Address:      paymentAddr.EncodeAddress(),
+					Chaincode:    hex.EncodeToString(chaincode),
+					RedeemScript: hex.EncodeToString(redeemScript),
+				},
+			},
+			VendorOrderConfirmation: &pb.OrderConfirmation{PaymentAddress: paymentAddr.EncodeAddress()},
+		}
+		if err := n.Datastore.Sales().Put(orderId, contract, pb.OrderState_AWAITING_FULFILLMENT, false); err != nil {
+			t.Fatal(err)
+		}
+		if err := n.Datastore.Sales().UpdateFunding(orderId, true, records); err != nil {
+			t.Fatal(err)
+		}
+	}
+	script := hex.EncodeToString([]byte{0xa9, 0x14})
+	records := []*wallet.TransactionRecord{
+		{Txid: stuck.String(), Index: 1, Value: 90000, ScriptPubKey: script},
+		{Txid: confirmed.String(), Index: 0, Value: 10000, ScriptPubKey: script},
+		{Txid: stuck.String(), Index: 2, Value: 5000, ScriptPubKey: script, Spent: true},
+		{Txid: chainhash.DoubleHashH([]byte("spend")).String(), Index: 0, Value: -5000, ScriptPubKey: script},
+	}
+	sale("QmDirect", pb.Order_Payment_DIRECT, records)
+	sale("QmModerated", pb.Order_Payment_MODERATED, records)
+	sale("QmAddressRequest", pb.Order_Payment_ADDRESS_REQUEST, records)
+	sale("QmConfirmed", pb.Order_Payment_DIRECT, records[1:2])
+
+	// Only the unconfirmed and unspent payment is swept back to the escrow with the vendor key
+	txid, err := n.AccelerateSalePayment("QmDirect")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wal.swept) != 1 || wal.swept[0].Op.Hash != stuck || wal.swept[0].Op.Index != 1 || wal.swept[0].Value != 90000 {
+		t.Errorf("Swept the wrong outputs: %+v", wal.swept)
+	}
+	if wal.sweptTo.EncodeAddress() != paymentAddr.EncodeAddress() || wal.feeLevel != wallet.FEE_BUMP || !bytes.Equal(wal.redeemScript, redeemScript) {
+		t.Error("Payment was not swept back to its escrow with a fee bump")
+	}
+	mECKey, err := mPrivKey.ECPrivKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vendorKey, err := hd.NewExtendedKey(chaincfg.TestNet3Params.HDPrivateKeyID[:], mECKey.Serialize(), chaincode, []byte{0x00, 0x00, 0x00, 0x00}, 0, 0, true).Child(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wal.sweepKey.String() != vendorKey.String() {
+		t.Error("Swept with the wrong key")
+	}
+	metadata, err := n.Datastore.TxMetadata().Get(txid.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metadata.OrderId != "QmDirect" || metadata.Memo != "Fee bump of "+stuck.String() {
+		t.Errorf("Wrong metadata for the fee bump: %+v", metadata)
+	}
+
+	// A payment to our wallet is bumped by the wallet
+	if _, err := n.AccelerateSalePayment("QmAddressRequest"); err != nil {
+		t.Fatal(err)
+	}
+	if len(wal.bumped) != 1 || wal.bumped[0] != stuck {
+		t.Errorf("Wrong transaction bumped: %v", wal.bumped)
+	}
+
+	if _, err := n.AccelerateSalePayment("QmModerated"); err != ErrAccelerateModerated {
+		t.Error("Accelerated a moderated payment")
+	}
+	if _, err := n.AccelerateSalePayment("QmConfirmed"); err != ErrNoStuckPayment {
+		t.Error("Accelerated a confirmed payment")
+	}
+
+	for _, test := range []struct {
+		method   pb.Order_Payment_Method
+		records  []*wallet.TransactionRecord
+		expected bool
+	}{
+		{pb.Order_Payment_DIRECT, records, true},
+		{pb.Order_Payment_ADDRESS_REQUEST, records, true},
+		{pb.Order_Payment_MODERATED, records, false},
+		{pb.Order_Payment_DIRECT, records[1:], false},
+	} {
+		contract := &pb.RicardianContract{BuyerOrder: &pb.Order{Payment: &pb.Order_Payment{Method: test.method, Coin: "TBTC"}}}
+		if n.CanAccelerateSalePayment(contract, test.records) != test.expected {
+			t.Errorf("Expected CanAccelerateSalePayment %v for a %s payment", test.expected, test.method)
+		}
+	}
+}
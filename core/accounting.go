@@ -0,0 +1,369 @@
+package core
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// All of our coins divide into 10^8 base units
+const unitsPerCoin = 100000000
+
+const (
+	CategorySale         = "sale"
+	CategoryPurchase     = "purchase"
+	CategoryRefund       = "refund"
+	CategoryModeratorFee = "moderator fee"
+	CategorySweep        = "sweep"
+	CategoryDeposit      = "deposit"
+	CategoryWithdrawal   = "withdrawal"
+)
+
+var ErrUnknownExportFormat = errors.New("Unknown export format. Use csv or ofx.")
+
+// AccountingEntry is a wallet transaction with its fiat value at the time it was seen
+type AccountingEntry struct {
+	Txid         string    `json:"txid"`
+	Timestamp    time.Time `json:"timestamp"`
+	Coin         string    `json:"coin"`
+	Amount       int64     `json:"amount"`
+	Fee          int64     `json:"fee"`
+	Rate         float64   `json:"rate"`
+	FiatValue    float64   `json:"fiatValue"`
+	Currency     string    `json:"currency"`
+	Category     string    `json:"category"`
+	OrderId      string    `json:"orderId"`
+	Counterparty string    `json:"counterparty"`
+	Memo         string    `json:"memo"`
+	Address      string    `json:"address"`
+}
+
+// RecordExchangeRate saves the current rate of the coin in the user's local currency
+func (n *OpenBazaarNode) RecordExchangeRate(coin string) error {
+	rates := n.exchangeRatesForCurrency(coin)
+	if rates == nil {
+		return nil
+	}
+	currency := n.localCurrency()
+	rate, err := rates.GetExchangeRate(currency)
+	if err != nil {
+		return err
+	}
+	return n.Datastore.RateHistory().Put(repo.RateSnapshot{
+		Coin:      strings.ToUpper(coin),
+		Currency:  currency,
+		Rate:      rate,
+		Timestamp: time.Now(),
+	})
+}
+
+// LocalValue converts an amount of the coin to the user's local currency, returning the value and the currency code
+func (n *OpenBazaarNode) LocalValue(coin string, amount uint64) (float64, string, error) {
+	rates := n.exchangeRatesForCurrency(coin)
+	if rates == nil {
+		return 0, "", errors.New("No exchange rates for " + coin)
+	}
+	currency := n.localCurrency()
+	rate, err := rates.GetExchangeRate(currency)
+	if err != nil {
+		return 0, "", err
+	}
+	return float64(amount) / unitsPerCoin * rate, currency, nil
+}
+
+func (n *OpenBazaarNode) localCurrency() string {
+	settings, err := n.Datastore.Settings().Get()
+	if err != nil || settings.LocalCurrency == nil || *settings.LocalCurrency == "" {
+		return "USD"
+	}
+	return strings.ToUpper(*settings.LocalCurrency)
+}
+
+/* AccountingEntries returns the transactions of the coin's wallet between from and to, oldest
+   first. An empty currency uses the local currency from the settings. */
+func (n *OpenBazaarNode) AccountingEntries(coin, currency string, from, to time.Time) ([]AccountingEntry, error) {
+	wal, err := n.WalletForCurrency(coin)
+	if err != nil {
+		return nil, err
+	}
+	code := strings.ToUpper(wal.CurrencyCode())
+	walletDB, ok := n.WalletDatastores[code]
+	if !ok {
+		return nil, ErrUnsupportedCurrency
+	}
+	if currency == "" {
+		currency = n.localCurrency()
+	}
+	return AccountingEntries(n.Datastore, walletDB.Txns(), code, currency, from, to)
+}
+
+/* AccountingEntries builds the entries from the databases alone so exports can be made while
+   the node is offline. Transactions without a recorded exchange rate have no fiat value. */
+func AccountingEntries(db repo.Datastore, txns wallet.Txns, coin, currency string, from, to time.Time) ([]AccountingEntry, error) {
+	transactions, err := txns.GetAll(false)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := db.TxMetadata().GetAll()
+	if err != nil {
+		return nil, err
+	}
+	sweeps, err := db.Sweeps().GetAll(coin)
+	if err != nil {
+		return nil, err
+	}
+	swept := make(map[string]bool)
+	for _, s := range sweeps {
+		swept[s.Txid] = true
+	}
+	currency = strings.ToUpper(currency)
+
+	var entries []AccountingEntry
+	for _, t := range transactions {
+		if (!from.IsZero() && t.Timestamp.Before(from)) || (!to.IsZero() && t.Timestamp.After(to)) {
+			continue
+		}
+		entry := AccountingEntry{
+			Txid:      t.Txid,
+			Timestamp: t.Timestamp,
+			Coin:      coin,
+			Amount:    t.Value,
+			Currency:  currency,
+		}
+		m, ok := metadata[t.Txid]
+		if ok {
+			entry.OrderId = m.OrderId
+			entry.Memo = m.Memo
+			entry.Address = m.Address
+		}
+		entry.Category, entry.Counterparty = categorize(db, entry.OrderId, t.Value, swept[t.Txid])
+		if t.Value < 0 {
+			entry.Fee = transactionFee(txns, t.Txid)
+		}
+		snapshot, err := db.RateHistory().Get(coin, currency, t.Timestamp, rateMaxDistance)
+		if err == nil {
+			entry.Rate = snapshot.Rate
+			entry.FiatValue = float64(t.Value) / unitsPerCoin * snapshot.Rate
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	return entries, nil
+}
+
+// Work out what a transaction was for from the order it is linked to
+func categorize(db repo.Datastore, orderId string, value int64, swept bool) (string, string) {
+	if swept {
+		return CategorySweep, ""
+	}
+	if orderId != "" {
+		if contract, _, _, _, _, err := db.Sales().GetByOrderId(orderId); err == nil {
+			counterparty := handleOrPeerID(contract.BuyerOrder.BuyerID)
+			if value < 0 {
+				return CategoryRefund, counterparty
+			}
+			return CategorySale, counterparty
+		}
+		if contract, _, _, _, _, err := db.Purchases().GetByOrderId(orderId); err == nil {
+			var counterparty string
+			if len(contract.VendorListings) > 0 {
+				counterparty = handleOrPeerID(contract.VendorListings[0].VendorID)
+			}
+			if value > 0 {
+				return CategoryRefund, counterparty
+			}
+			return CategoryPurchase, counterparty
+		}
+		if _, _, _, _, _, _, _, _, _, _, err := db.Cases().GetCaseMetadata(orderId); err == nil && value > 0 {
+			return CategoryModeratorFee, ""
+		}
+	}
+	if value < 0 {
+		return CategoryWithdrawal, ""
+	}
+	return CategoryDeposit, ""
+}
+
+func handleOrPeerID(id *pb.ID) string {
+	if id == nil {
+		return ""
+	}
+	if id.Handle != "" {
+		return id.Handle
+	}
+	return id.PeerID
+}
+
+// The fee is only known when we have every transaction the inputs spend
+func transactionFee(txns wallet.Txns, txid string) int64 {
+	hash, err := chainhash.NewHashFromStr(txid)
+	if err != nil {
+		return 0
+	}
+	tx, _, err := txns.Get(*hash)
+	if err != nil {
+		return 0
+	}
+	var in, out int64
+	for _, input := range tx.TxIn {
+		prev, _, err := txns.Get(input.PreviousOutPoint.Hash)
+		if err != nil || int(input.PreviousOutPoint.Index) >= len(prev.TxOut) {
+			return 0
+		}
+		in += prev.TxOut[input.PreviousOutPoint.Index].Value
+	}
+	for _, output := range tx.TxOut {
+		out += output.Value
+	}
+	if in < out {
+		return 0
+	}
+	return in - out
+}
+
+/* ParseAccountingPeriod parses the from and to dates of an export. Dates are either
+   2006-01-02 or RFC3339. A to date without a time includes the whole day. */
+func ParseAccountingPeriod(from, to string) (time.Time, time.Time, error) {
+	var start, end time.Time
+	var err error
+	if from != "" {
+		if start, err = time.Parse("2006-01-02", from); err != nil {
+			if start, err = time.Parse(time.RFC3339, from); err != nil {
+				return start, end, fmt.Errorf("Invalid from date: %s", from)
+			}
+		}
+	}
+	if to != "" {
+		if end, err = time.Parse("2006-01-02", to); err == nil {
+			end = end.Add(time.Hour*24 - time.Second)
+		} else if end, err = time.Parse(time.RFC3339, to); err != nil {
+			return start, end, fmt.Errorf("Invalid to date: %s", to)
+		}
+	}
+	return start, end, nil
+}
+
+// WriteAccountingExport writes the entries in the named format
+func WriteAccountingExport(w io.Writer, format string, entries []AccountingEntry) error {
+	switch strings.ToLower(format) {
+	case "", "csv":
+		return WriteAccountingCSV(w, entries)
+	case "ofx":
+		return WriteAccountingOFX(w, entries)
+	default:
+		return ErrUnknownExportFormat
+	}
+}
+
+func formatCoinAmount(amount int64) string {
+	return strconv.FormatFloat(float64(amount)/unitsPerCoin, 'f', 8, 64)
+}
+
+// WriteAccountingCSV writes one row per entry. The rate and fiat value are blank when no rate was recorded.
+func WriteAccountingCSV(w io.Writer, entries []AccountingEntry) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"Date", "Txid", "Category", "Amount", "Fee", "Coin", "Rate", "Fiat Value", "Currency", "Order ID", "Counterparty", "Address", "Memo"})
+	for _, e := range entries {
+		var rate, fiat string
+		if e.Rate != 0 {
+			rate = strconv.FormatFloat(e.Rate, 'f', 2, 64)
+			fiat = strconv.FormatFloat(e.FiatValue, 'f', 2, 64)
+		}
+		cw.Write([]string{
+			e.Timestamp.UTC().Format(time.RFC3339),
+			e.Txid,
+			e.Category,
+			formatCoinAmount(e.Amount),
+			formatCoinAmount(e.Fee),
+			e.Coin,
+			rate,
+			fiat,
+			e.Currency,
+			e.OrderId,
+			e.Counterparty,
+			e.Address,
+			e.Memo,
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func ofxEscape(s string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+/* WriteAccountingOFX writes the entries as an OFX 2 bank statement in the fiat currency, which
+   QuickBooks and most accounting software can import. The coin amount is kept in the memo. */
+func WriteAccountingOFX(w io.Writer, entries []AccountingEntry) error {
+	const dateFormat = "20060102150405"
+	currency := "USD"
+	var start, end time.Time
+	if len(entries) > 0 {
+		currency = entries[0].Currency
+		start, end = entries[0].Timestamp, entries[len(entries)-1].Timestamp
+	}
+	var balance float64
+	for _, e := range entries {
+		balance += e.FiatValue
+	}
+	now := time.Now().UTC().Format(dateFormat)
+
+	fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+<SIGNONMSGSRSV1><SONRS><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>`)
+	fmt.Fprintf(w, "<DTSERVER>%s</DTSERVER><LANGUAGE>ENG</LANGUAGE></SONRS></SIGNONMSGSRSV1>\n", now)
+	fmt.Fprint(w, "<BANKMSGSRSV1><STMTTRNRS><TRNUID>0</TRNUID><STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>\n")
+	fmt.Fprintf(w, "<STMTRS><CURDEF>%s</CURDEF>\n", ofxEscape(currency))
+	fmt.Fprint(w, "<BANKACCTFROM><BANKID>OpenBazaar</BANKID><ACCTID>wallet</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>\n")
+	fmt.Fprintf(w, "<BANKTRANLIST><DTSTART>%s</DTSTART><DTEND>%s</DTEND>\n", start.UTC().Format(dateFormat), end.UTC().Format(dateFormat))
+	for _, e := range entries {
+		trnType := "CREDIT"
+		if e.Amount < 0 {
+			trnType = "DEBIT"
+		}
+		name := e.Counterparty
+		if name == "" {
+			name = e.Category
+		}
+		memo := fmt.Sprintf("%s %s %s", e.Category, formatCoinAmount(e.Amount), e.Coin)
+		if e.OrderId != "" {
+			memo += " order " + e.OrderId
+		}
+		if e.Fee > 0 {
+			memo += " fee " + formatCoinAmount(e.Fee)
+		}
+		fmt.Fprint(w, "<STMTTRN>")
+		fmt.Fprintf(w, "<TRNTYPE>%s</TRNTYPE><DTPOSTED>%s</DTPOSTED><TRNAMT>%.2f</TRNAMT>", trnType, e.Timestamp.UTC().Format(dateFormat), e.FiatValue)
+		fmt.Fprintf(w, "<FITID>%s</FITID><NAME>%s</NAME><MEMO>%s</MEMO>", ofxEscape(e.Txid), ofxEscape(truncate(name, 32)), ofxEscape(truncate(memo, 255)))
+		fmt.Fprint(w, "</STMTTRN>\n")
+	}
+	fmt.Fprint(w, "</BANKTRANLIST>\n")
+	fmt.Fprintf(w, "<LEDGERBAL><BALAMT>%.2f</BALAMT><DTASOF>%s</DTASOF></LEDGERBAL>\n", balance, now)
+	_, err := fmt.Fprint(w, "</STMTRS></STMTTRNRS></BANKMSGSRSV1>\n</OFX>\n")
+	return err
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,93 @@
+package core_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+)
+
+func TestParseAccountingPeriod(t *testing.T) {
+	from, to, err := core.ParseAccountingPeriod("2018-01-01", "2018-01-31")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !from.Equal(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Parsed the wrong from date")
+	}
+	if !to.Equal(time.Date(2018, 1, 31, 23, 59, 59, 0, time.UTC)) {
+		t.Error("To date does not include the whole day")
+	}
+	if _, _, err := core.ParseAccountingPeriod("", "2018-01-31T12:00:00Z"); err != nil {
+		t.Error(err)
+	}
+	if _, _, err := core.ParseAccountingPeriod("yesterday", ""); err == nil {
+		t.Error("Parsed an invalid date")
+	}
+}
+
+var accountingEntries = []core.AccountingEntry{
+	{
+		Txid:         "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f",
+		Timestamp:    time.Date(2018, 3, 1, 12, 0, 0, 0, time.UTC),
+		Coin:         "BTC",
+		Amount:       50000000,
+		Rate:         10000,
+		FiatValue:    5000,
+		Currency:     "USD",
+		Category:     core.CategorySale,
+		OrderId:      "QmOrder",
+		Counterparty: "@buyer",
+	},
+	{
+		Txid:      "a5a3f3e8d0ad7a2c8e1f4c5a3c9f0d6e2b1a4c7d8e9f0a1b2c3d4e5f6a7b8c9d",
+		Timestamp: time.Date(2018, 3, 2, 12, 0, 0, 0, time.UTC),
+		Coin:      "BTC",
+		Amount:    -10000000,
+		Fee:       2000,
+		Currency:  "USD",
+		Category:  core.CategoryWithdrawal,
+		Memo:      "Rent & bills",
+	},
+}
+
+func TestWriteAccountingCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := core.WriteAccountingExport(&buf, "csv", accountingEntries); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected a header and 2 rows, got %d lines", len(lines))
+	}
+	if lines[1] != "2018-03-01T12:00:00Z,16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f,sale,0.50000000,0.00000000,BTC,10000.00,5000.00,USD,QmOrder,@buyer,," {
+		t.Errorf("Wrote the wrong sale row: %s", lines[1])
+	}
+	if !strings.Contains(lines[2], "withdrawal,-0.10000000,0.00002000,BTC,,,USD") {
+		t.Errorf("Withdrawal without a rate should have no fiat value: %s", lines[2])
+	}
+}
+
+func TestWriteAccountingOFX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := core.WriteAccountingExport(&buf, "ofx", accountingEntries); err != nil {
+		t.Fatal(err)
+	}
+	ofx := buf.String()
+	for _, s := range []string{
+		"<CURDEF>USD</CURDEF>",
+		"<TRNTYPE>CREDIT</TRNTYPE><DTPOSTED>20180301120000</DTPOSTED><TRNAMT>5000.00</TRNAMT>",
+		"<NAME>@buyer</NAME>",
+		"<TRNTYPE>DEBIT</TRNTYPE>",
+		"<BALAMT>5000.00</BALAMT>",
+	} {
+		if !strings.Contains(ofx, s) {
+			t.Errorf("OFX is missing %s", s)
+		}
+	}
+	if err := core.WriteAccountingExport(&buf, "xls", accountingEntries); err != core.ErrUnknownExportFormat {
+		t.Error("Wrote an unknown format")
+	}
+}
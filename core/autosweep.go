@@ -0,0 +1,207 @@
+package core
+
+import (
+	"golang.org/x/net/context"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/wallet-interface"
+	btc "github.com/btcsuite/btcutil"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+)
+
+// How often the auto-sweep policies are evaluated
+const autoSweepCheckInterval = time.Minute * 10
+
+/* AutoSweeper is a background service which moves sales proceeds out of the wallets
+   according to the AutoSweep policies in the config. */
+type AutoSweeper struct {
+	node     *OpenBazaarNode
+	policies []*repo.AutoSweepConfig
+	ctx      context.Context
+}
+
+func NewAutoSweeper(node *OpenBazaarNode, policies []*repo.AutoSweepConfig, ctx context.Context) *AutoSweeper {
+	return &AutoSweeper{node, policies, ctx}
+}
+
+func (s *AutoSweeper) Start() {
+	t := time.NewTicker(autoSweepCheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			// A watch only wallet can't sign the sweeps
+			if s.node.ColdStorage != nil {
+				continue
+			}
+			for _, policy := range s.policies {
+				if _, err := s.node.sweep(policy); err != nil {
+					log.Errorf("Error sweeping %s balance: %s", policy.Coin, err.Error())
+				}
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Parse a fee level as used in the config and API. Unknown levels are NORMAL.
+func parseFeeLevel(s string) wallet.FeeLevel {
+	switch strings.ToUpper(s) {
+	case "PRIORITY":
+		return wallet.PRIOIRTY
+	case "ECONOMIC":
+		return wallet.ECONOMIC
+	default:
+		return wallet.NORMAL
+	}
+}
+
+/* sweepAddress returns the address a sweep is sent to. An xpub destination is treated as an
+   account key and each sweep uses the next address on its external chain. The wallet encodes
+   the key's pay to pubkey hash script so the address is in its coin's format. */
+func sweepAddress(wal wallet.Wallet, destination string, index int) (btc.Address, error) {
+	key, err := hd.NewKeyFromString(destination)
+	if err != nil {
+		return wal.DecodeAddress(destination)
+	}
+	external, err := key.Child(0)
+	if err != nil {
+		return nil, err
+	}
+	child, err := external.Child(uint32(index))
+	if err != nil {
+		return nil, err
+	}
+	script, err := bitcoin.P2PKHScript(child)
+	if err != nil {
+		return nil, err
+	}
+	return wal.ScriptToAddress(script)
+}
+
+/* sweepAmount returns how much of the confirmed balance is swept once the pending refunds and
+   the reserve are kept back. It is zero if that is below the threshold. */
+func sweepAmount(confirmed, owed int64, policy *repo.AutoSweepConfig) int64 {
+	amount := confirmed - owed - policy.Reserve
+	if amount <= 0 || amount < policy.Threshold {
+		return 0
+	}
+	return amount
+}
+
+/* pendingRefunds returns how much the wallet may still have to refund. Direct payments are
+   refunded from the wallet until the order is fulfilled, while moderated payments are
+   refunded from escrow. */
+func (n *OpenBazaarNode) pendingRefunds(wal wallet.Wallet) (int64, error) {
+	states := []pb.OrderState{pb.OrderState_AWAITING_FULFILLMENT, pb.OrderState_PARTIALLY_FULFILLED}
+	sales, _, err := n.Datastore.Sales().GetAll(states, "", false, false, -1, []string{})
+	if err != nil {
+		return 0, err
+	}
+	var owed int64
+	for _, sale := range sales {
+		if sale.Moderated {
+			continue
+		}
+		contract, _, _, records, _, err := n.Datastore.Sales().GetByOrderId(sale.OrderId)
+		if err != nil {
+			return 0, err
+		}
+		if w, err := n.WalletForOrder(contract.BuyerOrder); err != nil || w != wal {
+			continue
+		}
+		for _, r := range records {
+			if r.Value > 0 {
+				owed += r.Value
+			}
+		}
+	}
+	return owed, nil
+}
+
+/* sweep sends the confirmed balance of the policy's wallet above its reserve and the coins
+   owed in pending refunds to the policy's destination. Nothing is sent if the amount is
+   below the threshold or the last sweep was less than the minimum interval ago. */
+func (n *OpenBazaarNode) sweep(policy *repo.AutoSweepConfig) (*repo.Sweep, error) {
+	wal, utxos, err := n.spendableUtxos(policy.Coin)
+	if err != nil {
+		return nil, err
+	}
+	coin := strings.ToUpper(wal.CurrencyCode())
+	previous, err := n.Datastore.Sweeps().GetAll(coin)
+	if err != nil {
+		return nil, err
+	}
+	if len(previous) > 0 && time.Since(previous[0].Timestamp) < policy.MinInterval {
+		return nil, nil
+	}
+
+	var confirmed int64
+	for _, u := range utxos {
+		if u.AtHeight > 0 {
+			confirmed += u.Value
+		}
+	}
+	owed, err := n.pendingRefunds(wal)
+	if err != nil {
+		return nil, err
+	}
+	amount := sweepAmount(confirmed, owed, policy)
+	if amount == 0 {
+		return nil, nil
+	}
+
+	var index int
+	for _, s := range previous {
+		if s.Destination == policy.Destination {
+			index++
+		}
+	}
+	addr, err := sweepAddress(wal, policy.Destination, index)
+	if err != nil {
+		return nil, err
+	}
+	feeLevel := parseFeeLevel(policy.FeeLevel)
+	fee, err := wal.EstimateSpendFee(amount, feeLevel)
+	if err != nil {
+		return nil, err
+	}
+	amount -= int64(fee)
+	txid, err := n.SpendCoins(coin, amount, addr, feeLevel, CoinControl{})
+	if err != nil {
+		return nil, err
+	}
+
+	sweep := repo.Sweep{
+		Txid:        txid.String(),
+		Coin:        coin,
+		Destination: policy.Destination,
+		Address:     addr.EncodeAddress(),
+		Amount:      amount,
+		Timestamp:   time.Now(),
+	}
+	if err := n.Datastore.Sweeps().Put(sweep); err != nil {
+		return nil, err
+	}
+	if err := n.Datastore.TxMetadata().Put(repo.Metadata{Txid: sweep.Txid, Address: sweep.Address, Memo: "Automatic sweep"}); err != nil {
+		log.Errorf("Error saving memo for sweep %s: %s", sweep.Txid, err.Error())
+	}
+	notif := notifications.SweepNotification{
+		ID:      notifications.NewID(),
+		Type:    "sweep",
+		Coin:    coin,
+		Txid:    sweep.Txid,
+		Address: sweep.Address,
+		Amount:  amount,
+	}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().Put(notif.ID, notif, notif.Type, time.Now())
+	log.Infof("Swept %d %s to %s in %s", amount, coin, sweep.Address, sweep.Txid)
+	return &sweep, nil
+}
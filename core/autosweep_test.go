@@ -0,0 +1,308 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	btc "github.com/btcsuite/btcutil"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/cpacia/bchutil"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// A wallet which records its spends. Only the methods a sweep uses are implemented.
+type sweepWallet struct {
+	wallet.Wallet
+	code   string
+	fee    uint64
+	spends []int64
+	to     []btc.Address
+}
+
+func (w *sweepWallet) CurrencyCode() string {
+	return w.code
+}
+
+func (w *sweepWallet) DecodeAddress(addr string) (btc.Address, error) {
+	return btc.DecodeAddress(addr, &chaincfg.TestNet3Params)
+}
+
+func (w *sweepWallet) ScriptToAddress(script []byte) (btc.Address, error) {
+	if w.code == "TBCH" {
+		return bchutil.ExtractPkScriptAddrs(script, &chaincfg.TestNet3Params)
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(script, &chaincfg.TestNet3Params)
+	if err != nil {
+		return nil, err
+	}
+	return addrs[0], nil
+}
+
+func (w *sweepWallet) EstimateSpendFee(amount int64, feeLevel wallet.FeeLevel) (uint64, error) {
+	return w.fee, nil
+}
+
+func (w *sweepWallet) Spend(amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
+	w.spends = append(w.spends, amount)
+	w.to = append(w.to, addr)
+	return chainhash.NewHash(chainhash.DoubleHashB([]byte(addr.EncodeAddress())))
+}
+
+// A node with a fresh datastore and the wallet as its only wallet
+func newTestNode(t *testing.T, wal wallet.Wallet) (*OpenBazaarNode, func()) {
+	dir, err := ioutil.TempDir("", "core")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(path.Join(dir, "datastore"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	datastore, err := db.Create(dir, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := datastore.InitTables(""); err != nil {
+		t.Fatal(err)
+	}
+	n := &OpenBazaarNode{
+		Datastore: datastore,
+		Wallet:    wal,
+		Broadcast: make(chan interface{}, 10),
+	}
+	return n, func() { os.RemoveAll(dir) }
+}
+
+func sweepAccountKey(t *testing.T) *hd.ExtendedKey {
+	mPrivKey, err := hd.NewMaster([]byte("auto sweep test seed for destinations"), &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := mPrivKey.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return account
+}
+
+func TestSweepAmount(t *testing.T) {
+	tests := []struct {
+		confirmed int64
+		owed      int64
+		reserve   int64
+		threshold int64
+		expected  int64
+	}{
+		{100000, 0, 0, 0, 100000},
+		{100000, 20000, 0, 0, 80000},
+		{100000, 20000, 30000, 0, 50000},
+		{100000, 20000, 30000, 50000, 50000},
+		{100000, 20000, 30000, 50001, 0},
+		{100000, 60000, 50000, 0, 0},
+		{0, 0, 0, 0, 0},
+	}
+	for i, test := range tests {
+		policy := &repo.AutoSweepConfig{Reserve: test.reserve, Threshold: test.threshold}
+		if amount := sweepAmount(test.confirmed, test.owed, policy); amount != test.expected {
+			t.Errorf("Test %d: expected to sweep %d, got %d", i, test.expected, amount)
+		}
+	}
+}
+
+func TestSweepAddress(t *testing.T) {
+	account := sweepAccountKey(t)
+	external, err := account.Child(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, code := range []string{"TBTC", "TBCH"} {
+		wal := &sweepWallet{code: code}
+		for i := 0; i < 2; i++ {
+			child, err := external.Child(uint32(i))
+			if err != nil {
+				t.Fatal(err)
+			}
+			pub, err := child.ECPubKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var expected btc.Address
+			if code == "TBCH" {
+				expected, err = bchutil.NewCashAddressPubKeyHash(btc.Hash160(pub.SerializeCompressed()), &chaincfg.TestNet3Params)
+			} else {
+				expected, err = btc.NewAddressPubKeyHash(btc.Hash160(pub.SerializeCompressed()), &chaincfg.TestNet3Params)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			addr, err := sweepAddress(wal, account.String(), i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if addr.EncodeAddress() != expected.EncodeAddress() {
+				t.Errorf("%s sweep %d: expected address %s, got %s", code, i, expected.EncodeAddress(), addr.EncodeAddress())
+			}
+		}
+	}
+
+	// Any other destination is a plain address
+	destination, err := btc.NewAddressPubKeyHash(make([]byte, 20), &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := sweepAddress(&sweepWallet{code: "TBTC"}, destination.EncodeAddress(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr.EncodeAddress() != destination.EncodeAddress() {
+		t.Errorf("Expected the destination address, got %s", addr.EncodeAddress())
+	}
+}
+
+func TestPendingRefunds(t *testing.T) {
+	wal := &sweepWallet{code: "TBTC"}
+	n, cleanup := newTestNode(t, wal)
+	defer cleanup()
+
+	sales := []struct {
+		orderId string
+		method  pb.Order_Payment_Method
+		coin    string
+		state   pb.OrderState
+		records []int64
+	}{
+		{"QmDirect", pb.Order_Payment_DIRECT, "TBTC", pb.OrderState_AWAITING_FULFILLMENT, []int64{30000, 20000}},
+		{"QmPartial", pb.Order_Payment_DIRECT, "", pb.OrderState_PARTIALLY_FULFILLED, []int64{15000, -5000}},
+		{"QmModerated", pb.Order_Payment_MODERATED, "TBTC", pb.OrderState_AWAITING_FULFILLMENT, []int64{70000}},
+		{"QmFulfilled", pb.Order_Payment_DIRECT, "TBTC", pb.OrderState_FULFILLED, []int64{40000}},
+		{"QmOtherCoin", pb.Order_Payment_DIRECT, "TBCH", pb.OrderState_AWAITING_FULFILLMENT, []int64{80000}},
+	}
+	for _, s := range sales {
+		contract := pb.RicardianContract{
+			VendorListings: []*pb.Listing{{Item: &pb.Listing_Item{Title: "Shoes", Images: []*pb.Listing_Item_Image{{Tiny: "QmThumb"}}}}},
+			BuyerOrder: &pb.Order{
+				BuyerID:   &pb.ID{PeerID: "QmBuyer"},
+				Timestamp: &timestamp.Timestamp{Seconds: 1515000000},
+				Payment:   &pb.Order_Payment{Method: s.method, Coin: s.coin},
+			},
+		}
+		if err := n.Datastore.Sales().Put(s.orderId, contract, s.state, false); err != nil {
+			t.Fatal(err)
+		}
+		var records []*wallet.TransactionRecord
+		for _, v := range s.records {
+			records = append(records, &wallet.TransactionRecord{Txid: s.orderId, Value: v})
+		}
+		if err := n.Datastore.Sales().UpdateFunding(s.orderId, true, records); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	owed, err := n.pendingRefunds(wal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owed != 65000 {
+		t.Errorf("Expected 65000 owed in refunds, got %d", owed)
+	}
+}
+
+func TestSweep(t *testing.T) {
+	wal := &sweepWallet{code: "TBTC", fee: 1000}
+	n, cleanup := newTestNode(t, wal)
+	defer cleanup()
+
+	for i, u := range []struct {
+		height int32
+		value  int64
+	}{
+		{100, 60000},
+		{101, 40000},
+		{0, 90000},
+	} {
+		hash := chainhash.DoubleHashH([]byte{byte(i)})
+		if err := n.Datastore.(wallet.Datastore).Utxos().Put(wallet.Utxo{Op: *wire.NewOutPoint(&hash, 0), AtHeight: u.height, Value: u.value, ScriptPubkey: []byte{0x00}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	account := sweepAccountKey(t)
+	policy := &repo.AutoSweepConfig{
+		Coin:        "TBTC",
+		Destination: account.String(),
+		Threshold:   50000,
+		Reserve:     20000,
+		MinInterval: time.Hour,
+	}
+
+	// Only the confirmed balance above the reserve is swept, less the fee
+	sweep, err := n.sweep(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sweep == nil || sweep.Amount != 79000 || len(wal.spends) != 1 || wal.spends[0] != 79000 {
+		t.Fatalf("Expected a sweep of 79000, got %+v", sweep)
+	}
+	first, err := sweepAddress(wal, account.String(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sweep.Address != first.EncodeAddress() || wal.to[0].EncodeAddress() != first.EncodeAddress() {
+		t.Errorf("Expected the sweep to the first address %s, got %s", first.EncodeAddress(), sweep.Address)
+	}
+	saved, err := n.Datastore.Sweeps().GetAll("TBTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved) != 1 || saved[0].Txid != sweep.Txid {
+		t.Error("Sweep was not saved")
+	}
+	select {
+	case <-n.Broadcast:
+	default:
+		t.Error("No notification for the sweep")
+	}
+
+	// The next sweep waits for the minimum interval
+	sweep, err = n.sweep(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sweep != nil || len(wal.spends) != 1 {
+		t.Error("Swept again before the minimum interval")
+	}
+
+	// Below the threshold nothing is sent
+	policy.MinInterval = 0
+	policy.Threshold = 80001
+	sweep, err = n.sweep(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sweep != nil || len(wal.spends) != 1 {
+		t.Error("Swept an amount below the threshold")
+	}
+
+	// The next sweep to an xpub uses its next address
+	policy.Threshold = 0
+	sweep, err = n.sweep(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := sweepAddress(wal, account.String(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sweep == nil || sweep.Address != second.EncodeAddress() {
+		t.Errorf("Expected the second sweep to %s", second.EncodeAddress())
+	}
+}
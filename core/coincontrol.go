@@ -0,0 +1,265 @@
+package core
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	btc "github.com/btcsuite/btcutil"
+)
+
+var ErrUtxoNotFound = errors.New("Outpoint is not an unspent output of this wallet")
+
+// Coins to spend from or to leave untouched when making a spend. Outpoints are in txid:index form.
+type CoinControl struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// An unspent output of one of our wallets along with its label and the order it came from
+type UtxoInfo struct {
+	Outpoint      string `json:"outpoint"`
+	Value         int64  `json:"value"`
+	Address       string `json:"address"`
+	Height        int32  `json:"height"`
+	Confirmations int32  `json:"confirmations"`
+	WatchOnly     bool   `json:"watchOnly"`
+	Label         string `json:"label"`
+	Frozen        bool   `json:"frozen"`
+	OrderId       string `json:"orderId"`
+}
+
+// Returns the txid:index form of the outpoint used to key utxo metadata
+func formatOutpoint(op wire.OutPoint) string {
+	return op.Hash.String() + ":" + strconv.Itoa(int(op.Index))
+}
+
+// Parse an outpoint in txid:index form
+func parseOutpoint(s string) (wire.OutPoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return wire.OutPoint{}, errors.New("Outpoint must be in txid:index form")
+	}
+	hash, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return wire.OutPoint{}, err
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return wire.OutPoint{}, err
+	}
+	return *wire.NewOutPoint(hash, uint32(index)), nil
+}
+
+// Returns the datastore holding the wallet's keys and transactions
+func (n *OpenBazaarNode) walletDatastore(wal wallet.Wallet) (wallet.Datastore, error) {
+	ds, ok := n.WalletDatastores[strings.ToUpper(wal.CurrencyCode())]
+	if !ok {
+		ds, ok = n.Datastore.(wallet.Datastore)
+		if !ok || wal != n.Wallet {
+			return nil, errors.New("Wallet datastore not found")
+		}
+	}
+	return ds, nil
+}
+
+// Returns the unspent outputs of the wallet for the coin
+func (n *OpenBazaarNode) walletUtxos(coin string) (wallet.Wallet, []wallet.Utxo, error) {
+	wal, err := n.WalletForCurrency(coin)
+	if err != nil {
+		return nil, nil, err
+	}
+	ds, err := n.walletDatastore(wal)
+	if err != nil {
+		return nil, nil, err
+	}
+	utxos, err := ds.Utxos().GetAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	return wal, utxos, nil
+}
+
+// Returns the wallet for the coin and its unspent outputs which are neither frozen nor watch only
+func (n *OpenBazaarNode) spendableUtxos(coin string) (wallet.Wallet, []wallet.Utxo, error) {
+	wal, utxos, err := n.walletUtxos(coin)
+	if err != nil {
+		return nil, nil, err
+	}
+	metadata, err := n.Datastore.UtxoMetadata().GetAll(strings.ToUpper(wal.CurrencyCode()))
+	if err != nil {
+		return nil, nil, err
+	}
+	var spendable []wallet.Utxo
+	for _, u := range utxos {
+		if m, ok := metadata[formatOutpoint(u.Op)]; (ok && m.Frozen) || u.WatchOnly {
+			continue
+		}
+		spendable = append(spendable, u)
+	}
+	return wal, spendable, nil
+}
+
+/* ListUtxos returns the unspent outputs of the wallet for the coin. Outputs received in an
+   order payment carry the order ID from the transaction's metadata. */
+func (n *OpenBazaarNode) ListUtxos(coin string) ([]UtxoInfo, error) {
+	wal, utxos, err := n.walletUtxos(coin)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := n.Datastore.UtxoMetadata().GetAll(strings.ToUpper(wal.CurrencyCode()))
+	if err != nil {
+		return nil, err
+	}
+	txMetadata, err := n.Datastore.TxMetadata().GetAll()
+	if err != nil {
+		return nil, err
+	}
+	height, _ := wal.ChainTip()
+	infos := []UtxoInfo{}
+	for _, u := range utxos {
+		info := UtxoInfo{
+			Outpoint:  formatOutpoint(u.Op),
+			Value:     u.Value,
+			Height:    u.AtHeight,
+			WatchOnly: u.WatchOnly,
+		}
+		if u.AtHeight > 0 {
+			info.Confirmations = int32(height) - u.AtHeight + 1
+		}
+		if addr, err := wal.ScriptToAddress(u.ScriptPubkey); err == nil {
+			info.Address = addr.EncodeAddress()
+		}
+		if m, ok := metadata[info.Outpoint]; ok {
+			info.Label = m.Label
+			info.Frozen = m.Frozen
+		}
+		if m, ok := txMetadata[u.Op.Hash.String()]; ok {
+			info.OrderId = m.OrderId
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// SetUtxoMetadata labels or freezes one of the wallet's unspent outputs. Frozen outputs are never spent by SpendCoins.
+func (n *OpenBazaarNode) SetUtxoMetadata(coin string, m repo.UtxoMeta) error {
+	op, err := parseOutpoint(m.Outpoint)
+	if err != nil {
+		return err
+	}
+	wal, utxos, err := n.walletUtxos(coin)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, u := range utxos {
+		if u.Op == op {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrUtxoNotFound
+	}
+	m.Coin = strings.ToUpper(wal.CurrencyCode())
+	m.Outpoint = formatOutpoint(op)
+	if m.Label == "" && !m.Frozen {
+		return n.Datastore.UtxoMetadata().Delete(m.Coin, m.Outpoint)
+	}
+	return n.Datastore.UtxoMetadata().Put(m)
+}
+
+/* SpendCoins sends the amount to the address from the wallet for the coin. Frozen outputs
+   and those in control.Exclude are never spent. If control.Include is set exactly those
+   outputs are spent. Without coin control the wallet's own Spend is used unless it would
+   pick frozen outputs. A watch only wallet exports the spend to be signed in cold storage. */
+func (n *OpenBazaarNode) SpendCoins(coin string, amount int64, addr btc.Address, feeLevel wallet.FeeLevel, control CoinControl) (*chainhash.Hash, error) {
+	wal, utxos, err := n.walletUtxos(coin)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := n.Datastore.UtxoMetadata().GetAll(strings.ToUpper(wal.CurrencyCode()))
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[wire.OutPoint]bool)
+	for _, s := range control.Exclude {
+		op, err := parseOutpoint(s)
+		if err != nil {
+			return nil, err
+		}
+		excluded[op] = true
+	}
+	included := make(map[wire.OutPoint]bool)
+	for _, s := range control.Include {
+		op, err := parseOutpoint(s)
+		if err != nil {
+			return nil, err
+		}
+		if excluded[op] {
+			return nil, errors.New("Outpoint is both included and excluded: " + s)
+		}
+		included[op] = true
+	}
+
+	var frozen bool
+	var candidates []wallet.Utxo
+	for _, u := range utxos {
+		if m, ok := metadata[formatOutpoint(u.Op)]; ok && m.Frozen {
+			frozen = true
+			if included[u.Op] {
+				return nil, errors.New("Outpoint is frozen: " + formatOutpoint(u.Op))
+			}
+			continue
+		}
+		if excluded[u.Op] || u.WatchOnly {
+			continue
+		}
+		if len(included) > 0 && !included[u.Op] {
+			continue
+		}
+		candidates = append(candidates, u)
+	}
+	if len(candidates) < len(included) {
+		return nil, ErrUtxoNotFound
+	}
+	if n.ColdStorage != nil {
+		outputs, err := bitcoin.SpendOutputs(wal, []bitcoin.SpendOutput{{Address: addr, Amount: amount}})
+		if err != nil {
+			return nil, err
+		}
+		req, err := n.walletSpendRequest(wal, candidates, len(included) > 0, outputs, feeLevel)
+		if err != nil {
+			return nil, err
+		}
+		return nil, n.ColdStorage.export(req)
+	}
+	if len(included) == 0 && len(excluded) == 0 {
+		if _, ok := wal.(bitcoin.CoinFreezer); !frozen || ok {
+			return wal.Spend(amount, addr, feeLevel)
+		}
+	}
+	return bitcoin.SpendCoins(wal, candidates, len(included) > 0, amount, addr, feeLevel)
+}
+
+/* EnforceCoinFreezes tells the wallets which pick their own coins which outputs are frozen, so
+   their spends and batch payouts leave them alone too. */
+func (n *OpenBazaarNode) EnforceCoinFreezes() {
+	for _, code := range n.Wallets.Codes() {
+		freezer, ok := n.Wallets[code].(bitcoin.CoinFreezer)
+		if !ok {
+			continue
+		}
+		coin := code
+		freezer.SetFrozenCoins(func(op wire.OutPoint) bool {
+			m, err := n.Datastore.UtxoMetadata().Get(coin, formatOutpoint(op))
+			return err == nil && m.Frozen
+		})
+	}
+}
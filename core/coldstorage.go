@@ -0,0 +1,742 @@
+package core
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	btc "github.com/btcsuite/btcutil"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// The order actions which can wait on signatures from cold storage
+const (
+	SigningActionFulfill = "fulfill"
+	SigningActionRefund  = "refund"
+	SigningActionReject  = "reject"
+	SigningActionRelease = "release"
+	SigningActionConfirm = "confirm"
+	SigningActionTimeout = "timeout"
+
+	// An ordinary spend from the watch only wallet
+	SigningActionSpend = "spend"
+)
+
+var ErrSigningRequestNotFound = errors.New("Signing request not found")
+
+// Returned by an order action which has exported a signing request instead of completing
+type SigningRequestExportedError struct {
+	Id string
+}
+
+func (e SigningRequestExportedError) Error() string {
+	return "The wallet keys are in cold storage. Signing request " + e.Id + " was exported and the action completes once its signatures are imported."
+}
+
+/* ColdStorage holds the public keys of a node whose private keys are kept on an offline
+   machine. The wallet is watch only and the mnemonic is removed from the node. Every spend
+   which needs our signature, from an escrow or from the wallet, is exported as a signing
+   request and completed once the signatures are imported. */
+type ColdStorage struct {
+	MasterPublicKey  *hd.ExtendedKey
+	AccountPublicKey *hd.ExtendedKey
+	BitcoinSig       []byte
+	PayoutAddress    string
+	Directory        string
+}
+
+/* A transaction to be signed offline. The transaction is unsigned and the inputs carry the
+   values the witness signatures commit to. For an escrow spend each of the KeyCount escrow
+   keys derived from the chaincode signs every input. A wallet spend has the path of the
+   wallet key for each input instead. */
+type SigningRequest struct {
+	Id           string                    `json:"id"`
+	Action       string                    `json:"action"`
+	OrderId      string                    `json:"orderId"`
+	Coin         string                    `json:"coin"`
+	Chaincode    string                    `json:"chaincode"`
+	KeyCount     int                       `json:"keyCount"`
+	KeyPaths     []wallet.KeyPath          `json:"keyPaths,omitempty"`
+	Inputs       []wallet.TransactionInput `json:"inputs"`
+	RedeemScript string                    `json:"redeemScript"`
+	Transaction  string                    `json:"transaction"`
+	Payload      string                    `json:"payload,omitempty"`
+	Signatures   []wallet.Signature        `json:"signatures,omitempty"`
+	Created      time.Time                 `json:"created"`
+}
+
+// Whether the request spends from our wallet rather than from an escrow
+func (r *SigningRequest) spendsWallet() bool {
+	return len(r.KeyPaths) > 0
+}
+
+// The signatures made offline for a signing request
+type SigningResponse struct {
+	Id         string             `json:"id"`
+	Signatures []wallet.Signature `json:"signatures"`
+}
+
+// NewColdStorage checks the config's key signs our peer ID the way a listing's bitcoin key must
+func NewColdStorage(cfg *repo.ColdStorageConfig, repoPath string, peerID string) (*ColdStorage, error) {
+	mPubKey, err := hd.NewKeyFromString(cfg.MasterPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	accountKey, err := hd.NewKeyFromString(cfg.AccountPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if mPubKey.IsPrivate() || accountKey.IsPrivate() {
+		return nil, errors.New("Cold storage keys must be extended public keys")
+	}
+	ecPubKey, err := mPubKey.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := hex.DecodeString(cfg.BitcoinSig)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyBitcoinSignature(ecPubKey.SerializeCompressed(), sig, peerID); err != nil {
+		return nil, errors.New("Cold storage bitcoin signature does not cover our peer ID")
+	}
+	dir := cfg.Directory
+	if dir == "" {
+		dir = path.Join(repoPath, "coldstorage")
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return &ColdStorage{
+		MasterPublicKey:  mPubKey,
+		AccountPublicKey: accountKey,
+		BitcoinSig:       sig,
+		PayoutAddress:    cfg.PayoutAddress,
+		Directory:        dir,
+	}, nil
+}
+
+/* MatchesMnemonic returns whether the cold storage keys come from the mnemonic, which means
+   the offline machine holds it and the node can forget it. */
+func (c *ColdStorage) MatchesMnemonic(mnemonic string, params *chaincfg.Params) (bool, error) {
+	mPrivKey, err := hd.NewMaster(bip39.NewSeed(mnemonic, ""), params)
+	if err != nil {
+		return false, err
+	}
+	account, err := bitcoin.Bip44Account(mPrivKey)
+	if err != nil {
+		return false, err
+	}
+	for _, keys := range [][2]*hd.ExtendedKey{{mPrivKey, c.MasterPublicKey}, {account, c.AccountPublicKey}} {
+		ours, err := keys[0].ECPubKey()
+		if err != nil {
+			return false, err
+		}
+		theirs, err := keys[1].ECPubKey()
+		if err != nil {
+			return false, err
+		}
+		if !ours.IsEqual(theirs) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func signingRequestId(action, orderId string) string {
+	return action + "-" + orderId
+}
+
+func (c *ColdStorage) requestPath(id string) string {
+	return path.Join(c.Directory, id+".json")
+}
+
+func (c *ColdStorage) load(id string) (*SigningRequest, error) {
+	if strings.ContainsAny(id, "/\\") {
+		return nil, ErrSigningRequestNotFound
+	}
+	b, err := ioutil.ReadFile(c.requestPath(id))
+	if os.IsNotExist(err) {
+		return nil, ErrSigningRequestNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	req := new(SigningRequest)
+	if err := json.Unmarshal(b, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func (c *ColdStorage) save(req *SigningRequest) error {
+	b, err := json.MarshalIndent(req, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.requestPath(req.Id), b, os.FileMode(0644))
+}
+
+// Write the request for the offline machine and return the error telling the caller to wait for it
+func (c *ColdStorage) export(req *SigningRequest) error {
+	if err := c.save(req); err != nil {
+		return err
+	}
+	log.Infof("Exported signing request %s to %s", req.Id, c.requestPath(req.Id))
+	return SigningRequestExportedError{req.Id}
+}
+
+// Check every signature was made by the keys the request names
+func (c *ColdStorage) verify(req *SigningRequest, sigs []wallet.Signature) error {
+	if req.spendsWallet() {
+		_, err := c.signedSpend(req, sigs)
+		return err
+	}
+	tx, err := bitcoin.DecodeTransaction(req.Transaction)
+	if err != nil {
+		return err
+	}
+	if len(sigs) != req.KeyCount*len(tx.TxIn) {
+		return errors.New("Wrong number of signatures for the signing request")
+	}
+	redeemScript, err := hex.DecodeString(req.RedeemScript)
+	if err != nil {
+		return err
+	}
+	chaincode, err := hex.DecodeString(req.Chaincode)
+	if err != nil {
+		return err
+	}
+	ecPubKey, err := c.MasterPublicKey.ECPubKey()
+	if err != nil {
+		return err
+	}
+	hdKey := hd.NewExtendedKey(
+		chaincfg.MainNetParams.HDPublicKeyID[:],
+		ecPubKey.SerializeCompressed(),
+		chaincode,
+		[]byte{0x00, 0x00, 0x00, 0x00},
+		0,
+		0,
+		false)
+	hashes := txscript.NewTxSigHashes(tx)
+	for k := 0; k < req.KeyCount; k++ {
+		key, err := hdKey.Child(uint32(k))
+		if err != nil {
+			return err
+		}
+		pub, err := key.ECPubKey()
+		if err != nil {
+			return err
+		}
+		for i := range tx.TxIn {
+			s := sigs[k*len(tx.TxIn)+i]
+			if int(s.InputIndex) != i || len(s.Signature) == 0 {
+				return errors.New("Signatures are not in input order")
+			}
+			hash, err := txscript.CalcWitnessSigHash(redeemScript, hashes, txscript.SigHashAll, tx, i, req.Inputs[i].Value)
+			if err != nil {
+				return err
+			}
+			sig, err := btcec.ParseDERSignature(s.Signature[:len(s.Signature)-1], btcec.S256())
+			if err != nil {
+				return err
+			}
+			if !sig.Verify(hash, pub) {
+				return errors.New("Signature does not match the cold storage key")
+			}
+		}
+	}
+	return nil
+}
+
+// Derive the wallet key for each input of a wallet spend from the account key
+func walletSpendKeys(account *hd.ExtendedKey, paths []wallet.KeyPath) ([]*hd.ExtendedKey, error) {
+	var keys []*hd.ExtendedKey
+	for _, p := range paths {
+		key, err := bitcoin.AccountChild(account, p)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Complete a wallet spend with the signatures and check each input now verifies
+func (c *ColdStorage) signedSpend(req *SigningRequest, sigs []wallet.Signature) (*wire.MsgTx, error) {
+	tx, err := bitcoin.DecodeTransaction(req.Transaction)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.KeyPaths) != len(tx.TxIn) || len(req.Inputs) != len(tx.TxIn) {
+		return nil, errors.New("Signing request inputs do not match the transaction")
+	}
+	keys, err := walletSpendKeys(c.AccountPublicKey, req.KeyPaths)
+	if err != nil {
+		return nil, err
+	}
+	if err := bitcoin.AddP2PKHSignatures(tx, sigs, keys); err != nil {
+		return nil, err
+	}
+	for i := range tx.TxIn {
+		script, err := bitcoin.P2PKHScript(keys[i])
+		if err != nil {
+			return nil, err
+		}
+		vm, err := txscript.NewEngine(script, tx, i, txscript.StandardVerifyFlags, nil, nil, req.Inputs[i].Value)
+		if err != nil {
+			return nil, err
+		}
+		if err := vm.Execute(); err != nil {
+			return nil, errors.New("Signature does not match the cold storage key")
+		}
+	}
+	return tx, nil
+}
+
+/* SignRequest makes the signatures for a signing request with the master private key of
+   the cold storage machine. */
+func SignRequest(req *SigningRequest, mPrivKey *hd.ExtendedKey) (*SigningResponse, error) {
+	tx, err := bitcoin.DecodeTransaction(req.Transaction)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Inputs) != len(tx.TxIn) {
+		return nil, errors.New("Signing request inputs do not match the transaction")
+	}
+	if req.spendsWallet() {
+		account, err := bitcoin.Bip44Account(mPrivKey)
+		if err != nil {
+			return nil, err
+		}
+		keys, err := walletSpendKeys(account, req.KeyPaths)
+		if err != nil {
+			return nil, err
+		}
+		sigs, err := bitcoin.SignP2PKHInputs(tx, keys)
+		if err != nil {
+			return nil, err
+		}
+		return &SigningResponse{Id: req.Id, Signatures: sigs}, nil
+	}
+	redeemScript, err := hex.DecodeString(req.RedeemScript)
+	if err != nil {
+		return nil, err
+	}
+	chaincode, err := hex.DecodeString(req.Chaincode)
+	if err != nil {
+		return nil, err
+	}
+	mECKey, err := mPrivKey.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	hdKey := hd.NewExtendedKey(
+		chaincfg.MainNetParams.HDPrivateKeyID[:],
+		mECKey.Serialize(),
+		chaincode,
+		[]byte{0x00, 0x00, 0x00, 0x00},
+		0,
+		0,
+		true)
+	var values []int64
+	for _, in := range req.Inputs {
+		values = append(values, in.Value)
+	}
+	resp := &SigningResponse{Id: req.Id}
+	for k := 0; k < req.KeyCount; k++ {
+		key, err := hdKey.Child(uint32(k))
+		if err != nil {
+			return nil, err
+		}
+		sigs, err := bitcoin.SignWitnessInputs(tx, values, key, redeemScript)
+		if err != nil {
+			return nil, err
+		}
+		resp.Signatures = append(resp.Signatures, sigs...)
+	}
+	return resp, nil
+}
+
+// Whether the contract's escrow needs our vendor keys and they are in cold storage
+func (n *OpenBazaarNode) vendorKeysOffline(contract *pb.RicardianContract) bool {
+	return n.ColdStorage != nil && len(contract.VendorListings) > 0 &&
+		contract.VendorListings[0].VendorID.PeerID == n.IpfsNode.Identity.Pretty()
+}
+
+// The signature of our peer ID by the wallet's master key, which cold storage made offline
+func (n *OpenBazaarNode) bitcoinSig(peerID string) ([]byte, error) {
+	if n.ColdStorage != nil {
+		return n.ColdStorage.BitcoinSig, nil
+	}
+	mPrivKey, err := bitcoin.MasterPrivateKey(n.Wallet)
+	if err != nil {
+		return nil, err
+	}
+	ecPrivKey, err := mPrivKey.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := ecPrivKey.Sign([]byte(peerID))
+	if err != nil {
+		return nil, err
+	}
+	return sig.Serialize(), nil
+}
+
+// The master public key our escrow keys as a vendor are derived from
+func (n *OpenBazaarNode) vendorMasterPublicKey(wal wallet.Wallet) (*btcec.PublicKey, error) {
+	if n.ColdStorage != nil {
+		return n.ColdStorage.MasterPublicKey.ECPubKey()
+	}
+	return wal.MasterPublicKey().ECPubKey()
+}
+
+// The address vendor payouts are sent to. Cold storage sends them to its payout address if one is set.
+func (n *OpenBazaarNode) vendorPayoutAddress(wal wallet.Wallet, purpose wallet.KeyPurpose) (btc.Address, error) {
+	if n.ColdStorage != nil && n.ColdStorage.PayoutAddress != "" {
+		return wal.DecodeAddress(n.ColdStorage.PayoutAddress)
+	}
+	return wal.CurrentAddress(purpose), nil
+}
+
+// Derive the escrow key for the contract from our hot wallet
+func (n *OpenBazaarNode) escrowSigningKey(wal wallet.Wallet, contract *pb.RicardianContract) (*hd.ExtendedKey, error) {
+	chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+	if err != nil {
+		return nil, err
+	}
+	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
+	mPrivKey, err := bitcoin.MasterPrivateKey(wal)
+	if err != nil {
+		return nil, err
+	}
+	mECKey, err := mPrivKey.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	hdKey := hd.NewExtendedKey(
+		wal.Params().HDPrivateKeyID[:],
+		mECKey.Serialize(),
+		chaincode,
+		parentFP,
+		0,
+		0,
+		true)
+	return hdKey, nil
+}
+
+func (n *OpenBazaarNode) newSigningRequest(action string, contract *pb.RicardianContract, ins []wallet.TransactionInput, keyCount int, tx string, payload string) (*SigningRequest, error) {
+	orderId, err := n.CalcOrderId(contract.BuyerOrder)
+	if err != nil {
+		return nil, err
+	}
+	return &SigningRequest{
+		Id:           signingRequestId(action, orderId),
+		Action:       action,
+		OrderId:      orderId,
+		Coin:         contract.BuyerOrder.Payment.Coin,
+		Chaincode:    contract.BuyerOrder.Payment.Chaincode,
+		KeyCount:     keyCount,
+		Inputs:       ins,
+		RedeemScript: contract.BuyerOrder.Payment.RedeemScript,
+		Transaction:  tx,
+		Payload:      payload,
+		Created:      time.Now(),
+	}, nil
+}
+
+/* escrowSignatures signs an escrow spend with our keys. When our vendor keys are in cold
+   storage the signatures imported for the same spend are returned, and if there are none a
+   signing request is exported. */
+func (n *OpenBazaarNode) escrowSignatures(action string, contract *pb.RicardianContract, ins []wallet.TransactionInput, outs []wallet.TransactionOutput, redeemScript []byte, feePerByte uint64, payload string) ([]wallet.Signature, error) {
+	if !n.vendorKeysOffline(contract) {
+		wal, err := n.WalletForOrder(contract.BuyerOrder)
+		if err != nil {
+			return nil, err
+		}
+		hdKey, err := n.escrowSigningKey(wal, contract)
+		if err != nil {
+			return nil, err
+		}
+		return n.CreateEscrowSignatures(contract.BuyerOrder.Payment, ins, outs, hdKey, redeemScript, feePerByte)
+	}
+	tx, err := bitcoin.UnsignedMultisigTransaction(ins, outs, redeemScript, feePerByte)
+	if err != nil {
+		return nil, err
+	}
+	req, err := n.newSigningRequest(action, contract, ins, escrowKeyCount(contract.BuyerOrder.Payment), bitcoin.EncodeTransaction(tx), payload)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := n.ColdStorage.load(req.Id)
+	if err == nil && len(stored.Signatures) > 0 && stored.Transaction == req.Transaction {
+		return stored.Signatures, nil
+	}
+	return nil, n.ColdStorage.export(req)
+}
+
+/* sweepEscrow moves the coins of a single signature escrow to our wallet. When our vendor
+   keys are in cold storage the coins go to the cold storage payout address once the sweep
+   has been signed offline. */
+func (n *OpenBazaarNode) sweepEscrow(action string, wal wallet.Wallet, contract *pb.RicardianContract, utxos []wallet.Utxo) error {
+	redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+	if err != nil {
+		return err
+	}
+	if !n.vendorKeysOffline(contract) {
+		hdKey, err := n.escrowSigningKey(wal, contract)
+		if err != nil {
+			return err
+		}
+		vendorKey, err := hdKey.Child(0)
+		if err != nil {
+			return err
+		}
+		_, err = wal.SweepAddress(utxos, nil, vendorKey, &redeemScript, wallet.NORMAL)
+		return err
+	}
+	orderId, err := n.CalcOrderId(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+
+	// The fee may have changed since the request was exported so the stored transaction is used
+	stored, err := n.ColdStorage.load(signingRequestId(action, orderId))
+	if err == nil && len(stored.Signatures) > 0 {
+		tx, err := bitcoin.DecodeTransaction(stored.Transaction)
+		if err != nil {
+			return err
+		}
+		if err := bitcoin.AddSweepWitness(tx, stored.Signatures, redeemScript); err != nil {
+			return err
+		}
+		return bitcoin.Broadcast(wal, tx)
+	}
+
+	addr, err := n.vendorPayoutAddress(wal, wallet.INTERNAL)
+	if err != nil {
+		return err
+	}
+	script, err := wal.AddressToScript(addr)
+	if err != nil {
+		return err
+	}
+	tx, err := bitcoin.UnsignedSweepTransaction(utxos, script, redeemScript, wal.GetFeePerByte(wallet.NORMAL))
+	if err != nil {
+		return err
+	}
+	var ins []wallet.TransactionInput
+	for _, u := range utxos {
+		hash, err := hex.DecodeString(u.Op.Hash.String())
+		if err != nil {
+			return err
+		}
+		ins = append(ins, wallet.TransactionInput{OutpointHash: hash, OutpointIndex: u.Op.Index, Value: u.Value})
+	}
+	req, err := n.newSigningRequest(action, contract, ins, 1, bitcoin.EncodeTransaction(tx), "")
+	if err != nil {
+		return err
+	}
+	return n.ColdStorage.export(req)
+}
+
+/* walletSpendRequest builds an unsigned spend from the watch only wallet to be signed offline.
+   The coins are selected the same way as for the wallet's own spends. */
+func (n *OpenBazaarNode) walletSpendRequest(wal wallet.Wallet, utxos []wallet.Utxo, spendAll bool, outputs []*wire.TxOut, feeLevel wallet.FeeLevel) (*SigningRequest, error) {
+	tx, prevScripts, err := bitcoin.BuildUnsignedSpendTransaction(wal, utxos, spendAll, outputs, feeLevel)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := n.walletDatastore(wal)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[wire.OutPoint]int64)
+	for _, u := range utxos {
+		values[u.Op] = u.Value
+	}
+	txid := tx.TxHash()
+	req := &SigningRequest{
+		Id:          signingRequestId(SigningActionSpend, txid.String()),
+		Action:      SigningActionSpend,
+		Coin:        wal.CurrencyCode(),
+		Transaction: bitcoin.EncodeTransaction(tx),
+		Created:     time.Now(),
+	}
+	for i, txIn := range tx.TxIn {
+		addr, err := wal.ScriptToAddress(prevScripts[i])
+		if err != nil {
+			return nil, err
+		}
+		keyPath, err := ds.Keys().GetPathForKey(addr.ScriptAddress())
+		if err != nil {
+			return nil, err
+		}
+		hash, err := hex.DecodeString(txIn.PreviousOutPoint.Hash.String())
+		if err != nil {
+			return nil, err
+		}
+		req.KeyPaths = append(req.KeyPaths, keyPath)
+		req.Inputs = append(req.Inputs, wallet.TransactionInput{
+			OutpointHash:  hash,
+			OutpointIndex: txIn.PreviousOutPoint.Index,
+			Value:         values[txIn.PreviousOutPoint],
+		})
+	}
+	return req, nil
+}
+
+/* walletSpend pays the amount to the address from the wallet for an order action. A watch
+   only wallet exports the spend and, once its signatures are imported and the action runs
+   again, broadcasts the stored transaction. */
+func (n *OpenBazaarNode) walletSpend(action string, contract *pb.RicardianContract, wal wallet.Wallet, amount int64, addr btc.Address, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
+	if n.ColdStorage == nil {
+		return n.SpendCoins(wal.CurrencyCode(), amount, addr, feeLevel, CoinControl{})
+	}
+	orderId, err := n.CalcOrderId(contract.BuyerOrder)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := n.ColdStorage.load(signingRequestId(action, orderId))
+	if err == nil && len(stored.Signatures) > 0 {
+		tx, err := n.ColdStorage.signedSpend(stored, stored.Signatures)
+		if err != nil {
+			return nil, err
+		}
+		if err := bitcoin.Broadcast(wal, tx); err != nil {
+			return nil, err
+		}
+		txid := tx.TxHash()
+		return &txid, nil
+	}
+	_, utxos, err := n.spendableUtxos(wal.CurrencyCode())
+	if err != nil {
+		return nil, err
+	}
+	outputs, err := bitcoin.SpendOutputs(wal, []bitcoin.SpendOutput{{Address: addr, Amount: amount}})
+	if err != nil {
+		return nil, err
+	}
+	req, err := n.walletSpendRequest(wal, utxos, false, outputs, feeLevel)
+	if err != nil {
+		return nil, err
+	}
+	req.Id = signingRequestId(action, orderId)
+	req.Action = action
+	req.OrderId = orderId
+	return nil, n.ColdStorage.export(req)
+}
+
+// ListSigningRequests returns the signing requests waiting on signatures, oldest first
+func (n *OpenBazaarNode) ListSigningRequests() ([]SigningRequest, error) {
+	if n.ColdStorage == nil {
+		return nil, errors.New("Cold storage is not enabled")
+	}
+	files, err := ioutil.ReadDir(n.ColdStorage.Directory)
+	if err != nil {
+		return nil, err
+	}
+	reqs := []SigningRequest{}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		req, err := n.ColdStorage.load(strings.TrimSuffix(f.Name(), ".json"))
+		if err != nil {
+			log.Errorf("Error reading signing request %s: %s", f.Name(), err.Error())
+			continue
+		}
+		reqs = append(reqs, *req)
+	}
+	sort.Slice(reqs, func(i, j int) bool {
+		return reqs[i].Created.Before(reqs[j].Created)
+	})
+	return reqs, nil
+}
+
+// GetSigningRequest returns the exported signing request with the ID
+func (n *OpenBazaarNode) GetSigningRequest(id string) (*SigningRequest, error) {
+	if n.ColdStorage == nil {
+		return nil, errors.New("Cold storage is not enabled")
+	}
+	return n.ColdStorage.load(id)
+}
+
+/* ImportSignatures stores the offline signatures for a signing request and resumes the order
+   action which exported it. The request is removed once the action completes. */
+func (n *OpenBazaarNode) ImportSignatures(resp *SigningResponse) error {
+	req, err := n.GetSigningRequest(resp.Id)
+	if err != nil {
+		return err
+	}
+	if err := n.ColdStorage.verify(req, resp.Signatures); err != nil {
+		return err
+	}
+	req.Signatures = resp.Signatures
+	if err := n.ColdStorage.save(req); err != nil {
+		return err
+	}
+
+	// An ordinary wallet spend has no order action waiting on it
+	if req.Action == SigningActionSpend {
+		wal, err := n.WalletForCurrency(req.Coin)
+		if err != nil {
+			return err
+		}
+		tx, err := n.ColdStorage.signedSpend(req, req.Signatures)
+		if err != nil {
+			return err
+		}
+		if err := bitcoin.Broadcast(wal, tx); err != nil {
+			return err
+		}
+		return os.Remove(n.ColdStorage.requestPath(req.Id))
+	}
+
+	contract, _, _, records, _, err := n.Datastore.Sales().GetByOrderId(req.OrderId)
+	if err != nil {
+		return err
+	}
+	switch req.Action {
+	case SigningActionFulfill:
+		fulfillment := new(pb.OrderFulfillment)
+		if err := jsonpb.UnmarshalString(req.Payload, fulfillment); err != nil {
+			return err
+		}
+		err = n.FulfillOrder(fulfillment, contract, records)
+	case SigningActionRefund:
+		err = n.RefundOrder(contract, records)
+	case SigningActionReject:
+		err = n.RejectOfflineOrder(contract, records)
+	case SigningActionRelease:
+		err = n.ReleaseFunds(contract, records)
+	case SigningActionConfirm:
+		err = n.ConfirmOfflineOrder(contract, records)
+	case SigningActionTimeout:
+		err = n.ReleaseFundsAfterTimeout(contract, records)
+	default:
+		return errors.New("Unknown signing request action")
+	}
+	if err != nil {
+		return err
+	}
+	return os.Remove(n.ColdStorage.requestPath(req.Id))
+}
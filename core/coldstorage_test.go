@@ -0,0 +1,178 @@
+package core_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	btc "github.com/btcsuite/btcutil"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+)
+
+func TestSignRequest(t *testing.T) {
+	params := &chaincfg.MainNetParams
+	mPrivKey, err := hd.NewMaster([]byte("cold storage test seed for signing requests"), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chaincode := make([]byte, 32)
+	chaincode[0] = 0x01
+	mECKey, err := mPrivKey.ECPrivKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hdKey := hd.NewExtendedKey(params.HDPrivateKeyID[:], mECKey.Serialize(), chaincode, []byte{0x00, 0x00, 0x00, 0x00}, 0, 0, true)
+
+	// A two key escrow where both keys belong to the cold storage machine, as on a panel
+	var pubkeys []*btc.AddressPubKey
+	for i := 0; i < 2; i++ {
+		key, err := hdKey.Child(uint32(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub, err := key.ECPubKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr, err := btc.NewAddressPubKey(pub.SerializeCompressed(), params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubkeys = append(pubkeys, addr)
+	}
+	redeemScript, err := txscript.MultiSigScript(pubkeys, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := sha256.Sum256(redeemScript)
+	addr, err := btc.NewAddressWitnessScriptHash(h[:], params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txid, _ := hex.DecodeString("7a1fba5cfcd1c2dd3a1d6f1a4bd1c5fe6c2c69a2f0e8a8b5a1c3d2e4f5a6b7c8")
+	ins := []wallet.TransactionInput{
+		{OutpointHash: txid, OutpointIndex: 0, Value: 100000},
+		{OutpointHash: txid, OutpointIndex: 1, Value: 100000},
+	}
+	outs := []wallet.TransactionOutput{{ScriptPubKey: pkScript, Value: 200000}}
+	tx, err := bitcoin.UnsignedMultisigTransaction(ins, outs, redeemScript, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &core.SigningRequest{
+		Id:           "release-test",
+		Chaincode:    hex.EncodeToString(chaincode),
+		KeyCount:     2,
+		Inputs:       ins,
+		RedeemScript: hex.EncodeToString(redeemScript),
+		Transaction:  bitcoin.EncodeTransaction(tx),
+	}
+	resp, err := core.SignRequest(req, mPrivKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != req.Id || len(resp.Signatures) != 4 {
+		t.Fatalf("Expected 4 signatures for request %s, got %d", req.Id, len(resp.Signatures))
+	}
+
+	signed, err := bitcoin.BuildMultisigTransaction(ins, outs, [][]wallet.Signature{resp.Signatures[:2], resp.Signatures[2:]}, redeemScript, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashes := txscript.NewTxSigHashes(signed)
+	for i := range signed.TxIn {
+		vm, err := txscript.NewEngine(pkScript, signed, i, txscript.StandardVerifyFlags, nil, hashes, ins[i].Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Errorf("Input %d failed to verify: %s", i, err)
+		}
+	}
+}
+
+func TestSignWalletSpend(t *testing.T) {
+	mPrivKey, err := hd.NewMaster([]byte("cold storage test seed for wallet spends"), &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := bitcoin.Bip44Account(mPrivKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The node only has the account public key to find the keys of its coins
+	accountPubKey, err := account.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := []wallet.KeyPath{{Purpose: wallet.EXTERNAL, Index: 0}, {Purpose: wallet.INTERNAL, Index: 3}}
+	var keys []*hd.ExtendedKey
+	var scripts [][]byte
+	for _, p := range paths {
+		key, err := bitcoin.AccountChild(accountPubKey, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		script, err := bitcoin.P2PKHScript(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, key)
+		scripts = append(scripts, script)
+	}
+
+	txid, _ := hex.DecodeString("7a1fba5cfcd1c2dd3a1d6f1a4bd1c5fe6c2c69a2f0e8a8b5a1c3d2e4f5a6b7c8")
+	hash, err := chainhash.NewHash(txid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ins := []wallet.TransactionInput{
+		{OutpointHash: txid, OutpointIndex: 0, Value: 100000},
+		{OutpointHash: txid, OutpointIndex: 1, Value: 50000},
+	}
+	tx := wire.NewMsgTx(1)
+	for _, in := range ins {
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(hash, in.OutpointIndex), nil, nil))
+	}
+	tx.AddTxOut(wire.NewTxOut(140000, scripts[0]))
+	req := &core.SigningRequest{
+		Id:          "spend-test",
+		Action:      core.SigningActionSpend,
+		KeyPaths:    paths,
+		Inputs:      ins,
+		Transaction: bitcoin.EncodeTransaction(tx),
+	}
+	resp, err := core.SignRequest(req, mPrivKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != req.Id || len(resp.Signatures) != 2 {
+		t.Fatalf("Expected 2 signatures for request %s, got %d", req.Id, len(resp.Signatures))
+	}
+
+	if err := bitcoin.AddP2PKHSignatures(tx, resp.Signatures, keys); err != nil {
+		t.Fatal(err)
+	}
+	for i := range tx.TxIn {
+		vm, err := txscript.NewEngine(scripts[i], tx, i, txscript.StandardVerifyFlags, nil, nil, ins[i].Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Errorf("Input %d failed to verify: %s", i, err)
+		}
+	}
+}
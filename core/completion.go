@@ -13,6 +13,7 @@ import (
 
 	"fmt"
 	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/wallet-interface"
@@ -46,13 +47,19 @@ type RatingData struct {
 }
 
 type SavedRating struct {
-	Slug    string   `json:"slug"`
-	Count   int      `json:"count"`
-	Average float32  `json:"average"`
-	Ratings []string `json:"ratings"`
+	Slug       string            `json:"slug"`
+	Count      int               `json:"count"`
+	Average    float32           `json:"average"`
+	Ratings    []string          `json:"ratings"`
+	Replies    map[string]string `json:"replies,omitempty"`    // rating hash -> vendor reply hash
+	Amendments map[string]string `json:"amendments,omitempty"` // original rating hash -> amendment hash
 }
 
 func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 
 	orderId, err := n.CalcOrderId(contract.BuyerOrder)
 	if err != nil {
@@ -139,7 +146,11 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 			return err
 		}
 
-		ratingKey, err := n.Wallet.MasterPrivateKey().Child(uint32(contract.BuyerOrder.Timestamp.Seconds))
+		mPrivKey, err := bitcoin.MasterPrivateKey(wal)
+		if err != nil {
+			return err
+		}
+		ratingKey, err := mPrivKey.Child(uint32(contract.BuyerOrder.Timestamp.Seconds))
 		if err != nil {
 			return err
 		}
@@ -172,12 +183,12 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 			}
 		}
 
-		payoutAddress, err := n.Wallet.DecodeAddress(contract.VendorOrderFulfillment[0].Payout.PayoutAddress)
+		payoutAddress, err := wal.DecodeAddress(contract.VendorOrderFulfillment[0].Payout.PayoutAddress)
 		if err != nil {
 			return err
 		}
 		var output wallet.TransactionOutput
-		outputScript, err := n.Wallet.AddressToScript(payoutAddress)
+		outputScript, err := wal.AddressToScript(payoutAddress)
 		if err != nil {
 			return err
 		}
@@ -189,7 +200,7 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 			return err
 		}
 		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		mPrivKey := n.Wallet.MasterPrivateKey()
+		mPrivKey, err := bitcoin.MasterPrivateKey(wal)
 		if err != nil {
 			return err
 		}
@@ -198,7 +209,7 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 			return err
 		}
 		hdKey := hd.NewExtendedKey(
-			n.Wallet.Params().HDPrivateKeyID[:],
+			wal.Params().HDPrivateKeyID[:],
 			mECKey.Serialize(),
 			chaincode,
 			parentFP,
@@ -206,16 +217,12 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 			0,
 			true)
 
-		buyerKey, err := hdKey.Child(0)
-		if err != nil {
-			return err
-		}
 		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
 		if err != nil {
 			return err
 		}
 
-		buyerSignatures, err := n.Wallet.CreateMultisigSignature(ins, []wallet.TransactionOutput{output}, buyerKey, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte)
+		buyerSignatures, err := n.CreateEscrowSignatures(contract.BuyerOrder.Payment, ins, []wallet.TransactionOutput{output}, hdKey, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte)
 		if err != nil {
 			return err
 		}
@@ -232,7 +239,7 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 			sig := wallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		_, err = n.Wallet.Multisign(ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte, true)
+		_, err = n.MultisignEscrow(contract.BuyerOrder.Payment, ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte, true)
 		if err != nil {
 			return err
 		}
@@ -264,12 +271,21 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 		return err
 	}
 
+	// Publish our ratings so they can be found even if the vendor leaves them out of their index
+	if err := n.publishGivenRatings(oc.Ratings); err != nil {
+		log.Errorf("Error publishing ratings for order %s: %s", orderId, err.Error())
+	}
+
 	return nil
 }
 
 var EscrowTimeLockedError error
 
 func (n *OpenBazaarNode) ReleaseFundsAfterTimeout(contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 	minConfirms := contract.VendorListings[0].Metadata.EscrowTimeoutHours * 6
 	var utxos []wallet.Utxo
 	for _, r := range records {
@@ -282,7 +298,7 @@ func (n *OpenBazaarNode) ReleaseFundsAfterTimeout(contract *pb.RicardianContract
 				return err
 			}
 
-			confirms, _, err := n.Wallet.GetConfirmations(*hash)
+			confirms, _, err := wal.GetConfirmations(*hash)
 			if err != nil {
 				return err
 			}
@@ -296,37 +312,7 @@ func (n *OpenBazaarNode) ReleaseFundsAfterTimeout(contract *pb.RicardianContract
 		}
 	}
 
-	chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
-	if err != nil {
-		return err
-	}
-	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-	mPrivKey := n.Wallet.MasterPrivateKey()
-	if err != nil {
-		return err
-	}
-	mECKey, err := mPrivKey.ECPrivKey()
-	if err != nil {
-		return err
-	}
-	hdKey := hd.NewExtendedKey(
-		n.Wallet.Params().HDPrivateKeyID[:],
-		mECKey.Serialize(),
-		chaincode,
-		parentFP,
-		0,
-		0,
-		true)
-
-	vendorKey, err := hdKey.Child(0)
-	if err != nil {
-		return err
-	}
-	redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
-	if err != nil {
-		return err
-	}
-	_, err = n.Wallet.SweepAddress(utxos, nil, vendorKey, &redeemScript, wallet.NORMAL)
+	err = n.sweepEscrow(SigningActionTimeout, wal, contract, utxos)
 	if err != nil {
 		return err
 	}
@@ -435,17 +421,17 @@ func (n *OpenBazaarNode) ValidateAndSaveRating(contract *pb.RicardianContract) (
 			continue
 		}
 
-		if err := n.updateRatingIndex(rating, ratingPath); err != nil {
+		if err := n.updateRatingIndex(rating, ratingPath, nil, ""); err != nil {
 			retErr = err
 			continue
 		}
 
-		if err := n.updateRatingInListingIndex(rating); err != nil {
+		if err := n.updateRatingInListingIndex(rating, nil); err != nil {
 			retErr = err
 			continue
 		}
 
-		if err := n.updateProfileRatings(rating); err != nil {
+		if err := n.updateProfileRatings(rating, nil); err != nil {
 			retErr = err
 			continue
 		}
@@ -453,14 +439,21 @@ func (n *OpenBazaarNode) ValidateAndSaveRating(contract *pb.RicardianContract) (
 	return
 }
 
-func (n *OpenBazaarNode) updateRatingIndex(rating *pb.Rating, ratingPath string) error {
+/* Add a rating to the ratings index. If replaced is set it is removed from the index and the
+   average is recomputed without it. A nil rating removes the replaced rating without adding
+   a new one, as is done when a rating is retracted. */
+func (n *OpenBazaarNode) updateRatingIndex(rating *pb.Rating, ratingPath string, replaced *pb.Rating, replacedHash string) error {
 	indexPath := path.Join(n.RepoPath, "root", "ratings.json")
 
 	var index []SavedRating
 
-	ratingHash, err := ipfs.GetHashOfFile(n.Context, ratingPath)
-	if err != nil {
-		return err
+	var ratingHash string
+	var err error
+	if rating != nil {
+		ratingHash, err = ipfs.GetHashOfFile(n.Context, ratingPath)
+		if err != nil {
+			return err
+		}
 	}
 
 	_, ferr := os.Stat(indexPath)
@@ -476,6 +469,34 @@ func (n *OpenBazaarNode) updateRatingIndex(rating *pb.Rating, ratingPath string)
 		}
 	}
 
+	// Remove the rating being replaced
+	if replaced != nil {
+		for i, d := range index {
+			if replaced.RatingData.VendorSig.Metadata.ListingSlug != d.Slug {
+				continue
+			}
+			for j, h := range d.Ratings {
+				if h != replacedHash {
+					continue
+				}
+				index[i].Ratings = append(index[i].Ratings[:j], index[i].Ratings[j+1:]...)
+				total := index[i].Average*float32(index[i].Count) - float32(replaced.RatingData.Overall)
+				index[i].Count -= 1
+				if index[i].Count > 0 {
+					index[i].Average = total / float32(index[i].Count)
+				} else {
+					index[i].Average = 0
+				}
+				break
+			}
+			break
+		}
+	}
+
+	if rating == nil {
+		return writeRatingIndex(indexPath, index)
+	}
+
 	// Check to see if the rating we are adding already exists in the list. If so update it.
 	exists := false
 	for i, d := range index {
@@ -500,8 +521,10 @@ func (n *OpenBazaarNode) updateRatingIndex(rating *pb.Rating, ratingPath string)
 		}
 		index = append(index, rs)
 	}
+	return writeRatingIndex(indexPath, index)
+}
 
-	// Write it back to file
+func writeRatingIndex(indexPath string, index []SavedRating) error {
 	f, err := os.Create(indexPath)
 	defer f.Close()
 	if err != nil {
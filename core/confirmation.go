@@ -12,12 +12,15 @@ import (
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
-	hd "github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 )
 
 func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, addressRequest, calculateNewTotal bool) (*pb.RicardianContract, error) {
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return nil, err
+	}
 	oc := new(pb.OrderConfirmation)
 	// Calculate order ID
 	orderID, err := n.CalcOrderId(contract.BuyerOrder)
@@ -26,7 +29,7 @@ func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, ad
 	}
 	oc.OrderID = orderID
 	if addressRequest {
-		addr := n.Wallet.NewAddress(wallet.EXTERNAL)
+		addr := wal.NewAddress(wallet.EXTERNAL)
 		oc.PaymentAddress = addr.EncodeAddress()
 	}
 
@@ -82,6 +85,14 @@ func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, ad
 	} else {
 		oc.RequestedAmount = contract.BuyerOrder.Payment.Amount
 	}
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_LIGHTNING {
+		invoice, err := n.newLightningInvoice(wal, orderID, oc.RequestedAmount)
+		if err != nil {
+			return nil, err
+		}
+		oc.PaymentRequest = invoice.PaymentRequest
+		oc.PaymentHash = invoice.PaymentHash
+	}
 	contract.VendorOrderConfirmation = oc
 	contract, err = n.SignOrderConfirmation(contract)
 	if err != nil {
@@ -91,7 +102,11 @@ func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, ad
 }
 
 func (n *OpenBazaarNode) ConfirmOfflineOrder(contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
-	contract, err := n.NewOrderConfirmation(contract, false, false)
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	contract, err = n.NewOrderConfirmation(contract, false, false)
 	if err != nil {
 		return err
 	}
@@ -121,37 +136,7 @@ func (n *OpenBazaarNode) ConfirmOfflineOrder(contract *pb.RicardianContract, rec
 			return errors.New("Cannot accept order because utxo has already been spent")
 		}
 
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
-		if err != nil {
-			return err
-		}
-		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		mPrivKey := n.Wallet.MasterPrivateKey()
-		if err != nil {
-			return err
-		}
-		mECKey, err := mPrivKey.ECPrivKey()
-		if err != nil {
-			return err
-		}
-		hdKey := hd.NewExtendedKey(
-			n.Wallet.Params().HDPrivateKeyID[:],
-			mECKey.Serialize(),
-			chaincode,
-			parentFP,
-			0,
-			0,
-			true)
-
-		vendorKey, err := hdKey.Child(0)
-		if err != nil {
-			return err
-		}
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
-		if err != nil {
-			return err
-		}
-		_, err = n.Wallet.SweepAddress(utxos, nil, vendorKey, &redeemScript, wallet.NORMAL)
+		err = n.sweepEscrow(SigningActionConfirm, wal, contract, utxos)
 		if err != nil {
 			return err
 		}
@@ -165,6 +150,10 @@ func (n *OpenBazaarNode) ConfirmOfflineOrder(contract *pb.RicardianContract, rec
 }
 
 func (n *OpenBazaarNode) RejectOfflineOrder(contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 	orderId, err := n.CalcOrderId(contract.BuyerOrder)
 	if err != nil {
 		return err
@@ -191,50 +180,24 @@ func (n *OpenBazaarNode) RejectOfflineOrder(contract *pb.RicardianContract, reco
 			}
 		}
 
-		refundAddress, err := n.Wallet.DecodeAddress(contract.BuyerOrder.RefundAddress)
+		refundAddress, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
 		if err != nil {
 			return err
 		}
 		var output wallet.TransactionOutput
 
-		outputScript, err := n.Wallet.AddressToScript(refundAddress)
+		outputScript, err := wal.AddressToScript(refundAddress)
 		if err != nil {
 			return err
 		}
 		output.ScriptPubKey = outputScript
 		output.Value = outValue
 
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
-		if err != nil {
-			return err
-		}
-		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		mPrivKey := n.Wallet.MasterPrivateKey()
-		if err != nil {
-			return err
-		}
-		mECKey, err := mPrivKey.ECPrivKey()
-		if err != nil {
-			return err
-		}
-		hdKey := hd.NewExtendedKey(
-			n.Wallet.Params().HDPrivateKeyID[:],
-			mECKey.Serialize(),
-			chaincode,
-			parentFP,
-			0,
-			0,
-			true)
-
-		vendorKey, err := hdKey.Child(0)
-		if err != nil {
-			return err
-		}
 		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
 		if err != nil {
 			return err
 		}
-		signatures, err := n.Wallet.CreateMultisigSignature(ins, []wallet.TransactionOutput{output}, vendorKey, redeemScript, contract.BuyerOrder.RefundFee)
+		signatures, err := n.escrowSignatures(SigningActionReject, contract, ins, []wallet.TransactionOutput{output}, redeemScript, contract.BuyerOrder.RefundFee, "")
 		if err != nil {
 			return err
 		}
@@ -254,6 +217,10 @@ func (n *OpenBazaarNode) RejectOfflineOrder(contract *pb.RicardianContract, reco
 }
 
 func (n *OpenBazaarNode) ValidateOrderConfirmation(contract *pb.RicardianContract, validateAddress bool) error {
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 	orderID, err := n.CalcOrderId(contract.BuyerOrder)
 	if err != nil {
 		return err
@@ -295,7 +262,7 @@ func (n *OpenBazaarNode) ValidateOrderConfirmation(contract *pb.RicardianContrac
 		}
 	}
 	if validateAddress {
-		_, err = n.Wallet.DecodeAddress(contract.VendorOrderConfirmation.PaymentAddress)
+		_, err = wal.DecodeAddress(contract.VendorOrderConfirmation.PaymentAddress)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/OpenBazaar/openbazaar-go/api/notifications"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/lightning"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/namesys"
 	"github.com/OpenBazaar/openbazaar-go/net"
@@ -67,6 +68,15 @@ type OpenBazaarNode struct {
 	// Bitcoin wallet implementation
 	Wallet wallet.Wallet
 
+	// All wallets the node accepts payment with, including Wallet
+	Wallets MultiWallet
+
+	// Exchange rates for the coin of each wallet in Wallets
+	WalletExchangeRates map[string]bitcoin.ExchangeRates
+
+	// The datastore holding the keys and coins of each wallet in Wallets
+	WalletDatastores map[string]wallet.Datastore
+
 	// Storage for our outgoing messages
 	MessageStorage sto.OfflineMessagingStorage
 
@@ -76,6 +86,9 @@ type OpenBazaarNode struct {
 	// A service that periodically republishes active pointers
 	PointerRepublisher *rep.PointerRepublisher
 
+	// A service that discovers moderators on the network and caches their profiles
+	ModeratorDirectory *ModeratorDirectory
+
 	// Used to resolve domains to OpenBazaar IDs
 	NameSystem *namesys.NameSystem
 
@@ -99,6 +112,25 @@ type OpenBazaarNode struct {
 
 	// Last ditch API to find records that dropped out of the DHT
 	IPNSBackupAPI string
+
+	// How long after leaving a rating it may be amended or retracted
+	RatingAmendmentWindow time.Duration
+
+	// The vendor's escrow public key when the private key is kept offline. Nil if not enabled.
+	ColdStorage *ColdStorage
+
+	// Lightning backend used to create and pay invoices for small orders. Nil if not enabled.
+	Lightning lightning.Backend
+
+	// The highest order total in satoshis we accept Lightning payments for. Zero for no limit.
+	LightningMaxOrderAmount int64
+
+	// How long the Lightning invoices we create for orders can be paid
+	LightningInvoiceExpiry time.Duration
+
+	// Retention of the websocket event log. Zero disables the limit.
+	EventLogMaxAge    time.Duration
+	EventLogMaxEvents int
 }
 
 // Unpin the current node repo, re-add it, then publish to IPNS
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -12,6 +13,7 @@ import (
 	libp2p "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
 
 	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/wallet-interface"
@@ -28,6 +30,10 @@ var DisputeWg = new(sync.WaitGroup)
 var ErrCaseNotFound = errors.New("Case not found")
 
 func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContract, records []*wallet.TransactionRecord, claim string) error {
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 	var isPurchase bool
 	if n.IpfsNode.Identity.Pretty() == contract.BuyerOrder.BuyerID.PeerID {
 		isPurchase = true
@@ -57,7 +63,7 @@ func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContr
 	dispute.Outpoints = outpoints
 
 	// Add payout address
-	dispute.PayoutAddress = n.Wallet.CurrentAddress(wallet.EXTERNAL).EncodeAddress()
+	dispute.PayoutAddress = wal.CurrentAddress(wallet.EXTERNAL).EncodeAddress()
 
 	// Serialize contract
 	ser, err := proto.Marshal(contract)
@@ -77,9 +83,11 @@ func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContr
 	contract.Signatures = append(contract.Signatures, rc.Signatures[0])
 
 	// Send to moderator
-	err = n.SendDisputeOpen(contract.BuyerOrder.Payment.Moderator, nil, rc)
-	if err != nil {
-		return err
+	for _, mod := range PaymentModerators(contract.BuyerOrder.Payment) {
+		err = n.SendDisputeOpen(mod, nil, rc)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Send to counterparty
@@ -187,6 +195,10 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 	if len(contract.VendorListings) == 0 || contract.BuyerOrder == nil || contract.BuyerOrder.Payment == nil {
 		return errors.New("Serialized contract is malformatted")
 	}
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 
 	orderId, err := n.CalcOrderId(contract.BuyerOrder)
 	if err != nil {
@@ -209,7 +221,7 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 	var DisputerHandle string
 	var DisputeeID string
 	var DisputeeHandle string
-	if contract.BuyerOrder.Payment.Moderator == n.IpfsNode.Identity.Pretty() { // Moderator
+	if IsPaymentModerator(contract.BuyerOrder.Payment, n.IpfsNode.Identity.Pretty()) { // Moderator
 		validationErrors := n.ValidateCaseContract(contract)
 		var err error
 		if contract.VendorListings[0].VendorID.PeerID == peerID {
@@ -267,7 +279,7 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		}
 		update.SerializedContract = ser
 		update.OrderId = orderId
-		update.PayoutAddress = n.Wallet.CurrentAddress(wallet.EXTERNAL).EncodeAddress()
+		update.PayoutAddress = wal.CurrentAddress(wallet.EXTERNAL).EncodeAddress()
 
 		var outpoints []*pb.Outpoint
 		for _, r := range records {
@@ -280,9 +292,11 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		update.Outpoints = outpoints
 
 		// Send the message
-		err = n.SendDisputeUpdate(myContract.BuyerOrder.Payment.Moderator, update)
-		if err != nil {
-			return err
+		for _, mod := range PaymentModerators(myContract.BuyerOrder.Payment) {
+			err = n.SendDisputeUpdate(mod, update)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Append the dispute and signature
@@ -324,7 +338,7 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		}
 		update.SerializedContract = ser
 		update.OrderId = orderId
-		update.PayoutAddress = n.Wallet.CurrentAddress(wallet.EXTERNAL).EncodeAddress()
+		update.PayoutAddress = wal.CurrentAddress(wallet.EXTERNAL).EncodeAddress()
 
 		var outpoints []*pb.Outpoint
 		for _, r := range records {
@@ -337,9 +351,11 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		update.Outpoints = outpoints
 
 		// Send the message
-		err = n.SendDisputeUpdate(myContract.BuyerOrder.Payment.Moderator, update)
-		if err != nil {
-			return err
+		for _, mod := range PaymentModerators(myContract.BuyerOrder.Payment) {
+			err = n.SendDisputeUpdate(mod, update)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Append the dispute and signature
@@ -384,6 +400,10 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	if buyerContract == nil {
 		buyerContract = vendorContract
 	}
+	wal, err := n.WalletForOrder(buyerContract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 
 	d := new(pb.DisputeResolution)
 
@@ -438,7 +458,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		if len(vendorContract.VendorOrderFulfillment) > 0 && vendorContract.VendorOrderFulfillment[0].Payout != nil {
 			feePerByte = vendorContract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte
 		} else {
-			feePerByte = n.Wallet.GetFeePerByte(wallet.NORMAL)
+			feePerByte = wal.GetFeePerByte(wallet.NORMAL)
 		}
 		buyerId = vendorContract.BuyerOrder.BuyerID.PeerID
 		buyerKey, err = libp2p.UnmarshalPublicKey(vendorContract.BuyerOrder.BuyerID.Pubkeys.Identity)
@@ -459,7 +479,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		if len(vendorContract.VendorOrderFulfillment) > 0 && vendorContract.VendorOrderFulfillment[0].Payout != nil {
 			feePerByte = vendorContract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte
 		} else {
-			feePerByte = n.Wallet.GetFeePerByte(wallet.NORMAL)
+			feePerByte = wal.GetFeePerByte(wallet.NORMAL)
 		}
 		buyerId = vendorContract.BuyerOrder.BuyerID.PeerID
 		buyerKey, err = libp2p.UnmarshalPublicKey(vendorContract.BuyerOrder.BuyerID.Pubkeys.Identity)
@@ -501,14 +521,14 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	var outputs []wallet.TransactionOutput
 	var modAddr btcutil.Address
 	var modValue uint64
-	modAddr = n.Wallet.CurrentAddress(wallet.EXTERNAL)
+	modAddr = wal.CurrentAddress(wallet.EXTERNAL)
 	modValue, err = n.GetModeratorFee(totalOut)
 	if err != nil {
 		return err
 	}
 	var modOutputScript []byte
 	if modValue > 0 {
-		modOutputScript, err = n.Wallet.AddressToScript(modAddr)
+		modOutputScript, err = wal.AddressToScript(modAddr)
 		if err != nil {
 			return err
 		}
@@ -524,12 +544,12 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	var buyerValue uint64
 	var buyerOutputScript []byte
 	if buyerPayout {
-		buyerAddr, err = n.Wallet.DecodeAddress(buyerPayoutAddress)
+		buyerAddr, err = wal.DecodeAddress(buyerPayoutAddress)
 		if err != nil {
 			return err
 		}
 		buyerValue = uint64((float64(totalOut) - float64(modValue)) * (float64(buyerPercentage) / 100))
-		buyerOutputScript, err = n.Wallet.AddressToScript(buyerAddr)
+		buyerOutputScript, err = wal.AddressToScript(buyerAddr)
 		if err != nil {
 			return err
 		}
@@ -544,12 +564,12 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	var vendorValue uint64
 	var vendorOutputScript []byte
 	if vendorPayout {
-		vendorAddr, err = n.Wallet.DecodeAddress(vendorPayoutAddress)
+		vendorAddr, err = wal.DecodeAddress(vendorPayoutAddress)
 		if err != nil {
 			return err
 		}
 		vendorValue = uint64((float64(totalOut) - float64(modValue)) * (float64(vendorPercentage) / 100))
-		vendorOutputScript, err = n.Wallet.AddressToScript(vendorAddr)
+		vendorOutputScript, err = wal.AddressToScript(vendorAddr)
 		if err != nil {
 			return err
 		}
@@ -585,7 +605,11 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	}
 
 	// Calculate total fee
-	txFee := n.Wallet.EstimateFee(inputs, outputs, feePerByte)
+	redeemScriptBytes, err := hex.DecodeString(redeemScript)
+	if err != nil {
+		return err
+	}
+	txFee := bitcoin.EstimateMultisigFee(inputs, outputs, redeemScriptBytes, feePerByte)
 
 	// Subtract fee from each output in proportion to output value
 	var outs []wallet.TransactionOutput
@@ -593,7 +617,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		outPercentage := float64(output.Value) / float64(totalOut)
 		outputShareOfFee := outPercentage * float64(txFee)
 		val := output.Value - int64(outputShareOfFee)
-		if !n.Wallet.IsDust(val) {
+		if !wal.IsDust(val) {
 			o := wallet.TransactionOutput{
 				Value:        val,
 				ScriptPubKey: output.ScriptPubKey,
@@ -611,7 +635,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	if err != nil {
 		return err
 	}
-	mPrivKey := n.Wallet.MasterPrivateKey()
+	mPrivKey, err := bitcoin.MasterPrivateKey(wal)
 	if err != nil {
 		return err
 	}
@@ -620,7 +644,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		return err
 	}
 	hdKey := hd.NewExtendedKey(
-		n.Wallet.Params().HDPrivateKeyID[:],
+		wal.Params().HDPrivateKeyID[:],
 		mECKey.Serialize(),
 		chaincodeBytes,
 		parentFP,
@@ -633,8 +657,8 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		return err
 	}
 
-	// Sign buyer rating key
-	if buyerContract != nil {
+	// Sign buyer rating key. The rating signatures cover the lead moderator's key so on a panel only the lead can sign them.
+	if buyerContract != nil && buyerContract.BuyerOrder.Payment.Moderator == n.IpfsNode.Identity.Pretty() {
 		ecPriv, err := moderatorKey.ECPrivKey()
 		if err != nil {
 			return err
@@ -650,11 +674,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	}
 
 	// Create signatures
-	redeemScriptBytes, err := hex.DecodeString(redeemScript)
-	if err != nil {
-		return err
-	}
-	sigs, err := n.Wallet.CreateMultisigSignature(inputs, outs, moderatorKey, redeemScriptBytes, 0)
+	sigs, err := wal.CreateMultisigSignature(inputs, outs, moderatorKey, redeemScriptBytes, 0)
 	if err != nil {
 		return err
 	}
@@ -704,6 +724,11 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		return err
 	}
 
+	// A panel resolution must be endorsed by the rest of the panel before it goes to the buyer and vendor
+	if IsPanelPayment(buyerContract.BuyerOrder.Payment) {
+		return n.ProposePanelResolution(rc, buyerContract.BuyerOrder.Payment)
+	}
+
 	err = n.SendDisputeClose(buyerId, &buyerKey, rc)
 	if err != nil {
 		return err
@@ -876,55 +901,30 @@ func (n *OpenBazaarNode) ValidateCaseContract(contract *pb.RicardianContract) []
 			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
 			return validationErrors
 		}
-		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		mECKey, err := n.Wallet.MasterPublicKey().ECPubKey()
+		wal, err := n.WalletForOrder(contract.BuyerOrder)
 		if err != nil {
-			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
+			validationErrors = append(validationErrors, "The contract is paid in a currency we have no wallet for")
 			return validationErrors
 		}
-		hdKey := hd.NewExtendedKey(
-			n.Wallet.Params().HDPublicKeyID[:],
-			mECKey.SerializeCompressed(),
-			chaincode,
-			parentFP,
-			0,
-			0,
-			false)
-		moderatorKey, err := hdKey.Child(0)
+		mECKey, err := wal.MasterPublicKey().ECPubKey()
 		if err != nil {
 			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
 			return validationErrors
 		}
-
-		hdKey = hd.NewExtendedKey(
-			n.Wallet.Params().HDPublicKeyID[:],
-			contract.BuyerOrder.BuyerID.Pubkeys.Bitcoin,
-			chaincode,
-			parentFP,
-			0,
-			0,
-			false)
-
-		buyerKey, err := hdKey.Child(0)
-		if err != nil {
-			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
-			return validationErrors
+		// On a panel our key must be the one the buyer put in the order
+		if index := panelIndex(contract.BuyerOrder.Payment, n.IpfsNode.Identity.Pretty()); IsPanelPayment(contract.BuyerOrder.Payment) && index >= 0 {
+			moderatorKey, err := n.moderatorEscrowKey(mECKey.SerializeCompressed(), chaincode)
+			if err != nil || index >= len(contract.BuyerOrder.Payment.ModeratorKeys) || !bytes.Equal(moderatorKey, contract.BuyerOrder.Payment.ModeratorKeys[index]) {
+				validationErrors = append(validationErrors, "Our moderator key in the order is incorrect")
+			}
 		}
-		hdKey = hd.NewExtendedKey(
-			n.Wallet.Params().HDPublicKeyID[:],
-			contract.VendorListings[0].VendorID.Pubkeys.Bitcoin,
-			chaincode,
-			parentFP,
-			0,
-			0,
-			false)
-		vendorKey, err := hdKey.Child(0)
+		keys, threshold, timeoutKey, err := n.escrowPublicKeys(contract.BuyerOrder.Payment, contract.BuyerOrder.BuyerID.Pubkeys.Bitcoin, contract.VendorListings[0].VendorID.Pubkeys.Bitcoin, mECKey.SerializeCompressed())
 		if err != nil {
 			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
 			return validationErrors
 		}
 		timeout, _ := time.ParseDuration(strconv.Itoa(int(contract.VendorListings[0].Metadata.EscrowTimeoutHours)) + "h")
-		addr, redeemScript, err := n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKey}, 2, timeout, vendorKey)
+		addr, redeemScript, err := wal.GenerateMultisigScript(keys, threshold, timeout, timeoutKey)
 
 		if contract.BuyerOrder.Payment.Address != addr.EncodeAddress() {
 			validationErrors = append(validationErrors, "The calculated bitcoin address doesn't match the address in the order")
@@ -939,23 +939,33 @@ func (n *OpenBazaarNode) ValidateCaseContract(contract *pb.RicardianContract) []
 }
 
 func (n *OpenBazaarNode) ValidateDisputeResolution(contract *pb.RicardianContract) error {
-	err := n.verifySignatureOnDisputeResolution(contract)
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	err = n.verifySignatureOnDisputeResolution(contract)
 	if err != nil {
 		return err
 	}
 	if contract.DisputeResolution.Payout == nil || len(contract.DisputeResolution.Payout.Sigs) == 0 {
 		return errors.New("DisputeResolution contains invalid payout")
 	}
+	if IsPanelPayment(contract.BuyerOrder.Payment) {
+		err = n.verifyPanelEndorsements(contract, true)
+		if err != nil {
+			return err
+		}
+	}
 	checkWeOwnAddress := func(scriptPubKey string) error {
 		scriptBytes, err := hex.DecodeString(scriptPubKey)
 		if err != nil {
 			return err
 		}
-		addr, err := n.Wallet.ScriptToAddress(scriptBytes)
+		addr, err := wal.ScriptToAddress(scriptBytes)
 		if err != nil {
 			return err
 		}
-		if !n.Wallet.HasKey(addr) {
+		if !wal.HasKey(addr) {
 			return errors.New("Moderator payout sends coins to an address we don't control")
 		}
 		return nil
@@ -970,8 +980,16 @@ func (n *OpenBazaarNode) ValidateDisputeResolution(contract *pb.RicardianContrac
 }
 
 func (n *OpenBazaarNode) verifySignatureOnDisputeResolution(contract *pb.RicardianContract) error {
+	// A panel resolution is signed by whichever panel member proposed it
+	signer := contract.BuyerOrder.Payment.Moderator
+	if IsPanelPayment(contract.BuyerOrder.Payment) {
+		if !IsPaymentModerator(contract.BuyerOrder.Payment, contract.DisputeResolution.ProposedBy) {
+			return errors.New("Dispute resolution was not proposed by a panel moderator")
+		}
+		signer = contract.DisputeResolution.ProposedBy
+	}
 
-	moderatorID, err := peer.IDB58Decode(contract.BuyerOrder.Payment.Moderator)
+	moderatorID, err := peer.IDB58Decode(signer)
 	if err != nil {
 		return err
 	}
@@ -1009,85 +1027,11 @@ func (n *OpenBazaarNode) verifySignatureOnDisputeResolution(contract *pb.Ricardi
 }
 
 func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
-	// Create inputs
-	var inputs []wallet.TransactionInput
-	for _, o := range contract.DisputeResolution.Payout.Inputs {
-		decodedHash, err := hex.DecodeString(o.Hash)
-		if err != nil {
-			return err
-		}
-		input := wallet.TransactionInput{
-			OutpointHash:  decodedHash,
-			OutpointIndex: o.Index,
-			Value:         int64(o.Value),
-		}
-		inputs = append(inputs, input)
-	}
-
-	if len(inputs) == 0 {
-		return errors.New("Transaction has no inputs")
-	}
-
-	// Create outputs
-	var outputs []wallet.TransactionOutput
-	if contract.DisputeResolution.Payout.BuyerOutput != nil {
-		decodedScript, err := hex.DecodeString(contract.DisputeResolution.Payout.BuyerOutput.Script)
-		if err != nil {
-			return err
-		}
-		output := wallet.TransactionOutput{
-			ScriptPubKey: decodedScript,
-			Value:        int64(contract.DisputeResolution.Payout.BuyerOutput.Amount),
-		}
-		outputs = append(outputs, output)
-	}
-	if contract.DisputeResolution.Payout.VendorOutput != nil {
-		decodedScript, err := hex.DecodeString(contract.DisputeResolution.Payout.VendorOutput.Script)
-		if err != nil {
-			return err
-		}
-		output := wallet.TransactionOutput{
-			ScriptPubKey: decodedScript,
-			Value:        int64(contract.DisputeResolution.Payout.VendorOutput.Amount),
-		}
-		outputs = append(outputs, output)
-	}
-	if contract.DisputeResolution.Payout.ModeratorOutput != nil {
-		decodedScript, err := hex.DecodeString(contract.DisputeResolution.Payout.ModeratorOutput.Script)
-		if err != nil {
-			return err
-		}
-		output := wallet.TransactionOutput{
-			ScriptPubKey: decodedScript,
-			Value:        int64(contract.DisputeResolution.Payout.ModeratorOutput.Amount),
-		}
-		outputs = append(outputs, output)
-	}
-
-	// Create signing key
-	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-	chaincodeBytes, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
-	if err != nil {
-		return err
-	}
-	mPrivKey := n.Wallet.MasterPrivateKey()
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
 	if err != nil {
 		return err
 	}
-	mECKey, err := mPrivKey.ECPrivKey()
-	if err != nil {
-		return err
-	}
-	hdKey := hd.NewExtendedKey(
-		n.Wallet.Params().HDPrivateKeyID[:],
-		mECKey.Serialize(),
-		chaincodeBytes,
-		parentFP,
-		0,
-		0,
-		true)
-
-	signingKey, err := hdKey.Child(0)
+	inputs, outputs, err := payoutTransaction(contract.DisputeResolution.Payout)
 	if err != nil {
 		return err
 	}
@@ -1097,7 +1041,7 @@ func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []
 	if err != nil {
 		return err
 	}
-	mySigs, err := n.Wallet.CreateMultisigSignature(inputs, outputs, signingKey, redeemScriptBytes, 0)
+	mySigs, err := n.escrowSignatures(SigningActionRelease, contract, inputs, outputs, redeemScriptBytes, 0, "")
 	if err != nil {
 		return err
 	}
@@ -1133,10 +1077,79 @@ func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []
 		n.Datastore.Sales().Put(orderId, *contract, pb.OrderState_DECIDED, true)
 	}
 
-	_, err = n.Wallet.Multisign(inputs, outputs, mySigs, moderatorSigs, redeemScriptBytes, 0, true)
+	if IsPanelPayment(contract.BuyerOrder.Payment) {
+		moderatorSets, err := panelModeratorSignatures(contract.BuyerOrder.Payment, contract)
+		if err != nil {
+			return err
+		}
+		sets := append(splitSignatures(mySigs, escrowKeyCount(contract.BuyerOrder.Payment)), moderatorSets...)
+		_, err = bitcoin.MultisignMany(wal, inputs, outputs, sets, redeemScriptBytes, 0, true)
+		return err
+	}
+
+	_, err = wal.Multisign(inputs, outputs, mySigs, moderatorSigs, redeemScriptBytes, 0, true)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// Build the inputs and outputs of the transaction paying out a dispute resolution
+func payoutTransaction(payout *pb.DisputeResolution_Payout) ([]wallet.TransactionInput, []wallet.TransactionOutput, error) {
+	// Create inputs
+	var inputs []wallet.TransactionInput
+	for _, o := range payout.Inputs {
+		decodedHash, err := hex.DecodeString(o.Hash)
+		if err != nil {
+			return nil, nil, err
+		}
+		input := wallet.TransactionInput{
+			OutpointHash:  decodedHash,
+			OutpointIndex: o.Index,
+			Value:         int64(o.Value),
+		}
+		inputs = append(inputs, input)
+	}
+
+	if len(inputs) == 0 {
+		return nil, nil, errors.New("Transaction has no inputs")
+	}
+
+	// Create outputs
+	var outputs []wallet.TransactionOutput
+	if payout.BuyerOutput != nil {
+		decodedScript, err := hex.DecodeString(payout.BuyerOutput.Script)
+		if err != nil {
+			return nil, nil, err
+		}
+		output := wallet.TransactionOutput{
+			ScriptPubKey: decodedScript,
+			Value:        int64(payout.BuyerOutput.Amount),
+		}
+		outputs = append(outputs, output)
+	}
+	if payout.VendorOutput != nil {
+		decodedScript, err := hex.DecodeString(payout.VendorOutput.Script)
+		if err != nil {
+			return nil, nil, err
+		}
+		output := wallet.TransactionOutput{
+			ScriptPubKey: decodedScript,
+			Value:        int64(payout.VendorOutput.Amount),
+		}
+		outputs = append(outputs, output)
+	}
+	if payout.ModeratorOutput != nil {
+		decodedScript, err := hex.DecodeString(payout.ModeratorOutput.Script)
+		if err != nil {
+			return nil, nil, err
+		}
+		output := wallet.TransactionOutput{
+			ScriptPubKey: decodedScript,
+			Value:        int64(payout.ModeratorOutput.Amount),
+		}
+		outputs = append(outputs, output)
+	}
+	return inputs, outputs, nil
+}
@@ -8,14 +8,18 @@ import (
 
 	"time"
 
+	"github.com/OpenBazaar/jsonpb"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/wallet-interface"
-	hd "github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 )
 
 func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 	if fulfillment.Slug == "" && len(contract.VendorListings) == 1 {
 		fulfillment.Slug = contract.VendorListings[0].Slug
 	} else if fulfillment.Slug == "" && len(contract.VendorListings) > 1 {
@@ -24,9 +28,18 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 	rc := new(pb.RicardianContract)
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
 		payout := new(pb.OrderFulfillment_Payout)
-		currentAddress := n.Wallet.CurrentAddress(wallet.EXTERNAL)
-		payout.PayoutAddress = currentAddress.EncodeAddress()
-		payout.PayoutFeePerByte = n.Wallet.GetFeePerByte(wallet.NORMAL)
+		if n.vendorKeysOffline(contract) && fulfillment.Payout != nil {
+			// Resuming with imported signatures so the spend must match the exported one
+			payout.PayoutAddress = fulfillment.Payout.PayoutAddress
+			payout.PayoutFeePerByte = fulfillment.Payout.PayoutFeePerByte
+		} else {
+			currentAddress, err := n.vendorPayoutAddress(wal, wallet.EXTERNAL)
+			if err != nil {
+				return err
+			}
+			payout.PayoutAddress = currentAddress.EncodeAddress()
+			payout.PayoutFeePerByte = wal.GetFeePerByte(wallet.NORMAL)
+		}
 		var ins []wallet.TransactionInput
 		var outValue int64
 		for _, r := range records {
@@ -43,42 +56,26 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 
 		var output wallet.TransactionOutput
 
-		outputScript, err := n.Wallet.AddressToScript(currentAddress)
+		payoutAddress, err := wal.DecodeAddress(payout.PayoutAddress)
 		if err != nil {
 			return err
 		}
-		output.ScriptPubKey = outputScript
-		output.Value = outValue
-
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
-		if err != nil {
-			return err
-		}
-		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		mPrivKey := n.Wallet.MasterPrivateKey()
+		outputScript, err := wal.AddressToScript(payoutAddress)
 		if err != nil {
 			return err
 		}
-		mECKey, err := mPrivKey.ECPrivKey()
-		if err != nil {
-			return err
-		}
-		hdKey := hd.NewExtendedKey(
-			n.Wallet.Params().HDPrivateKeyID[:],
-			mECKey.Serialize(),
-			chaincode,
-			parentFP,
-			0,
-			0,
-			true)
+		output.ScriptPubKey = outputScript
+		output.Value = outValue
+
+		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
 
-		vendorKey, err := hdKey.Child(0)
+		fulfillment.Payout = payout
+		m := jsonpb.Marshaler{}
+		payload, err := m.MarshalToString(fulfillment)
 		if err != nil {
 			return err
 		}
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
-
-		signatures, err := n.Wallet.CreateMultisigSignature(ins, []wallet.TransactionOutput{output}, vendorKey, redeemScript, payout.PayoutFeePerByte)
+		signatures, err := n.escrowSignatures(SigningActionFulfill, contract, ins, []wallet.TransactionOutput{output}, redeemScript, payout.PayoutFeePerByte, payload)
 		if err != nil {
 			return err
 		}
@@ -186,6 +183,10 @@ func (n *OpenBazaarNode) SignOrderFulfillment(contract *pb.RicardianContract) (*
 }
 
 func (n *OpenBazaarNode) ValidateOrderFulfillment(fulfillment *pb.OrderFulfillment, contract *pb.RicardianContract) error {
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 	if err := verifySignaturesOnOrderFulfilment(contract); err != nil {
 		return err
 	}
@@ -236,7 +237,7 @@ func (n *OpenBazaarNode) ValidateOrderFulfillment(fulfillment *pb.OrderFulfillme
 		if fulfillment.Payout == nil {
 			return errors.New("Payout object for multisig is nil")
 		}
-		_, err := n.Wallet.DecodeAddress(fulfillment.Payout.PayoutAddress)
+		_, err := wal.DecodeAddress(fulfillment.Payout.PayoutAddress)
 		if err != nil {
 			return errors.New("Invalid payout address")
 		}
@@ -0,0 +1,143 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/lightning"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	ErrLightningDisabled    = errors.New("Lightning payments are not enabled")
+	ErrLightningCoin        = errors.New("Lightning payments are only accepted in bitcoin")
+	ErrLightningOrderAmount = errors.New("Order total is too high to pay with Lightning")
+	ErrLightningRefund      = errors.New("Orders paid with Lightning cannot be refunded by the node. Pay the buyer back from the Lightning node instead.")
+)
+
+// Lightning invoices are denominated in bitcoin so only bitcoin orders can use them
+func lightningCoin(wal wallet.Wallet) bool {
+	code := strings.ToUpper(wal.CurrencyCode())
+	return code == "BTC" || code == "TBTC"
+}
+
+// Create the invoice a Lightning order is paid with
+func (n *OpenBazaarNode) newLightningInvoice(wal wallet.Wallet, orderId string, amount uint64) (*lightning.Invoice, error) {
+	if n.Lightning == nil {
+		return nil, ErrLightningDisabled
+	}
+	if !lightningCoin(wal) {
+		return nil, ErrLightningCoin
+	}
+	if n.LightningMaxOrderAmount > 0 && int64(amount) > n.LightningMaxOrderAmount {
+		return nil, ErrLightningOrderAmount
+	}
+	return n.Lightning.CreateInvoice(int64(amount), "OpenBazaar order "+orderId, n.LightningInvoiceExpiry)
+}
+
+/* ValidateLightningInvoice checks the vendor's invoice pays for the order. The invoice can only
+   be decoded if we have a Lightning backend, otherwise the signed payment hash is trusted. */
+func (n *OpenBazaarNode) ValidateLightningInvoice(contract *pb.RicardianContract) error {
+	oc := contract.VendorOrderConfirmation
+	if oc.PaymentRequest == "" || oc.PaymentHash == "" {
+		return errors.New("Vendor did not respond with a Lightning invoice")
+	}
+	if n.Lightning == nil {
+		return nil
+	}
+	inv, err := n.Lightning.DecodeInvoice(oc.PaymentRequest)
+	if err != nil {
+		return err
+	}
+	if inv.PaymentHash != oc.PaymentHash {
+		return errors.New("Lightning invoice does not match the payment hash")
+	}
+	if inv.Amount != int64(contract.BuyerOrder.Payment.Amount) {
+		return errors.New("Lightning invoice is for the wrong amount")
+	}
+	return nil
+}
+
+/* purchaseWithLightning sends an order asking the vendor for a Lightning invoice. The vendor
+   must be online to create the invoice. The payment request is returned in place of an
+   address and can be paid with PayLightningOrder or any Lightning wallet. */
+func (n *OpenBazaarNode) purchaseWithLightning(contract *pb.RicardianContract) (string, string, uint64, bool, error) {
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+	if !lightningCoin(wal) {
+		return "", "", 0, false, ErrLightningCoin
+	}
+	total, err := n.CalculateOrderTotal(contract)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+	contract.BuyerOrder.Payment.Method = pb.Order_Payment_LIGHTNING
+	contract.BuyerOrder.Payment.Amount = total
+	contract, err = n.SignOrder(contract)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+
+	resp, err := n.SendOrder(contract.VendorListings[0].VendorID.PeerID, contract)
+	if err != nil {
+		return "", "", 0, false, errors.New("The vendor must be online to pay with Lightning")
+	}
+	if resp.MessageType == pb.Message_ERROR {
+		return "", "", 0, false, fmt.Errorf("Vendor rejected order, reason: %s", string(resp.Payload.Value))
+	}
+	if resp.MessageType != pb.Message_ORDER_CONFIRMATION {
+		return "", "", 0, false, errors.New("Vendor responded to the order with an incorrect message type")
+	}
+	if resp.Payload == nil {
+		return "", "", 0, false, errors.New("Vendor responded with nil payload")
+	}
+	rc := new(pb.RicardianContract)
+	if err := proto.Unmarshal(resp.Payload.Value, rc); err != nil {
+		return "", "", 0, false, errors.New("Error parsing the vendor's response")
+	}
+	contract.VendorOrderConfirmation = rc.VendorOrderConfirmation
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_ORDER_CONFIRMATION {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	if err := n.ValidateOrderConfirmation(contract, false); err != nil {
+		return "", "", 0, false, err
+	}
+	if err := n.ValidateLightningInvoice(contract); err != nil {
+		return "", "", 0, false, err
+	}
+	orderId, err := n.CalcOrderId(contract.BuyerOrder)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+	if err := n.Datastore.Purchases().Put(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false); err != nil {
+		return "", "", 0, false, err
+	}
+	return orderId, contract.VendorOrderConfirmation.PaymentRequest, contract.BuyerOrder.Payment.Amount, true, nil
+}
+
+/* PayLightningOrder pays the invoice of a Lightning purchase with our backend. The purchase is
+   marked funded by the backend's settlement callback. */
+func (n *OpenBazaarNode) PayLightningOrder(orderId string) error {
+	if n.Lightning == nil {
+		return ErrLightningDisabled
+	}
+	contract, state, _, _, _, err := n.Datastore.Purchases().GetByOrderId(orderId)
+	if err != nil {
+		return err
+	}
+	if contract.BuyerOrder.Payment.Method != pb.Order_Payment_LIGHTNING {
+		return errors.New("Order is not paid with Lightning")
+	}
+	if state != pb.OrderState_AWAITING_PAYMENT {
+		return errors.New("Order is not awaiting payment")
+	}
+	_, err = n.Lightning.PayInvoice(contract.VendorOrderConfirmation.PaymentRequest)
+	return err
+}
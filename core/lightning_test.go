@@ -0,0 +1,56 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/lightning"
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestValidateLightningInvoice(t *testing.T) {
+	network := lightning.NewFakeNetwork()
+	vendor := network.NewNode()
+	inv, err := vendor.CreateInvoice(20000, "OpenBazaar order", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract := &pb.RicardianContract{
+		BuyerOrder: &pb.Order{Payment: &pb.Order_Payment{Method: pb.Order_Payment_LIGHTNING, Amount: 20000}},
+		VendorOrderConfirmation: &pb.OrderConfirmation{
+			PaymentRequest: inv.PaymentRequest,
+			PaymentHash:    inv.PaymentHash,
+		},
+	}
+	node := &core.OpenBazaarNode{Lightning: network.NewNode()}
+	if err := node.ValidateLightningInvoice(contract); err != nil {
+		t.Error(err)
+	}
+
+	contract.BuyerOrder.Payment.Amount = 30000
+	if err := node.ValidateLightningInvoice(contract); err == nil {
+		t.Error("Accepted an invoice for the wrong amount")
+	}
+	contract.BuyerOrder.Payment.Amount = 20000
+
+	contract.VendorOrderConfirmation.PaymentHash = "00"
+	if err := node.ValidateLightningInvoice(contract); err == nil {
+		t.Error("Accepted an invoice with the wrong payment hash")
+	}
+
+	contract.VendorOrderConfirmation.PaymentRequest = ""
+	if err := (&core.OpenBazaarNode{}).ValidateLightningInvoice(contract); err == nil {
+		t.Error("Accepted a confirmation without an invoice")
+	}
+}
+
+func TestRefundLightningOrder(t *testing.T) {
+	n := new(core.OpenBazaarNode)
+	contract := &pb.RicardianContract{
+		BuyerOrder: &pb.Order{Payment: &pb.Order_Payment{Method: pb.Order_Payment_LIGHTNING, Amount: 20000}},
+	}
+	if err := n.RefundOrder(contract, nil); err != core.ErrLightningRefund {
+		t.Errorf("Expected a Lightning order to be refused a refund, got %v", err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -60,6 +61,7 @@ type ListingData struct {
 	Slug          string    `json:"slug"`
 	Title         string    `json:"title"`
 	Categories    []string  `json:"categories"`
+	Tags          []string  `json:"tags,omitempty"`
 	NSFW          bool      `json:"nsfw"`
 	ContractType  string    `json:"contractType"`
 	Description   string    `json:"description"`
@@ -70,6 +72,7 @@ type ListingData struct {
 	Language      string    `json:"language"`
 	AverageRating float32   `json:"averageRating"`
 	RatingCount   uint32    `json:"ratingCount"`
+	CreatedAt     string    `json:"createdAt,omitempty"`
 }
 
 func (n *OpenBazaarNode) GenerateSlug(title string) (string, error) {
@@ -116,8 +119,12 @@ func (n *OpenBazaarNode) SignListing(listing *pb.Listing) (*pb.SignedListing, er
 		}
 	}
 
-	// Set crypto currency
-	listing.Metadata.AcceptedCurrencies = []string{strings.ToUpper(n.Wallet.CurrencyCode())}
+	// Set crypto currencies. If none are given all of our wallets' currencies are accepted.
+	var err error
+	listing.Metadata.AcceptedCurrencies, err = n.filterAcceptedCurrencies(listing.Metadata.AcceptedCurrencies)
+	if err != nil {
+		return sl, err
+	}
 
 	// Sanitize a few critical fields
 	if listing.Item == nil {
@@ -158,7 +165,7 @@ func (n *OpenBazaarNode) SignListing(listing *pb.Listing) (*pb.SignedListing, er
 	}
 	p := new(pb.ID_Pubkeys)
 	p.Identity = pubkey
-	ecPubKey, err := n.Wallet.MasterPublicKey().ECPubKey()
+	ecPubKey, err := n.vendorMasterPublicKey(n.Wallet)
 	if err != nil {
 		return sl, err
 	}
@@ -166,13 +173,11 @@ func (n *OpenBazaarNode) SignListing(listing *pb.Listing) (*pb.SignedListing, er
 	id.Pubkeys = p
 	listing.VendorID = id
 
-	// Sign the GUID with the Bitcoin key
-	ecPrivKey, err := n.Wallet.MasterPrivateKey().ECPrivKey()
+	// Sign the GUID with the Bitcoin key. A cold storage key signed it offline.
+	id.BitcoinSig, err = n.bitcoinSig(id.PeerID)
 	if err != nil {
 		return sl, err
 	}
-	sig, err := ecPrivKey.Sign([]byte(id.PeerID))
-	id.BitcoinSig = sig.Serialize()
 
 	// Update coupon db
 	n.Datastore.Coupons().Delete(listing.Slug)
@@ -306,6 +311,7 @@ func (n *OpenBazaarNode) extractListingData(listing *pb.SignedListing) (ListingD
 		Slug:         listing.Listing.Slug,
 		Title:        listing.Listing.Item.Title,
 		Categories:   listing.Listing.Item.Categories,
+		Tags:         listing.Listing.Item.Tags,
 		NSFW:         listing.Listing.Item.Nsfw,
 		ContractType: listing.Listing.Metadata.ContractType.String(),
 		Description:  listing.Listing.Item.Description[:descriptionLength],
@@ -344,12 +350,14 @@ func (n *OpenBazaarNode) updateListingOnDisk(index []ListingData, ld ListingData
 	// Check to see if the listing we are adding already exists in the list. If so delete it.
 	var avgRating float32
 	var ratingCount uint32
+	var createdAt string
 	for i, d := range index {
 		if d.Slug != ld.Slug {
 			continue
 		}
 		avgRating = d.AverageRating
 		ratingCount = d.RatingCount
+		createdAt = d.CreatedAt
 
 		if len(index) == 1 {
 			index = []ListingData{}
@@ -363,6 +371,13 @@ func (n *OpenBazaarNode) updateListingOnDisk(index []ListingData, ld ListingData
 		ld.AverageRating = avgRating
 		ld.RatingCount = ratingCount
 	}
+	// Listings keep the time they were first added to the index
+	if ld.CreatedAt == "" {
+		ld.CreatedAt = createdAt
+	}
+	if ld.CreatedAt == "" {
+		ld.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	}
 	index = append(index, ld)
 
 	// Write it back to file
@@ -383,7 +398,13 @@ func (n *OpenBazaarNode) updateListingOnDisk(index []ListingData, ld ListingData
 	return nil
 }
 
-func (n *OpenBazaarNode) updateRatingInListingIndex(rating *pb.Rating) error {
+/* Add a rating to the listing's average. If replaced is set it is taken out of the average
+   first. A nil rating only removes the replaced one. */
+func (n *OpenBazaarNode) updateRatingInListingIndex(rating *pb.Rating, replaced *pb.Rating) error {
+	r := rating
+	if r == nil {
+		r = replaced
+	}
 	index, err := n.getListingIndex()
 	if err != nil {
 		return err
@@ -391,7 +412,7 @@ func (n *OpenBazaarNode) updateRatingInListingIndex(rating *pb.Rating) error {
 	var ld ListingData
 	exists := false
 	for _, l := range index {
-		if l.Slug != rating.RatingData.VendorSig.Metadata.ListingSlug {
+		if l.Slug != r.RatingData.VendorSig.Metadata.ListingSlug {
 			continue
 		}
 		ld = l
@@ -401,9 +422,19 @@ func (n *OpenBazaarNode) updateRatingInListingIndex(rating *pb.Rating) error {
 		return errors.New("Listing for rating does not exist in index")
 	}
 	totalRating := ld.AverageRating * float32(ld.RatingCount)
-	totalRating += float32(rating.RatingData.Overall)
-	ld.AverageRating = totalRating / float32(ld.RatingCount+1)
-	ld.RatingCount++
+	if replaced != nil && ld.RatingCount > 0 {
+		totalRating -= float32(replaced.RatingData.Overall)
+		ld.RatingCount--
+	}
+	if rating != nil {
+		totalRating += float32(rating.RatingData.Overall)
+		ld.RatingCount++
+	}
+	if ld.RatingCount > 0 {
+		ld.AverageRating = totalRating / float32(ld.RatingCount)
+	} else {
+		ld.AverageRating = 0
+	}
 	return n.updateListingOnDisk(index, ld, true)
 }
 
@@ -598,6 +629,58 @@ func (n *OpenBazaarNode) GetListings() ([]byte, error) {
 	return file, nil
 }
 
+// ErrPriceWithoutCurrency is returned for a price range which doesn't say what currency it's in
+var ErrPriceWithoutCurrency = errors.New("A currency is required to filter by price")
+
+/* QueryListings returns the page of a listing index matching the query and the number of
+   listings which match. It works on the index of any node so peers' listings can be queried
+   the same way. Prices in different currencies can't be compared so a price range must be
+   given with the currency. */
+func QueryListings(index []ListingData, q repo.ListingQuery) ([]ListingData, int, error) {
+	if (q.MinPrice > 0 || q.MaxPrice > 0) && q.Currency == "" {
+		return nil, 0, ErrPriceWithoutCurrency
+	}
+	var matches []ListingData
+	for _, l := range index {
+		if !repo.MatchesAny(q.Tags, l.Tags) || !repo.MatchesAny(q.Categories, l.Categories) {
+			continue
+		}
+		if q.ContractType != "" && !strings.EqualFold(q.ContractType, l.ContractType) {
+			continue
+		}
+		if q.Currency != "" && !strings.EqualFold(q.Currency, l.Price.CurrencyCode) {
+			continue
+		}
+		if l.Price.Amount < q.MinPrice || (q.MaxPrice > 0 && l.Price.Amount > q.MaxPrice) {
+			continue
+		}
+		if !q.Created.Contains(parseIndexTime(l.CreatedAt)) {
+			continue
+		}
+		matches = append(matches, l)
+	}
+
+	less := map[string]func(a, b ListingData) bool{
+		repo.SortDateAsc:    func(a, b ListingData) bool { return parseIndexTime(a.CreatedAt).Before(parseIndexTime(b.CreatedAt)) },
+		repo.SortDateDesc:   func(a, b ListingData) bool { return parseIndexTime(a.CreatedAt).After(parseIndexTime(b.CreatedAt)) },
+		repo.SortPriceAsc:   func(a, b ListingData) bool { return a.Price.Amount < b.Price.Amount },
+		repo.SortPriceDesc:  func(a, b ListingData) bool { return a.Price.Amount > b.Price.Amount },
+		repo.SortTitleAsc:   func(a, b ListingData) bool { return strings.ToLower(a.Title) < strings.ToLower(b.Title) },
+		repo.SortTitleDesc:  func(a, b ListingData) bool { return strings.ToLower(a.Title) > strings.ToLower(b.Title) },
+		repo.SortRatingDesc: func(a, b ListingData) bool { return a.AverageRating > b.AverageRating },
+	}[q.SortBy]
+	if less != nil {
+		sort.SliceStable(matches, func(i, j int) bool { return less(matches[i], matches[j]) })
+	}
+
+	slugs := make([]string, len(matches))
+	for i, l := range matches {
+		slugs[i] = l.Slug
+	}
+	start, end := q.Bounds(slugs)
+	return matches[start:end], len(matches), nil
+}
+
 func (n *OpenBazaarNode) GetListingFromHash(hash string) (*pb.SignedListing, error) {
 	// Read listings.json
 	indexPath := path.Join(n.RepoPath, "root", "listings.json")
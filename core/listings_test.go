@@ -0,0 +1,65 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestQueryListings(t *testing.T) {
+	newListing := func(slug, contractType, currency string, amount uint64, created string, tags ...string) core.ListingData {
+		l := core.ListingData{Slug: slug, Title: slug, ContractType: contractType, Tags: tags, CreatedAt: created}
+		l.Price.CurrencyCode = currency
+		l.Price.Amount = amount
+		return l
+	}
+	index := []core.ListingData{
+		newListing("shirt", "PHYSICAL_GOOD", "USD", 2000, "2018-03-01T00:00:00Z", "clothing"),
+		newListing("ebook", "DIGITAL_GOOD", "USD", 500, "2018-01-01T00:00:00Z", "books"),
+		newListing("hat", "PHYSICAL_GOOD", "BTC", 100000, "2018-02-01T00:00:00Z", "clothing", "hats"),
+		newListing("old", "PHYSICAL_GOOD", "USD", 100, ""),
+	}
+	slugs := func(listings []core.ListingData) string {
+		var s string
+		for _, l := range listings {
+			s += l.Slug + " "
+		}
+		return s
+	}
+
+	tests := []struct {
+		query    repo.ListingQuery
+		expected string
+		total    int
+	}{
+		{repo.ListingQuery{Page: repo.Page{Limit: -1}}, "shirt ebook hat old ", 4},
+		{repo.ListingQuery{Tags: []string{"Clothing"}, Page: repo.Page{Limit: -1}}, "shirt hat ", 2},
+		{repo.ListingQuery{ContractType: "physical_good", Currency: "usd", Page: repo.Page{Limit: -1}}, "shirt old ", 2},
+		{repo.ListingQuery{Currency: "USD", MinPrice: 200, MaxPrice: 5000, Page: repo.Page{Limit: -1}}, "shirt ebook ", 2},
+		{repo.ListingQuery{Currency: "BTC", MinPrice: 200, Page: repo.Page{Limit: -1}}, "hat ", 1},
+		{repo.ListingQuery{Created: repo.TimeRange{From: time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC)}, Page: repo.Page{Limit: -1}}, "shirt hat ", 2},
+		{repo.ListingQuery{SortBy: repo.SortPriceAsc, Page: repo.Page{Limit: 2}}, "old ebook ", 4},
+		{repo.ListingQuery{SortBy: repo.SortDateDesc, Page: repo.Page{OffsetID: "shirt", Limit: 1}}, "hat ", 4},
+		{repo.ListingQuery{SortBy: repo.SortTitleAsc, Page: repo.Page{OffsetID: "hat", Limit: -1}}, "old shirt ", 4},
+		{repo.ListingQuery{Tags: []string{"hats"}, Page: repo.Page{OffsetID: "hat", Limit: -1}}, "", 1},
+	}
+	for _, test := range tests {
+		listings, total, err := core.QueryListings(index, test.query)
+		if err != nil {
+			t.Error(err)
+		}
+		if s := slugs(listings); s != test.expected || total != test.total {
+			t.Errorf("%+v: expected %q of %d, got %q of %d", test.query, test.expected, test.total, s, total)
+		}
+	}
+}
+
+func TestQueryListingsPriceWithoutCurrency(t *testing.T) {
+	for _, q := range []repo.ListingQuery{{MinPrice: 200}, {MaxPrice: 5000}} {
+		if _, _, err := core.QueryListings(nil, q); err != core.ErrPriceWithoutCurrency {
+			t.Errorf("%+v: expected ErrPriceWithoutCurrency, got %v", q, err)
+		}
+	}
+}
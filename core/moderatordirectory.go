@@ -0,0 +1,287 @@
+package core
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	routing "gx/ipfs/QmUCS9EnqNq1kCnJds2eLDypBiS21aSiCf1MVzSUVB9TGA/go-libp2p-kad-dht"
+)
+
+const (
+	kModeratorDiscoveryFrequency = time.Hour
+	kModeratorLivenessFrequency  = time.Minute * 15
+	kModeratorExpiration         = time.Hour * 24 * 7
+)
+
+// A cached moderator profile along with the liveness data collected by the directory
+type ModeratorEntry struct {
+	PeerID     string     `json:"peerId"`
+	Profile    pb.Profile `json:"profile"`
+	Online     bool       `json:"online"`
+	LastSeen   time.Time  `json:"lastSeen"`
+	LastCheck  time.Time  `json:"lastCheck"`
+	Checks     int        `json:"checks"`
+	Responses  int        `json:"responses"`
+	Discovered time.Time  `json:"discovered"`
+}
+
+// The fraction of liveness checks this moderator has responded to
+func (e *ModeratorEntry) ResponseRate() float64 {
+	if e.Checks == 0 {
+		return 0
+	}
+	return float64(e.Responses) / float64(e.Checks)
+}
+
+/* Filter options for searching the moderator directory.
+   Zero values are ignored. Fixed fees are compared in satoshi so
+   MaxFixedFee may be denominated in any currency we have a rate for. */
+type ModeratorFilter struct {
+	Currencies      []string
+	Languages       []string
+	FeeTypes        []pb.Moderator_Fee_FeeType
+	MaxPercentage   float32
+	MaxFixedFee     *pb.Moderator_Price
+	MinRating       float32
+	MinRatingCount  uint32
+	MinResponseRate float64
+	OnlineOnly      bool
+}
+
+// ModeratorDirectory periodically walks the moderator pointers in the DHT and caches the profiles it finds
+type ModeratorDirectory struct {
+	node    *OpenBazaarNode
+	entries map[string]*ModeratorEntry
+	lock    sync.RWMutex
+}
+
+func NewModeratorDirectory(node *OpenBazaarNode) *ModeratorDirectory {
+	return &ModeratorDirectory{
+		node:    node,
+		entries: make(map[string]*ModeratorEntry),
+	}
+}
+
+func (d *ModeratorDirectory) Run() {
+	discover := time.NewTicker(kModeratorDiscoveryFrequency)
+	defer discover.Stop()
+	liveness := time.NewTicker(kModeratorLivenessFrequency)
+	defer liveness.Stop()
+	go d.Discover()
+	for {
+		select {
+		case <-discover.C:
+			go d.Discover()
+		case <-liveness.C:
+			go d.CheckLiveness()
+		}
+	}
+}
+
+// Discover walks the moderator pointers and fetches the profile of any moderator we find
+func (d *ModeratorDirectory) Discover() {
+	dht, ok := d.node.IpfsNode.Routing.(*routing.IpfsDHT)
+	if !ok {
+		log.Error("Moderator directory requires the DHT routing system")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+	found := make(map[string]bool)
+	var wg sync.WaitGroup
+	for p := range ipfs.FindPointersAsync(dht, ctx, ModeratorPointerID, 64) {
+		pid, err := ExtractIDFromPointer(p)
+		if err != nil || found[pid] {
+			continue
+		}
+		found[pid] = true
+		wg.Add(1)
+		go func(pid string) {
+			defer wg.Done()
+			d.Refresh(pid)
+		}(pid)
+	}
+	wg.Wait()
+	d.prune()
+}
+
+// Refresh fetches the latest profile for the given moderator and updates the cache
+func (d *ModeratorDirectory) Refresh(peerID string) error {
+	profile, err := d.node.FetchProfile(peerID, false)
+	if err != nil {
+		return err
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if !profile.Moderator || profile.ModeratorInfo == nil {
+		delete(d.entries, peerID)
+		return nil
+	}
+	entry, ok := d.entries[peerID]
+	if !ok {
+		entry = &ModeratorEntry{PeerID: peerID, Discovered: time.Now()}
+		d.entries[peerID] = entry
+	}
+	entry.Profile = profile
+	return nil
+}
+
+// CheckLiveness pings each cached moderator and records whether it responded
+func (d *ModeratorDirectory) CheckLiveness() {
+	if d.node.Service == nil {
+		return
+	}
+	d.lock.RLock()
+	var ids []string
+	for id := range d.entries {
+		ids = append(ids, id)
+	}
+	d.lock.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func(pid string) {
+			defer wg.Done()
+			status, err := d.node.GetPeerStatus(pid)
+			online := err == nil && status == "online"
+			d.lock.Lock()
+			defer d.lock.Unlock()
+			entry, ok := d.entries[pid]
+			if !ok {
+				return
+			}
+			entry.Checks++
+			entry.LastCheck = time.Now()
+			entry.Online = online
+			if online {
+				entry.Responses++
+				entry.LastSeen = entry.LastCheck
+			}
+		}(id)
+	}
+	wg.Wait()
+}
+
+// Remove moderators we haven't been able to reach for a long time
+func (d *ModeratorDirectory) prune() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for id, entry := range d.entries {
+		lastSeen := entry.LastSeen
+		if lastSeen.IsZero() {
+			lastSeen = entry.Discovered
+		}
+		if time.Since(lastSeen) > kModeratorExpiration {
+			delete(d.entries, id)
+		}
+	}
+}
+
+// Search returns the cached moderators matching the filter sorted by average rating
+func (d *ModeratorDirectory) Search(filter ModeratorFilter) []ModeratorEntry {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	var ret []ModeratorEntry
+	for _, entry := range d.entries {
+		if d.matches(entry, filter) {
+			ret = append(ret, *entry)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		ri, rj := averageRating(&ret[i].Profile), averageRating(&ret[j].Profile)
+		if ri == rj {
+			return ret[i].ResponseRate() > ret[j].ResponseRate()
+		}
+		return ri > rj
+	})
+	return ret
+}
+
+func (d *ModeratorDirectory) matches(entry *ModeratorEntry, filter ModeratorFilter) bool {
+	if !MatchModerator(&entry.Profile, filter) {
+		return false
+	}
+	if filter.OnlineOnly && !entry.Online {
+		return false
+	}
+	if filter.MinResponseRate > 0 && entry.ResponseRate() < filter.MinResponseRate {
+		return false
+	}
+	if filter.MaxFixedFee != nil {
+		fee := entry.Profile.ModeratorInfo.Fee
+		if fee.FeeType == pb.Moderator_Fee_FIXED || fee.FeeType == pb.Moderator_Fee_FIXED_PLUS_PERCENTAGE {
+			if fee.FixedFee == nil {
+				return false
+			}
+			max, err := d.node.getPriceInSatoshi("", filter.MaxFixedFee.CurrencyCode, filter.MaxFixedFee.Amount)
+			if err != nil {
+				return false
+			}
+			amt, err := d.node.getPriceInSatoshi("", fee.FixedFee.CurrencyCode, fee.FixedFee.Amount)
+			if err != nil || amt > max {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MatchModerator checks the profile against the filter fields which do not require any network data
+func MatchModerator(profile *pb.Profile, filter ModeratorFilter) bool {
+	if !profile.Moderator || profile.ModeratorInfo == nil || profile.ModeratorInfo.Fee == nil {
+		return false
+	}
+	info := profile.ModeratorInfo
+	if len(filter.Currencies) > 0 && !containsAnyFold(info.AcceptedCurrencies, filter.Currencies) {
+		return false
+	}
+	if len(filter.Languages) > 0 && !containsAnyFold(info.Languages, filter.Languages) {
+		return false
+	}
+	if len(filter.FeeTypes) > 0 {
+		match := false
+		for _, ft := range filter.FeeTypes {
+			if info.Fee.FeeType == ft {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	if filter.MaxPercentage > 0 && info.Fee.FeeType != pb.Moderator_Fee_FIXED && info.Fee.Percentage > filter.MaxPercentage {
+		return false
+	}
+	if filter.MinRating > 0 && averageRating(profile) < filter.MinRating {
+		return false
+	}
+	if filter.MinRatingCount > 0 && (profile.Stats == nil || profile.Stats.RatingCount < filter.MinRatingCount) {
+		return false
+	}
+	return true
+}
+
+func averageRating(profile *pb.Profile) float32 {
+	if profile.Stats == nil {
+		return 0
+	}
+	return profile.Stats.AverageRating
+}
+
+func containsAnyFold(have []string, want []string) bool {
+	for _, h := range have {
+		for _, w := range want {
+			if strings.EqualFold(h, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestMatchModerator(t *testing.T) {
+	profile := &pb.Profile{
+		Moderator: true,
+		ModeratorInfo: &pb.Moderator{
+			Languages:          []string{"English", "Spanish"},
+			AcceptedCurrencies: []string{"BTC"},
+			Fee: &pb.Moderator_Fee{
+				FeeType:    pb.Moderator_Fee_PERCENTAGE,
+				Percentage: 5,
+			},
+		},
+		Stats: &pb.Profile_Stats{
+			RatingCount:   10,
+			AverageRating: 4.5,
+		},
+	}
+	tests := []struct {
+		filter core.ModeratorFilter
+		match  bool
+	}{
+		{core.ModeratorFilter{}, true},
+		{core.ModeratorFilter{Currencies: []string{"btc"}}, true},
+		{core.ModeratorFilter{Currencies: []string{"BCH"}}, false},
+		{core.ModeratorFilter{Languages: []string{"spanish", "German"}}, true},
+		{core.ModeratorFilter{Languages: []string{"German"}}, false},
+		{core.ModeratorFilter{FeeTypes: []pb.Moderator_Fee_FeeType{pb.Moderator_Fee_FIXED}}, false},
+		{core.ModeratorFilter{FeeTypes: []pb.Moderator_Fee_FeeType{pb.Moderator_Fee_FIXED, pb.Moderator_Fee_PERCENTAGE}}, true},
+		{core.ModeratorFilter{MaxPercentage: 4}, false},
+		{core.ModeratorFilter{MaxPercentage: 5}, true},
+		{core.ModeratorFilter{MinRating: 4}, true},
+		{core.ModeratorFilter{MinRating: 4.8}, false},
+		{core.ModeratorFilter{MinRatingCount: 11}, false},
+	}
+	for i, test := range tests {
+		if core.MatchModerator(profile, test.filter) != test.match {
+			t.Errorf("Test %d: expected match to be %t", i, test.match)
+		}
+	}
+
+	profile.Moderator = false
+	if core.MatchModerator(profile, core.ModeratorFilter{}) {
+		t.Error("Matched a profile that is not a moderator")
+	}
+}
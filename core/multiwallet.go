@@ -0,0 +1,161 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/wallet-interface"
+)
+
+var ErrUnsupportedCurrency = errors.New("No wallet is configured for this currency")
+
+/* MultiWallet holds the wallets the node accepts payment with, keyed by upper case currency
+   code. All wallets are created from the same mnemonic so the bitcoin key in our ID can be
+   used to derive escrow keys for any of them. */
+type MultiWallet map[string]wallet.Wallet
+
+// Codes returns the currency codes of the wallets in sorted order
+func (m MultiWallet) Codes() []string {
+	var codes []string
+	for code := range m {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+/* WalletForCurrency returns the wallet for the currency code. The code may be given without
+   the testnet prefix, as it is in listing prices. An empty code returns the primary wallet. */
+func (n *OpenBazaarNode) WalletForCurrency(code string) (wallet.Wallet, error) {
+	if code == "" {
+		return n.Wallet, nil
+	}
+	code = strings.ToUpper(code)
+	wallets := n.Wallets
+	if wallets == nil && n.Wallet != nil {
+		wallets = MultiWallet{strings.ToUpper(n.Wallet.CurrencyCode()): n.Wallet}
+	}
+	if w, ok := wallets[code]; ok {
+		return w, nil
+	}
+	if w, ok := wallets["T"+code]; ok {
+		return w, nil
+	}
+	return nil, ErrUnsupportedCurrency
+}
+
+// WalletForOrder returns the wallet the order is paid with. Orders made before the payment coin was recorded use the primary wallet.
+func (n *OpenBazaarNode) WalletForOrder(order *pb.Order) (wallet.Wallet, error) {
+	return n.WalletForCurrency(order.Payment.GetCoin())
+}
+
+// Returns the exchange rates for the wallet's coin, falling back to the primary wallet's rates
+func (n *OpenBazaarNode) exchangeRatesForCurrency(code string) bitcoin.ExchangeRates {
+	if code != "" {
+		w, err := n.WalletForCurrency(code)
+		if err == nil {
+			if rates, ok := n.WalletExchangeRates[strings.ToUpper(w.CurrencyCode())]; ok && rates != nil {
+				return rates
+			}
+		}
+	}
+	return n.ExchangeRates
+}
+
+// AcceptedCurrencies returns the currency codes of all our wallets with the primary wallet's first
+func (n *OpenBazaarNode) AcceptedCurrencies() []string {
+	primary := strings.ToUpper(n.Wallet.CurrencyCode())
+	currencies := []string{primary}
+	for _, code := range n.Wallets.Codes() {
+		if code != primary {
+			currencies = append(currencies, code)
+		}
+	}
+	return currencies
+}
+
+/* Check the requested currencies against our wallets. If none are requested all of our
+   wallets' currencies are returned. */
+func (n *OpenBazaarNode) filterAcceptedCurrencies(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return n.AcceptedCurrencies(), nil
+	}
+	var currencies []string
+	seen := make(map[string]bool)
+	for _, c := range requested {
+		w, err := n.WalletForCurrency(c)
+		if err != nil {
+			return nil, fmt.Errorf("No wallet is configured for %s", c)
+		}
+		code := strings.ToUpper(w.CurrencyCode())
+		if !seen[code] {
+			seen[code] = true
+			currencies = append(currencies, code)
+		}
+	}
+	return currencies, nil
+}
+
+/* Pick the coin to pay for the listings with. If coin is set it must be accepted by every
+   listing, otherwise the first currency accepted by all listings that we have a wallet for
+   is used. */
+func (n *OpenBazaarNode) selectPaymentCoin(coin string, listings []*pb.Listing) (string, error) {
+	accepts := func(l *pb.Listing, code string) bool {
+		for _, c := range l.Metadata.AcceptedCurrencies {
+			if strings.EqualFold(c, code) || strings.EqualFold("t"+c, code) || strings.EqualFold(c, "t"+code) {
+				return true
+			}
+		}
+		return false
+	}
+	acceptedByAll := func(code string) bool {
+		for _, l := range listings {
+			if !accepts(l, code) {
+				return false
+			}
+		}
+		return true
+	}
+	if coin != "" {
+		w, err := n.WalletForCurrency(coin)
+		if err != nil {
+			return "", err
+		}
+		code := strings.ToUpper(w.CurrencyCode())
+		if !acceptedByAll(code) {
+			return "", fmt.Errorf("Not all listings accept %s", coin)
+		}
+		return code, nil
+	}
+	if len(listings) == 0 {
+		return "", errors.New("No listings in order")
+	}
+	for _, c := range listings[0].Metadata.AcceptedCurrencies {
+		w, err := n.WalletForCurrency(c)
+		if err != nil {
+			continue
+		}
+		code := strings.ToUpper(w.CurrencyCode())
+		if acceptedByAll(code) {
+			return code, nil
+		}
+	}
+	return "", fmt.Errorf("Listings only accept %s, our wallets use %s", strings.Join(listings[0].Metadata.AcceptedCurrencies, ", "), strings.Join(n.AcceptedCurrencies(), ", "))
+}
+
+// CloseWallets closes the primary wallet and any additional wallets
+func (n *OpenBazaarNode) CloseWallets() {
+	if n.Lightning != nil {
+		n.Lightning.Close()
+	}
+	n.Wallet.Close()
+	for _, w := range n.Wallets {
+		if w != n.Wallet {
+			w.Close()
+		}
+	}
+}
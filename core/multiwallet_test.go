@@ -0,0 +1,23 @@
+package core_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+)
+
+func TestMultiWalletCodes(t *testing.T) {
+	m := core.MultiWallet{
+		"ZEC": nil,
+		"BTC": nil,
+		"BCH": nil,
+	}
+	if codes := m.Codes(); !reflect.DeepEqual(codes, []string{"BCH", "BTC", "ZEC"}) {
+		t.Error("Codes returned incorrect order", codes)
+	}
+	var empty core.MultiWallet
+	if len(empty.Codes()) != 0 {
+		t.Error("Empty MultiWallet returned codes")
+	}
+}
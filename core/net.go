@@ -401,6 +401,30 @@ func (n *OpenBazaarNode) SendDisputeClose(peerId string, k *libp2p.PubKey, resol
 	return nil
 }
 
+func (n *OpenBazaarNode) SendPanelResolution(peerId string, resolutionMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(resolutionMessage)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_PANEL_RESOLUTION,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, nil, m)
+}
+
+func (n *OpenBazaarNode) SendRatingAmendment(peerId string, k *libp2p.PubKey, amendment *pb.RatingAmendment) error {
+	a, err := ptypes.MarshalAny(amendment)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_RATING_AMENDMENT,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, k, m)
+}
+
 func (n *OpenBazaarNode) SendChat(peerId string, chatMessage *pb.Chat) error {
 	a, err := ptypes.MarshalAny(chatMessage)
 	if err != nil {
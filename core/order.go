@@ -15,6 +15,7 @@ import (
 	"strconv"
 
 	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/wallet-interface"
@@ -47,17 +48,21 @@ type item struct {
 }
 
 type PurchaseData struct {
-	ShipTo               string  `json:"shipTo"`
-	Address              string  `json:"address"`
-	City                 string  `json:"city"`
-	State                string  `json:"state"`
-	PostalCode           string  `json:"postalCode"`
-	CountryCode          string  `json:"countryCode"`
-	AddressNotes         string  `json:"addressNotes"`
-	Moderator            string  `json:"moderator"`
-	Items                []item  `json:"items"`
-	AlternateContactInfo string  `json:"alternateContactInfo"`
-	RefundAddress        *string `json:"refundAddress"` //optional, can be left out of json
+	ShipTo               string   `json:"shipTo"`
+	Address              string   `json:"address"`
+	City                 string   `json:"city"`
+	State                string   `json:"state"`
+	PostalCode           string   `json:"postalCode"`
+	CountryCode          string   `json:"countryCode"`
+	AddressNotes         string   `json:"addressNotes"`
+	Moderator            string   `json:"moderator"`
+	Moderators           []string `json:"moderators"`         // optional, selects a moderator panel
+	Lightning            bool     `json:"lightning"`          // optional, pays with a Lightning invoice
+	ModeratorThreshold   uint32   `json:"moderatorThreshold"` // number of panel moderators needed to resolve a dispute
+	Items                []item   `json:"items"`
+	AlternateContactInfo string   `json:"alternateContactInfo"`
+	RefundAddress        *string  `json:"refundAddress"` //optional, can be left out of json
+	PaymentCoin          string   `json:"paymentCoin"`   // optional, defaults to the first coin all listings accept
 }
 
 // We use this to check to see if the approximate fee to release funds from escrow is greater than 1/4th of the amount
@@ -71,51 +76,58 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 	if err != nil {
 		return "", "", 0, false, err
 	}
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return "", "", 0, false, err
+	}
 
 	// Add payment data and send to vendor
-	if data.Moderator != "" { // Moderated payment
-		if data.Moderator == n.IpfsNode.Identity.Pretty() {
-			return "", "", 0, false, errors.New("Cannot select self as moderator")
-		}
-		if data.Moderator == contract.VendorListings[0].VendorID.PeerID {
-			return "", "", 0, false, errors.New("Cannot select vendor as moderator")
-		}
-		payment := new(pb.Order_Payment)
-		payment.Method = pb.Order_Payment_MODERATED
-		payment.Moderator = data.Moderator
-
-		ipnsPath := ipfspath.FromString(data.Moderator + "/profile.json")
-		profileBytes, err := n.IPNSResolveThenCat(ipnsPath, time.Minute)
-		if err != nil {
-			return "", "", 0, false, errors.New("Moderator could not be found")
+	if data.Lightning {
+		if data.Moderator != "" || len(data.Moderators) > 0 {
+			return "", "", 0, false, errors.New("Lightning payments cannot be moderated")
 		}
-		profile := new(pb.Profile)
-		err = jsonpb.UnmarshalString(string(profileBytes), profile)
-		if err != nil {
-			return "", "", 0, false, err
-		}
-		moderatorKeyBytes, err := hex.DecodeString(profile.BitcoinPubkey)
-		if err != nil {
-			return "", "", 0, false, err
+		return n.purchaseWithLightning(contract)
+	}
+	if data.Moderator != "" || len(data.Moderators) > 0 { // Moderated payment
+		moderators := data.Moderators
+		if len(moderators) == 0 {
+			moderators = []string{data.Moderator}
 		}
-		if !profile.Moderator || profile.ModeratorInfo == nil || len(profile.ModeratorInfo.AcceptedCurrencies) == 0 {
-			return "", "", 0, false, errors.New("Moderator is not capable of moderating this transaction")
+		if len(moderators) > 1 {
+			err = ValidatePanel(moderators, data.ModeratorThreshold)
+			if err != nil {
+				return "", "", 0, false, err
+			}
+			if !bitcoin.SupportsMultisignMany(wal) {
+				return "", "", 0, false, errors.New("Wallet does not support moderator panels")
+			}
 		}
-		currencyAccepted := false
-		for _, currency := range profile.ModeratorInfo.AcceptedCurrencies {
-			if strings.ToLower(currency) == strings.ToLower(n.Wallet.CurrencyCode()) {
-				currencyAccepted = true
+		for _, mod := range moderators {
+			if mod == n.IpfsNode.Identity.Pretty() {
+				return "", "", 0, false, errors.New("Cannot select self as moderator")
+			}
+			if mod == contract.VendorListings[0].VendorID.PeerID {
+				return "", "", 0, false, errors.New("Cannot select vendor as moderator")
 			}
 		}
-		if !currencyAccepted {
-			return "", "", 0, false, errors.New("Moderator does not accept our currency")
+		payment := contract.BuyerOrder.Payment
+		payment.Method = pb.Order_Payment_MODERATED
+		payment.Moderator = moderators[0]
+
+		var moderatorKeys [][]byte
+		for _, mod := range moderators {
+			moderatorKeyBytes, err := n.getModeratorBitcoinKey(mod, contract.BuyerOrder.Payment.Coin)
+			if err != nil {
+				return "", "", 0, false, err
+			}
+			moderatorKeys = append(moderatorKeys, moderatorKeyBytes)
 		}
 		total, err := n.CalculateOrderTotal(contract)
 		if err != nil {
 			return "", "", 0, false, err
 		}
 		payment.Amount = total
-		fpb := n.Wallet.GetFeePerByte(wallet.NORMAL)
+		fpb := wal.GetFeePerByte(wallet.NORMAL)
 		if (fpb * EscrowReleaseSize) > (payment.Amount / 4) {
 			return "", "", 0, false, errors.New("Transaction fee too high for moderated payment")
 		}
@@ -127,68 +139,41 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 		if err != nil {
 			return "", "", 0, false, err
 		}
-		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		hdKey := hd.NewExtendedKey(
-			n.Wallet.Params().HDPublicKeyID[:],
-			contract.VendorListings[0].VendorID.Pubkeys.Bitcoin,
-			chaincode,
-			parentFP,
-			0,
-			0,
-			false)
-
-		vendorKey, err := hdKey.Child(0)
-		if err != nil {
-			return "", "", 0, false, err
+		payment.Chaincode = hex.EncodeToString(chaincode)
+		for _, key := range moderatorKeys {
+			modPub, err := n.moderatorEscrowKey(key, chaincode)
+			if err != nil {
+				return "", "", 0, false, err
+			}
+			payment.ModeratorKeys = append(payment.ModeratorKeys, modPub)
 		}
-		hdKey = hd.NewExtendedKey(
-			n.Wallet.Params().HDPublicKeyID[:],
-			contract.BuyerOrder.BuyerID.Pubkeys.Bitcoin,
-			chaincode,
-			parentFP,
-			0,
-			0,
-			false)
-
-		buyerKey, err := hdKey.Child(0)
-		if err != nil {
-			return "", "", 0, false, err
+		payment.ModeratorKey = payment.ModeratorKeys[0]
+		if len(moderators) > 1 {
+			payment.Moderators = moderators
+			payment.ModeratorThreshold = data.ModeratorThreshold
+		} else {
+			payment.ModeratorKeys = nil
 		}
-		hdKey = hd.NewExtendedKey(
-			n.Wallet.Params().HDPublicKeyID[:],
-			moderatorKeyBytes,
-			chaincode,
-			parentFP,
-			0,
-			0,
-			false)
 
-		moderatorKey, err := hdKey.Child(0)
+		keys, threshold, timeoutKey, err := n.escrowPublicKeys(payment, contract.BuyerOrder.BuyerID.Pubkeys.Bitcoin, contract.VendorListings[0].VendorID.Pubkeys.Bitcoin, moderatorKeys[0])
 		if err != nil {
 			return "", "", 0, false, err
 		}
-		modPub, err := moderatorKey.ECPubKey()
-		if err != nil {
-			return "", "", 0, false, err
-		}
-		payment.ModeratorKey = modPub.SerializeCompressed()
-
 		timeout, err := time.ParseDuration(strconv.Itoa(int(contract.VendorListings[0].Metadata.EscrowTimeoutHours)) + "h")
-		addr, redeemScript, err := n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKey}, 2, timeout, vendorKey)
+		addr, redeemScript, err := wal.GenerateMultisigScript(keys, threshold, timeout, timeoutKey)
 		if err != nil {
 			return "", "", 0, false, err
 		}
 		payment.Address = addr.EncodeAddress()
 		payment.RedeemScript = hex.EncodeToString(redeemScript)
-		payment.Chaincode = hex.EncodeToString(chaincode)
 		contract.BuyerOrder.Payment = payment
-		contract.BuyerOrder.RefundFee = n.Wallet.GetFeePerByte(wallet.NORMAL)
+		contract.BuyerOrder.RefundFee = wal.GetFeePerByte(wallet.NORMAL)
 
-		script, err := n.Wallet.AddressToScript(addr)
+		script, err := wal.AddressToScript(addr)
 		if err != nil {
 			return "", "", 0, false, err
 		}
-		err = n.Wallet.AddWatchedScript(script)
+		err = wal.AddWatchedScript(script)
 		if err != nil {
 			return "", "", 0, false, err
 		}
@@ -265,7 +250,7 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 			return orderId, contract.VendorOrderConfirmation.PaymentAddress, contract.BuyerOrder.Payment.Amount, true, nil
 		}
 	} else { // Direct payment
-		payment := new(pb.Order_Payment)
+		payment := contract.BuyerOrder.Payment
 		payment.Method = pb.Order_Payment_ADDRESS_REQUEST
 		total, err := n.CalculateOrderTotal(contract)
 		if err != nil {
@@ -284,7 +269,7 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 		if err != nil { // Vendor offline
 			// Change payment code to direct
 
-			fpb := n.Wallet.GetFeePerByte(wallet.NORMAL)
+			fpb := wal.GetFeePerByte(wallet.NORMAL)
 			if (fpb * EscrowReleaseSize) > (payment.Amount / 4) {
 				return "", "", 0, false, errors.New("Transaction fee too high for offline 2of2 multisig payment")
 			}
@@ -299,7 +284,7 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 			}
 			parentFP := []byte{0x00, 0x00, 0x00, 0x00}
 			hdKey := hd.NewExtendedKey(
-				n.Wallet.Params().HDPublicKeyID[:],
+				wal.Params().HDPublicKeyID[:],
 				contract.VendorListings[0].VendorID.Pubkeys.Bitcoin,
 				chaincode,
 				parentFP,
@@ -312,7 +297,7 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 				return "", "", 0, false, err
 			}
 			hdKey = hd.NewExtendedKey(
-				n.Wallet.Params().HDPublicKeyID[:],
+				wal.Params().HDPublicKeyID[:],
 				contract.BuyerOrder.BuyerID.Pubkeys.Bitcoin,
 				chaincode,
 				parentFP,
@@ -324,7 +309,7 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 			if err != nil {
 				return "", "", 0, false, err
 			}
-			addr, redeemScript, err := n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey}, 1, time.Duration(0), nil)
+			addr, redeemScript, err := wal.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey}, 1, time.Duration(0), nil)
 			if err != nil {
 				return "", "", 0, false, err
 			}
@@ -332,11 +317,11 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 			payment.RedeemScript = hex.EncodeToString(redeemScript)
 			payment.Chaincode = hex.EncodeToString(chaincode)
 
-			script, err := n.Wallet.AddressToScript(addr)
+			script, err := wal.AddressToScript(addr)
 			if err != nil {
 				return "", "", 0, false, err
 			}
-			err = n.Wallet.AddWatchedScript(script)
+			err = wal.AddWatchedScript(script)
 			if err != nil {
 				return "", "", 0, false, err
 			}
@@ -404,15 +389,15 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 			if err != nil {
 				return "", "", 0, false, err
 			}
-			addr, err := n.Wallet.DecodeAddress(contract.VendorOrderConfirmation.PaymentAddress)
+			addr, err := wal.DecodeAddress(contract.VendorOrderConfirmation.PaymentAddress)
 			if err != nil {
 				return "", "", 0, false, err
 			}
-			script, err := n.Wallet.AddressToScript(addr)
+			script, err := wal.AddressToScript(addr)
 			if err != nil {
 				return "", "", 0, false, err
 			}
-			err = n.Wallet.AddWatchedScript(script)
+			err = wal.AddWatchedScript(script)
 			if err != nil {
 				return "", "", 0, false, err
 			}
@@ -433,11 +418,6 @@ func (n *OpenBazaarNode) createContractWithOrder(data *PurchaseData) (*pb.Ricard
 	contract := new(pb.RicardianContract)
 	order := new(pb.Order)
 	order.Version = 1
-	if data.RefundAddress != nil {
-		order.RefundAddress = *(data.RefundAddress)
-	} else {
-		order.RefundAddress = n.Wallet.NewAddress(wallet.INTERNAL).EncodeAddress()
-	}
 	shipping := &pb.Order_Shipping{
 		ShipTo:       data.ShipTo,
 		Address:      data.Address,
@@ -469,12 +449,10 @@ func (n *OpenBazaarNode) createContractWithOrder(data *PurchaseData) (*pb.Ricard
 	keys.Bitcoin = ecPubKey.SerializeCompressed()
 	id.Pubkeys = keys
 	// Sign the PeerID with the Bitcoin key
-	ecPrivKey, err := n.Wallet.MasterPrivateKey().ECPrivKey()
+	id.BitcoinSig, err = n.bitcoinSig(id.PeerID)
 	if err != nil {
 		return nil, err
 	}
-	sig, err := ecPrivKey.Sign([]byte(id.PeerID))
-	id.BitcoinSig = sig.Serialize()
 	order.BuyerID = id
 
 	ts, err := ptypes.TimestampProto(time.Now())
@@ -550,10 +528,6 @@ func (n *OpenBazaarNode) createContractWithOrder(data *PurchaseData) (*pb.Ricard
 			listing = addedListings[item.ListingHash]
 		}
 
-		if strings.ToLower(listing.Metadata.AcceptedCurrencies[0]) != strings.ToLower(n.Wallet.CurrencyCode()) {
-			return nil, fmt.Errorf("Contract only accepts %s, our wallet uses %s", listing.Metadata.AcceptedCurrencies[0], n.Wallet.CurrencyCode())
-		}
-
 		// Remove any duplicate coupons
 		couponMap := make(map[string]bool)
 		var coupons []string
@@ -608,6 +582,22 @@ func (n *OpenBazaarNode) createContractWithOrder(data *PurchaseData) (*pb.Ricard
 		order.Items = append(order.Items, i)
 	}
 
+	// Pay with a coin every listing accepts
+	coin, err := n.selectPaymentCoin(data.PaymentCoin, contract.VendorListings)
+	if err != nil {
+		return nil, err
+	}
+	order.Payment = &pb.Order_Payment{Coin: coin}
+	wal, err := n.WalletForCurrency(coin)
+	if err != nil {
+		return nil, err
+	}
+	if data.RefundAddress != nil {
+		order.RefundAddress = *(data.RefundAddress)
+	} else {
+		order.RefundAddress = wal.NewAddress(wallet.INTERNAL).EncodeAddress()
+	}
+
 	contract.BuyerOrder = order
 	return contract, nil
 }
@@ -625,6 +615,10 @@ func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, reco
 	if err != nil {
 		return err
 	}
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 	// Sweep the temp address into our wallet
 	var utxos []wallet.Utxo
 	for _, r := range records {
@@ -655,7 +649,7 @@ func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, reco
 		return err
 	}
 	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-	mPrivKey := n.Wallet.MasterPrivateKey()
+	mPrivKey, err := bitcoin.MasterPrivateKey(wal)
 	if err != nil {
 		return err
 	}
@@ -664,7 +658,7 @@ func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, reco
 		return err
 	}
 	hdKey := hd.NewExtendedKey(
-		n.Wallet.Params().HDPrivateKeyID[:],
+		wal.Params().HDPrivateKeyID[:],
 		mECKey.Serialize(),
 		chaincode,
 		parentFP,
@@ -677,11 +671,11 @@ func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, reco
 		return err
 	}
 	redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
-	refundAddress, err := n.Wallet.DecodeAddress(contract.BuyerOrder.RefundAddress)
+	refundAddress, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
 	if err != nil {
 		return err
 	}
-	_, err = n.Wallet.SweepAddress(utxos, &refundAddress, buyerKey, &redeemScript, wallet.NORMAL)
+	_, err = wal.SweepAddress(utxos, &refundAddress, buyerKey, &redeemScript, wallet.NORMAL)
 	if err != nil {
 		return err
 	}
@@ -706,8 +700,9 @@ func (n *OpenBazaarNode) CalcOrderId(order *pb.Order) (string, error) {
 }
 
 func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (uint64, error) {
-	if n.ExchangeRates != nil {
-		n.ExchangeRates.GetLatestRate("") // Refresh the exchange rates
+	coin := contract.BuyerOrder.Payment.GetCoin()
+	if rates := n.exchangeRatesForCurrency(coin); rates != nil {
+		rates.GetLatestRate("") // Refresh the exchange rates
 	}
 	var total uint64
 	physicalGoods := make(map[string]*pb.Listing)
@@ -722,7 +717,7 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 		if l.Metadata.ContractType == pb.Listing_Metadata_PHYSICAL_GOOD {
 			physicalGoods[item.ListingHash] = l
 		}
-		satoshis, err := n.getPriceInSatoshi(l.Metadata.PricingCurrency, l.Item.Price)
+		satoshis, err := n.getPriceInSatoshi(coin, l.Metadata.PricingCurrency, l.Item.Price)
 		if err != nil {
 			return 0, err
 		}
@@ -740,7 +735,7 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 					if sku.Surcharge < 0 {
 						surcharge = uint64(-sku.Surcharge)
 					}
-					satoshis, err := n.getPriceInSatoshi(l.Metadata.PricingCurrency, surcharge)
+					satoshis, err := n.getPriceInSatoshi(coin, l.Metadata.PricingCurrency, surcharge)
 					if err != nil {
 						return 0, err
 					}
@@ -765,7 +760,7 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 				}
 				if id.B58String() == vendorCoupon.GetHash() {
 					if discount := vendorCoupon.GetPriceDiscount(); discount > 0 {
-						satoshis, err := n.getPriceInSatoshi(l.Metadata.PricingCurrency, discount)
+						satoshis, err := n.getPriceInSatoshi(coin, l.Metadata.PricingCurrency, discount)
 						if err != nil {
 							return 0, err
 						}
@@ -839,14 +834,14 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 		if !ok {
 			return 0, errors.New("Shipping service not found in listing")
 		}
-		shippingSatoshi, err := n.getPriceInSatoshi(listing.Metadata.PricingCurrency, service.Price)
+		shippingSatoshi, err := n.getPriceInSatoshi(coin, listing.Metadata.PricingCurrency, service.Price)
 		if err != nil {
 			return 0, err
 		}
 
 		var secondarySatoshi uint64
 		if service.AdditionalItemPrice > 0 {
-			secondarySatoshi, err = n.getPriceInSatoshi(listing.Metadata.PricingCurrency, service.AdditionalItemPrice)
+			secondarySatoshi, err = n.getPriceInSatoshi(coin, listing.Metadata.PricingCurrency, service.AdditionalItemPrice)
 			if err != nil {
 				return 0, err
 			}
@@ -902,17 +897,23 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 	return total + shippingTotal, nil
 }
 
-func (n *OpenBazaarNode) getPriceInSatoshi(currencyCode string, amount uint64) (uint64, error) {
-	if strings.ToLower(currencyCode) == strings.ToLower(n.Wallet.CurrencyCode()) || "t"+strings.ToLower(currencyCode) == strings.ToLower(n.Wallet.CurrencyCode()) {
+// Convert a price to the smallest unit of the payment coin. An empty coin uses the primary wallet.
+func (n *OpenBazaarNode) getPriceInSatoshi(coin string, currencyCode string, amount uint64) (uint64, error) {
+	wal, err := n.WalletForCurrency(coin)
+	if err != nil {
+		return 0, err
+	}
+	if strings.ToLower(currencyCode) == strings.ToLower(wal.CurrencyCode()) || "t"+strings.ToLower(currencyCode) == strings.ToLower(wal.CurrencyCode()) {
 		return amount, nil
 	}
-	exchangeRate, err := n.ExchangeRates.GetExchangeRate(currencyCode)
+	rates := n.exchangeRatesForCurrency(coin)
+	exchangeRate, err := rates.GetExchangeRate(currencyCode)
 	if err != nil {
 		return 0, err
 	}
 	formatedAmount := float64(amount) / 100
 	btc := formatedAmount / exchangeRate
-	satoshis := btc * float64(n.ExchangeRates.UnitsPerCoin())
+	satoshis := btc * float64(rates.UnitsPerCoin())
 	return uint64(satoshis), nil
 }
 
@@ -961,6 +962,15 @@ func (n *OpenBazaarNode) ValidateOrder(contract *pb.RicardianContract, checkInve
 	if contract.BuyerOrder.Payment == nil {
 		return errors.New("Order doesn't contain a payment")
 	}
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	if contract.BuyerOrder.Payment.Coin != "" {
+		if _, err := n.selectPaymentCoin(contract.BuyerOrder.Payment.Coin, contract.VendorListings); err != nil {
+			return err
+		}
+	}
 	if contract.BuyerOrder.BuyerID == nil {
 		return errors.New("Order doesn't contain a buyer ID")
 	}
@@ -979,23 +989,36 @@ func (n *OpenBazaarNode) ValidateOrder(contract *pb.RicardianContract, checkInve
 		return errors.New("Order is missing a timestamp")
 	}
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
-		_, err := mh.FromB58String(contract.BuyerOrder.Payment.Moderator)
-		if err != nil {
-			return errors.New("Invalid moderator")
-		}
 		var availableMods []string
 		for _, listing := range contract.VendorListings {
 			availableMods = append(availableMods, listing.Moderators...)
 		}
-		validMod := false
-		for _, mod := range availableMods {
-			if mod == contract.BuyerOrder.Payment.Moderator {
-				validMod = true
-				break
+		if IsPanelPayment(contract.BuyerOrder.Payment) {
+			if contract.BuyerOrder.Payment.Moderators[0] != contract.BuyerOrder.Payment.Moderator {
+				return errors.New("Lead moderator does not match the moderator panel")
+			}
+			if err := ValidatePanel(contract.BuyerOrder.Payment.Moderators, contract.BuyerOrder.Payment.ModeratorThreshold); err != nil {
+				return err
+			}
+			if !bitcoin.SupportsMultisignMany(wal) {
+				return errors.New("Wallet does not support moderator panels")
 			}
 		}
-		if !validMod {
-			return errors.New("Invalid moderator")
+		for _, moderator := range PaymentModerators(contract.BuyerOrder.Payment) {
+			_, err := mh.FromB58String(moderator)
+			if err != nil {
+				return errors.New("Invalid moderator")
+			}
+			validMod := false
+			for _, mod := range availableMods {
+				if mod == moderator {
+					validMod = true
+					break
+				}
+			}
+			if !validMod {
+				return errors.New("Invalid moderator")
+			}
 		}
 	}
 
@@ -1184,7 +1207,7 @@ collectListings:
 	}
 
 	// Validate the buyers's signature on the order
-	err := verifySignaturesOnOrder(contract)
+	err = verifySignaturesOnOrder(contract)
 	if err != nil {
 		return err
 	}
@@ -1192,17 +1215,21 @@ collectListings:
 }
 
 func (n *OpenBazaarNode) ValidateDirectPaymentAddress(order *pb.Order) error {
+	wal, err := n.WalletForOrder(order)
+	if err != nil {
+		return err
+	}
 	chaincode, err := hex.DecodeString(order.Payment.Chaincode)
 	if err != nil {
 		return err
 	}
 	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-	mECKey, err := n.Wallet.MasterPublicKey().ECPubKey()
+	mECKey, err := n.vendorMasterPublicKey(wal)
 	if err != nil {
 		return err
 	}
 	hdKey := hd.NewExtendedKey(
-		n.Wallet.Params().HDPublicKeyID[:],
+		wal.Params().HDPublicKeyID[:],
 		mECKey.SerializeCompressed(),
 		chaincode,
 		parentFP,
@@ -1215,7 +1242,7 @@ func (n *OpenBazaarNode) ValidateDirectPaymentAddress(order *pb.Order) error {
 		return err
 	}
 	hdKey = hd.NewExtendedKey(
-		n.Wallet.Params().HDPublicKeyID[:],
+		wal.Params().HDPublicKeyID[:],
 		order.BuyerID.Pubkeys.Bitcoin,
 		chaincode,
 		parentFP,
@@ -1227,7 +1254,7 @@ func (n *OpenBazaarNode) ValidateDirectPaymentAddress(order *pb.Order) error {
 	if err != nil {
 		return err
 	}
-	addr, redeemScript, err := n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey}, 1, time.Duration(0), nil)
+	addr, redeemScript, err := wal.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey}, 1, time.Duration(0), nil)
 	if order.Payment.Address != addr.EncodeAddress() {
 		return errors.New("Invalid payment address")
 	}
@@ -1238,84 +1265,95 @@ func (n *OpenBazaarNode) ValidateDirectPaymentAddress(order *pb.Order) error {
 }
 
 func (n *OpenBazaarNode) ValidateModeratedPaymentAddress(order *pb.Order, timeout time.Duration) error {
-	ipnsPath := ipfspath.FromString(order.Payment.Moderator + "/profile.json")
-	profileBytes, err := n.IPNSResolveThenCat(ipnsPath, time.Minute)
+	wal, err := n.WalletForOrder(order)
 	if err != nil {
 		return err
 	}
-	profile := new(pb.Profile)
-	err = jsonpb.UnmarshalString(string(profileBytes), profile)
+	chaincode, err := hex.DecodeString(order.Payment.Chaincode)
 	if err != nil {
 		return err
 	}
-	moderatorBytes, err := hex.DecodeString(profile.BitcoinPubkey)
+	var moderatorBytes []byte
+	for i, mod := range PaymentModerators(order.Payment) {
+		moderatorKey, err := n.getModeratorBitcoinKey(mod, order.Payment.Coin)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			moderatorBytes = moderatorKey
+		}
+		modPub, err := n.moderatorEscrowKey(moderatorKey, chaincode)
+		if err != nil {
+			return err
+		}
+		expected := order.Payment.ModeratorKey
+		if IsPanelPayment(order.Payment) {
+			if i >= len(order.Payment.ModeratorKeys) {
+				return errors.New("Invalid moderator key")
+			}
+			expected = order.Payment.ModeratorKeys[i]
+		}
+		if !bytes.Equal(expected, modPub) {
+			return errors.New("Invalid moderator key")
+		}
+	}
+	if moderatorBytes == nil {
+		return errors.New("Invalid moderator")
+	}
+	mECKey, err := n.vendorMasterPublicKey(wal)
 	if err != nil {
 		return err
 	}
-
-	chaincode, err := hex.DecodeString(order.Payment.Chaincode)
+	keys, threshold, timeoutKey, err := n.escrowPublicKeys(order.Payment, order.BuyerID.Pubkeys.Bitcoin, mECKey.SerializeCompressed(), moderatorBytes)
 	if err != nil {
 		return err
 	}
-	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-	mECKey, err := n.Wallet.MasterPublicKey().ECPubKey()
+	addr, redeemScript, err := wal.GenerateMultisigScript(keys, threshold, timeout, timeoutKey)
 	if err != nil {
 		return err
 	}
-	hdKey := hd.NewExtendedKey(
-		n.Wallet.Params().HDPublicKeyID[:],
-		mECKey.SerializeCompressed(),
-		chaincode,
-		parentFP,
-		0,
-		0,
-		false)
+	if order.Payment.Address != addr.EncodeAddress() {
+		return errors.New("Invalid payment address")
+	}
+	if order.Payment.RedeemScript != hex.EncodeToString(redeemScript) {
+		return errors.New("Invalid redeem script")
+	}
+	return nil
+}
 
-	vendorKey, err := hdKey.Child(0)
+// Fetch a moderator's profile and return their master bitcoin public key if they can moderate for the coin
+func (n *OpenBazaarNode) getModeratorBitcoinKey(peerID string, coin string) ([]byte, error) {
+	wal, err := n.WalletForCurrency(coin)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	hdKey = hd.NewExtendedKey(
-		n.Wallet.Params().HDPublicKeyID[:],
-		order.BuyerID.Pubkeys.Bitcoin,
-		chaincode,
-		parentFP,
-		0,
-		0,
-		false)
-
-	buyerKey, err := hdKey.Child(0)
+	ipnsPath := ipfspath.FromString(peerID + "/profile.json")
+	profileBytes, err := n.IPNSResolveThenCat(ipnsPath, time.Minute)
 	if err != nil {
-		return err
+		return nil, errors.New("Moderator could not be found")
 	}
-	hdKey = hd.NewExtendedKey(
-		n.Wallet.Params().HDPublicKeyID[:],
-		moderatorBytes,
-		chaincode,
-		parentFP,
-		0,
-		0,
-		false)
-
-	ModeratorKey, err := hdKey.Child(0)
+	profile := new(pb.Profile)
+	err = jsonpb.UnmarshalString(string(profileBytes), profile)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	ModPub, err := ModeratorKey.ECPubKey()
+	moderatorKeyBytes, err := hex.DecodeString(profile.BitcoinPubkey)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if !bytes.Equal(order.Payment.ModeratorKey, ModPub.SerializeCompressed()) {
-		return errors.New("Invalid moderator key")
+	if !profile.Moderator || profile.ModeratorInfo == nil || len(profile.ModeratorInfo.AcceptedCurrencies) == 0 {
+		return nil, errors.New("Moderator is not capable of moderating this transaction")
 	}
-	addr, redeemScript, err := n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *ModeratorKey}, 2, timeout, vendorKey)
-	if order.Payment.Address != addr.EncodeAddress() {
-		return errors.New("Invalid payment address")
+	currencyAccepted := false
+	for _, currency := range profile.ModeratorInfo.AcceptedCurrencies {
+		if strings.ToLower(currency) == strings.ToLower(wal.CurrencyCode()) {
+			currencyAccepted = true
+		}
 	}
-	if order.Payment.RedeemScript != hex.EncodeToString(redeemScript) {
-		return errors.New("Invalid redeem script")
+	if !currencyAccepted {
+		return nil, errors.New("Moderator does not accept our currency")
 	}
-	return nil
+	return moderatorKeyBytes, nil
 }
 
 func (n *OpenBazaarNode) SignOrder(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
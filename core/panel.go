@@ -0,0 +1,658 @@
+package core
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/wallet-interface"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	dht "gx/ipfs/QmUCS9EnqNq1kCnJds2eLDypBiS21aSiCf1MVzSUVB9TGA/go-libp2p-kad-dht"
+	ds "gx/ipfs/QmVSase1JP7cq9QkPT46oNwdp9pT6kBkG3oqS14y3QcZjG/go-datastore"
+	peer "gx/ipfs/QmXYjuNuxVzXKJCfWasQk1RqkhVLDM9jtUKhqc2WPQmFSB/go-libp2p-peer"
+	libp2p "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
+)
+
+/* Moderator panel escrows
+
+   With a panel of N moderators and a threshold of t, the buyer and vendor each contribute t
+   keys (the first t children of their chaincode key) and every moderator contributes one.
+   The redeem script then requires 2t signatures. Buyer and vendor together can release funds
+   as usual, either party can release funds with t moderators, but a party colluding with fewer
+   than t moderators, or the moderators alone, cannot.
+
+   The moderator fee is paid to the panel member who proposed the resolution. */
+
+const (
+	// The standard script limit for a CHECKMULTISIG spend
+	MaxEscrowScriptKeys = 15
+
+	PanelResolutionPrefix = "/panelresolution/"
+)
+
+var ErrPanelResolutionNotFound = errors.New("Panel resolution not found")
+
+// IsPanelPayment returns whether the payment is escrowed by a panel of moderators rather than a single moderator
+func IsPanelPayment(payment *pb.Order_Payment) bool {
+	return payment != nil && payment.Method == pb.Order_Payment_MODERATED && len(payment.Moderators) > 1
+}
+
+// PaymentModerators returns every moderator who may take part in a dispute over this payment
+func PaymentModerators(payment *pb.Order_Payment) []string {
+	if IsPanelPayment(payment) {
+		return payment.Moderators
+	}
+	if payment == nil || payment.Moderator == "" {
+		return nil
+	}
+	return []string{payment.Moderator}
+}
+
+// IsPaymentModerator returns whether the peer is a moderator (or panel member) for this payment
+func IsPaymentModerator(payment *pb.Order_Payment, peerID string) bool {
+	return panelIndex(payment, peerID) >= 0
+}
+
+func panelIndex(payment *pb.Order_Payment, peerID string) int {
+	for i, mod := range PaymentModerators(payment) {
+		if mod == peerID {
+			return i
+		}
+	}
+	return -1
+}
+
+// The number of keys the buyer and the vendor each contribute to the escrow script
+func escrowKeyCount(payment *pb.Order_Payment) int {
+	if IsPanelPayment(payment) {
+		return int(payment.ModeratorThreshold)
+	}
+	return 1
+}
+
+// ValidatePanel checks that a moderator panel of this size and threshold can be built into a redeem script
+func ValidatePanel(moderators []string, threshold uint32) error {
+	n := len(moderators)
+	t := int(threshold)
+	if n < 2 {
+		return errors.New("A moderator panel requires at least two moderators")
+	}
+	seen := make(map[string]bool)
+	for _, mod := range moderators {
+		if seen[mod] {
+			return errors.New("Duplicate moderator in panel")
+		}
+		seen[mod] = true
+	}
+	if t < 2 || t > n {
+		return fmt.Errorf("Panel threshold must be between 2 and %d", n)
+	}
+	// A majority of moderators must not be able to spend without either party
+	if n > 2*t-1 {
+		return fmt.Errorf("A panel of %d moderators requires a threshold of at least %d", n, (n+2)/2)
+	}
+	if 2*t+n > MaxEscrowScriptKeys {
+		return errors.New("Moderator panel is too large")
+	}
+	return nil
+}
+
+/* Derive the public keys of the escrow redeem script in script order along with the number of
+   required signatures and the escrow timeout key. For a single moderator this returns the
+   usual buyer, vendor, moderator keys with a threshold of two. */
+func (n *OpenBazaarNode) escrowPublicKeys(payment *pb.Order_Payment, buyerPubkey, vendorPubkey, moderatorPubkey []byte) ([]hd.ExtendedKey, int, *hd.ExtendedKey, error) {
+	wal, err := n.WalletForCurrency(payment.Coin)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	chaincode, err := hex.DecodeString(payment.Chaincode)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
+	count := escrowKeyCount(payment)
+	deriveKeys := func(pubkey []byte) ([]hd.ExtendedKey, error) {
+		hdKey := hd.NewExtendedKey(
+			wal.Params().HDPublicKeyID[:],
+			pubkey,
+			chaincode,
+			parentFP,
+			0,
+			0,
+			false)
+		var keys []hd.ExtendedKey
+		for i := 0; i < count; i++ {
+			key, err := hdKey.Child(uint32(i))
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, *key)
+		}
+		return keys, nil
+	}
+	buyerKeys, err := deriveKeys(buyerPubkey)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	vendorKeys, err := deriveKeys(vendorPubkey)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	timeoutKey := vendorKeys[0]
+	keys := append(buyerKeys, vendorKeys...)
+
+	if !IsPanelPayment(payment) {
+		hdKey := hd.NewExtendedKey(
+			wal.Params().HDPublicKeyID[:],
+			moderatorPubkey,
+			chaincode,
+			parentFP,
+			0,
+			0,
+			false)
+		moderatorKey, err := hdKey.Child(0)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		return append(keys, *moderatorKey), 2, &timeoutKey, nil
+	}
+
+	// Panel moderator keys are already derived from the chaincode and are carried in the order
+	if len(payment.ModeratorKeys) != len(payment.Moderators) {
+		return nil, 0, nil, errors.New("Number of moderator keys does not match number of moderators")
+	}
+	for _, key := range payment.ModeratorKeys {
+		keys = append(keys, *hd.NewExtendedKey(
+			wal.Params().HDPublicKeyID[:],
+			key,
+			chaincode,
+			parentFP,
+			0,
+			0,
+			false))
+	}
+	return keys, 2 * count, &timeoutKey, nil
+}
+
+// Derive the chaincode child of a moderator's master public key
+func (n *OpenBazaarNode) moderatorEscrowKey(moderatorPubkey []byte, chaincode []byte) ([]byte, error) {
+	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
+	hdKey := hd.NewExtendedKey(
+		n.Wallet.Params().HDPublicKeyID[:],
+		moderatorPubkey,
+		chaincode,
+		parentFP,
+		0,
+		0,
+		false)
+	key, err := hdKey.Child(0)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := key.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+	return pub.SerializeCompressed(), nil
+}
+
+/* CreateEscrowSignatures signs the escrow inputs with every key this party contributes to the
+   redeem script. The signatures for all keys are returned in one list in key order. */
+func (n *OpenBazaarNode) CreateEscrowSignatures(payment *pb.Order_Payment, ins []wallet.TransactionInput, outs []wallet.TransactionOutput, hdKey *hd.ExtendedKey, redeemScript []byte, feePerByte uint64) ([]wallet.Signature, error) {
+	wal, err := n.WalletForCurrency(payment.Coin)
+	if err != nil {
+		return nil, err
+	}
+	if IsPanelPayment(payment) {
+		outs = bitcoin.DeductMultisigFee(ins, outs, redeemScript, feePerByte)
+		feePerByte = 0
+	}
+	var sigs []wallet.Signature
+	for i := 0; i < escrowKeyCount(payment); i++ {
+		key, err := hdKey.Child(uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		s, err := wal.CreateMultisigSignature(ins, outs, key, redeemScript, feePerByte)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, s...)
+	}
+	return sigs, nil
+}
+
+/* MultisignEscrow releases funds from escrow using the buyer's and vendor's signatures.
+   Either list may hold the signatures of several keys as returned by CreateEscrowSignatures. */
+func (n *OpenBazaarNode) MultisignEscrow(payment *pb.Order_Payment, ins []wallet.TransactionInput, outs []wallet.TransactionOutput, sigs1, sigs2 []wallet.Signature, redeemScript []byte, feePerByte uint64, broadcast bool) ([]byte, error) {
+	wal, err := n.WalletForCurrency(payment.Coin)
+	if err != nil {
+		return nil, err
+	}
+	if !IsPanelPayment(payment) {
+		return wal.Multisign(ins, outs, sigs1, sigs2, redeemScript, feePerByte, broadcast)
+	}
+	count := escrowKeyCount(payment)
+	sets := append(splitSignatures(sigs1, count), splitSignatures(sigs2, count)...)
+	outs = bitcoin.DeductMultisigFee(ins, outs, redeemScript, feePerByte)
+	return bitcoin.MultisignMany(wal, ins, outs, sets, redeemScript, 0, broadcast)
+}
+
+/* Split a list holding the signatures of several keys into one set per key. Each signature is
+   placed in the first set which does not yet have a signature for its input. */
+func splitSignatures(sigs []wallet.Signature, count int) [][]wallet.Signature {
+	sets := make([][]wallet.Signature, count)
+	for _, sig := range sigs {
+		for i := range sets {
+			exists := false
+			for _, s := range sets[i] {
+				if s.InputIndex == sig.InputIndex {
+					exists = true
+					break
+				}
+			}
+			if !exists {
+				sets[i] = append(sets[i], sig)
+				break
+			}
+		}
+	}
+	return sets
+}
+
+func toWalletSignatures(sigs []*pb.BitcoinSignature) []wallet.Signature {
+	var ret []wallet.Signature
+	for _, sig := range sigs {
+		ret = append(ret, wallet.Signature{InputIndex: sig.InputIndex, Signature: sig.Signature})
+	}
+	return ret
+}
+
+/* Collect the moderator signatures of a panel resolution in script order. The proposer's
+   signatures are in the payout and each endorsement carries the signatures of one more panel member. */
+func panelModeratorSignatures(payment *pb.Order_Payment, contract *pb.RicardianContract) ([][]wallet.Signature, error) {
+	type modSigs struct {
+		index int
+		sigs  []wallet.Signature
+	}
+	var all []modSigs
+	all = append(all, modSigs{panelIndex(payment, contract.DisputeResolution.ProposedBy), toWalletSignatures(contract.DisputeResolution.Payout.Sigs)})
+	if contract.PanelResolution != nil {
+		for _, e := range contract.PanelResolution.Endorsements {
+			all = append(all, modSigs{panelIndex(payment, e.Moderator), toWalletSignatures(e.Sigs)})
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].index < all[j].index })
+	threshold := int(payment.ModeratorThreshold)
+	if len(all) < threshold {
+		return nil, errors.New("Panel resolution does not have enough endorsements")
+	}
+	var sets [][]wallet.Signature
+	for _, m := range all[:threshold] {
+		sets = append(sets, m.sigs)
+	}
+	return sets, nil
+}
+
+/* Verify the endorsements on a panel resolution come from distinct panel members and cover the
+   resolution. If requireThreshold is set the resolution must also have enough endorsements to spend. */
+func (n *OpenBazaarNode) verifyPanelEndorsements(contract *pb.RicardianContract, requireThreshold bool) error {
+	payment := contract.BuyerOrder.Payment
+	if contract.PanelResolution == nil {
+		return errors.New("Dispute resolution is missing the panel endorsements")
+	}
+	ser, err := proto.Marshal(contract.DisputeResolution)
+	if err != nil {
+		return err
+	}
+	seen := map[string]bool{contract.DisputeResolution.ProposedBy: true}
+	for _, e := range contract.PanelResolution.Endorsements {
+		if panelIndex(payment, e.Moderator) < 0 {
+			return errors.New("Endorsement is not from a panel moderator")
+		}
+		if seen[e.Moderator] {
+			return errors.New("Duplicate endorsement on panel resolution")
+		}
+		seen[e.Moderator] = true
+		pubkey, err := n.getModeratorIdentityKey(e.Moderator)
+		if err != nil {
+			return err
+		}
+		valid, err := pubkey.Verify(ser, e.Signature)
+		if err != nil || !valid {
+			return errors.New("Invalid signature on panel endorsement")
+		}
+		if len(e.Sigs) == 0 {
+			return errors.New("Panel endorsement contains no transaction signatures")
+		}
+	}
+	if requireThreshold && len(seen) < int(payment.ModeratorThreshold) {
+		return errors.New("Panel resolution does not have enough endorsements")
+	}
+	return nil
+}
+
+// Whether the proposer plus the endorsements meet the panel threshold
+func panelResolutionComplete(rc *pb.RicardianContract, payment *pb.Order_Payment) bool {
+	return rc.PanelResolution != nil && len(rc.PanelResolution.Endorsements)+1 >= int(payment.ModeratorThreshold)
+}
+
+// Look up the identity key of a moderator and check it matches their peer ID
+func (n *OpenBazaarNode) getModeratorIdentityKey(peerID string) (libp2p.PubKey, error) {
+	pid, err := peer.IDB58Decode(peerID)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pubkey, err := n.IpfsNode.Routing.(*dht.IpfsDHT).GetPublicKey(ctx, pid)
+	if err != nil {
+		log.Errorf("Failed to find public key for %s", pid.Pretty())
+		return nil, err
+	}
+	if !pid.MatchesPublicKey(pubkey) {
+		return nil, errors.New("Public key does not match moderator ID")
+	}
+	return pubkey, nil
+}
+
+/* ProposePanelResolution stores the proposed resolution and sends it to the rest of the panel
+   for endorsement. The proposer's own transaction signatures are in the payout. */
+func (n *OpenBazaarNode) ProposePanelResolution(rc *pb.RicardianContract, payment *pb.Order_Payment) error {
+	rc.PanelResolution = &pb.PanelResolution{OrderId: rc.DisputeResolution.OrderId}
+	err := n.putPanelResolution(rc)
+	if err != nil {
+		return err
+	}
+	for _, mod := range payment.Moderators {
+		if mod == n.IpfsNode.Identity.Pretty() {
+			continue
+		}
+		if err := n.SendPanelResolution(mod, rc); err != nil {
+			log.Errorf("Error sending panel resolution to %s: %s", mod, err.Error())
+		}
+	}
+	return nil
+}
+
+/* EndorsePanelResolution adds our signatures to a resolution proposed by another panel member.
+   The payout is checked against our copy of the case first. When the resolution reaches the
+   panel threshold it is sent to the buyer and vendor, otherwise it is passed on to the panel. */
+func (n *OpenBazaarNode) EndorsePanelResolution(orderId string) error {
+	rc, err := n.GetPanelResolution(orderId)
+	if err != nil {
+		return err
+	}
+	if rc.DisputeResolution.ProposedBy == n.IpfsNode.Identity.Pretty() {
+		return errors.New("We proposed this panel resolution")
+	}
+	for _, e := range rc.PanelResolution.Endorsements {
+		if e.Moderator == n.IpfsNode.Identity.Pretty() {
+			return errors.New("Panel resolution is already endorsed")
+		}
+	}
+	buyerContract, vendorContract, buyerPayoutAddress, vendorPayoutAddress, buyerOutpoints, vendorOutpoints, state, err := n.Datastore.Cases().GetPayoutDetails(orderId)
+	if err != nil {
+		return ErrCaseNotFound
+	}
+	if state != pb.OrderState_DISPUTED {
+		return errors.New("A dispute for this order is not open")
+	}
+	contract := buyerContract
+	if contract == nil {
+		contract = vendorContract
+	}
+	if contract == nil {
+		return ErrCaseNotFound
+	}
+	payment := contract.BuyerOrder.Payment
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	if !IsPanelPayment(payment) || !IsPaymentModerator(payment, n.IpfsNode.Identity.Pretty()) {
+		return errors.New("We are not a member of the moderator panel for this order")
+	}
+	contract.DisputeResolution = rc.DisputeResolution
+	contract.PanelResolution = rc.PanelResolution
+	contract.Signatures = append(contract.Signatures, rc.Signatures...)
+	if err := n.verifySignatureOnDisputeResolution(contract); err != nil {
+		return err
+	}
+	if err := n.verifyPanelEndorsements(contract, false); err != nil {
+		return err
+	}
+	// Make sure the payout only spends the escrow and only pays the parties to this case
+	payout := rc.DisputeResolution.Payout
+	if payout == nil {
+		return errors.New("DisputeResolution contains invalid payout")
+	}
+	knownOutpoints := make(map[string]bool)
+	for _, o := range append(buyerOutpoints, vendorOutpoints...) {
+		knownOutpoints[o.Hash+":"+fmt.Sprint(o.Index)] = true
+	}
+	for _, o := range payout.Inputs {
+		if !knownOutpoints[o.Hash+":"+fmt.Sprint(o.Index)] {
+			return errors.New("Payout spends an input which is not part of this case")
+		}
+	}
+	checkPayoutScript := func(output *pb.DisputeResolution_Payout_Output, address string) error {
+		if output == nil {
+			return nil
+		}
+		addr, err := wal.DecodeAddress(address)
+		if err != nil {
+			return err
+		}
+		script, err := wal.AddressToScript(addr)
+		if err != nil {
+			return err
+		}
+		if hex.EncodeToString(script) != output.Script {
+			return errors.New("Payout does not pay the address provided by the party")
+		}
+		return nil
+	}
+	if err := checkPayoutScript(payout.BuyerOutput, buyerPayoutAddress); err != nil {
+		return err
+	}
+	if err := checkPayoutScript(payout.VendorOutput, vendorPayoutAddress); err != nil {
+		return err
+	}
+
+	// Sign the payout
+	inputs, outputs, err := payoutTransaction(payout)
+	if err != nil {
+		return err
+	}
+	redeemScript, err := hex.DecodeString(payment.RedeemScript)
+	if err != nil {
+		return err
+	}
+	chaincode, err := hex.DecodeString(payment.Chaincode)
+	if err != nil {
+		return err
+	}
+	mPrivKey, err := bitcoin.MasterPrivateKey(wal)
+	if err != nil {
+		return err
+	}
+	mECKey, err := mPrivKey.ECPrivKey()
+	if err != nil {
+		return err
+	}
+	hdKey := hd.NewExtendedKey(
+		wal.Params().HDPrivateKeyID[:],
+		mECKey.Serialize(),
+		chaincode,
+		[]byte{0x00, 0x00, 0x00, 0x00},
+		0,
+		0,
+		true)
+	moderatorKey, err := hdKey.Child(0)
+	if err != nil {
+		return err
+	}
+	sigs, err := wal.CreateMultisigSignature(inputs, outputs, moderatorKey, redeemScript, 0)
+	if err != nil {
+		return err
+	}
+	endorsement := new(pb.PanelResolution_Endorsement)
+	endorsement.Moderator = n.IpfsNode.Identity.Pretty()
+	for _, sig := range sigs {
+		endorsement.Sigs = append(endorsement.Sigs, &pb.BitcoinSignature{InputIndex: sig.InputIndex, Signature: sig.Signature})
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	endorsement.Timestamp = ts
+	ser, err := proto.Marshal(rc.DisputeResolution)
+	if err != nil {
+		return err
+	}
+	endorsement.Signature, err = n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return err
+	}
+	rc.PanelResolution.Endorsements = append(rc.PanelResolution.Endorsements, endorsement)
+	err = n.putPanelResolution(rc)
+	if err != nil {
+		return err
+	}
+
+	if !panelResolutionComplete(rc, payment) {
+		for _, mod := range payment.Moderators {
+			if mod == n.IpfsNode.Identity.Pretty() {
+				continue
+			}
+			if err := n.SendPanelResolution(mod, rc); err != nil {
+				log.Errorf("Error sending panel resolution to %s: %s", mod, err.Error())
+			}
+		}
+		return nil
+	}
+	return n.finalizePanelResolution(rc, contract)
+}
+
+// Send a resolution which has reached the panel threshold to the buyer, vendor and the rest of the panel
+func (n *OpenBazaarNode) finalizePanelResolution(rc *pb.RicardianContract, contract *pb.RicardianContract) error {
+	buyerKey, err := libp2p.UnmarshalPublicKey(contract.BuyerOrder.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	vendorKey, err := libp2p.UnmarshalPublicKey(contract.VendorListings[0].VendorID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	err = n.SendDisputeClose(contract.BuyerOrder.BuyerID.PeerID, &buyerKey, rc)
+	if err != nil {
+		return err
+	}
+	err = n.SendDisputeClose(contract.VendorListings[0].VendorID.PeerID, &vendorKey, rc)
+	if err != nil {
+		return err
+	}
+	for _, mod := range contract.BuyerOrder.Payment.Moderators {
+		if mod == n.IpfsNode.Identity.Pretty() {
+			continue
+		}
+		if err := n.SendPanelResolution(mod, rc); err != nil {
+			log.Errorf("Error sending panel resolution to %s: %s", mod, err.Error())
+		}
+	}
+	return n.Datastore.Cases().MarkAsClosed(rc.DisputeResolution.OrderId, rc.DisputeResolution)
+}
+
+/* ProcessPanelResolution handles a resolution received from another panel member. The proposal is
+   saved so we can endorse it and the case is closed once the resolution reaches the threshold.
+   Returns whether the resolution is complete. */
+func (n *OpenBazaarNode) ProcessPanelResolution(rc *pb.RicardianContract) (bool, error) {
+	if rc.DisputeResolution == nil || rc.PanelResolution == nil {
+		return false, errors.New("Message is missing the panel resolution")
+	}
+	buyerContract, vendorContract, _, _, _, _, state, err := n.Datastore.Cases().GetPayoutDetails(rc.DisputeResolution.OrderId)
+	if err != nil {
+		return false, ErrCaseNotFound
+	}
+	contract := buyerContract
+	if contract == nil {
+		contract = vendorContract
+	}
+	if contract == nil || !IsPanelPayment(contract.BuyerOrder.Payment) {
+		return false, errors.New("Case is not moderated by a panel")
+	}
+	if stored, err := n.GetPanelResolution(rc.DisputeResolution.OrderId); err == nil {
+		MergePanelEndorsements(rc, stored)
+	}
+	contract.DisputeResolution = rc.DisputeResolution
+	contract.PanelResolution = rc.PanelResolution
+	contract.Signatures = append(contract.Signatures, rc.Signatures...)
+	if err := n.verifySignatureOnDisputeResolution(contract); err != nil {
+		return false, err
+	}
+	complete := panelResolutionComplete(rc, contract.BuyerOrder.Payment)
+	if err := n.verifyPanelEndorsements(contract, complete); err != nil {
+		return false, err
+	}
+	err = n.putPanelResolution(rc)
+	if err != nil {
+		return false, err
+	}
+	if complete && state == pb.OrderState_DISPUTED {
+		return true, n.Datastore.Cases().MarkAsClosed(rc.DisputeResolution.OrderId, rc.DisputeResolution)
+	}
+	return false, nil
+}
+
+/* MergePanelEndorsements adds the endorsements of a stored copy of the same resolution that rc is
+   missing, so endorsements passed around the panel in parallel are not lost. A different
+   resolution is a new proposal and its endorsements start over. */
+func MergePanelEndorsements(rc *pb.RicardianContract, stored *pb.RicardianContract) {
+	if rc.PanelResolution == nil || stored.PanelResolution == nil || !proto.Equal(rc.DisputeResolution, stored.DisputeResolution) {
+		return
+	}
+	have := make(map[string]bool)
+	for _, e := range rc.PanelResolution.Endorsements {
+		have[e.Moderator] = true
+	}
+	for _, e := range stored.PanelResolution.Endorsements {
+		if !have[e.Moderator] {
+			rc.PanelResolution.Endorsements = append(rc.PanelResolution.Endorsements, e)
+			have[e.Moderator] = true
+		}
+	}
+}
+
+// GetPanelResolution returns the latest panel resolution we have for the order
+func (n *OpenBazaarNode) GetPanelResolution(orderId string) (*pb.RicardianContract, error) {
+	val, err := n.IpfsNode.Repo.Datastore().Get(ds.NewKey(PanelResolutionPrefix + orderId))
+	if err != nil {
+		return nil, ErrPanelResolutionNotFound
+	}
+	b, ok := val.([]byte)
+	if !ok {
+		return nil, ErrPanelResolutionNotFound
+	}
+	rc := new(pb.RicardianContract)
+	err = proto.Unmarshal(b, rc)
+	if err != nil {
+		return nil, err
+	}
+	return rc, nil
+}
+
+func (n *OpenBazaarNode) putPanelResolution(rc *pb.RicardianContract) error {
+	ser, err := proto.Marshal(rc)
+	if err != nil {
+		return err
+	}
+	return n.IpfsNode.Repo.Datastore().Put(ds.NewKey(PanelResolutionPrefix+rc.DisputeResolution.OrderId), ser)
+}
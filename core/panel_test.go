@@ -0,0 +1,93 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestValidatePanel(t *testing.T) {
+	tests := []struct {
+		moderators []string
+		threshold  uint32
+		valid      bool
+	}{
+		{[]string{"a"}, 1, false},
+		{[]string{"a", "b"}, 2, true},
+		{[]string{"a", "b", "c"}, 2, true},
+		{[]string{"a", "b", "c"}, 1, false},
+		{[]string{"a", "b", "c"}, 4, false},
+		{[]string{"a", "b", "a"}, 2, false},
+		{[]string{"a", "b", "c", "d"}, 2, false},
+		{[]string{"a", "b", "c", "d", "e"}, 3, true},
+		{[]string{"a", "b", "c", "d", "e", "f", "g"}, 4, true},
+		{[]string{"a", "b", "c", "d", "e", "f", "g", "h"}, 5, false},
+	}
+	for i, test := range tests {
+		err := core.ValidatePanel(test.moderators, test.threshold)
+		if (err == nil) != test.valid {
+			t.Errorf("Test %d: expected valid to be %t, got error %v", i, test.valid, err)
+		}
+	}
+}
+
+func TestPaymentModerators(t *testing.T) {
+	payment := &pb.Order_Payment{
+		Method:    pb.Order_Payment_MODERATED,
+		Moderator: "a",
+	}
+	if core.IsPanelPayment(payment) {
+		t.Error("Single moderator payment reported as a panel")
+	}
+	if !core.IsPaymentModerator(payment, "a") || core.IsPaymentModerator(payment, "b") {
+		t.Error("Incorrect moderator for single moderator payment")
+	}
+	payment.Moderators = []string{"a", "b", "c"}
+	payment.ModeratorThreshold = 2
+	if !core.IsPanelPayment(payment) {
+		t.Error("Panel payment not detected")
+	}
+	if !core.IsPaymentModerator(payment, "c") || core.IsPaymentModerator(payment, "d") {
+		t.Error("Incorrect moderators for panel payment")
+	}
+}
+
+func TestMergePanelEndorsements(t *testing.T) {
+	resolution := func() *pb.DisputeResolution {
+		return &pb.DisputeResolution{OrderId: "QmOrder", ProposedBy: "a", Resolution: "Split it"}
+	}
+	endorsement := func(mod string) *pb.PanelResolution_Endorsement {
+		return &pb.PanelResolution_Endorsement{Moderator: mod, Signature: []byte(mod)}
+	}
+	stored := &pb.RicardianContract{
+		DisputeResolution: resolution(),
+		PanelResolution:   &pb.PanelResolution{OrderId: "QmOrder", Endorsements: []*pb.PanelResolution_Endorsement{endorsement("b")}},
+	}
+	rc := &pb.RicardianContract{
+		DisputeResolution: resolution(),
+		PanelResolution:   &pb.PanelResolution{OrderId: "QmOrder", Endorsements: []*pb.PanelResolution_Endorsement{endorsement("c"), endorsement("b")}},
+	}
+	core.MergePanelEndorsements(rc, stored)
+	if len(rc.PanelResolution.Endorsements) != 2 {
+		t.Errorf("Expected 2 endorsements, got %d", len(rc.PanelResolution.Endorsements))
+	}
+
+	rc.PanelResolution.Endorsements = []*pb.PanelResolution_Endorsement{endorsement("c")}
+	stored.PanelResolution.Endorsements = append(stored.PanelResolution.Endorsements, endorsement("d"))
+	core.MergePanelEndorsements(rc, stored)
+	var mods []string
+	for _, e := range rc.PanelResolution.Endorsements {
+		mods = append(mods, e.Moderator)
+	}
+	if len(mods) != 3 || mods[0] != "c" || mods[1] != "b" || mods[2] != "d" {
+		t.Errorf("Endorsements were not merged: %v", mods)
+	}
+
+	rc.DisputeResolution.Resolution = "Refund the buyer"
+	rc.PanelResolution.Endorsements = nil
+	core.MergePanelEndorsements(rc, stored)
+	if len(rc.PanelResolution.Endorsements) != 0 {
+		t.Error("Endorsements of a different resolution were merged")
+	}
+}
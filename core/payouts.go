@@ -0,0 +1,97 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// One payment of a batch payout
+type PayoutOutput struct {
+	Address string `json:"address"`
+	Amount  int64  `json:"amount"`
+	Memo    string `json:"memo"`
+}
+
+// Decode the payout addresses for the wallet
+func payoutSpendOutputs(wal wallet.Wallet, outs []PayoutOutput) ([]bitcoin.SpendOutput, error) {
+	if len(outs) == 0 {
+		return nil, errors.New("No outputs to pay")
+	}
+	var spendOuts []bitcoin.SpendOutput
+	for _, o := range outs {
+		addr, err := wal.DecodeAddress(o.Address)
+		if err != nil {
+			return nil, err
+		}
+		spendOuts = append(spendOuts, bitcoin.SpendOutput{Address: addr, Amount: o.Amount})
+	}
+	return spendOuts, nil
+}
+
+/* SpendMany pays all of the outputs from the wallet for the coin in one transaction with a
+   single change output. Frozen coins are not spent. The memo of each output is saved in the
+   transaction's metadata. */
+func (n *OpenBazaarNode) SpendMany(coin string, outs []PayoutOutput, memo string, feeLevel wallet.FeeLevel) (*chainhash.Hash, error) {
+	wal, utxos, err := n.spendableUtxos(coin)
+	if err != nil {
+		return nil, err
+	}
+	spendOuts, err := payoutSpendOutputs(wal, outs)
+	if err != nil {
+		return nil, err
+	}
+	if n.ColdStorage != nil {
+		outputs, err := bitcoin.SpendOutputs(wal, spendOuts)
+		if err != nil {
+			return nil, err
+		}
+		req, err := n.walletSpendRequest(wal, utxos, false, outputs, feeLevel)
+		if err != nil {
+			return nil, err
+		}
+		return nil, n.ColdStorage.export(req)
+	}
+	txid, err := bitcoin.SpendMany(wal, utxos, spendOuts, feeLevel)
+	if err != nil {
+		return nil, err
+	}
+	outputMemos := make(map[string]string)
+	for i, o := range outs {
+		if o.Memo == "" {
+			continue
+		}
+		addr := spendOuts[i].Address.EncodeAddress()
+		if m, ok := outputMemos[addr]; ok {
+			outputMemos[addr] = m + "; " + o.Memo
+		} else {
+			outputMemos[addr] = o.Memo
+		}
+	}
+	// Batch spends signal opt-in RBF like the wallets' own spends so the fee can be bumped
+	if err := n.Datastore.TxMetadata().Put(repo.Metadata{
+		Txid:        txid.String(),
+		Memo:        memo,
+		CanBumpFee:  true,
+		OutputMemos: outputMemos,
+	}); err != nil {
+		return txid, err
+	}
+	return txid, nil
+}
+
+// EstimateSpendManyFee returns the fee SpendMany would pay for the outputs
+func (n *OpenBazaarNode) EstimateSpendManyFee(coin string, outs []PayoutOutput, feeLevel wallet.FeeLevel) (uint64, error) {
+	wal, utxos, err := n.spendableUtxos(coin)
+	if err != nil {
+		return 0, err
+	}
+	spendOuts, err := payoutSpendOutputs(wal, outs)
+	if err != nil {
+		return 0, err
+	}
+	return bitcoin.EstimateSpendManyFee(wal, utxos, spendOuts, feeLevel)
+}
@@ -9,12 +9,15 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/OpenBazaar/jsonpb"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/golang/protobuf/proto"
 	"github.com/kennygrant/sanitize"
 )
@@ -28,7 +31,7 @@ const (
 )
 
 // JSON structure returned for each post from GETPosts
-type postData struct {
+type PostData struct {
 	Hash      string      `json:"hash"`
 	Slug      string      `json:"slug"`
 	Title     string      `json:"title"`
@@ -100,12 +103,10 @@ func (n *OpenBazaarNode) SignPost(post *pb.Post) (*pb.SignedPost, error) {
 	post.VendorID = id
 
 	// Sign the GUID with the Bitcoin key
-	ecPrivKey, err := n.Wallet.MasterPrivateKey().ECPrivKey()
+	id.BitcoinSig, err = n.bitcoinSig(id.PeerID)
 	if err != nil {
 		return sp, err
 	}
-	sig, err := ecPrivKey.Sign([]byte(id.PeerID))
-	id.BitcoinSig = sig.Serialize()
 
 	// Sign post
 	serializedPost, err := proto.Marshal(post)
@@ -134,14 +135,14 @@ func (n *OpenBazaarNode) UpdatePostIndex(post *pb.SignedPost) error {
 	return n.updatePostOnDisk(index, ld)
 }
 
-//extractpostData  [Extract data from the post, used to make postData and in GETPosts]
-func (n *OpenBazaarNode) extractpostData(post *pb.SignedPost) (postData, error) {
+//extractpostData  [Extract data from the post, used to make PostData and in GETPosts]
+func (n *OpenBazaarNode) extractpostData(post *pb.SignedPost) (PostData, error) {
 	postPath := path.Join(n.RepoPath, "root", "posts", post.Post.Slug+".json")
 
 	// Get the hash of the post's file and add to postHash variable
 	postHash, err := ipfs.GetHashOfFile(n.Context, postPath)
 	if err != nil {
-		return postData{}, err
+		return PostData{}, err
 	}
 
 	/* Generic function to loop through each element in an array
@@ -156,7 +157,7 @@ func (n *OpenBazaarNode) extractpostData(post *pb.SignedPost) (postData, error)
 	}
 
 	/* Add a tag in the post to an array called tags,
-	which will be added to the postData object below */
+	which will be added to the PostData object below */
 	tags := []string{}
 	for _, tag := range post.Post.Tags {
 		if !contains(tags, tag) {
@@ -167,20 +168,20 @@ func (n *OpenBazaarNode) extractpostData(post *pb.SignedPost) (postData, error)
 		}
 	}
 
-	// Create the postData object
-	ld := postData{
+	// Create the PostData object
+	ld := PostData{
 		Hash:  postHash,
 		Slug:  post.Post.Slug,
 		Title: post.Post.Title,
 		Tags:  tags,
 	}
 
-	// Add a timestamp to postData if it doesn't exist
+	// Add a timestamp to PostData if it doesn't exist
 	if post.Post.Timestamp != nil {
 		ld.Timestamp = FormatRFC3339PB(*post.Post.Timestamp)
 	}
 
-	// Add images to postData if they exist
+	// Add images to PostData if they exist
 	imageArray := []postImage{}
 	if len(post.Post.Images) > 0 {
 		for _, imageSlice := range post.Post.Images {
@@ -193,15 +194,15 @@ func (n *OpenBazaarNode) extractpostData(post *pb.SignedPost) (postData, error)
 	}
 	ld.Images = imageArray
 
-	// Returns postData in its final form
+	// Returns PostData in its final form
 	return ld, nil
 }
 
 //getPostIndex  [Get the post's index]
-func (n *OpenBazaarNode) getPostIndex() ([]postData, error) {
+func (n *OpenBazaarNode) getPostIndex() ([]PostData, error) {
 	indexPath := path.Join(n.RepoPath, "root", "posts.json")
 
-	var index []postData
+	var index []PostData
 
 	_, ferr := os.Stat(indexPath)
 	if !os.IsNotExist(ferr) {
@@ -219,7 +220,7 @@ func (n *OpenBazaarNode) getPostIndex() ([]postData, error) {
 }
 
 //updatePostOnDisk  [Update the posts.json file in the posts directory]
-func (n *OpenBazaarNode) updatePostOnDisk(index []postData, ld postData) error {
+func (n *OpenBazaarNode) updatePostOnDisk(index []PostData, ld PostData) error {
 	indexPath := path.Join(n.RepoPath, "root", "posts.json")
 	// Check to see if the post we are adding already exists in the list. If so delete it.
 	for i, d := range index {
@@ -228,7 +229,7 @@ func (n *OpenBazaarNode) updatePostOnDisk(index []postData, ld postData) error {
 		}
 
 		if len(index) == 1 {
-			index = []postData{}
+			index = []PostData{}
 			break
 		}
 		index = append(index[:i], index[i+1:]...)
@@ -259,7 +260,7 @@ func (n *OpenBazaarNode) updatePostOnDisk(index []postData, ld postData) error {
 func (n *OpenBazaarNode) UpdatePostHashes(hashes map[string]string) error {
 	indexPath := path.Join(n.RepoPath, "root", "posts.json")
 
-	var index []postData
+	var index []PostData
 
 	_, ferr := os.Stat(indexPath)
 	if os.IsNotExist(ferr) {
@@ -311,7 +312,7 @@ func (n *OpenBazaarNode) GetPostCount() int {
 		return 0
 	}
 
-	var index []postData
+	var index []PostData
 	err = json.Unmarshal(file, &index)
 	if err != nil {
 		return 0
@@ -326,7 +327,7 @@ func (n *OpenBazaarNode) DeletePost(slug string) error {
 	if err != nil {
 		return err
 	}
-	var index []postData
+	var index []PostData
 	indexPath := path.Join(n.RepoPath, "root", "posts.json")
 	_, ferr := os.Stat(indexPath)
 	if !os.IsNotExist(ferr) {
@@ -348,7 +349,7 @@ func (n *OpenBazaarNode) DeletePost(slug string) error {
 		}
 
 		if len(index) == 1 {
-			index = []postData{}
+			index = []PostData{}
 			break
 		}
 		index = append(index[:i], index[i+1:]...)
@@ -384,7 +385,7 @@ func (n *OpenBazaarNode) GetPosts() ([]byte, error) {
 	}
 
 	// Unmarshal the index to check if file contains valid json
-	var index []postData
+	var index []PostData
 	err = json.Unmarshal(file, &index)
 	if err != nil {
 		return nil, err
@@ -394,6 +395,42 @@ func (n *OpenBazaarNode) GetPosts() ([]byte, error) {
 	return file, nil
 }
 
+//QueryPosts  [Get the page of a post index matching the query and the number of posts which match]
+func QueryPosts(index []PostData, q repo.PostQuery) ([]PostData, int) {
+	var matches []PostData
+	for _, p := range index {
+		if repo.MatchesAny(q.Tags, p.Tags) && q.Posted.Contains(parseIndexTime(p.Timestamp)) {
+			matches = append(matches, p)
+		}
+	}
+	switch q.SortBy {
+	case repo.SortDateAsc:
+		sort.SliceStable(matches, func(i, j int) bool {
+			return parseIndexTime(matches[i].Timestamp).Before(parseIndexTime(matches[j].Timestamp))
+		})
+	case repo.SortDateDesc:
+		sort.SliceStable(matches, func(i, j int) bool {
+			return parseIndexTime(matches[i].Timestamp).After(parseIndexTime(matches[j].Timestamp))
+		})
+	}
+
+	slugs := make([]string, len(matches))
+	for i, p := range matches {
+		slugs[i] = p.Slug
+	}
+	start, end := q.Bounds(slugs)
+	return matches[start:end], len(matches)
+}
+
+// parseIndexTime returns the time of an index entry, or the zero time if it doesn't have one
+func parseIndexTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
 //GetPostFromHash  [Get a post based on the hash]
 func (n *OpenBazaarNode) GetPostFromHash(hash string) (*pb.SignedPost, error) {
 	// Read posts.json
@@ -404,7 +441,7 @@ func (n *OpenBazaarNode) GetPostFromHash(hash string) (*pb.SignedPost, error) {
 	}
 
 	// Unmarshal the index
-	var index []postData
+	var index []PostData
 	err = json.Unmarshal(file, &index)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,29 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestQueryPosts(t *testing.T) {
+	index := []core.PostData{
+		{Slug: "first", Tags: []string{"news"}, Timestamp: "2018-01-01T00:00:00.000Z"},
+		{Slug: "second", Tags: []string{"sale"}, Timestamp: "2018-02-01T00:00:00.000Z"},
+		{Slug: "third", Tags: []string{"news", "sale"}, Timestamp: "2018-03-01T00:00:00.000Z"},
+	}
+	posts, total := core.QueryPosts(index, repo.PostQuery{Tags: []string{"news"}, SortBy: repo.SortDateDesc, Page: repo.Page{Limit: 1}})
+	if total != 2 || len(posts) != 1 || posts[0].Slug != "third" {
+		t.Errorf("Unexpected page %v of %d", posts, total)
+	}
+	posts, total = core.QueryPosts(index, repo.PostQuery{Tags: []string{"news"}, SortBy: repo.SortDateDesc, Page: repo.Page{OffsetID: "third", Limit: 1}})
+	if total != 2 || len(posts) != 1 || posts[0].Slug != "first" {
+		t.Errorf("Unexpected page %v of %d", posts, total)
+	}
+	posts, total = core.QueryPosts(index, repo.PostQuery{Posted: repo.TimeRange{To: time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC)}, Page: repo.Page{Limit: -1}})
+	if total != 2 || len(posts) != 2 || posts[0].Slug != "first" || posts[1].Slug != "second" {
+		t.Errorf("Unexpected page %v of %d", posts, total)
+	}
+}
@@ -168,7 +168,7 @@ func (n *OpenBazaarNode) UpdateProfile(profile *pb.Profile) error {
 		OrigName:     false,
 	}
 	if profile.ModeratorInfo != nil {
-		profile.ModeratorInfo.AcceptedCurrencies = []string{strings.ToUpper(n.Wallet.CurrencyCode())}
+		profile.ModeratorInfo.AcceptedCurrencies = n.AcceptedCurrencies()
 	}
 	profile.PeerID = n.IpfsNode.Identity.Pretty()
 	ts, err := ptypes.TimestampProto(time.Now())
@@ -312,7 +312,8 @@ func (n *OpenBazaarNode) updateProfileCounts() error {
 	return nil
 }
 
-func (n *OpenBazaarNode) updateProfileRatings(newRating *pb.Rating) error {
+// Update the profile's rating stats, taking out the replaced rating if set. newRating may be nil.
+func (n *OpenBazaarNode) updateProfileRatings(newRating *pb.Rating, replaced *pb.Rating) error {
 	profilePath := path.Join(n.RepoPath, "root", "profile.json")
 	profile := new(pb.Profile)
 	_, ferr := os.Stat(profilePath)
@@ -329,11 +330,21 @@ func (n *OpenBazaarNode) updateProfileRatings(newRating *pb.Rating) error {
 	} else {
 		return nil
 	}
-	if profile.Stats != nil && newRating.RatingData != nil {
+	if profile.Stats != nil {
 		total := profile.Stats.AverageRating * float32(profile.Stats.RatingCount)
-		total += float32(newRating.RatingData.Overall)
-		profile.Stats.RatingCount += 1
-		profile.Stats.AverageRating = total / float32(profile.Stats.RatingCount)
+		if replaced != nil && replaced.RatingData != nil && profile.Stats.RatingCount > 0 {
+			total -= float32(replaced.RatingData.Overall)
+			profile.Stats.RatingCount -= 1
+		}
+		if newRating != nil && newRating.RatingData != nil {
+			total += float32(newRating.RatingData.Overall)
+			profile.Stats.RatingCount += 1
+		}
+		if profile.Stats.RatingCount > 0 {
+			profile.Stats.AverageRating = total / float32(profile.Stats.RatingCount)
+		} else {
+			profile.Stats.AverageRating = 0
+		}
 	}
 	newPro, _, err := n.appendCountsToProfile(profile)
 	if err != nil {
@@ -365,6 +376,9 @@ func ValidateProfile(profile *pb.Profile) error {
 	if len(profile.ShortDescription) > ShortDescriptionLength {
 		return fmt.Errorf("Short description character length is greater than the max of %d", ShortDescriptionLength)
 	}
+	if len(profile.Language) > WordMaxCharacters {
+		return fmt.Errorf("Language character length is greater than the max of %d", WordMaxCharacters)
+	}
 	if profile.ContactInfo != nil {
 		if len(profile.ContactInfo.Website) > URLMaxCharacters {
 			return fmt.Errorf("Website character length is greater than the max of %d", URLMaxCharacters)
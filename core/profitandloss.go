@@ -0,0 +1,126 @@
+package core
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var ErrUnknownPeriod = errors.New("Unknown period. Use day, month, year or leave it empty for the whole range.")
+
+/* PeriodSummary totals the order activity of one period in fiat. Transaction values already
+   include the network fee we paid so Fees is a breakdown and is not subtracted again. */
+type PeriodSummary struct {
+	Start         time.Time `json:"start"`
+	End           time.Time `json:"end"`
+	GrossSales    float64   `json:"grossSales"`
+	Purchases     float64   `json:"purchases"`
+	Refunds       float64   `json:"refunds"`
+	ModeratorFees float64   `json:"moderatorFees"`
+	Fees          float64   `json:"fees"`
+	Net           float64   `json:"net"`
+	Unvalued      int       `json:"unvalued"`
+}
+
+type ProfitAndLoss struct {
+	Currency string            `json:"currency"`
+	Entries  []AccountingEntry `json:"entries"`
+	Periods  []PeriodSummary   `json:"periods"`
+}
+
+/* ProfitAndLoss values the sales, purchases, refunds and moderator fees of every wallet in
+   the currency at the time they happened and summarizes them by period. */
+func (n *OpenBazaarNode) ProfitAndLoss(currency string, from, to time.Time, period string) (*ProfitAndLoss, error) {
+	if currency == "" {
+		currency = n.localCurrency()
+	}
+	var entries []AccountingEntry
+	for _, code := range n.Wallets.Codes() {
+		walletEntries, err := n.AccountingEntries(code, currency, from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range walletEntries {
+			switch e.Category {
+			case CategorySale, CategoryPurchase, CategoryRefund, CategoryModeratorFee:
+				entries = append(entries, e)
+			}
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	periods, err := SummarizeProfitAndLoss(entries, period)
+	if err != nil {
+		return nil, err
+	}
+	return &ProfitAndLoss{Currency: currency, Entries: entries, Periods: periods}, nil
+}
+
+// Returns the start of the period t falls in and the start of the next one
+func periodBounds(t time.Time, period string) (time.Time, time.Time, error) {
+	t = t.UTC()
+	switch period {
+	case "day":
+		start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 0, 1), nil
+	case "month":
+		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0), nil
+	case "year":
+		start := time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(1, 0, 0), nil
+	}
+	return time.Time{}, time.Time{}, ErrUnknownPeriod
+}
+
+/* SummarizeProfitAndLoss groups the entries by day, month or year in UTC. An empty period
+   gives a single summary spanning the entries. Entries without a recorded rate are counted
+   as unvalued. */
+func SummarizeProfitAndLoss(entries []AccountingEntry, period string) ([]PeriodSummary, error) {
+	if period != "" {
+		if _, _, err := periodBounds(time.Now(), period); err != nil {
+			return nil, err
+		}
+	}
+	var summaries []PeriodSummary
+	index := make(map[time.Time]int)
+	for _, e := range entries {
+		var start, end time.Time
+		if period != "" {
+			start, end, _ = periodBounds(e.Timestamp, period)
+		}
+		i, ok := index[start]
+		if !ok {
+			i = len(summaries)
+			index[start] = i
+			summaries = append(summaries, PeriodSummary{Start: start, End: end})
+		}
+		s := &summaries[i]
+		if period == "" {
+			if s.Start.IsZero() || e.Timestamp.Before(s.Start) {
+				s.Start = e.Timestamp
+			}
+			if e.Timestamp.After(s.End) {
+				s.End = e.Timestamp
+			}
+		}
+		if e.Rate == 0 {
+			s.Unvalued++
+			continue
+		}
+		switch e.Category {
+		case CategorySale:
+			s.GrossSales += e.FiatValue
+		case CategoryPurchase:
+			s.Purchases -= e.FiatValue
+		case CategoryRefund:
+			s.Refunds -= e.FiatValue
+		case CategoryModeratorFee:
+			s.ModeratorFees += e.FiatValue
+		}
+		s.Fees += float64(e.Fee) / unitsPerCoin * e.Rate
+		s.Net += e.FiatValue
+	}
+	return summaries, nil
+}
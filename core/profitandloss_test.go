@@ -0,0 +1,189 @@
+package core_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func TestSummarizeProfitAndLoss(t *testing.T) {
+	entries := []core.AccountingEntry{
+		{Timestamp: time.Date(2018, 1, 5, 0, 0, 0, 0, time.UTC), Category: core.CategorySale, Amount: 100000000, Rate: 15000, FiatValue: 15000},
+		{Timestamp: time.Date(2018, 1, 20, 0, 0, 0, 0, time.UTC), Category: core.CategoryRefund, Amount: -10000000, Fee: 100000, Rate: 12000, FiatValue: -1200},
+		{Timestamp: time.Date(2018, 1, 25, 0, 0, 0, 0, time.UTC), Category: core.CategorySale, Amount: 50000000},
+		{Timestamp: time.Date(2018, 2, 10, 0, 0, 0, 0, time.UTC), Category: core.CategoryModeratorFee, Amount: 1000000, Rate: 8000, FiatValue: 80},
+		{Timestamp: time.Date(2018, 2, 11, 0, 0, 0, 0, time.UTC), Category: core.CategoryPurchase, Amount: -2000000, Rate: 8000, FiatValue: -160},
+	}
+	periods, err := core.SummarizeProfitAndLoss(entries, "month")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(periods) != 2 {
+		t.Fatalf("Expected 2 periods, got %d", len(periods))
+	}
+	jan := periods[0]
+	if !jan.Start.Equal(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)) || !jan.End.Equal(time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("January has the wrong bounds")
+	}
+	if jan.GrossSales != 15000 || jan.Refunds != 1200 || jan.Fees != 12 || jan.Net != 13800 || jan.Unvalued != 1 {
+		t.Errorf("Wrong January summary: %+v", jan)
+	}
+	feb := periods[1]
+	if feb.ModeratorFees != 80 || feb.Purchases != 160 || feb.Net != -80 {
+		t.Errorf("Wrong February summary: %+v", feb)
+	}
+
+	total, err := core.SummarizeProfitAndLoss(entries, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(total) != 1 || total[0].Net != 13720 || !total[0].Start.Equal(entries[0].Timestamp) || !total[0].End.Equal(entries[4].Timestamp) {
+		t.Errorf("Wrong summary for the whole range: %+v", total)
+	}
+
+	if _, err := core.SummarizeProfitAndLoss(entries, "week"); err != core.ErrUnknownPeriod {
+		t.Error("Summarized an unknown period")
+	}
+}
+
+func TestProfitAndLossModeratedSale(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profitandloss")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(path.Join(dir, "datastore"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	datastore, err := db.Create(dir, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := datastore.InitTables(""); err != nil {
+		t.Fatal(err)
+	}
+
+	sale := func(method pb.Order_Payment_Method) pb.RicardianContract {
+		return pb.RicardianContract{
+			VendorListings: []*pb.Listing{{Item: &pb.Listing_Item{Title: "Shoes", Images: []*pb.Listing_Item_Image{{Tiny: "QmThumb"}}}}},
+			BuyerOrder: &pb.Order{
+				BuyerID:   &pb.ID{PeerID: "QmBuyer", Handle: "@buyer"},
+				Timestamp: &timestamp.Timestamp{Seconds: 1515000000},
+				Payment:   &pb.Order_Payment{Method: method, Amount: 100000000},
+			},
+		}
+	}
+	if err := datastore.Sales().Put("QmModerated", sale(pb.Order_Payment_MODERATED), pb.OrderState_COMPLETED, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := datastore.Sales().Put("QmRefunded", sale(pb.Order_Payment_DIRECT), pb.OrderState_REFUNDED, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := datastore.Cases().Put("QmCase", pb.OrderState_DISPUTED, true, "Never arrived"); err != nil {
+		t.Fatal(err)
+	}
+	if err := datastore.Cases().MarkAsClosed("QmCase", &pb.DisputeResolution{OrderId: "QmCase"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The escrow release, the refund and the moderator payout linked to their orders as the node saves them
+	ts := time.Date(2018, 1, 10, 0, 0, 0, 0, time.UTC)
+	for i, tx := range []struct {
+		orderId string
+		value   int
+	}{
+		{"QmModerated", 100000000},
+		{"QmRefunded", -20000000},
+		{"QmCase", 1000000},
+	} {
+		msg := wire.NewMsgTx(1)
+		msg.AddTxOut(wire.NewTxOut(int64(i), []byte{0x00}))
+		if err := datastore.Txns().Put(msg, tx.value, 500000, ts.Add(time.Duration(i)*time.Hour), false); err != nil {
+			t.Fatal(err)
+		}
+		if err := datastore.TxMetadata().Put(repo.Metadata{Txid: msg.TxHash().String(), OrderId: tx.orderId}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := datastore.RateHistory().Put(repo.RateSnapshot{Coin: "BTC", Currency: "USD", Rate: 10000, Timestamp: ts}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := core.AccountingEntries(datastore, datastore.Txns(), "BTC", "USD", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	categories := []string{core.CategorySale, core.CategoryRefund, core.CategoryModeratorFee}
+	if len(entries) != len(categories) {
+		t.Fatalf("Expected %d entries, got %d", len(categories), len(entries))
+	}
+	for i, e := range entries {
+		if e.Category != categories[i] {
+			t.Errorf("Entry %d is a %s, expected a %s", i, e.Category, categories[i])
+		}
+	}
+	if entries[0].Counterparty != "@buyer" {
+		t.Error("The moderated sale has the wrong counterparty")
+	}
+
+	periods, err := core.SummarizeProfitAndLoss(entries, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(periods) != 1 || periods[0].GrossSales != 10000 || periods[0].Refunds != 2000 || periods[0].ModeratorFees != 100 || periods[0].Net != 8100 {
+		t.Errorf("Wrong summary: %+v", periods)
+	}
+}
+
+func TestProfitAndLossBeforeFirstRate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "profitandloss")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(path.Join(dir, "datastore"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	datastore, err := db.Create(dir, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := datastore.InitTables(""); err != nil {
+		t.Fatal(err)
+	}
+
+	// A deposit made a month before the node started recording rates
+	ts := time.Date(2018, 1, 10, 0, 0, 0, 0, time.UTC)
+	msg := wire.NewMsgTx(1)
+	msg.AddTxOut(wire.NewTxOut(0, []byte{0x00}))
+	if err := datastore.Txns().Put(msg, 100000000, 500000, ts.AddDate(0, -1, 0), false); err != nil {
+		t.Fatal(err)
+	}
+	if err := datastore.RateHistory().Put(repo.RateSnapshot{Coin: "BTC", Currency: "USD", Rate: 10000, Timestamp: ts}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := core.AccountingEntries(datastore, datastore.Txns(), "BTC", "USD", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Rate != 0 || entries[0].FiatValue != 0 {
+		t.Fatalf("Transaction before the first snapshot was valued: %+v", entries)
+	}
+	periods, err := core.SummarizeProfitAndLoss(entries, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(periods) != 1 || periods[0].Unvalued != 1 {
+		t.Errorf("Expected 1 unvalued transaction, got %+v", periods)
+	}
+}
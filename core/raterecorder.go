@@ -0,0 +1,43 @@
+package core
+
+import (
+	"golang.org/x/net/context"
+	"time"
+)
+
+// How often the exchange rate of each wallet's coin is saved
+const rateRecordInterval = time.Hour
+
+// A transaction further than this from every snapshot has no known rate
+const rateMaxDistance = 2 * rateRecordInterval
+
+// The price fetchers need a moment to fill their caches after startup
+const rateRecordStartDelay = time.Minute
+
+/* RateRecorder is a background service which saves snapshots of the exchange rates so
+   transactions can later be valued at the rate of the day they happened. */
+type RateRecorder struct {
+	node *OpenBazaarNode
+	ctx  context.Context
+}
+
+func NewRateRecorder(node *OpenBazaarNode, ctx context.Context) *RateRecorder {
+	return &RateRecorder{node, ctx}
+}
+
+func (r *RateRecorder) Start() {
+	next := time.After(rateRecordStartDelay)
+	for {
+		select {
+		case <-next:
+			for _, code := range r.node.Wallets.Codes() {
+				if err := r.node.RecordExchangeRate(code); err != nil {
+					log.Errorf("Error recording %s exchange rate: %s", code, err.Error())
+				}
+			}
+			next = time.After(rateRecordInterval)
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package core
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	ipfspath "github.com/ipfs/go-ipfs/path"
+)
+
+const (
+	// Upper bound on the number of peers queried for a single aggregation
+	kMaxAggregationPeers = 100
+
+	kAggregationConcurrency = 16
+)
+
+// A rating this node left for a vendor. These are published in givenratings.json so that
+// anyone can find ratings a vendor chose not to publish.
+type GivenRating struct {
+	VendorID   string `json:"vendorId"`
+	Slug       string `json:"slug"`
+	RatingHash string `json:"ratingHash"`
+	Amends     string `json:"amends,omitempty"` // hash of the original rating if this is an amendment
+}
+
+// A verified rating found while aggregating
+type AggregatedRating struct {
+	RatingHash        string     `json:"ratingHash"`
+	Sources           []string   `json:"sources"`
+	PublishedByVendor bool       `json:"publishedByVendor"`
+	Rating            *pb.Rating `json:"rating"`
+}
+
+type RatingAggregate struct {
+	VendorID     string             `json:"vendorId"`
+	Slug         string             `json:"slug,omitempty"`
+	Count        int                `json:"count"`
+	Average      float32            `json:"average"`
+	Omitted      int                `json:"omitted"`
+	PeersQueried int                `json:"peersQueried"`
+	Ratings      []AggregatedRating `json:"ratings"`
+}
+
+/* Publish the ratings we leave for a vendor under our own root. The rating file is marshalled
+   the same way the vendor saves it so both copies normally have the same hash. Anonymous
+   ratings are not published since doing so would link them to our peer ID. */
+func (n *OpenBazaarNode) publishGivenRatings(ratings []*pb.Rating) error {
+	index, err := n.getGivenRatingsIndex()
+	if err != nil {
+		return err
+	}
+	for _, rating := range ratings {
+		if rating.RatingData.BuyerID == nil {
+			continue
+		}
+		gr, err := n.saveGivenRating(rating)
+		if err != nil {
+			return err
+		}
+		index = append(index, gr)
+	}
+	return n.writeGivenRatingsIndex(index)
+}
+
+/* Replace a published rating with its amendment, or unlist it if the amendment is a retraction.
+   The original rating file is kept so the amendment can still be checked against it. */
+func (n *OpenBazaarNode) amendGivenRating(original *pb.Rating, amended *pb.Rating) error {
+	if original.RatingData.BuyerID == nil {
+		return nil
+	}
+	index, err := n.getGivenRatingsIndex()
+	if err != nil {
+		return err
+	}
+	_, originalFile, err := marshalRatingFile(original)
+	if err != nil {
+		return err
+	}
+	originalHash, err := ipfs.GetHashOfFile(n.Context, path.Join(n.RepoPath, "root", "givenratings", originalFile))
+	if err != nil {
+		return err
+	}
+	var updated []GivenRating
+	for _, gr := range index {
+		if gr.RatingHash != originalHash && gr.Amends != originalHash {
+			updated = append(updated, gr)
+		}
+	}
+	if amended != nil {
+		gr, err := n.saveGivenRating(amended)
+		if err != nil {
+			return err
+		}
+		gr.Amends = originalHash
+		updated = append(updated, gr)
+	}
+	return n.writeGivenRatingsIndex(updated)
+}
+
+func (n *OpenBazaarNode) saveGivenRating(rating *pb.Rating) (GivenRating, error) {
+	ratingsDir := path.Join(n.RepoPath, "root", "givenratings")
+	if err := os.MkdirAll(ratingsDir, os.ModePerm); err != nil {
+		return GivenRating{}, err
+	}
+	ratingJson, filename, err := marshalRatingFile(rating)
+	if err != nil {
+		return GivenRating{}, err
+	}
+	ratingPath := path.Join(ratingsDir, filename)
+	if err := ioutil.WriteFile(ratingPath, []byte(ratingJson), os.ModePerm); err != nil {
+		return GivenRating{}, err
+	}
+	ratingHash, err := ipfs.GetHashOfFile(n.Context, ratingPath)
+	if err != nil {
+		return GivenRating{}, err
+	}
+	return GivenRating{
+		VendorID:   rating.RatingData.VendorID.PeerID,
+		Slug:       rating.RatingData.VendorSig.Metadata.ListingSlug,
+		RatingHash: ratingHash,
+	}, nil
+}
+
+func (n *OpenBazaarNode) getGivenRatingsIndex() ([]GivenRating, error) {
+	var index []GivenRating
+	file, err := ioutil.ReadFile(path.Join(n.RepoPath, "root", "givenratings.json"))
+	if os.IsNotExist(err) {
+		return index, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(file, &index); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func (n *OpenBazaarNode) writeGivenRatingsIndex(index []GivenRating) error {
+	j, err := json.MarshalIndent(index, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(n.RepoPath, "root", "givenratings.json"), j, os.ModePerm)
+}
+
+/* AggregateRatings collects the ratings for a vendor, or one of the vendor's listings if slug is set,
+   from the vendor's own index and from the ratings published by the vendor's followers and our
+   connected peers. Every rating is checked with ValidateRating. Ratings left by buyers which the
+   vendor did not publish are counted as omitted. */
+func (n *OpenBazaarNode) AggregateRatings(vendorID string, slug string) (*RatingAggregate, error) {
+	collector := newRatingCollector(vendorID, slug)
+	addRating := func(ratingHash string, source string, fromVendor bool) {
+		rating, err := n.fetchRating(ratingHash)
+		if err != nil {
+			return
+		}
+		collector.add(ratingHash, rating, source, fromVendor)
+	}
+
+	// Ratings the vendor published
+	var indexBytes []byte
+	if vendorID == n.IpfsNode.Identity.Pretty() {
+		indexBytes, _ = ioutil.ReadFile(path.Join(n.RepoPath, "root", "ratings.json"))
+	} else {
+		indexBytes, _ = n.IPNSResolveThenCat(ipfspath.FromString(path.Join(vendorID, "ratings.json")), time.Minute)
+	}
+	var vendorIndex []SavedRating
+	if indexBytes != nil {
+		json.Unmarshal(indexBytes, &vendorIndex)
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, kAggregationConcurrency)
+	for _, sr := range vendorIndex {
+		if slug != "" && sr.Slug != slug {
+			continue
+		}
+		for _, h := range sr.Ratings {
+			wg.Add(1)
+			go func(h string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				addRating(h, vendorID, true)
+			}(h)
+		}
+	}
+	wg.Wait()
+
+	// Ratings published by buyers
+	peers := n.ratingAggregationPeers(vendorID)
+	for _, p := range peers {
+		wg.Add(1)
+		go func(p string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			for _, gr := range n.fetchGivenRatings(p) {
+				if gr.VendorID != vendorID || (slug != "" && gr.Slug != slug) {
+					continue
+				}
+				addRating(gr.RatingHash, p, false)
+			}
+		}(p)
+	}
+	wg.Wait()
+	return collector.aggregate(len(peers)), nil
+}
+
+// Merges the validated ratings found for a vendor. A rating found at several sources is counted once.
+type ratingCollector struct {
+	vendorID string
+	slug     string
+	found    map[string]*AggregatedRating
+	sync.Mutex
+}
+
+func newRatingCollector(vendorID string, slug string) *ratingCollector {
+	return &ratingCollector{vendorID: vendorID, slug: slug, found: make(map[string]*AggregatedRating)}
+}
+
+/* Add a rating found at the source. Ratings of other vendors or listings are ignored, as are
+   ratings a buyer publishes which they did not sign themselves. */
+func (c *ratingCollector) add(ratingHash string, rating *pb.Rating, source string, fromVendor bool) {
+	if rating.RatingData.VendorID.PeerID != c.vendorID {
+		return
+	}
+	if c.slug != "" && rating.RatingData.VendorSig.Metadata.ListingSlug != c.slug {
+		return
+	}
+	// A buyer may only vouch for ratings they signed themselves
+	if !fromVendor && (rating.RatingData.BuyerID == nil || rating.RatingData.BuyerID.PeerID != source) {
+		return
+	}
+	key := hex.EncodeToString(rating.Signature)
+	c.Lock()
+	defer c.Unlock()
+	ar, ok := c.found[key]
+	if !ok {
+		ar = &AggregatedRating{RatingHash: ratingHash, Rating: rating}
+		c.found[key] = ar
+	}
+	ar.Sources = append(ar.Sources, source)
+	if fromVendor {
+		ar.PublishedByVendor = true
+		ar.RatingHash = ratingHash
+	}
+}
+
+// Summarize the collected ratings with the newest first
+func (c *ratingCollector) aggregate(peersQueried int) *RatingAggregate {
+	c.Lock()
+	defer c.Unlock()
+	agg := &RatingAggregate{VendorID: c.vendorID, Slug: c.slug, PeersQueried: peersQueried}
+	var total float32
+	for _, ar := range c.found {
+		agg.Ratings = append(agg.Ratings, *ar)
+		total += float32(ar.Rating.RatingData.Overall)
+		if !ar.PublishedByVendor {
+			agg.Omitted++
+		}
+	}
+	agg.Count = len(agg.Ratings)
+	if agg.Count > 0 {
+		agg.Average = total / float32(agg.Count)
+	}
+	sort.Slice(agg.Ratings, func(i, j int) bool {
+		return agg.Ratings[i].Rating.RatingData.Timestamp.GetSeconds() > agg.Ratings[j].Rating.RatingData.Timestamp.GetSeconds()
+	})
+	return agg
+}
+
+// The vendor's followers and our connected peers, excluding the vendor
+func (n *OpenBazaarNode) ratingAggregationPeers(vendorID string) []string {
+	seen := map[string]bool{vendorID: true}
+	var peers []string
+	add := func(p string) {
+		if !seen[p] && len(peers) < kMaxAggregationPeers {
+			seen[p] = true
+			peers = append(peers, p)
+		}
+	}
+	if vendorID == n.IpfsNode.Identity.Pretty() {
+		followers, err := n.Datastore.Followers().Get("", -1)
+		if err == nil {
+			for _, f := range followers {
+				add(f.PeerId)
+			}
+		}
+	} else if followBytes, err := n.IPNSResolveThenCat(ipfspath.FromString(path.Join(vendorID, "followers.json")), time.Minute); err == nil {
+		var followers []repo.Follower
+		if err := json.Unmarshal(followBytes, &followers); err == nil {
+			for _, f := range followers {
+				add(f.PeerId)
+			}
+		}
+	}
+	for _, p := range n.IpfsNode.PeerHost.Network().Peers() {
+		add(p.Pretty())
+	}
+	if vendorID != n.IpfsNode.Identity.Pretty() {
+		add(n.IpfsNode.Identity.Pretty())
+	}
+	return peers
+}
+
+func (n *OpenBazaarNode) fetchGivenRatings(peerID string) []GivenRating {
+	var indexBytes []byte
+	var err error
+	if peerID == n.IpfsNode.Identity.Pretty() {
+		indexBytes, err = ioutil.ReadFile(path.Join(n.RepoPath, "root", "givenratings.json"))
+	} else {
+		indexBytes, err = n.IPNSResolveThenCat(ipfspath.FromString(path.Join(peerID, "givenratings.json")), time.Second*30)
+	}
+	if err != nil {
+		return nil
+	}
+	var index []GivenRating
+	if err := json.Unmarshal(indexBytes, &index); err != nil {
+		return nil
+	}
+	return index
+}
+
+// Fetch a rating by hash and validate it
+func (n *OpenBazaarNode) fetchRating(ratingHash string) (*pb.Rating, error) {
+	ratingBytes, err := ipfs.Cat(n.Context, ratingHash, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	rating := new(pb.Rating)
+	err = jsonpb.UnmarshalString(string(ratingBytes), rating)
+	if err != nil {
+		return nil, err
+	}
+	valid, err := ValidateRating(rating)
+	if !valid || err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
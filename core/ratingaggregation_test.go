@@ -0,0 +1,200 @@
+package core
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	peer "gx/ipfs/QmXYjuNuxVzXKJCfWasQk1RqkhVLDM9jtUKhqc2WPQmFSB/go-libp2p-peer"
+	crypto "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
+)
+
+type ratingTestPeer struct {
+	id   *pb.ID
+	priv crypto.PrivKey
+}
+
+func newRatingTestPeer(t *testing.T) *ratingTestPeer {
+	priv, pub, err := crypto.GenerateKeyPair(crypto.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubBytes, err := pub.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &ratingTestPeer{&pb.ID{PeerID: pid.Pretty(), Pubkeys: &pb.ID_Pubkeys{Identity: pubBytes}}, priv}
+}
+
+// A rating signed by the vendor, the buyer unless the buyer is nil, and the rating key
+func newTestRating(t *testing.T, vendor *ratingTestPeer, buyer *ratingTestPeer, slug string, overall uint32, ts int64) *pb.Rating {
+	ratingKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata := &pb.RatingSignature_TransactionMetadata{
+		ListingSlug: slug,
+		RatingKey:   ratingKey.PubKey().SerializeCompressed(),
+	}
+	ser, err := proto.Marshal(metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vendorSig, err := vendor.priv.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := &pb.Rating_RatingData{
+		VendorID:  vendor.id,
+		VendorSig: &pb.RatingSignature{Metadata: metadata, Signature: vendorSig},
+		RatingKey: metadata.RatingKey,
+		Overall:   overall,
+		Timestamp: &timestamp.Timestamp{Seconds: ts},
+	}
+	if buyer != nil {
+		data.BuyerID = buyer.id
+		data.BuyerSig, err = buyer.priv.Sign(data.RatingKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	ser, err = proto.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashed := sha256.Sum256(ser)
+	sig, err := ratingKey.Sign(hashed[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.Rating{RatingData: data, Signature: sig.Serialize()}
+}
+
+func TestValidateAggregatedRating(t *testing.T) {
+	vendor := newRatingTestPeer(t)
+	buyer := newRatingTestPeer(t)
+	other := newRatingTestPeer(t)
+
+	tests := []struct {
+		name   string
+		buyer  *ratingTestPeer
+		modify func(r *pb.Rating)
+		valid  bool
+	}{
+		{"signed rating", buyer, func(r *pb.Rating) {}, true},
+		{"anonymous rating", nil, func(r *pb.Rating) {}, true},
+		{"changed score", buyer, func(r *pb.Rating) { r.RatingData.Overall = 1 }, false},
+		{"changed slug", buyer, func(r *pb.Rating) { r.RatingData.VendorSig.Metadata.ListingSlug = "hat" }, false},
+		{"claimed by another buyer", buyer, func(r *pb.Rating) { r.RatingData.BuyerID = other.id }, false},
+		{"claimed for another vendor", buyer, func(r *pb.Rating) { r.RatingData.VendorID = other.id }, false},
+	}
+	for _, test := range tests {
+		rating := newTestRating(t, vendor, test.buyer, "shoes", 5, 100)
+		test.modify(rating)
+		valid, _ := ValidateRating(rating)
+		if valid != test.valid {
+			t.Errorf("%s: expected valid %v, got %v", test.name, test.valid, valid)
+		}
+	}
+}
+
+func TestRatingCollector(t *testing.T) {
+	vendor := newRatingTestPeer(t)
+	buyer1 := newRatingTestPeer(t)
+	buyer2 := newRatingTestPeer(t)
+	otherVendor := newRatingTestPeer(t)
+
+	shoes := newTestRating(t, vendor, buyer1, "shoes", 5, 100)
+	hat := newTestRating(t, vendor, buyer2, "hat", 3, 200)
+	anonymous := newTestRating(t, vendor, nil, "shoes", 4, 300)
+	elsewhere := newTestRating(t, otherVendor, buyer1, "shoes", 1, 400)
+
+	type find struct {
+		hash       string
+		rating     *pb.Rating
+		source     string
+		fromVendor bool
+	}
+	v := vendor.id.PeerID
+	tests := []struct {
+		name    string
+		slug    string
+		finds   []find
+		count   int
+		omitted int
+		average float32
+		newest  string
+		sources int
+	}{
+		{
+			name:    "vendor index",
+			finds:   []find{{"QmShoes", shoes, v, true}, {"QmHat", hat, v, true}, {"QmAnon", anonymous, v, true}},
+			count:   3,
+			average: 4,
+			newest:  "QmAnon",
+			sources: 1,
+		},
+		{
+			name:    "buyer copy of a published rating",
+			finds:   []find{{"QmBuyerShoes", shoes, buyer1.id.PeerID, false}, {"QmShoes", shoes, v, true}},
+			count:   1,
+			average: 5,
+			newest:  "QmShoes",
+			sources: 2,
+		},
+		{
+			name:    "rating the vendor omitted",
+			finds:   []find{{"QmShoes", shoes, v, true}, {"QmHat", hat, buyer2.id.PeerID, false}},
+			count:   2,
+			omitted: 1,
+			average: 4,
+			newest:  "QmHat",
+			sources: 1,
+		},
+		{
+			name:  "buyer publishing another buyer's rating",
+			finds: []find{{"QmHat", hat, buyer1.id.PeerID, false}},
+		},
+		{
+			name:  "peer publishing an anonymous rating",
+			finds: []find{{"QmAnon", anonymous, buyer1.id.PeerID, false}},
+		},
+		{
+			name:  "rating of another vendor",
+			finds: []find{{"QmElsewhere", elsewhere, v, true}, {"QmElsewhere", elsewhere, buyer1.id.PeerID, false}},
+		},
+		{
+			name:    "listing",
+			slug:    "shoes",
+			finds:   []find{{"QmShoes", shoes, v, true}, {"QmHat", hat, v, true}, {"QmHat", hat, buyer2.id.PeerID, false}},
+			count:   1,
+			average: 5,
+			newest:  "QmShoes",
+			sources: 1,
+		},
+	}
+	for _, test := range tests {
+		c := newRatingCollector(v, test.slug)
+		for _, f := range test.finds {
+			c.add(f.hash, f.rating, f.source, f.fromVendor)
+		}
+		agg := c.aggregate(len(test.finds))
+		if agg.Count != test.count || agg.Omitted != test.omitted || agg.Average != test.average || agg.PeersQueried != len(test.finds) {
+			t.Errorf("%s: wrong aggregate %d ratings, %d omitted, average %f", test.name, agg.Count, agg.Omitted, agg.Average)
+			continue
+		}
+		if test.count == 0 {
+			continue
+		}
+		if agg.Ratings[0].RatingHash != test.newest || len(agg.Ratings[0].Sources) != test.sources {
+			t.Errorf("%s: expected %s from %d sources first, got %s from %v", test.name, test.newest, test.sources, agg.Ratings[0].RatingHash, agg.Ratings[0].Sources)
+		}
+	}
+}
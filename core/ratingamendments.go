@@ -0,0 +1,418 @@
+package core
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	ipfspath "github.com/ipfs/go-ipfs/path"
+	libp2p "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
+)
+
+var (
+	ErrRatingAmendmentWindowClosed = errors.New("The window for amending this rating has closed")
+	ErrOrderNotCompleted           = errors.New("Order has not been completed")
+)
+
+// An amendment or retraction of one of the ratings left in an order completion
+type OrderRatingAmendment struct {
+	OrderId string `json:"orderId"`
+	Retract bool   `json:"retract"`
+	RatingData
+}
+
+// The amendment is stored in the ratings directory under the hash of the original rating
+func ratingAmendmentFilename(originalHash string) string {
+	return originalHash + ".amendment.json"
+}
+
+/* AmendRating replaces or retracts a rating we left in the order completion. The amended rating
+   keeps the rating key, vendor signature and buyer ID of the original so it validates the same
+   way. Both the new rating and the amendment are signed with the rating key, which ties the
+   amendment to the original. */
+func (n *OpenBazaarNode) AmendRating(amendment *OrderRatingAmendment, contract *pb.RicardianContract) (*pb.RatingAmendment, error) {
+	if contract.BuyerOrderCompletion == nil {
+		return nil, ErrOrderNotCompleted
+	}
+	var original *pb.Rating
+	for _, r := range contract.BuyerOrderCompletion.Ratings {
+		if r.RatingData != nil && r.RatingData.VendorSig != nil && r.RatingData.VendorSig.Metadata.ListingSlug == amendment.Slug {
+			original = r
+			break
+		}
+	}
+	if original == nil {
+		return nil, ErrRatingNotFound
+	}
+	if !n.withinRatingAmendmentWindow(original) {
+		return nil, ErrRatingAmendmentWindowClosed
+	}
+
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	ad := &pb.RatingAmendment_AmendmentData{
+		OrderId:           amendment.OrderId,
+		OriginalSignature: original.Signature,
+		Retracted:         amendment.Retract,
+		Timestamp:         ts,
+	}
+	if !amendment.Retract {
+		rd := &pb.Rating_RatingData{
+			RatingKey:       original.RatingData.RatingKey,
+			VendorID:        original.RatingData.VendorID,
+			VendorSig:       original.RatingData.VendorSig,
+			BuyerID:         original.RatingData.BuyerID,
+			BuyerName:       original.RatingData.BuyerName,
+			BuyerSig:        original.RatingData.BuyerSig,
+			ModeratorSig:    original.RatingData.ModeratorSig,
+			Timestamp:       ts,
+			Overall:         uint32(amendment.Overall),
+			Quality:         uint32(amendment.Quality),
+			Description:     uint32(amendment.Description),
+			DeliverySpeed:   uint32(amendment.DeliverySpeed),
+			CustomerService: uint32(amendment.CustomerService),
+			Review:          amendment.Review,
+		}
+		ser, err := proto.Marshal(rd)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := n.signWithRatingKey(contract, ser)
+		if err != nil {
+			return nil, err
+		}
+		ad.Rating = &pb.Rating{RatingData: rd, Signature: sig}
+	}
+
+	ra := &pb.RatingAmendment{AmendmentData: ad}
+	ser, err := proto.Marshal(ad)
+	if err != nil {
+		return nil, err
+	}
+	ra.Signature, err = n.signWithRatingKey(contract, ser)
+	if err != nil {
+		return nil, err
+	}
+
+	vendorKey, err := libp2p.UnmarshalPublicKey(contract.VendorListings[0].VendorID.Pubkeys.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.SendRatingAmendment(contract.VendorListings[0].VendorID.PeerID, &vendorKey, ra); err != nil {
+		return nil, err
+	}
+
+	if err := n.amendGivenRating(original, ad.Rating); err != nil {
+		log.Errorf("Error publishing amended rating for order %s: %s", amendment.OrderId, err.Error())
+	}
+	return ra, nil
+}
+
+// Sign with the rating key derived for the order, as is done for the ratings in CompleteOrder
+func (n *OpenBazaarNode) signWithRatingKey(contract *pb.RicardianContract, ser []byte) ([]byte, error) {
+	mPrivKey, err := bitcoin.MasterPrivateKey(n.Wallet)
+	if err != nil {
+		return nil, err
+	}
+	ratingKey, err := mPrivKey.Child(uint32(contract.BuyerOrder.Timestamp.Seconds))
+	if err != nil {
+		return nil, err
+	}
+	ecRatingKey, err := ratingKey.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	hashed := sha256.Sum256(ser)
+	sig, err := ecRatingKey.Sign(hashed[:])
+	if err != nil {
+		return nil, err
+	}
+	return sig.Serialize(), nil
+}
+
+func (n *OpenBazaarNode) withinRatingAmendmentWindow(original *pb.Rating) bool {
+	ratingTime, err := ptypes.Timestamp(original.RatingData.Timestamp)
+	if err != nil {
+		return false
+	}
+	return time.Since(ratingTime) <= n.RatingAmendmentWindow
+}
+
+/* ProcessRatingAmendment validates an amendment sent by the buyer of one of our sales and updates
+   the ratings index, listing index and profile. The original rating file is left in place and
+   the amendment is saved next to it so both can still be verified. */
+func (n *OpenBazaarNode) ProcessRatingAmendment(amendment *pb.RatingAmendment) (*pb.Rating, error) {
+	if amendment.AmendmentData == nil {
+		return nil, errors.New("missing amendment data")
+	}
+	contract, _, _, _, _, err := n.Datastore.Sales().GetByOrderId(amendment.AmendmentData.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if contract.BuyerOrderCompletion == nil {
+		return nil, ErrOrderNotCompleted
+	}
+	var original *pb.Rating
+	for _, r := range contract.BuyerOrderCompletion.Ratings {
+		if bytes.Equal(r.Signature, amendment.AmendmentData.OriginalSignature) {
+			original = r
+			break
+		}
+	}
+	if original == nil {
+		return nil, ErrRatingNotFound
+	}
+	if err := ValidateRatingAmendment(amendment, original); err != nil {
+		return nil, err
+	}
+	if !n.withinRatingAmendmentWindow(original) {
+		return nil, ErrRatingAmendmentWindowClosed
+	}
+
+	ratingsDir := path.Join(n.RepoPath, "root", "ratings")
+	_, originalFile, err := marshalRatingFile(original)
+	if err != nil {
+		return nil, err
+	}
+	originalHash, err := ipfs.GetHashOfFile(n.Context, path.Join(ratingsDir, originalFile))
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the rating currently in the index. This is the original unless it was already amended.
+	indexPath := path.Join(n.RepoPath, "root", "ratings.json")
+	var index []SavedRating
+	if file, err := ioutil.ReadFile(indexPath); err == nil {
+		if err := json.Unmarshal(file, &index); err != nil {
+			return nil, err
+		}
+	}
+	slug := original.RatingData.VendorSig.Metadata.ListingSlug
+	current, currentHash := original, originalHash
+	for _, sr := range index {
+		if sr.Slug != slug {
+			continue
+		}
+		if _, ok := sr.Amendments[originalHash]; !ok {
+			break
+		}
+		prevBytes, err := ioutil.ReadFile(path.Join(ratingsDir, ratingAmendmentFilename(originalHash)))
+		if err != nil {
+			return nil, err
+		}
+		prev := new(pb.RatingAmendment)
+		if err := jsonpb.UnmarshalString(string(prevBytes), prev); err != nil {
+			return nil, err
+		}
+		if amendment.AmendmentData.Timestamp.GetSeconds() <= prev.AmendmentData.Timestamp.GetSeconds() {
+			return nil, errors.New("Amendment is older than the current amendment")
+		}
+		current, currentHash = prev.AmendmentData.Rating, ""
+		if current != nil {
+			_, currentFile, err := marshalRatingFile(current)
+			if err != nil {
+				return nil, err
+			}
+			currentHash, err = ipfs.GetHashOfFile(n.Context, path.Join(ratingsDir, currentFile))
+			if err != nil {
+				return nil, err
+			}
+		}
+		break
+	}
+
+	// Only take the current rating out of the averages if it was counted in the first place
+	listed := false
+	for _, sr := range index {
+		for _, h := range sr.Ratings {
+			if current != nil && h == currentHash {
+				listed = true
+			}
+		}
+	}
+	if !listed {
+		current = nil
+	}
+
+	amended := amendment.AmendmentData.Rating
+	var amendedPath string
+	if amended != nil {
+		ratingJson, amendedFile, err := marshalRatingFile(amended)
+		if err != nil {
+			return nil, err
+		}
+		amendedPath = path.Join(ratingsDir, amendedFile)
+		if err := ioutil.WriteFile(amendedPath, []byte(ratingJson), os.ModePerm); err != nil {
+			return nil, err
+		}
+	}
+	if amended != nil || current != nil {
+		if err := n.updateRatingIndex(amended, amendedPath, current, currentHash); err != nil {
+			return nil, err
+		}
+		if err := n.updateRatingInListingIndex(amended, current); err != nil {
+			return nil, err
+		}
+		if err := n.updateProfileRatings(amended, current); err != nil {
+			return nil, err
+		}
+	}
+
+	// Save the amendment next to the original and record it in the index
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	amendmentJson, err := m.MarshalToString(amendment)
+	if err != nil {
+		return nil, err
+	}
+	amendmentPath := path.Join(ratingsDir, ratingAmendmentFilename(originalHash))
+	if err := ioutil.WriteFile(amendmentPath, []byte(amendmentJson), os.ModePerm); err != nil {
+		return nil, err
+	}
+	amendmentHash, err := ipfs.GetHashOfFile(n.Context, amendmentPath)
+	if err != nil {
+		return nil, err
+	}
+	index = nil
+	if file, err := ioutil.ReadFile(indexPath); err == nil {
+		if err := json.Unmarshal(file, &index); err != nil {
+			return nil, err
+		}
+	}
+	for i, sr := range index {
+		if sr.Slug != slug {
+			continue
+		}
+		if index[i].Amendments == nil {
+			index[i].Amendments = make(map[string]string)
+		}
+		index[i].Amendments[originalHash] = amendmentHash
+	}
+	if err := writeRatingIndex(indexPath, index); err != nil {
+		return nil, err
+	}
+	return original, nil
+}
+
+/* ValidateRatingAmendment checks the amendment was signed by the key of the original rating
+   and that the amended rating only changes the buyer's scores and review. */
+func ValidateRatingAmendment(amendment *pb.RatingAmendment, original *pb.Rating) error {
+	ad := amendment.AmendmentData
+	if ad == nil || original.RatingData == nil {
+		return errors.New("missing amendment data")
+	}
+	if !bytes.Equal(ad.OriginalSignature, original.Signature) {
+		return errors.New("amendment does not reference the original rating")
+	}
+	ratingKey, err := btcec.ParsePubKey(original.RatingData.RatingKey, btcec.S256())
+	if err != nil {
+		return err
+	}
+	sig, err := btcec.ParseSignature(amendment.Signature, btcec.S256())
+	if err != nil {
+		return err
+	}
+	ser, err := proto.Marshal(ad)
+	if err != nil {
+		return err
+	}
+	hashed := sha256.Sum256(ser)
+	if !sig.Verify(hashed[:], ratingKey) {
+		return errors.New("invalid amendment signature")
+	}
+
+	if ad.Retracted {
+		if ad.Rating != nil {
+			return errors.New("retraction must not contain a rating")
+		}
+		return nil
+	}
+	if ad.Rating == nil || ad.Rating.RatingData == nil {
+		return errors.New("amendment does not contain a rating")
+	}
+	if valid, err := ValidateRating(ad.Rating); !valid || err != nil {
+		return err
+	}
+	rd := ad.Rating.RatingData
+	if !bytes.Equal(rd.RatingKey, original.RatingData.RatingKey) {
+		return errors.New("amended rating key does not match original")
+	}
+	if !proto.Equal(rd.VendorSig, original.RatingData.VendorSig) || !proto.Equal(rd.VendorID, original.RatingData.VendorID) {
+		return errors.New("amended rating vendor does not match original")
+	}
+	if !proto.Equal(rd.BuyerID, original.RatingData.BuyerID) {
+		return errors.New("amended rating buyer does not match original")
+	}
+	for _, val := range []uint32{rd.Overall, rd.Quality, rd.Description, rd.DeliverySpeed, rd.CustomerService} {
+		if val < RatingMin || val > RatingMax {
+			return errors.New("rating values must be between 1 and 5")
+		}
+	}
+	if len(rd.Review) > ReviewMaxCharacters {
+		return errors.New("too many characters in review")
+	}
+	return nil
+}
+
+// GetRatingAmendment returns the amendment to the rating, if one has been published, after checking it against the original
+func (n *OpenBazaarNode) GetRatingAmendment(vendorID string, ratingHash string) (*pb.RatingAmendment, error) {
+	var amendmentBytes []byte
+	var err error
+	if vendorID == n.IpfsNode.Identity.Pretty() {
+		amendmentBytes, err = ioutil.ReadFile(path.Join(n.RepoPath, "root", "ratings", ratingAmendmentFilename(ratingHash)))
+	} else {
+		amendmentBytes, err = n.IPNSResolveThenCat(ipfspath.FromString(path.Join(vendorID, "ratings", ratingAmendmentFilename(ratingHash))), time.Minute)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ra := new(pb.RatingAmendment)
+	if err := jsonpb.UnmarshalString(string(amendmentBytes), ra); err != nil {
+		return nil, err
+	}
+	original, err := n.fetchRating(ratingHash)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateRatingAmendment(ra, original); err != nil {
+		return nil, err
+	}
+	return ra, nil
+}
+
+// Marshal the rating the way ValidateAndSaveRating does and return the name of its file in the ratings directory
+func marshalRatingFile(rating *pb.Rating) (string, string, error) {
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	ratingJson, err := m.MarshalToString(rating)
+	if err != nil {
+		return "", "", err
+	}
+	mh, err := EncodeMultihash([]byte(ratingJson))
+	if err != nil {
+		return "", "", err
+	}
+	return ratingJson, mh.B58String()[:12] + ".json", nil
+}
@@ -0,0 +1,80 @@
+package core_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestValidateRatingAmendment(t *testing.T) {
+	ratingKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	original := &pb.Rating{
+		RatingData: &pb.Rating_RatingData{
+			RatingKey: ratingKey.PubKey().SerializeCompressed(),
+			Overall:   1,
+		},
+		Signature: []byte("original signature"),
+	}
+	sign := func(ra *pb.RatingAmendment, key *btcec.PrivateKey) {
+		ser, err := proto.Marshal(ra.AmendmentData)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hashed := sha256.Sum256(ser)
+		sig, err := key.Sign(hashed[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		ra.Signature = sig.Serialize()
+	}
+
+	retraction := &pb.RatingAmendment{
+		AmendmentData: &pb.RatingAmendment_AmendmentData{
+			OrderId:           "QmOrderId",
+			OriginalSignature: original.Signature,
+			Retracted:         true,
+		},
+	}
+	sign(retraction, ratingKey)
+	if err := core.ValidateRatingAmendment(retraction, original); err != nil {
+		t.Error("Valid retraction failed to validate", err)
+	}
+
+	retraction.AmendmentData.OrderId = "QmOtherOrderId"
+	if err := core.ValidateRatingAmendment(retraction, original); err == nil {
+		t.Error("Modified retraction validated")
+	}
+
+	otherKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign(retraction, otherKey)
+	if err := core.ValidateRatingAmendment(retraction, original); err == nil {
+		t.Error("Retraction signed with the wrong key validated")
+	}
+
+	retraction.AmendmentData.OriginalSignature = []byte("another rating")
+	sign(retraction, ratingKey)
+	if err := core.ValidateRatingAmendment(retraction, original); err == nil {
+		t.Error("Retraction of a different rating validated")
+	}
+
+	amendment := &pb.RatingAmendment{
+		AmendmentData: &pb.RatingAmendment_AmendmentData{
+			OrderId:           "QmOrderId",
+			OriginalSignature: original.Signature,
+		},
+	}
+	sign(amendment, ratingKey)
+	if err := core.ValidateRatingAmendment(amendment, original); err == nil {
+		t.Error("Amendment without a rating validated")
+	}
+}
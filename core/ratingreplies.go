@@ -0,0 +1,198 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	ipfspath "github.com/ipfs/go-ipfs/path"
+	peer "gx/ipfs/QmXYjuNuxVzXKJCfWasQk1RqkhVLDM9jtUKhqc2WPQmFSB/go-libp2p-peer"
+	crypto "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
+)
+
+const ReplyMaxCharacters = 3000
+
+var ErrRatingNotFound = errors.New("Rating not found")
+
+// The reply to a rating is stored in the ratings directory next to the rating itself
+func ratingReplyFilename(ratingHash string) string {
+	return ratingHash + ".reply.json"
+}
+
+// ReplyToRating signs and publishes the vendor's reply to one of the ratings in our ratings index
+func (n *OpenBazaarNode) ReplyToRating(ratingHash string, reply string) (*pb.RatingReply, error) {
+	if reply == "" {
+		return nil, errors.New("Reply is empty")
+	}
+	if len(reply) > ReplyMaxCharacters {
+		return nil, errors.New("Reply is longer than the max of 3000 characters")
+	}
+	indexPath := path.Join(n.RepoPath, "root", "ratings.json")
+	file, err := ioutil.ReadFile(indexPath)
+	if err != nil {
+		return nil, ErrRatingNotFound
+	}
+	var index []SavedRating
+	err = json.Unmarshal(file, &index)
+	if err != nil {
+		return nil, err
+	}
+	slugIndex := -1
+	for i, r := range index {
+		for _, h := range r.Ratings {
+			if h == ratingHash {
+				slugIndex = i
+				break
+			}
+		}
+	}
+	if slugIndex < 0 {
+		return nil, ErrRatingNotFound
+	}
+
+	// Add our ID to the reply
+	id := new(pb.ID)
+	id.PeerID = n.IpfsNode.Identity.Pretty()
+	pubkey, err := n.IpfsNode.PrivateKey.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+	profile, err := n.GetProfile()
+	if err == nil {
+		id.Handle = profile.Handle
+	}
+	p := new(pb.ID_Pubkeys)
+	p.Identity = pubkey
+	ecPubKey, err := n.Wallet.MasterPublicKey().ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+	p.Bitcoin = ecPubKey.SerializeCompressed()
+	id.Pubkeys = p
+
+	// Sign the GUID with the Bitcoin key
+	id.BitcoinSig, err = n.bitcoinSig(id.PeerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	rr := new(pb.RatingReply)
+	rr.ReplyData = &pb.RatingReply_ReplyData{
+		RatingHash: ratingHash,
+		VendorID:   id,
+		Reply:      reply,
+		Timestamp:  ts,
+	}
+	ser, err := proto.Marshal(rr.ReplyData)
+	if err != nil {
+		return nil, err
+	}
+	rr.Signature, err = n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save the reply next to the rating
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	replyJson, err := m.MarshalToString(rr)
+	if err != nil {
+		return nil, err
+	}
+	replyPath := path.Join(n.RepoPath, "root", "ratings", ratingReplyFilename(ratingHash))
+	err = ioutil.WriteFile(replyPath, []byte(replyJson), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	replyHash, err := ipfs.GetHashOfFile(n.Context, replyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the reply in the ratings index
+	if index[slugIndex].Replies == nil {
+		index[slugIndex].Replies = make(map[string]string)
+	}
+	index[slugIndex].Replies[ratingHash] = replyHash
+	j, err := json.MarshalIndent(index, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	err = ioutil.WriteFile(indexPath, j, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	return rr, nil
+}
+
+// GetRatingReply returns the vendor's reply to the rating, if one has been published
+func (n *OpenBazaarNode) GetRatingReply(vendorID string, ratingHash string) (*pb.RatingReply, error) {
+	var replyBytes []byte
+	var err error
+	if vendorID == n.IpfsNode.Identity.Pretty() {
+		replyBytes, err = ioutil.ReadFile(path.Join(n.RepoPath, "root", "ratings", ratingReplyFilename(ratingHash)))
+	} else {
+		replyBytes, err = n.IPNSResolveThenCat(ipfspath.FromString(path.Join(vendorID, "ratings", ratingReplyFilename(ratingHash))), time.Minute)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rr := new(pb.RatingReply)
+	err = jsonpb.UnmarshalString(string(replyBytes), rr)
+	if err != nil {
+		return nil, err
+	}
+	valid, err := ValidateRatingReply(rr)
+	if !valid || err != nil {
+		return nil, err
+	}
+	if rr.ReplyData.RatingHash != ratingHash || rr.ReplyData.VendorID.PeerID != vendorID {
+		return nil, errors.New("Reply does not match rating")
+	}
+	return rr, nil
+}
+
+// ValidateRatingReply checks the reply was signed by the vendor's identity key
+func ValidateRatingReply(reply *pb.RatingReply) (bool, error) {
+	if reply.ReplyData == nil || reply.ReplyData.VendorID == nil || reply.ReplyData.VendorID.Pubkeys == nil {
+		return false, errors.New("missing reply data")
+	}
+	vendorKey, err := crypto.UnmarshalPublicKey(reply.ReplyData.VendorID.Pubkeys.Identity)
+	if err != nil {
+		return false, err
+	}
+	ser, err := proto.Marshal(reply.ReplyData)
+	if err != nil {
+		return false, err
+	}
+	valid, err := vendorKey.Verify(ser, reply.Signature)
+	if !valid || err != nil {
+		return false, errors.New("invalid vendor signature")
+	}
+
+	// Validate vendor peerID matches pubkey
+	id, err := peer.IDB58Decode(reply.ReplyData.VendorID.PeerID)
+	if err != nil {
+		return false, err
+	}
+	if !id.MatchesPublicKey(vendorKey) {
+		return false, errors.New("vendor ID does not match public key")
+	}
+	return true, nil
+}
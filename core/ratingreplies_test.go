@@ -0,0 +1,54 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	peer "gx/ipfs/QmXYjuNuxVzXKJCfWasQk1RqkhVLDM9jtUKhqc2WPQmFSB/go-libp2p-peer"
+	crypto "gx/ipfs/QmaPbCnUMBohSGo3KnxEa2bHqyJVVeEEcwtqJAYxerieBo/go-libp2p-crypto"
+)
+
+func TestValidateRatingReply(t *testing.T) {
+	priv, pub, err := crypto.GenerateKeyPair(crypto.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubBytes, err := pub.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply := &pb.RatingReply{
+		ReplyData: &pb.RatingReply_ReplyData{
+			RatingHash: "QmRatingHash",
+			VendorID: &pb.ID{
+				PeerID:  pid.Pretty(),
+				Pubkeys: &pb.ID_Pubkeys{Identity: pubBytes},
+			},
+			Reply: "Sorry about the delay, a replacement is on the way",
+		},
+	}
+	ser, err := proto.Marshal(reply.ReplyData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply.Signature, err = priv.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err := core.ValidateRatingReply(reply)
+	if !valid || err != nil {
+		t.Error("Valid reply failed to validate", err)
+	}
+
+	reply.ReplyData.Reply = "Edited"
+	valid, _ = core.ValidateRatingReply(reply)
+	if valid {
+		t.Error("Modified reply validated")
+	}
+}
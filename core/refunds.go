@@ -7,13 +7,21 @@ import (
 	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/wallet-interface"
-	hd "github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 )
 
 func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
+	// The payment went to the Lightning node so the on-chain wallet has nothing to refund it from
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_LIGHTNING {
+		return ErrLightningRefund
+	}
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
 	refundMsg := new(pb.Refund)
 	orderId, err := n.CalcOrderId(contract.BuyerOrder)
 	if err != nil {
@@ -40,51 +48,25 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 			}
 		}
 
-		refundAddress, err := n.Wallet.DecodeAddress(contract.BuyerOrder.RefundAddress)
+		refundAddress, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
 		if err != nil {
 			return err
 		}
 		var output wallet.TransactionOutput
 
-		outputScript, err := n.Wallet.AddressToScript(refundAddress)
+		outputScript, err := wal.AddressToScript(refundAddress)
 		if err != nil {
 			return err
 		}
 		output.ScriptPubKey = outputScript
 		output.Value = outValue
 
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
-		if err != nil {
-			return err
-		}
-		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		mPrivKey := n.Wallet.MasterPrivateKey()
-		if err != nil {
-			return err
-		}
-		mECKey, err := mPrivKey.ECPrivKey()
-		if err != nil {
-			return err
-		}
-		hdKey := hd.NewExtendedKey(
-			n.Wallet.Params().HDPrivateKeyID[:],
-			mECKey.Serialize(),
-			chaincode,
-			parentFP,
-			0,
-			0,
-			true)
-
-		vendorKey, err := hdKey.Child(0)
-		if err != nil {
-			return err
-		}
 		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
 		if err != nil {
 			return err
 		}
 
-		signatures, err := n.Wallet.CreateMultisigSignature(ins, []wallet.TransactionOutput{output}, vendorKey, redeemScript, contract.BuyerOrder.RefundFee)
+		signatures, err := n.escrowSignatures(SigningActionRefund, contract, ins, []wallet.TransactionOutput{output}, redeemScript, contract.BuyerOrder.RefundFee, "")
 		if err != nil {
 			return err
 		}
@@ -101,14 +83,21 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 				outValue += r.Value
 			}
 		}
-		refundAddr, err := n.Wallet.DecodeAddress(contract.BuyerOrder.RefundAddress)
+		refundAddr, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
 		if err != nil {
 			return err
 		}
-		txid, err := n.Wallet.Spend(outValue, refundAddr, wallet.NORMAL)
+		txid, err := n.walletSpend(SigningActionRefund, contract, wal, outValue, refundAddr, wallet.NORMAL)
 		if err != nil {
 			return err
 		}
+		var thumbnail string
+		var title string
+		if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+			thumbnail = contract.VendorListings[0].Item.Images[0].Tiny
+			title = contract.VendorListings[0].Item.Title
+		}
+		n.Datastore.TxMetadata().Put(repo.Metadata{Txid: txid.String(), Memo: title, OrderId: orderId, Thumbnail: thumbnail, CanBumpFee: true})
 		txinfo := new(pb.Refund_TransactionInfo)
 		txinfo.Txid = txid.String()
 		txinfo.Value = uint64(outValue)
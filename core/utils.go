@@ -65,6 +65,10 @@ func FormatRFC3339PB(ts google_protobuf.Timestamp) string {
 
 // Used by the GET order API to build transaction records suitable to be included in the order response
 func (n *OpenBazaarNode) BuildTransactionRecords(contract *pb.RicardianContract, records []*wallet.TransactionRecord, state pb.OrderState) ([]*pb.TransactionRecord, *pb.TransactionRecord, error) {
+	wal, err := n.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return nil, nil, err
+	}
 	paymentRecords := []*pb.TransactionRecord{}
 	payments := make(map[string]*pb.TransactionRecord)
 
@@ -83,11 +87,16 @@ func (n *OpenBazaarNode) BuildTransactionRecords(contract *pb.RicardianContract,
 				return paymentRecords, nil, err
 			}
 			tx.Timestamp = ts
+			if contract.BuyerOrder.Payment.Method == pb.Order_Payment_LIGHTNING {
+				// Lightning records are settled invoices rather than transactions
+				payments[r.Txid] = tx
+				continue
+			}
 			ch, err := chainhash.NewHashFromStr(tx.Txid)
 			if err != nil {
 				return paymentRecords, nil, err
 			}
-			confirmations, height, err := n.Wallet.GetConfirmations(*ch)
+			confirmations, height, err := wal.GetConfirmations(*ch)
 			if err != nil {
 				return paymentRecords, nil, err
 			}
@@ -121,7 +130,7 @@ func (n *OpenBazaarNode) BuildTransactionRecords(contract *pb.RicardianContract,
 			if err != nil {
 				return paymentRecords, refundRecord, err
 			}
-			confirmations, height, err := n.Wallet.GetConfirmations(*ch)
+			confirmations, height, err := wal.GetConfirmations(*ch)
 			if err != nil {
 				return paymentRecords, refundRecord, nil
 			}
@@ -22,12 +22,12 @@ import (
 	bstk "github.com/OpenBazaar/go-blockstackclient"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin/exchange"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/spvwallet"
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	obnet "github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/repo/db"
 	"github.com/OpenBazaar/openbazaar-go/storage/selfhosted"
-	"github.com/OpenBazaar/spvwallet"
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/ipfs/go-ipfs/commands"
@@ -370,7 +370,7 @@ func (n *Node) Stop() error {
 	core.Node.Datastore.Close()
 	repoLockFile := filepath.Join(core.Node.RepoPath, lockfile.LockFile)
 	os.Remove(repoLockFile)
-	core.Node.Wallet.Close()
+	core.Node.CloseWallets()
 	core.Node.IpfsNode.Close()
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/pb"
@@ -57,6 +58,10 @@ func (service *OpenBazaarService) HandlerForMsgType(t pb.Message_MessageType) fu
 		return service.handleDisputeUpdate
 	case pb.Message_DISPUTE_CLOSE:
 		return service.handleDisputeClose
+	case pb.Message_PANEL_RESOLUTION:
+		return service.handlePanelResolution
+	case pb.Message_RATING_AMENDMENT:
+		return service.handleRatingAmendment
 	case pb.Message_CHAT:
 		return service.handleChat
 	case pb.Message_MODERATOR_ADD:
@@ -271,10 +276,40 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 	if err != nil {
 		return errorResponse(err.Error()), err
 	}
+	wal, err := service.node.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return errorResponse(err.Error()), err
+	}
 	currentTime := time.Now()
 	purchaseTime := time.Unix(contract.BuyerOrder.Timestamp.Seconds, int64(contract.BuyerOrder.Timestamp.Nanos))
 
-	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_ADDRESS_REQUEST {
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_LIGHTNING {
+		if offline {
+			return errorResponse("Lightning orders must be sent while the vendor is online"), errors.New("Received an offline Lightning order")
+		}
+		total, err := service.node.CalculateOrderTotal(contract)
+		if err != nil {
+			return errorResponse("Error calculating payment amount"), err
+		}
+		if !service.node.ValidatePaymentAmount(total, contract.BuyerOrder.Payment.Amount) {
+			return errorResponse("Calculated a different payment amount"), errors.New("Calculated different payment amount")
+		}
+		contract, err = service.node.NewOrderConfirmation(contract, false, false)
+		if err != nil {
+			return errorResponse(err.Error()), err
+		}
+		a, err := ptypes.MarshalAny(contract)
+		if err != nil {
+			return errorResponse("Error building order confirmation"), err
+		}
+		service.node.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+		m := pb.Message{
+			MessageType: pb.Message_ORDER_CONFIRMATION,
+			Payload:     a,
+		}
+		log.Debugf("Received lightning ORDER message from %s", peer.Pretty())
+		return &m, nil
+	} else if contract.BuyerOrder.Payment.Method == pb.Order_Payment_ADDRESS_REQUEST {
 		total, err := service.node.CalculateOrderTotal(contract)
 		if err != nil {
 			return errorResponse("Error calculating payment amount"), err
@@ -305,15 +340,15 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 		if err != nil {
 			return errorResponse(err.Error()), err
 		}
-		addr, err := service.node.Wallet.DecodeAddress(contract.BuyerOrder.Payment.Address)
+		addr, err := wal.DecodeAddress(contract.BuyerOrder.Payment.Address)
 		if err != nil {
 			return errorResponse(err.Error()), err
 		}
-		script, err := service.node.Wallet.AddressToScript(addr)
+		script, err := wal.AddressToScript(addr)
 		if err != nil {
 			return errorResponse(err.Error()), err
 		}
-		service.node.Wallet.AddWatchedScript(script)
+		wal.AddWatchedScript(script)
 		orderId, err := service.node.CalcOrderId(contract.BuyerOrder)
 		if err != nil {
 			return errorResponse(err.Error()), err
@@ -340,15 +375,15 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 		if err != nil {
 			return errorResponse(err.Error()), err
 		}
-		addr, err := service.node.Wallet.DecodeAddress(contract.BuyerOrder.Payment.Address)
+		addr, err := wal.DecodeAddress(contract.BuyerOrder.Payment.Address)
 		if err != nil {
 			return errorResponse(err.Error()), err
 		}
-		script, err := service.node.Wallet.AddressToScript(addr)
+		script, err := wal.AddressToScript(addr)
 		if err != nil {
 			return errorResponse(err.Error()), err
 		}
-		service.node.Wallet.AddWatchedScript(script)
+		wal.AddWatchedScript(script)
 		contract, err = service.node.NewOrderConfirmation(contract, false, false)
 		if err != nil {
 			return errorResponse("Error building order confirmation"), errors.New("Error building order confirmation")
@@ -378,17 +413,17 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			log.Error(err)
 			return errorResponse(err.Error()), err
 		}
-		addr, err := service.node.Wallet.DecodeAddress(contract.BuyerOrder.Payment.Address)
+		addr, err := wal.DecodeAddress(contract.BuyerOrder.Payment.Address)
 		if err != nil {
 			log.Error(err)
 			return errorResponse(err.Error()), err
 		}
-		script, err := service.node.Wallet.AddressToScript(addr)
+		script, err := wal.AddressToScript(addr)
 		if err != nil {
 			log.Error(err)
 			return errorResponse(err.Error()), err
 		}
-		service.node.Wallet.AddWatchedScript(script)
+		wal.AddWatchedScript(script)
 		orderId, err := service.node.CalcOrderId(contract.BuyerOrder)
 		if err != nil {
 			log.Error(err)
@@ -533,12 +568,18 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 	if err != nil {
 		return nil, net.OutOfOrderMessage
 	}
+	wal, err := service.node.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return nil, err
+	}
 
 	if state == pb.OrderState_DECLINED {
 		return nil, net.DuplicateMessage
 	}
 
-	if contract.BuyerOrder.Payment.Method != pb.Order_Payment_MODERATED {
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_LIGHTNING {
+		// Nothing to reclaim, a paid Lightning invoice is refunded by the vendor
+	} else if contract.BuyerOrder.Payment.Method != pb.Order_Payment_MODERATED {
 		// Sweep the address into our wallet
 		var utxos []wallet.Utxo
 		for _, r := range records {
@@ -565,7 +606,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			return nil, err
 		}
 		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		mPrivKey := service.node.Wallet.MasterPrivateKey()
+		mPrivKey, err := bitcoin.MasterPrivateKey(wal)
 		if err != nil {
 			return nil, err
 		}
@@ -574,7 +615,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			return nil, err
 		}
 		hdKey := hd.NewExtendedKey(
-			service.node.Wallet.Params().HDPrivateKeyID[:],
+			wal.Params().HDPrivateKeyID[:],
 			mECKey.Serialize(),
 			chaincode,
 			parentFP,
@@ -587,11 +628,11 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			return nil, err
 		}
 		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
-		refundAddress, err := service.node.Wallet.DecodeAddress(contract.BuyerOrder.RefundAddress)
+		refundAddress, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
 		if err != nil {
 			return nil, err
 		}
-		_, err = service.node.Wallet.SweepAddress(utxos, &refundAddress, buyerKey, &redeemScript, wallet.NORMAL)
+		_, err = wal.SweepAddress(utxos, &refundAddress, buyerKey, &redeemScript, wallet.NORMAL)
 		if err != nil {
 			return nil, err
 		}
@@ -610,12 +651,12 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			}
 		}
 
-		refundAddress, err := service.node.Wallet.DecodeAddress(contract.BuyerOrder.RefundAddress)
+		refundAddress, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
 		if err != nil {
 			return nil, err
 		}
 		var output wallet.TransactionOutput
-		outputScript, err := service.node.Wallet.AddressToScript(refundAddress)
+		outputScript, err := wal.AddressToScript(refundAddress)
 		if err != nil {
 			return nil, err
 		}
@@ -627,7 +668,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			return nil, err
 		}
 		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		mPrivKey := service.node.Wallet.MasterPrivateKey()
+		mPrivKey, err := bitcoin.MasterPrivateKey(wal)
 		if err != nil {
 			return nil, err
 		}
@@ -636,7 +677,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			return nil, err
 		}
 		hdKey := hd.NewExtendedKey(
-			service.node.Wallet.Params().HDPrivateKeyID[:],
+			wal.Params().HDPrivateKeyID[:],
 			mECKey.Serialize(),
 			chaincode,
 			parentFP,
@@ -644,13 +685,9 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			0,
 			true)
 
-		buyerKey, err := hdKey.Child(0)
-		if err != nil {
-			return nil, err
-		}
 		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
 
-		buyerSignatures, err := service.node.Wallet.CreateMultisigSignature(ins, []wallet.TransactionOutput{output}, buyerKey, redeemScript, contract.BuyerOrder.RefundFee)
+		buyerSignatures, err := service.node.CreateEscrowSignatures(contract.BuyerOrder.Payment, ins, []wallet.TransactionOutput{output}, hdKey, redeemScript, contract.BuyerOrder.RefundFee)
 		if err != nil {
 			return nil, err
 		}
@@ -659,7 +696,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			sig := wallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		_, err = service.node.Wallet.Multisign(ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee, true)
+		_, err = service.node.MultisignEscrow(contract.BuyerOrder.Payment, ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee, true)
 		if err != nil {
 			return nil, err
 		}
@@ -714,6 +751,10 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 	if err != nil {
 		return nil, net.OutOfOrderMessage
 	}
+	wal, err := service.node.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return nil, err
+	}
 
 	if !(state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_AWAITING_FULFILLMENT) {
 		return nil, net.DuplicateMessage
@@ -734,12 +775,12 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 			}
 		}
 
-		refundAddress, err := service.node.Wallet.DecodeAddress(contract.BuyerOrder.RefundAddress)
+		refundAddress, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
 		if err != nil {
 			return nil, err
 		}
 		var output wallet.TransactionOutput
-		outputScript, err := service.node.Wallet.AddressToScript(refundAddress)
+		outputScript, err := wal.AddressToScript(refundAddress)
 		if err != nil {
 			return nil, err
 		}
@@ -751,7 +792,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 			return nil, err
 		}
 		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		mPrivKey := service.node.Wallet.MasterPrivateKey()
+		mPrivKey, err := bitcoin.MasterPrivateKey(wal)
 		if err != nil {
 			return nil, err
 		}
@@ -760,7 +801,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 			return nil, err
 		}
 		hdKey := hd.NewExtendedKey(
-			service.node.Wallet.Params().HDPrivateKeyID[:],
+			wal.Params().HDPrivateKeyID[:],
 			mECKey.Serialize(),
 			chaincode,
 			parentFP,
@@ -768,16 +809,12 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 			0,
 			true)
 
-		buyerKey, err := hdKey.Child(0)
-		if err != nil {
-			return nil, err
-		}
 		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
 		if err != nil {
 			return nil, err
 		}
 
-		buyerSignatures, err := service.node.Wallet.CreateMultisigSignature(ins, []wallet.TransactionOutput{output}, buyerKey, redeemScript, contract.BuyerOrder.RefundFee)
+		buyerSignatures, err := service.node.CreateEscrowSignatures(contract.BuyerOrder.Payment, ins, []wallet.TransactionOutput{output}, hdKey, redeemScript, contract.BuyerOrder.RefundFee)
 		if err != nil {
 			return nil, err
 		}
@@ -786,7 +823,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 			sig := wallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		_, err = service.node.Wallet.Multisign(ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee, true)
+		_, err = service.node.MultisignEscrow(contract.BuyerOrder.Payment, ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee, true)
 		if err != nil {
 			return nil, err
 		}
@@ -910,6 +947,10 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 	if err != nil {
 		return nil, net.OutOfOrderMessage
 	}
+	wal, err := service.node.WalletForOrder(contract.BuyerOrder)
+	if err != nil {
+		return nil, err
+	}
 
 	if state == pb.OrderState_COMPLETED {
 		return nil, net.DuplicateMessage
@@ -941,15 +982,15 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 		}
 		var payoutAddress btcutil.Address
 		if len(contract.VendorOrderFulfillment) > 0 {
-			payoutAddress, err = service.node.Wallet.DecodeAddress(contract.VendorOrderFulfillment[0].Payout.PayoutAddress)
+			payoutAddress, err = wal.DecodeAddress(contract.VendorOrderFulfillment[0].Payout.PayoutAddress)
 			if err != nil {
 				return nil, err
 			}
 		} else {
-			payoutAddress = service.node.Wallet.CurrentAddress(wallet.EXTERNAL)
+			payoutAddress = wal.CurrentAddress(wallet.EXTERNAL)
 		}
 		var output wallet.TransactionOutput
-		outputScript, err := service.node.Wallet.AddressToScript(payoutAddress)
+		outputScript, err := wal.AddressToScript(payoutAddress)
 		if err != nil {
 			return nil, err
 		}
@@ -972,7 +1013,7 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 			buyerSignatures = append(buyerSignatures, sig)
 		}
 
-		_, err = service.node.Wallet.Multisign(ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte, true)
+		_, err = service.node.MultisignEscrow(contract.BuyerOrder.Payment, ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte, true)
 		if err != nil {
 			return nil, err
 		}
@@ -1162,6 +1203,7 @@ func (service *OpenBazaarService) handleDisputeClose(p peer.ID, pmes *pb.Message
 
 	// Validate
 	contract.DisputeResolution = rc.DisputeResolution
+	contract.PanelResolution = rc.PanelResolution
 	for _, sig := range rc.Signatures {
 		if sig.Section == pb.Signature_DISPUTE_RESOLUTION {
 			contract.Signatures = append(contract.Signatures, sig)
@@ -1453,3 +1495,70 @@ func (service *OpenBazaarService) handleStore(pid peer.ID, pmes *pb.Message, opt
 	}
 	return m, nil
 }
+
+func (service *OpenBazaarService) handlePanelResolution(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+
+	// Unmarshall
+	if pmes.Payload == nil {
+		return nil, errors.New("Payload is nil")
+	}
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Process message
+	complete, err := service.node.ProcessPanelResolution(rc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send notification to websocket
+	n := notifications.PanelResolutionNotification{
+		ID:           notifications.NewID(),
+		Type:         "panelResolution",
+		OrderId:      rc.DisputeResolution.OrderId,
+		ProposedBy:   rc.DisputeResolution.ProposedBy,
+		Endorsements: len(rc.PanelResolution.Endorsements),
+		Complete:     complete,
+	}
+	service.broadcast <- n
+
+	service.datastore.Notifications().Put(n.ID, n, n.Type, time.Now())
+	log.Debugf("Received PANEL_RESOLUTION message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleRatingAmendment(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+
+	// Unmarshall
+	if pmes.Payload == nil {
+		return nil, errors.New("Payload is nil")
+	}
+	ra := new(pb.RatingAmendment)
+	err := ptypes.UnmarshalAny(pmes.Payload, ra)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate and update the ratings
+	original, err := service.node.ProcessRatingAmendment(ra)
+	if err != nil {
+		return nil, err
+	}
+
+	// Send notification to websocket
+	n := notifications.RatingAmendmentNotification{
+		ID:        notifications.NewID(),
+		Type:      "ratingAmendment",
+		OrderId:   ra.AmendmentData.OrderId,
+		Slug:      original.RatingData.VendorSig.Metadata.ListingSlug,
+		Retracted: ra.AmendmentData.Retracted,
+	}
+	service.broadcast <- n
+
+	service.datastore.Notifications().Put(n.ID, n, n.Type, time.Now())
+	log.Debugf("Received RATING_AMENDMENT message from %s", p.Pretty())
+	return nil, nil
+}
@@ -44,7 +44,7 @@ func main() {
 				core.Node.Datastore.Close()
 				repoLockFile := filepath.Join(core.Node.RepoPath, lockfile.LockFile)
 				os.Remove(repoLockFile)
-				core.Node.Wallet.Close()
+				core.Node.CloseWallets()
 				core.Node.IpfsNode.Close()
 			}
 			os.Exit(1)
@@ -60,7 +60,7 @@ func main() {
 		&cmd.Status{})
 	parser.AddCommand("setapicreds",
 		"set API credentials",
-		"The API password field in the config file takes a SHA256 hash of the password. This command will generate the hash for you and save it to the config file.",
+		"The API password field in the config file takes a SHA256 hash of the password. This command will generate the hash for you and save it to the config file. With --token it instead creates a named API token limited to --scopes, which can be listed with --list and deleted with --revoke.",
 		&cmd.SetAPICreds{})
 	parser.AddCommand("start",
 		"start the OpenBazaar-Server",
@@ -90,6 +90,18 @@ func main() {
 		"convert this node to a different coin type",
 		"This command will convert the node to use a different cryptocurrency",
 		&cmd.Convert{})
+	parser.AddCommand("coldsign",
+		"sign for a cold storage node",
+		"Run on the offline machine holding the cold storage mnemonic. With --peerid it prints the values for the node's ColdStorage config. With --request it signs a signing request exported by the node so the signatures can be imported through the API.",
+		&cmd.ColdSign{})
+	parser.AddCommand("restoremnemonic",
+		"restore the mnemonic of a cold storage node",
+		"Enabling cold storage removes the wallet mnemonic from the node. This command puts it back so cold storage can be disabled and the node can spend from its own wallet again.",
+		&cmd.RestoreMnemonic{})
+	parser.AddCommand("export",
+		"export wallet transactions for accounting",
+		"Exports the transactions of a wallet as CSV or OFX for accounting software. Each transaction is valued in fiat with the exchange rate the node recorded when it was seen, and labeled with its category, linked order and counterparty.",
+		&cmd.Export{})
 	if len(os.Args) > 1 && (os.Args[1] == "--version" || os.Args[1] == "-v") {
 		fmt.Println(core.VERSION)
 		return
@@ -13,6 +13,7 @@ It is generated from these files:
 	orders.proto
 	posts.proto
 	profile.proto
+	services.proto
 
 It has these top-level messages:
 	Coupon
@@ -32,8 +33,11 @@ It has these top-level messages:
 	OrderFulfillment
 	OrderCompletion
 	Rating
+	RatingReply
+	RatingAmendment
 	Dispute
 	DisputeResolution
+	PanelResolution
 	DisputeAcceptance
 	Outpoint
 	Refund
@@ -51,6 +55,31 @@ It has these top-level messages:
 	Post
 	SignedPost
 	Profile
+	Empty
+	ListingsRequest
+	ListingIndex
+	ListingRequest
+	ListingSlug
+	PurchaseRequest
+	PurchaseResponse
+	OrderRequest
+	OrderConfirmationRequest
+	OrderCompletionRequest
+	OpenDisputeRequest
+	CloseDisputeRequest
+	CoinRequest
+	AddressResponse
+	BalanceResponse
+	SpendRequest
+	SpendResponse
+	ChatMessage
+	SendMessageRequest
+	ChatMessageID
+	ChatMessages
+	ChatMessagesRequest
+	ChatConversations
+	SubscribeRequest
+	Event
 */
 package pb
 
@@ -102,6 +131,7 @@ type OrderRespApi struct {
 	UnreadChatMessages         uint64               `protobuf:"varint,5,opt,name=unreadChatMessages" json:"unreadChatMessages,omitempty"`
 	PaymentAddressTransactions []*TransactionRecord `protobuf:"bytes,6,rep,name=paymentAddressTransactions" json:"paymentAddressTransactions,omitempty"`
 	RefundAddressTransaction   *TransactionRecord   `protobuf:"bytes,7,opt,name=refundAddressTransaction" json:"refundAddressTransaction,omitempty"`
+	CanAccelerate              bool                 `protobuf:"varint,8,opt,name=canAccelerate" json:"canAccelerate,omitempty"`
 }
 
 func (m *OrderRespApi) Reset()                    { *m = OrderRespApi{} }
@@ -158,6 +188,13 @@ func (m *OrderRespApi) GetRefundAddressTransaction() *TransactionRecord {
 	return nil
 }
 
+func (m *OrderRespApi) GetCanAccelerate() bool {
+	if m != nil {
+		return m.CanAccelerate
+	}
+	return false
+}
+
 type CaseRespApi struct {
 	Timestamp                      *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
 	BuyerContract                  *RicardianContract         `protobuf:"bytes,2,opt,name=buyerContract" json:"buyerContract,omitempty"`
@@ -403,45 +440,45 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0x56, 0xfe, 0x93, 0x49, 0x13, 0x84, 0x55, 0xa1, 0x55, 0x24, 0x68, 0x58, 0x71, 0xc8, 0x69,
-	0x8b, 0xca, 0xa5, 0xe2, 0x56, 0x52, 0x90, 0x2a, 0x01, 0xad, 0x4c, 0x05, 0x12, 0x9c, 0x9c, 0xf5,
-	0x24, 0xb1, 0x94, 0xd8, 0x2b, 0xdb, 0x5b, 0xd1, 0x97, 0xe1, 0x2d, 0x78, 0x20, 0xde, 0x04, 0xd9,
-	0xeb, 0x4d, 0x13, 0xd2, 0x6d, 0xc5, 0xcd, 0x33, 0xf3, 0xcd, 0x37, 0xb3, 0x33, 0xdf, 0x2c, 0xf4,
-	0x58, 0x26, 0x92, 0x4c, 0x2b, 0xab, 0x46, 0x4f, 0x52, 0x25, 0xad, 0x66, 0xa9, 0x35, 0xc1, 0x71,
-	0xa0, 0x34, 0x47, 0x5d, 0x5a, 0x83, 0x4c, 0xab, 0xb9, 0x58, 0x61, 0x30, 0x8f, 0x16, 0x4a, 0x2d,
-	0x56, 0x78, 0xec, 0xad, 0x59, 0x3e, 0x3f, 0xb6, 0x62, 0x8d, 0xc6, 0xb2, 0x75, 0x56, 0x00, 0xe2,
-	0xd7, 0xd0, 0x9e, 0xaa, 0x3c, 0x53, 0x92, 0x10, 0x68, 0x2e, 0x99, 0x59, 0x46, 0xb5, 0x71, 0x6d,
-	0xd2, 0xa3, 0xfe, 0xed, 0x7c, 0xa9, 0xe2, 0x18, 0xd5, 0x0b, 0x9f, 0x7b, 0xc7, 0x7f, 0xea, 0x70,
-	0x70, 0xe9, 0x4a, 0x52, 0x34, 0xd9, 0x59, 0x26, 0x48, 0x02, 0xdd, 0xb2, 0x27, 0x9f, 0xdc, 0x3f,
-	0x21, 0x09, 0x15, 0x29, 0xd3, 0x5c, 0x30, 0x39, 0x0d, 0x11, 0xba, 0xc1, 0x90, 0x97, 0xd0, 0x32,
-	0x96, 0xd9, 0x82, 0x75, 0x78, 0xd2, 0x4f, 0x3c, 0xdb, 0x17, 0xe7, 0xa2, 0x45, 0xc4, 0xd5, 0xd5,
-	0xc8, 0x78, 0xd4, 0x18, 0xd7, 0x26, 0x5d, 0xea, 0xdf, 0xe4, 0x19, 0xb4, 0xe7, 0xb9, 0xe4, 0xc8,
-	0xa3, 0xa6, 0xf7, 0x06, 0x8b, 0x24, 0x40, 0x72, 0xe9, 0x10, 0xd3, 0x25, 0xb3, 0x9f, 0xd0, 0x18,
-	0xb6, 0x40, 0x13, 0xb5, 0xc6, 0xb5, 0x49, 0x93, 0xde, 0x13, 0x21, 0x14, 0x46, 0x19, 0xbb, 0x5d,
-	0xa3, 0xb4, 0x67, 0x9c, 0x6b, 0x34, 0xe6, 0x5a, 0x33, 0x69, 0x58, 0x6a, 0x85, 0x92, 0x26, 0x6a,
-	0x8f, 0x1b, 0xfe, 0x03, 0xb6, 0x9c, 0x14, 0x53, 0xa5, 0x39, 0x7d, 0x20, 0x8b, 0x7c, 0x86, 0x48,
-	0xa3, 0xeb, 0x67, 0x3f, 0x18, 0x75, 0xc2, 0x48, 0xf6, 0x19, 0x2b, 0x73, 0xe2, 0x5f, 0x4d, 0xe8,
-	0x4f, 0x99, 0xc1, 0x72, 0xc4, 0xa7, 0xd0, 0xdb, 0x2c, 0x2e, 0xcc, 0x78, 0x94, 0x14, 0xab, 0x4d,
-	0xca, 0xd5, 0x26, 0xd7, 0x25, 0x82, 0xde, 0x81, 0xc9, 0x29, 0x0c, 0x66, 0xf9, 0x2d, 0xea, 0x72,
-	0x0f, 0x7e, 0xe8, 0xf7, 0x6f, 0x68, 0x17, 0x48, 0xde, 0xc2, 0xf0, 0x06, 0x25, 0x57, 0x77, 0xa9,
-	0x8d, 0xca, 0xd4, 0x7f, 0x90, 0xe4, 0x1c, 0x9e, 0xef, 0x90, 0x7d, 0x65, 0x2b, 0xc1, 0x99, 0xfb,
-	0xb4, 0xf7, 0x5a, 0x2b, 0x6d, 0xa2, 0xe6, 0xb8, 0x31, 0xe9, 0xd1, 0x87, 0x41, 0xe4, 0x03, 0xbc,
-	0xd8, 0xe5, 0xdd, 0xa3, 0x69, 0x79, 0x9a, 0x47, 0x50, 0x77, 0x82, 0x6b, 0x3f, 0x2a, 0xb8, 0xce,
-	0x96, 0xe0, 0xc6, 0xd0, 0xf7, 0xfd, 0x5d, 0x66, 0x28, 0x91, 0x47, 0x5d, 0x1f, 0xda, 0x76, 0x91,
-	0x43, 0x68, 0xa5, 0x2b, 0x26, 0xd6, 0x51, 0xcf, 0xdf, 0x47, 0x61, 0x54, 0x08, 0x12, 0x2a, 0x05,
-	0x79, 0x02, 0xa0, 0xd1, 0xa8, 0x55, 0xee, 0xe5, 0xd2, 0x0f, 0x43, 0x3e, 0x17, 0x26, 0xcb, 0xad,
-	0x53, 0x40, 0x88, 0xd0, 0x2d, 0x54, 0xfc, 0xbb, 0x06, 0x4f, 0xf7, 0x04, 0xe5, 0xbe, 0xc2, 0xfe,
-	0x14, 0xbc, 0x3c, 0x61, 0xf7, 0x76, 0x3d, 0xde, 0xb0, 0x55, 0x5e, 0x5c, 0x5b, 0x83, 0x16, 0x06,
-	0x79, 0x05, 0x83, 0x54, 0xc9, 0xb9, 0xd0, 0x6b, 0x56, 0xe8, 0xde, 0xed, 0x76, 0x40, 0x77, 0x9d,
-	0xee, 0xe4, 0x96, 0x28, 0x16, 0x4b, 0xeb, 0x4f, 0x6e, 0x40, 0x83, 0xb5, 0x2b, 0xc7, 0xd6, 0x7f,
-	0xc8, 0x31, 0xfe, 0x08, 0xc3, 0x2b, 0x44, 0x7d, 0x26, 0xf9, 0x55, 0xf1, 0x9f, 0x72, 0x35, 0x32,
-	0x44, 0x7d, 0x51, 0x76, 0x1d, 0x2c, 0x12, 0x43, 0x27, 0xfc, 0xca, 0x82, 0x64, 0xbb, 0x49, 0x48,
-	0xa1, 0x65, 0x20, 0x9e, 0xc1, 0xe1, 0x2e, 0xdb, 0x37, 0x61, 0x97, 0x17, 0xe7, 0x64, 0x08, 0xf5,
-	0xcd, 0x14, 0xea, 0x82, 0x6f, 0xd5, 0xa8, 0x57, 0xd5, 0x68, 0x54, 0xd5, 0xf8, 0x01, 0x07, 0x94,
-	0x59, 0x21, 0x17, 0x15, 0xdc, 0x23, 0xe8, 0x6a, 0x1f, 0xdf, 0xb0, 0x6f, 0x6c, 0x72, 0x04, 0xed,
-	0xe2, 0x1d, 0xe8, 0x3b, 0x49, 0x41, 0x45, 0x83, 0xfb, 0x5d, 0xf3, 0x7b, 0x3d, 0x9b, 0xcd, 0xda,
-	0x7e, 0x66, 0x6f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x96, 0xc3, 0x9b, 0xe6, 0x05, 0x00,
-	0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6e, 0x13, 0x3f,
+	0x10, 0x56, 0xfe, 0x27, 0x93, 0x26, 0x3f, 0xfd, 0xac, 0x0a, 0xad, 0x22, 0x41, 0x43, 0xc4, 0x21,
+	0xa7, 0x2d, 0x2a, 0x97, 0x8a, 0x5b, 0x48, 0x41, 0xaa, 0x04, 0xb4, 0x32, 0x15, 0x48, 0x70, 0x72,
+	0xd6, 0x93, 0xc4, 0x52, 0x62, 0xaf, 0x6c, 0x6f, 0x45, 0x9f, 0x80, 0xb7, 0xe0, 0x2d, 0x78, 0x3f,
+	0x64, 0xaf, 0x37, 0xcd, 0x12, 0xb6, 0x15, 0x37, 0xcf, 0xcc, 0x37, 0xdf, 0xcc, 0xce, 0x7c, 0xb3,
+	0xd0, 0x63, 0xa9, 0x88, 0x53, 0xad, 0xac, 0x1a, 0xfd, 0x97, 0x28, 0x69, 0x35, 0x4b, 0xac, 0x09,
+	0x8e, 0x23, 0xa5, 0x39, 0xea, 0xc2, 0x1a, 0xa4, 0x5a, 0x2d, 0xc5, 0x06, 0x83, 0x79, 0xb2, 0x52,
+	0x6a, 0xb5, 0xc1, 0x53, 0x6f, 0x2d, 0xb2, 0xe5, 0xa9, 0x15, 0x5b, 0x34, 0x96, 0x6d, 0xd3, 0x1c,
+	0x30, 0x79, 0x09, 0xed, 0xb9, 0xca, 0x52, 0x25, 0x09, 0x81, 0xe6, 0x9a, 0x99, 0x75, 0x54, 0x1b,
+	0xd7, 0xa6, 0x3d, 0xea, 0xdf, 0xce, 0x97, 0x28, 0x8e, 0x51, 0x3d, 0xf7, 0xb9, 0xf7, 0xe4, 0x47,
+	0x03, 0x8e, 0xae, 0x5c, 0x49, 0x8a, 0x26, 0x9d, 0xa5, 0x82, 0xc4, 0xd0, 0x2d, 0x7a, 0xf2, 0xc9,
+	0xfd, 0x33, 0x12, 0x53, 0x91, 0x30, 0xcd, 0x05, 0x93, 0xf3, 0x10, 0xa1, 0x3b, 0x0c, 0x79, 0x0e,
+	0x2d, 0x63, 0x99, 0xcd, 0x59, 0x87, 0x67, 0xfd, 0xd8, 0xb3, 0x7d, 0x72, 0x2e, 0x9a, 0x47, 0x5c,
+	0x5d, 0x8d, 0x8c, 0x47, 0x8d, 0x71, 0x6d, 0xda, 0xa5, 0xfe, 0x4d, 0x9e, 0x40, 0x7b, 0x99, 0x49,
+	0x8e, 0x3c, 0x6a, 0x7a, 0x6f, 0xb0, 0x48, 0x0c, 0x24, 0x93, 0x0e, 0x31, 0x5f, 0x33, 0xfb, 0x01,
+	0x8d, 0x61, 0x2b, 0x34, 0x51, 0x6b, 0x5c, 0x9b, 0x36, 0xe9, 0x5f, 0x22, 0x84, 0xc2, 0x28, 0x65,
+	0x77, 0x5b, 0x94, 0x76, 0xc6, 0xb9, 0x46, 0x63, 0x6e, 0x34, 0x93, 0x86, 0x25, 0x56, 0x28, 0x69,
+	0xa2, 0xf6, 0xb8, 0xe1, 0x3f, 0x60, 0xcf, 0x49, 0x31, 0x51, 0x9a, 0xd3, 0x07, 0xb2, 0xc8, 0x47,
+	0x88, 0x34, 0xba, 0x7e, 0x0e, 0x83, 0x51, 0x27, 0x8c, 0xe4, 0x90, 0xb1, 0x32, 0x87, 0xbc, 0x80,
+	0x41, 0xc2, 0xe4, 0x2c, 0x49, 0x70, 0x83, 0xda, 0x8d, 0xaa, 0xeb, 0x3f, 0xb9, 0xec, 0x9c, 0xfc,
+	0x6c, 0x42, 0x7f, 0xce, 0x0c, 0x16, 0x8b, 0x38, 0x87, 0xde, 0x6e, 0xbd, 0x61, 0x13, 0xa3, 0x38,
+	0x17, 0x40, 0x5c, 0x08, 0x20, 0xbe, 0x29, 0x10, 0xf4, 0x1e, 0x4c, 0xce, 0x61, 0xb0, 0xc8, 0xee,
+	0x50, 0x17, 0xdb, 0x8a, 0xea, 0xa1, 0xe9, 0xc3, 0x3d, 0x96, 0x81, 0xe4, 0x35, 0x0c, 0x6f, 0x51,
+	0x72, 0x75, 0x9f, 0xda, 0xa8, 0x4c, 0xfd, 0x03, 0x49, 0x2e, 0xe0, 0x69, 0x89, 0xec, 0x33, 0xdb,
+	0x08, 0xce, 0xdc, 0x00, 0xde, 0x6a, 0xad, 0xb4, 0x89, 0x9a, 0xe3, 0xc6, 0xb4, 0x47, 0x1f, 0x06,
+	0x91, 0x77, 0xf0, 0xac, 0xcc, 0x7b, 0x40, 0xd3, 0xf2, 0x34, 0x8f, 0xa0, 0xee, 0x65, 0xd9, 0x7e,
+	0x54, 0x96, 0x9d, 0x3d, 0x59, 0x8e, 0xa1, 0xef, 0xfb, 0xbb, 0x4a, 0x51, 0x22, 0x0f, 0x8b, 0xda,
+	0x77, 0x91, 0x63, 0x68, 0x25, 0x1b, 0x26, 0xb6, 0x51, 0xcf, 0x5f, 0x51, 0x6e, 0x54, 0xc8, 0x16,
+	0x2a, 0x65, 0x7b, 0x06, 0xa0, 0xd1, 0xa8, 0x4d, 0xe6, 0x45, 0xd5, 0x0f, 0x43, 0xbe, 0x10, 0x26,
+	0xcd, 0x2c, 0xd2, 0x5d, 0x84, 0xee, 0xa1, 0x26, 0xbf, 0x6a, 0xf0, 0xff, 0x81, 0xec, 0xdc, 0x57,
+	0xd8, 0xef, 0x82, 0x17, 0x87, 0xee, 0xde, 0xae, 0xc7, 0x5b, 0xb6, 0xc9, 0xf2, 0x9b, 0x6c, 0xd0,
+	0xdc, 0xf0, 0x32, 0x54, 0x72, 0x29, 0xf4, 0x96, 0xe5, 0xd7, 0xe1, 0x76, 0x3b, 0xa0, 0x65, 0xa7,
+	0x3b, 0xcc, 0x35, 0x8a, 0xd5, 0xda, 0xfa, 0xc3, 0x1c, 0xd0, 0x60, 0x95, 0xe5, 0xd8, 0xfa, 0x07,
+	0x39, 0x4e, 0xde, 0xc3, 0xf0, 0x1a, 0x51, 0xcf, 0x24, 0xbf, 0xce, 0xff, 0x66, 0xae, 0x46, 0x8a,
+	0xa8, 0x2f, 0x8b, 0xae, 0x83, 0x45, 0x26, 0xd0, 0x09, 0x3f, 0xbc, 0x20, 0xd9, 0x6e, 0x1c, 0x52,
+	0x68, 0x11, 0x98, 0x2c, 0xe0, 0xb8, 0xcc, 0xf6, 0x45, 0xd8, 0xf5, 0xe5, 0x05, 0x19, 0x42, 0x7d,
+	0x37, 0x85, 0xba, 0xe0, 0x7b, 0x35, 0xea, 0x55, 0x35, 0x1a, 0x55, 0x35, 0xbe, 0xc1, 0x11, 0x65,
+	0x56, 0xc8, 0x55, 0x05, 0xf7, 0x08, 0xba, 0xda, 0xc7, 0x77, 0xec, 0x3b, 0x9b, 0x9c, 0x40, 0x3b,
+	0x7f, 0x07, 0xfa, 0x4e, 0x9c, 0x53, 0xd1, 0xe0, 0x7e, 0xd3, 0xfc, 0x5a, 0x4f, 0x17, 0x8b, 0xb6,
+	0x9f, 0xd9, 0xab, 0xdf, 0x03, 0x00, 0xb7, 0xdf, 0xdc, 0x3c, 0x0c, 0x06, 0x00, 0x00,
 }
@@ -94,17 +94,20 @@ const (
 	Order_Payment_ADDRESS_REQUEST Order_Payment_Method = 0
 	Order_Payment_DIRECT          Order_Payment_Method = 1
 	Order_Payment_MODERATED       Order_Payment_Method = 2
+	Order_Payment_LIGHTNING       Order_Payment_Method = 3
 )
 
 var Order_Payment_Method_name = map[int32]string{
 	0: "ADDRESS_REQUEST",
 	1: "DIRECT",
 	2: "MODERATED",
+	3: "LIGHTNING",
 }
 var Order_Payment_Method_value = map[string]int32{
 	"ADDRESS_REQUEST": 0,
 	"DIRECT":          1,
 	"MODERATED":       2,
+	"LIGHTNING":       3,
 }
 
 func (x Order_Payment_Method) String() string {
@@ -149,7 +152,7 @@ var Signature_Section_value = map[string]int32{
 func (x Signature_Section) String() string {
 	return proto.EnumName(Signature_Section_name, int32(x))
 }
-func (Signature_Section) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{16, 0} }
+func (Signature_Section) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{19, 0} }
 
 type RicardianContract struct {
 	VendorListings          []*Listing          `protobuf:"bytes,1,rep,name=vendorListings" json:"vendorListings,omitempty"`
@@ -162,6 +165,7 @@ type RicardianContract struct {
 	DisputeAcceptance       *DisputeAcceptance  `protobuf:"bytes,8,opt,name=disputeAcceptance" json:"disputeAcceptance,omitempty"`
 	Refund                  *Refund             `protobuf:"bytes,9,opt,name=refund" json:"refund,omitempty"`
 	Signatures              []*Signature        `protobuf:"bytes,10,rep,name=signatures" json:"signatures,omitempty"`
+	PanelResolution         *PanelResolution    `protobuf:"bytes,11,opt,name=panelResolution" json:"panelResolution,omitempty"`
 }
 
 func (m *RicardianContract) Reset()                    { *m = RicardianContract{} }
@@ -239,6 +243,13 @@ func (m *RicardianContract) GetSignatures() []*Signature {
 	return nil
 }
 
+func (m *RicardianContract) GetPanelResolution() *PanelResolution {
+	if m != nil {
+		return m.PanelResolution
+	}
+	return nil
+}
+
 type Listing struct {
 	Slug               string                    `protobuf:"bytes,1,opt,name=slug" json:"slug,omitempty"`
 	VendorID           *ID                       `protobuf:"bytes,2,opt,name=vendorID" json:"vendorID,omitempty"`
@@ -1239,6 +1250,11 @@ type Order_Payment struct {
 	Address      string               `protobuf:"bytes,5,opt,name=address" json:"address,omitempty"`
 	RedeemScript string               `protobuf:"bytes,6,opt,name=redeemScript" json:"redeemScript,omitempty"`
 	ModeratorKey []byte               `protobuf:"bytes,7,opt,name=moderatorKey,proto3" json:"moderatorKey,omitempty"`
+	// Moderator panel escrows only. The lead moderator is also set in the moderator field.
+	Moderators         []string `protobuf:"bytes,8,rep,name=moderators" json:"moderators,omitempty"`
+	ModeratorKeys      [][]byte `protobuf:"bytes,9,rep,name=moderatorKeys,proto3" json:"moderatorKeys,omitempty"`
+	ModeratorThreshold uint32   `protobuf:"varint,10,opt,name=moderatorThreshold" json:"moderatorThreshold,omitempty"`
+	Coin               string   `protobuf:"bytes,11,opt,name=coin" json:"coin,omitempty"`
 }
 
 func (m *Order_Payment) Reset()                    { *m = Order_Payment{} }
@@ -1295,6 +1311,34 @@ func (m *Order_Payment) GetModeratorKey() []byte {
 	return nil
 }
 
+func (m *Order_Payment) GetModerators() []string {
+	if m != nil {
+		return m.Moderators
+	}
+	return nil
+}
+
+func (m *Order_Payment) GetModeratorKeys() [][]byte {
+	if m != nil {
+		return m.ModeratorKeys
+	}
+	return nil
+}
+
+func (m *Order_Payment) GetModeratorThreshold() uint32 {
+	if m != nil {
+		return m.ModeratorThreshold
+	}
+	return 0
+}
+
+func (m *Order_Payment) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
 type OrderConfirmation struct {
 	OrderID   string                     `protobuf:"bytes,1,opt,name=orderID" json:"orderID,omitempty"`
 	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
@@ -1302,6 +1346,9 @@ type OrderConfirmation struct {
 	PaymentAddress   string             `protobuf:"bytes,3,opt,name=paymentAddress" json:"paymentAddress,omitempty"`
 	RequestedAmount  uint64             `protobuf:"varint,4,opt,name=requestedAmount" json:"requestedAmount,omitempty"`
 	RatingSignatures []*RatingSignature `protobuf:"bytes,5,rep,name=ratingSignatures" json:"ratingSignatures,omitempty"`
+	// Lightning payments only
+	PaymentRequest string `protobuf:"bytes,6,opt,name=paymentRequest" json:"paymentRequest,omitempty"`
+	PaymentHash    string `protobuf:"bytes,7,opt,name=paymentHash" json:"paymentHash,omitempty"`
 }
 
 func (m *OrderConfirmation) Reset()                    { *m = OrderConfirmation{} }
@@ -1344,6 +1391,20 @@ func (m *OrderConfirmation) GetRatingSignatures() []*RatingSignature {
 	return nil
 }
 
+func (m *OrderConfirmation) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *OrderConfirmation) GetPaymentHash() string {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return ""
+}
+
 type OrderReject struct {
 	OrderID   string                     `protobuf:"bytes,1,opt,name=orderID" json:"orderID,omitempty"`
 	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
@@ -1869,6 +1930,144 @@ func (m *Rating_RatingData) GetReview() string {
 	return ""
 }
 
+type RatingReply struct {
+	ReplyData *RatingReply_ReplyData `protobuf:"bytes,1,opt,name=replyData" json:"replyData,omitempty"`
+	Signature []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *RatingReply) Reset()                    { *m = RatingReply{} }
+func (m *RatingReply) String() string            { return proto.CompactTextString(m) }
+func (*RatingReply) ProtoMessage()               {}
+func (*RatingReply) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
+
+func (m *RatingReply) GetReplyData() *RatingReply_ReplyData {
+	if m != nil {
+		return m.ReplyData
+	}
+	return nil
+}
+
+func (m *RatingReply) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type RatingReply_ReplyData struct {
+	RatingHash string                     `protobuf:"bytes,1,opt,name=ratingHash" json:"ratingHash,omitempty"`
+	VendorID   *ID                        `protobuf:"bytes,2,opt,name=vendorID" json:"vendorID,omitempty"`
+	Reply      string                     `protobuf:"bytes,3,opt,name=reply" json:"reply,omitempty"`
+	Timestamp  *google_protobuf.Timestamp `protobuf:"bytes,4,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *RatingReply_ReplyData) Reset()                    { *m = RatingReply_ReplyData{} }
+func (m *RatingReply_ReplyData) String() string            { return proto.CompactTextString(m) }
+func (*RatingReply_ReplyData) ProtoMessage()               {}
+func (*RatingReply_ReplyData) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10, 0} }
+
+func (m *RatingReply_ReplyData) GetRatingHash() string {
+	if m != nil {
+		return m.RatingHash
+	}
+	return ""
+}
+
+func (m *RatingReply_ReplyData) GetVendorID() *ID {
+	if m != nil {
+		return m.VendorID
+	}
+	return nil
+}
+
+func (m *RatingReply_ReplyData) GetReply() string {
+	if m != nil {
+		return m.Reply
+	}
+	return ""
+}
+
+func (m *RatingReply_ReplyData) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type RatingAmendment struct {
+	AmendmentData *RatingAmendment_AmendmentData `protobuf:"bytes,1,opt,name=amendmentData" json:"amendmentData,omitempty"`
+	Signature     []byte                         `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *RatingAmendment) Reset()                    { *m = RatingAmendment{} }
+func (m *RatingAmendment) String() string            { return proto.CompactTextString(m) }
+func (*RatingAmendment) ProtoMessage()               {}
+func (*RatingAmendment) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
+
+func (m *RatingAmendment) GetAmendmentData() *RatingAmendment_AmendmentData {
+	if m != nil {
+		return m.AmendmentData
+	}
+	return nil
+}
+
+func (m *RatingAmendment) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type RatingAmendment_AmendmentData struct {
+	OrderId           string                     `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
+	OriginalSignature []byte                     `protobuf:"bytes,2,opt,name=originalSignature,proto3" json:"originalSignature,omitempty"`
+	Rating            *Rating                    `protobuf:"bytes,3,opt,name=rating" json:"rating,omitempty"`
+	Retracted         bool                       `protobuf:"varint,4,opt,name=retracted" json:"retracted,omitempty"`
+	Timestamp         *google_protobuf.Timestamp `protobuf:"bytes,5,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *RatingAmendment_AmendmentData) Reset()         { *m = RatingAmendment_AmendmentData{} }
+func (m *RatingAmendment_AmendmentData) String() string { return proto.CompactTextString(m) }
+func (*RatingAmendment_AmendmentData) ProtoMessage()    {}
+func (*RatingAmendment_AmendmentData) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{11, 0}
+}
+
+func (m *RatingAmendment_AmendmentData) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *RatingAmendment_AmendmentData) GetOriginalSignature() []byte {
+	if m != nil {
+		return m.OriginalSignature
+	}
+	return nil
+}
+
+func (m *RatingAmendment_AmendmentData) GetRating() *Rating {
+	if m != nil {
+		return m.Rating
+	}
+	return nil
+}
+
+func (m *RatingAmendment_AmendmentData) GetRetracted() bool {
+	if m != nil {
+		return m.Retracted
+	}
+	return false
+}
+
+func (m *RatingAmendment_AmendmentData) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 type Dispute struct {
 	Timestamp          *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
 	Claim              string                     `protobuf:"bytes,2,opt,name=claim" json:"claim,omitempty"`
@@ -1880,7 +2079,7 @@ type Dispute struct {
 func (m *Dispute) Reset()                    { *m = Dispute{} }
 func (m *Dispute) String() string            { return proto.CompactTextString(m) }
 func (*Dispute) ProtoMessage()               {}
-func (*Dispute) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
+func (*Dispute) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
 
 func (m *Dispute) GetTimestamp() *google_protobuf.Timestamp {
 	if m != nil {
@@ -1929,7 +2128,7 @@ type DisputeResolution struct {
 func (m *DisputeResolution) Reset()                    { *m = DisputeResolution{} }
 func (m *DisputeResolution) String() string            { return proto.CompactTextString(m) }
 func (*DisputeResolution) ProtoMessage()               {}
-func (*DisputeResolution) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
+func (*DisputeResolution) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13} }
 
 func (m *DisputeResolution) GetTimestamp() *google_protobuf.Timestamp {
 	if m != nil {
@@ -1984,7 +2183,7 @@ type DisputeResolution_Payout struct {
 func (m *DisputeResolution_Payout) Reset()                    { *m = DisputeResolution_Payout{} }
 func (m *DisputeResolution_Payout) String() string            { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout) ProtoMessage()               {}
-func (*DisputeResolution_Payout) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11, 0} }
+func (*DisputeResolution_Payout) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13, 0} }
 
 func (m *DisputeResolution_Payout) GetSigs() []*BitcoinSignature {
 	if m != nil {
@@ -2030,7 +2229,7 @@ func (m *DisputeResolution_Payout_Output) Reset()         { *m = DisputeResoluti
 func (m *DisputeResolution_Payout_Output) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout_Output) ProtoMessage()    {}
 func (*DisputeResolution_Payout_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{11, 0, 0}
+	return fileDescriptor1, []int{13, 0, 0}
 }
 
 func (m *DisputeResolution_Payout_Output) GetScript() string {
@@ -2047,6 +2246,72 @@ func (m *DisputeResolution_Payout_Output) GetAmount() uint64 {
 	return 0
 }
 
+type PanelResolution struct {
+	OrderId      string                         `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
+	Endorsements []*PanelResolution_Endorsement `protobuf:"bytes,2,rep,name=endorsements" json:"endorsements,omitempty"`
+}
+
+func (m *PanelResolution) Reset()                    { *m = PanelResolution{} }
+func (m *PanelResolution) String() string            { return proto.CompactTextString(m) }
+func (*PanelResolution) ProtoMessage()               {}
+func (*PanelResolution) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14} }
+
+func (m *PanelResolution) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *PanelResolution) GetEndorsements() []*PanelResolution_Endorsement {
+	if m != nil {
+		return m.Endorsements
+	}
+	return nil
+}
+
+type PanelResolution_Endorsement struct {
+	Moderator string                     `protobuf:"bytes,1,opt,name=moderator" json:"moderator,omitempty"`
+	Sigs      []*BitcoinSignature        `protobuf:"bytes,2,rep,name=sigs" json:"sigs,omitempty"`
+	Signature []byte                     `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,4,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *PanelResolution_Endorsement) Reset()         { *m = PanelResolution_Endorsement{} }
+func (m *PanelResolution_Endorsement) String() string { return proto.CompactTextString(m) }
+func (*PanelResolution_Endorsement) ProtoMessage()    {}
+func (*PanelResolution_Endorsement) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{14, 0}
+}
+
+func (m *PanelResolution_Endorsement) GetModerator() string {
+	if m != nil {
+		return m.Moderator
+	}
+	return ""
+}
+
+func (m *PanelResolution_Endorsement) GetSigs() []*BitcoinSignature {
+	if m != nil {
+		return m.Sigs
+	}
+	return nil
+}
+
+func (m *PanelResolution_Endorsement) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *PanelResolution_Endorsement) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 type DisputeAcceptance struct {
 	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
 	ClosedBy  string                     `protobuf:"bytes,2,opt,name=closedBy" json:"closedBy,omitempty"`
@@ -2055,7 +2320,7 @@ type DisputeAcceptance struct {
 func (m *DisputeAcceptance) Reset()                    { *m = DisputeAcceptance{} }
 func (m *DisputeAcceptance) String() string            { return proto.CompactTextString(m) }
 func (*DisputeAcceptance) ProtoMessage()               {}
-func (*DisputeAcceptance) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
+func (*DisputeAcceptance) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{15} }
 
 func (m *DisputeAcceptance) GetTimestamp() *google_protobuf.Timestamp {
 	if m != nil {
@@ -2080,7 +2345,7 @@ type Outpoint struct {
 func (m *Outpoint) Reset()                    { *m = Outpoint{} }
 func (m *Outpoint) String() string            { return proto.CompactTextString(m) }
 func (*Outpoint) ProtoMessage()               {}
-func (*Outpoint) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13} }
+func (*Outpoint) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{16} }
 
 func (m *Outpoint) GetHash() string {
 	if m != nil {
@@ -2114,7 +2379,7 @@ type Refund struct {
 func (m *Refund) Reset()                    { *m = Refund{} }
 func (m *Refund) String() string            { return proto.CompactTextString(m) }
 func (*Refund) ProtoMessage()               {}
-func (*Refund) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14} }
+func (*Refund) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{17} }
 
 func (m *Refund) GetOrderID() string {
 	if m != nil {
@@ -2159,7 +2424,7 @@ type Refund_TransactionInfo struct {
 func (m *Refund_TransactionInfo) Reset()                    { *m = Refund_TransactionInfo{} }
 func (m *Refund_TransactionInfo) String() string            { return proto.CompactTextString(m) }
 func (*Refund_TransactionInfo) ProtoMessage()               {}
-func (*Refund_TransactionInfo) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14, 0} }
+func (*Refund_TransactionInfo) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{17, 0} }
 
 func (m *Refund_TransactionInfo) GetTxid() string {
 	if m != nil {
@@ -2185,7 +2450,7 @@ type ID struct {
 func (m *ID) Reset()                    { *m = ID{} }
 func (m *ID) String() string            { return proto.CompactTextString(m) }
 func (*ID) ProtoMessage()               {}
-func (*ID) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{15} }
+func (*ID) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{18} }
 
 func (m *ID) GetPeerID() string {
 	if m != nil {
@@ -2223,7 +2488,7 @@ type ID_Pubkeys struct {
 func (m *ID_Pubkeys) Reset()                    { *m = ID_Pubkeys{} }
 func (m *ID_Pubkeys) String() string            { return proto.CompactTextString(m) }
 func (*ID_Pubkeys) ProtoMessage()               {}
-func (*ID_Pubkeys) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{15, 0} }
+func (*ID_Pubkeys) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{18, 0} }
 
 func (m *ID_Pubkeys) GetIdentity() []byte {
 	if m != nil {
@@ -2247,7 +2512,7 @@ type Signature struct {
 func (m *Signature) Reset()                    { *m = Signature{} }
 func (m *Signature) String() string            { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()               {}
-func (*Signature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{16} }
+func (*Signature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{19} }
 
 func (m *Signature) GetSection() Signature_Section {
 	if m != nil {
@@ -2272,7 +2537,7 @@ type SignedListing struct {
 func (m *SignedListing) Reset()                    { *m = SignedListing{} }
 func (m *SignedListing) String() string            { return proto.CompactTextString(m) }
 func (*SignedListing) ProtoMessage()               {}
-func (*SignedListing) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{17} }
+func (*SignedListing) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{20} }
 
 func (m *SignedListing) GetListing() *Listing {
 	if m != nil {
@@ -2327,10 +2592,16 @@ func init() {
 	proto.RegisterType((*OrderCompletion)(nil), "OrderCompletion")
 	proto.RegisterType((*Rating)(nil), "Rating")
 	proto.RegisterType((*Rating_RatingData)(nil), "Rating.RatingData")
+	proto.RegisterType((*RatingReply)(nil), "RatingReply")
+	proto.RegisterType((*RatingReply_ReplyData)(nil), "RatingReply.ReplyData")
+	proto.RegisterType((*RatingAmendment)(nil), "RatingAmendment")
+	proto.RegisterType((*RatingAmendment_AmendmentData)(nil), "RatingAmendment.AmendmentData")
 	proto.RegisterType((*Dispute)(nil), "Dispute")
 	proto.RegisterType((*DisputeResolution)(nil), "DisputeResolution")
 	proto.RegisterType((*DisputeResolution_Payout)(nil), "DisputeResolution.Payout")
 	proto.RegisterType((*DisputeResolution_Payout_Output)(nil), "DisputeResolution.Payout.Output")
+	proto.RegisterType((*PanelResolution)(nil), "PanelResolution")
+	proto.RegisterType((*PanelResolution_Endorsement)(nil), "PanelResolution.Endorsement")
 	proto.RegisterType((*DisputeAcceptance)(nil), "DisputeAcceptance")
 	proto.RegisterType((*Outpoint)(nil), "Outpoint")
 	proto.RegisterType((*Refund)(nil), "Refund")
@@ -2349,200 +2620,217 @@ func init() {
 func init() { proto.RegisterFile("contracts.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 3116 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
-	0xb5, 0xe6, 0xe0, 0x8d, 0x43, 0x90, 0x00, 0x5b, 0xb4, 0x8c, 0x8b, 0xf2, 0xb5, 0x28, 0x94, 0xac,
-	0x2b, 0xcb, 0xf2, 0x58, 0xe6, 0xdd, 0xa8, 0xe2, 0x54, 0x6c, 0x12, 0x00, 0x4d, 0x58, 0x14, 0x89,
-	0x34, 0x40, 0xe7, 0xb1, 0x51, 0x35, 0x67, 0x9a, 0xe0, 0x44, 0x83, 0x19, 0x78, 0xa6, 0x87, 0x22,
-	0x92, 0x55, 0x76, 0x59, 0xa4, 0x2a, 0x8b, 0x2c, 0xbc, 0xc8, 0x1f, 0xc8, 0x0f, 0xc8, 0x2e, 0xd9,
-	0x24, 0xfb, 0x54, 0xaa, 0xb2, 0xf2, 0x2e, 0xa9, 0x54, 0xd6, 0x79, 0xac, 0xb2, 0xc8, 0x26, 0xd5,
-	0xaf, 0x79, 0x01, 0x94, 0x25, 0xa7, 0x52, 0x59, 0x11, 0xe7, 0x3b, 0xa7, 0x7b, 0x7a, 0x4e, 0x9f,
-	0xc7, 0xd7, 0x3d, 0x84, 0xa6, 0xe5, 0x7b, 0x2c, 0x20, 0x16, 0x0b, 0xcd, 0x79, 0xe0, 0x33, 0xbf,
-	0x83, 0x2c, 0x3f, 0xf2, 0x58, 0xb0, 0xb0, 0x7c, 0x9b, 0x6a, 0xec, 0xd6, 0xd4, 0xf7, 0xa7, 0x2e,
-	0x7d, 0x4f, 0x48, 0x67, 0xd1, 0xf9, 0x7b, 0xcc, 0x99, 0xd1, 0x90, 0x91, 0xd9, 0x5c, 0x1a, 0x74,
-	0x7f, 0x5d, 0x82, 0x2d, 0xec, 0x58, 0x24, 0xb0, 0x1d, 0xe2, 0xf5, 0xd4, 0x8c, 0xe8, 0x21, 0x6c,
-	0x5e, 0x52, 0xcf, 0xf6, 0x83, 0x23, 0x27, 0x64, 0x8e, 0x37, 0x0d, 0xdb, 0xc6, 0x4e, 0xf1, 0xde,
-	0xfa, 0x6e, 0xcd, 0x54, 0x00, 0xce, 0xe9, 0xd1, 0x5d, 0x80, 0xb3, 0x68, 0x41, 0x83, 0x93, 0xc0,
-	0xa6, 0x41, 0xbb, 0xb0, 0x63, 0xdc, 0x5b, 0xdf, 0xad, 0x98, 0x42, 0xc2, 0x29, 0x0d, 0x3a, 0x82,
-	0xd7, 0xe5, 0x48, 0x21, 0xf6, 0x7c, 0xef, 0xdc, 0x09, 0x66, 0x84, 0x39, 0xbe, 0xd7, 0x2e, 0x8a,
-	0x41, 0xc8, 0x5c, 0xd2, 0xe0, 0xeb, 0x86, 0xa0, 0x21, 0xdc, 0x4c, 0xa9, 0x0e, 0x22, 0xf7, 0xdc,
-	0x71, 0xdd, 0x19, 0xf5, 0x58, 0xbb, 0x24, 0xd6, 0xbb, 0x65, 0xe6, 0x15, 0xf8, 0x9a, 0x01, 0xa8,
-	0x0f, 0xdb, 0xc9, 0x32, 0x7b, 0xfe, 0x6c, 0xee, 0x52, 0xb1, 0xaa, 0xb2, 0x58, 0x55, 0xcb, 0xcc,
-	0xe1, 0x78, 0xa5, 0x35, 0xea, 0x42, 0xd5, 0x76, 0xc2, 0x79, 0xc4, 0x68, 0xbb, 0x22, 0x06, 0xd6,
-	0xcc, 0xbe, 0x94, 0xb1, 0x56, 0xa0, 0x8f, 0x60, 0x4b, 0xfd, 0xc4, 0x34, 0xf4, 0xdd, 0x48, 0x3c,
-	0xa6, 0xaa, 0x5e, 0xbe, 0x9f, 0xd7, 0xe0, 0x65, 0xe3, 0xd4, 0x0c, 0x7b, 0x96, 0x45, 0xe7, 0x8c,
-	0x78, 0x16, 0x6d, 0xd7, 0xb2, 0x33, 0x24, 0x1a, 0xbc, 0x6c, 0x8c, 0x6e, 0x41, 0x25, 0xa0, 0xe7,
-	0x91, 0x67, 0xb7, 0xeb, 0x62, 0x58, 0xd5, 0xc4, 0x42, 0xc4, 0x0a, 0x46, 0xf7, 0x01, 0x42, 0x67,
-	0xea, 0x11, 0x16, 0x05, 0x34, 0x6c, 0x83, 0xf0, 0x26, 0x98, 0x63, 0x0d, 0xe1, 0x94, 0xb6, 0xfb,
-	0xbb, 0x1b, 0x50, 0x55, 0x81, 0x80, 0x10, 0x94, 0x42, 0x37, 0x9a, 0xb6, 0x8d, 0x1d, 0xe3, 0x5e,
-	0x1d, 0x8b, 0xdf, 0xe8, 0x16, 0xd4, 0xa4, 0xd3, 0x87, 0x7d, 0x15, 0x19, 0x45, 0x73, 0xd8, 0xc7,
-	0x31, 0x88, 0xde, 0x85, 0xda, 0x8c, 0x32, 0x62, 0x13, 0x46, 0x54, 0x14, 0x6c, 0xe9, 0x40, 0x33,
-	0x9f, 0x28, 0x05, 0x8e, 0x4d, 0xd0, 0x6d, 0x28, 0x39, 0x8c, 0xce, 0xda, 0x25, 0x61, 0xba, 0x11,
-	0x9b, 0x0e, 0x19, 0x9d, 0x61, 0xa1, 0x42, 0x7b, 0xd0, 0x0c, 0x2f, 0x9c, 0xf9, 0xdc, 0xf1, 0xa6,
-	0x27, 0x73, 0xee, 0xb3, 0xb0, 0x5d, 0x16, 0xef, 0xf0, 0x7a, 0x6c, 0x3d, 0xce, 0xe8, 0x71, 0xde,
-	0x1e, 0x75, 0xa1, 0xcc, 0xc8, 0x15, 0x0d, 0xdb, 0x15, 0x31, 0xb0, 0x11, 0x0f, 0x9c, 0x90, 0x2b,
-	0x2c, 0x55, 0xe8, 0x6d, 0xa8, 0x5a, 0x7e, 0x34, 0xe7, 0xd3, 0x57, 0x85, 0x55, 0x33, 0xb6, 0xea,
-	0x09, 0x1c, 0x6b, 0x3d, 0x7a, 0x13, 0x60, 0xe6, 0xdb, 0x34, 0x20, 0xcc, 0x0f, 0xc2, 0x76, 0x6d,
-	0xa7, 0x78, 0xaf, 0x8e, 0x53, 0x08, 0x32, 0x01, 0x31, 0x1a, 0xcc, 0xc2, 0x3d, 0xcf, 0xee, 0xf9,
-	0x9e, 0xed, 0xc8, 0x45, 0xd7, 0x85, 0x1b, 0x57, 0x68, 0x50, 0x17, 0x1a, 0x72, 0xab, 0x46, 0xbe,
-	0xeb, 0x58, 0x8b, 0x36, 0x08, 0xcb, 0x0c, 0xd6, 0xf9, 0x4b, 0x11, 0x6a, 0xda, 0x7f, 0xa8, 0x0d,
-	0xd5, 0x4b, 0x1a, 0x84, 0x3c, 0xd8, 0xf8, 0xe6, 0x6c, 0x60, 0x2d, 0xa2, 0x7d, 0x68, 0xe8, 0x5a,
-	0x32, 0x59, 0xcc, 0xa9, 0xd8, 0xa3, 0xcd, 0xdd, 0x37, 0x97, 0xb6, 0xc0, 0xec, 0xa5, 0xac, 0x70,
-	0x66, 0x0c, 0x7a, 0x08, 0x95, 0x73, 0x9f, 0xa7, 0xa5, 0xd8, 0xc0, 0xcd, 0xdd, 0xf6, 0xf2, 0xe8,
-	0x03, 0xa1, 0xc7, 0xca, 0x0e, 0xed, 0x42, 0x85, 0x5e, 0xcd, 0x9d, 0x60, 0xa1, 0xf6, 0xb1, 0x63,
-	0xca, 0x5a, 0x65, 0xea, 0x5a, 0x65, 0x4e, 0x74, 0xad, 0xc2, 0xca, 0x92, 0x3b, 0x89, 0x88, 0x20,
-	0xa6, 0x76, 0x2f, 0x0a, 0x02, 0xea, 0x59, 0x0e, 0x95, 0x3b, 0x5b, 0xc7, 0x2b, 0x34, 0xe8, 0x1e,
-	0x34, 0xe7, 0x81, 0x63, 0x39, 0xde, 0x54, 0x81, 0x0b, 0x91, 0x96, 0x75, 0x9c, 0x87, 0x51, 0x07,
-	0x6a, 0x2e, 0xf1, 0xa6, 0x11, 0x99, 0x52, 0x91, 0x8b, 0x75, 0x1c, 0xcb, 0xfc, 0xa9, 0x34, 0xb4,
-	0x02, 0xff, 0x39, 0x5f, 0x90, 0x1f, 0xb1, 0x43, 0x3f, 0x12, 0x5b, 0xc8, 0x9d, 0xb8, 0x42, 0xd3,
-	0x1d, 0x41, 0x23, 0xed, 0x29, 0xb4, 0x05, 0x1b, 0xa3, 0xc3, 0xef, 0x8c, 0x87, 0xbd, 0xbd, 0xa3,
-	0xa7, 0x1f, 0x9f, 0x9c, 0xf4, 0x5b, 0x6b, 0xa8, 0x05, 0x8d, 0xfe, 0xf0, 0xe3, 0xe1, 0x44, 0x23,
-	0x06, 0x5a, 0x87, 0xea, 0x78, 0x80, 0x3f, 0x1d, 0xf6, 0x06, 0xad, 0x02, 0xda, 0x04, 0xe8, 0xe1,
-	0x93, 0x6f, 0xf5, 0x9f, 0x1e, 0x9c, 0x1e, 0xf7, 0x5b, 0xc5, 0xee, 0x5d, 0xa8, 0x48, 0xef, 0xa1,
-	0x26, 0xac, 0x1f, 0x0c, 0xbf, 0x3d, 0xe8, 0x3f, 0x1d, 0x61, 0x6e, 0xba, 0xc6, 0xc7, 0xed, 0x9d,
-	0xf6, 0x26, 0xc3, 0x93, 0xe3, 0x96, 0xd1, 0xf9, 0x43, 0x05, 0x4a, 0x3c, 0x0b, 0xd0, 0x36, 0x94,
-	0x99, 0xc3, 0x5c, 0xaa, 0xf2, 0x50, 0x0a, 0x68, 0x07, 0xd6, 0x6d, 0xbe, 0x5e, 0x47, 0x84, 0xb8,
-	0xd8, 0xe7, 0x3a, 0x4e, 0x43, 0xe8, 0x2e, 0x6c, 0xce, 0x03, 0xdf, 0xa2, 0x61, 0xe8, 0x78, 0x53,
-	0xfe, 0x52, 0x62, 0x3b, 0xeb, 0x38, 0x87, 0xf2, 0xf9, 0xb9, 0x07, 0xa9, 0xd8, 0xbb, 0x12, 0x96,
-	0x02, 0x4f, 0x7e, 0x2f, 0x3c, 0x7f, 0x2e, 0x6a, 0x66, 0x0d, 0x8b, 0xdf, 0x1c, 0x63, 0x64, 0x2a,
-	0xb3, 0xa8, 0x8e, 0xc5, 0x6f, 0xf4, 0x0e, 0x54, 0x9c, 0x19, 0x99, 0x52, 0x9d, 0x35, 0x37, 0x32,
-	0x29, 0x6c, 0x0e, 0xb9, 0x0e, 0x2b, 0x13, 0x9e, 0x38, 0x16, 0x61, 0x74, 0xea, 0x07, 0x7c, 0xaf,
-	0x55, 0xe2, 0x24, 0x08, 0x5f, 0xca, 0x34, 0x20, 0x33, 0x99, 0x2b, 0x05, 0x2c, 0x05, 0xf4, 0x06,
-	0xd4, 0x2d, 0x9d, 0x2c, 0x2a, 0x37, 0x12, 0x00, 0x99, 0x50, 0xf5, 0x55, 0x59, 0x58, 0x17, 0x2b,
-	0xd8, 0xce, 0xae, 0x40, 0xd5, 0x04, 0x6d, 0x84, 0xde, 0x82, 0x52, 0xf8, 0x2c, 0x0a, 0xdb, 0x0d,
-	0xd5, 0x55, 0x32, 0xc6, 0xe3, 0x67, 0x11, 0x16, 0xea, 0xce, 0x6f, 0x0c, 0xa8, 0xc8, 0xa1, 0xc2,
-	0x15, 0x64, 0xa6, 0xfd, 0x2f, 0x7e, 0xbf, 0x84, 0xfb, 0x1f, 0x41, 0xed, 0x92, 0x04, 0x0e, 0xf1,
-	0x58, 0xd8, 0x2e, 0x8a, 0x67, 0xbd, 0xb1, 0x6a, 0x61, 0xe6, 0xa7, 0xd2, 0x08, 0xc7, 0xd6, 0x9d,
-	0x43, 0xa8, 0x2a, 0x70, 0xe5, 0xa3, 0xdf, 0x86, 0xb2, 0x70, 0xa7, 0xaa, 0xbf, 0x2b, 0x1d, 0x2e,
-	0x2d, 0x3a, 0x3f, 0x34, 0xa0, 0x38, 0x7e, 0x16, 0xf1, 0x02, 0xa3, 0x66, 0xef, 0xf9, 0xb3, 0x33,
-	0x5f, 0x30, 0x80, 0x0d, 0x9c, 0xc1, 0xb8, 0x97, 0xe7, 0x81, 0x6f, 0x47, 0x16, 0x53, 0xa5, 0xbd,
-	0x8e, 0x13, 0x80, 0x6b, 0xc3, 0x28, 0xb0, 0x2e, 0x48, 0x30, 0x95, 0x71, 0x54, 0xc4, 0x09, 0xc0,
-	0x33, 0xee, 0xb3, 0x88, 0x78, 0xcc, 0x61, 0xb2, 0x02, 0x14, 0x71, 0x2c, 0x77, 0x3e, 0x37, 0xa0,
-	0x2c, 0x16, 0xc5, 0xad, 0xce, 0x1d, 0x97, 0xa6, 0x5e, 0x28, 0x96, 0xb9, 0xce, 0x0f, 0x9c, 0xa9,
-	0xe3, 0x11, 0x57, 0x3d, 0x3c, 0x96, 0x79, 0x54, 0xb8, 0xf1, 0x73, 0xeb, 0x58, 0x0a, 0xe8, 0x26,
-	0x54, 0x66, 0xd4, 0x76, 0x22, 0xd9, 0x3b, 0xea, 0x58, 0x49, 0xdc, 0x3a, 0x9c, 0x11, 0xd7, 0x15,
-	0x91, 0x5b, 0xc7, 0x52, 0x10, 0xa1, 0xeb, 0x78, 0xba, 0x64, 0x88, 0xdf, 0x9d, 0x1f, 0x17, 0x61,
-	0x33, 0xdb, 0x39, 0x56, 0xfa, 0xfb, 0x11, 0x94, 0x58, 0x52, 0x4a, 0xef, 0x5c, 0xd3, 0x74, 0x62,
-	0x51, 0x14, 0x54, 0x31, 0x02, 0xdd, 0x85, 0x6a, 0x40, 0xa7, 0x22, 0x34, 0x79, 0x04, 0x6c, 0xee,
-	0x36, 0x78, 0x2b, 0xe1, 0xbc, 0xae, 0xe7, 0xdb, 0x14, 0x6b, 0x25, 0xfa, 0x00, 0x6a, 0x21, 0x0d,
-	0x2e, 0x1d, 0x8b, 0xea, 0xd6, 0x76, 0xeb, 0xda, 0xa7, 0x48, 0x3b, 0x1c, 0x0f, 0xe8, 0xfc, 0xd4,
-	0x80, 0xaa, 0x42, 0x57, 0x2e, 0x3f, 0x4e, 0xef, 0x42, 0x3a, 0xbd, 0x1f, 0xc0, 0x16, 0x0d, 0x99,
-	0x33, 0x23, 0x8c, 0xda, 0x7d, 0xea, 0x3a, 0x97, 0x34, 0x58, 0x28, 0xff, 0x2e, 0x2b, 0xd0, 0x43,
-	0xb8, 0x41, 0x6c, 0x99, 0x6f, 0xc4, 0xe5, 0x61, 0x36, 0x4a, 0x15, 0x8c, 0x55, 0xaa, 0xee, 0xfb,
-	0xd0, 0x48, 0x3b, 0x84, 0x17, 0xc9, 0xa3, 0x13, 0x5e, 0x34, 0x47, 0xc3, 0xde, 0xe3, 0xd3, 0x51,
-	0x6b, 0x2d, 0x5f, 0xfd, 0x8c, 0xce, 0x4f, 0x0c, 0x28, 0x4e, 0xc8, 0x15, 0x6f, 0x6e, 0x8c, 0x5c,
-	0x89, 0xee, 0x25, 0xdf, 0x43, 0x8b, 0xe8, 0x01, 0x00, 0x23, 0x57, 0x58, 0xb9, 0xb4, 0xb0, 0xc2,
-	0xa5, 0x29, 0x3d, 0x4f, 0x51, 0x46, 0xae, 0xf4, 0x2a, 0xc4, 0xcb, 0xd5, 0x70, 0x1a, 0xe2, 0xe5,
-	0x68, 0x4e, 0x03, 0x8b, 0x7a, 0x8c, 0xa7, 0x53, 0x49, 0xd4, 0x9c, 0x14, 0x22, 0x6a, 0x80, 0xec,
-	0xfd, 0xd7, 0x14, 0xe1, 0x6d, 0x28, 0x5d, 0x90, 0xf0, 0x42, 0x46, 0xec, 0xe1, 0x1a, 0x16, 0x12,
-	0xba, 0x03, 0x0d, 0xdb, 0x09, 0x05, 0x83, 0xe7, 0x8b, 0x92, 0x6e, 0x3d, 0x5c, 0xc3, 0x19, 0x14,
-	0xdd, 0x87, 0xa6, 0x7a, 0x54, 0x5f, 0xc1, 0x22, 0x62, 0x0b, 0x87, 0x06, 0xce, 0x2b, 0xd0, 0x5d,
-	0xd8, 0x10, 0xdb, 0x16, 0x5b, 0xf2, 0x30, 0x2e, 0x1d, 0x1a, 0x38, 0x0b, 0xef, 0x57, 0xa0, 0xc4,
-	0x4f, 0x0c, 0xfb, 0x00, 0x35, 0xfd, 0xac, 0xee, 0x5f, 0xeb, 0x50, 0x96, 0x7c, 0xfd, 0x0e, 0x6c,
-	0x48, 0x4a, 0xb1, 0x67, 0xdb, 0x01, 0x0d, 0x43, 0xf5, 0x2e, 0x59, 0x90, 0x67, 0xba, 0x04, 0x0e,
-	0xa8, 0x8e, 0x99, 0x04, 0x40, 0xef, 0x40, 0x2d, 0x4c, 0x7b, 0x94, 0xd3, 0x24, 0x31, 0x7b, 0x1c,
-	0xa8, 0x38, 0x36, 0x40, 0xff, 0x0b, 0x55, 0xc1, 0xac, 0x87, 0x7d, 0xc5, 0x0b, 0x04, 0x57, 0xd4,
-	0x18, 0x7a, 0x04, 0xf5, 0xf8, 0x08, 0xa3, 0xb8, 0xf9, 0x8b, 0x88, 0x43, 0x62, 0x8c, 0x6e, 0x43,
-	0x99, 0x53, 0x43, 0xcd, 0xe7, 0xd6, 0xd5, 0x12, 0x04, 0x69, 0x94, 0x1a, 0x74, 0x0f, 0xaa, 0x73,
-	0xb2, 0x10, 0xe7, 0x07, 0xc9, 0xc7, 0x37, 0x95, 0xd1, 0x48, 0xa2, 0x58, 0xab, 0x79, 0x14, 0x04,
-	0x84, 0xe7, 0xda, 0x63, 0xba, 0x90, 0x4d, 0xa9, 0x81, 0x53, 0x08, 0xda, 0x85, 0x6d, 0xe2, 0x32,
-	0x1a, 0x78, 0x84, 0x51, 0xce, 0x05, 0x88, 0xc5, 0x86, 0xde, 0xb9, 0xaf, 0xf8, 0xdc, 0x4a, 0x5d,
-	0x9a, 0xa0, 0x41, 0x86, 0xa0, 0x75, 0x7e, 0x6f, 0x40, 0x2d, 0x0e, 0xc0, 0x9b, 0x50, 0xe1, 0xce,
-	0x9a, 0xf8, 0x6a, 0x2b, 0x94, 0xc4, 0x87, 0x13, 0xb5, 0x47, 0xb2, 0x18, 0x6a, 0x91, 0x67, 0xb8,
-	0xc5, 0xab, 0xac, 0x4c, 0x55, 0xf1, 0x5b, 0x54, 0x3c, 0x46, 0x18, 0x55, 0x85, 0x50, 0x0a, 0x22,
-	0xb8, 0xfd, 0x90, 0x11, 0x57, 0xc4, 0xa0, 0x2c, 0x86, 0x29, 0x84, 0x17, 0x27, 0x75, 0xc8, 0x14,
-	0xd1, 0xb4, 0x54, 0x9c, 0x94, 0x92, 0xf7, 0x0e, 0xf5, 0xf0, 0x63, 0x9f, 0x89, 0x36, 0x2f, 0xc8,
-	0x69, 0x1a, 0xeb, 0xfc, 0xb1, 0xa0, 0xb8, 0xca, 0x0e, 0xac, 0xbb, 0xb2, 0x70, 0x1d, 0xf2, 0xbc,
-	0x90, 0x6f, 0x95, 0x86, 0x32, 0xad, 0xa2, 0x20, 0x5c, 0x13, 0xcb, 0xe8, 0x41, 0xd2, 0xca, 0x65,
-	0xc7, 0x44, 0xa9, 0x8d, 0x5d, 0x6a, 0xe4, 0xfb, 0xb0, 0x99, 0xe5, 0xf9, 0x31, 0xf9, 0x4c, 0x0d,
-	0xca, 0x9d, 0x0c, 0x72, 0x23, 0xb8, 0x3b, 0x67, 0x74, 0xe6, 0x2b, 0xf7, 0x88, 0xdf, 0xfc, 0x1d,
-	0x24, 0xd1, 0xe7, 0x7e, 0xd0, 0x64, 0x27, 0x0d, 0x75, 0x76, 0x5f, 0x48, 0x0d, 0xb6, 0xa1, 0x7c,
-	0x49, 0xdc, 0x88, 0xaa, 0xad, 0x93, 0x42, 0xe7, 0x1b, 0x2f, 0xd5, 0x6b, 0xda, 0x50, 0x55, 0x85,
-	0x5d, 0x6f, 0xbc, 0x12, 0x3b, 0x3f, 0x2f, 0x40, 0x55, 0x85, 0x2e, 0x7a, 0x97, 0xb7, 0x3e, 0x76,
-	0xe1, 0xdb, 0x62, 0xec, 0xe6, 0xee, 0x6b, 0xd9, 0xd0, 0xe6, 0x34, 0xfd, 0xc2, 0xb7, 0xb1, 0x32,
-	0xe2, 0x19, 0x1d, 0x1f, 0x4e, 0x74, 0x67, 0x8f, 0x01, 0x1e, 0x83, 0x64, 0x26, 0x8a, 0x4a, 0x51,
-	0x24, 0xbb, 0x92, 0x04, 0xeb, 0xba, 0x20, 0x8e, 0xc7, 0x0b, 0x8a, 0x8a, 0xac, 0x04, 0x48, 0x47,
-	0x68, 0x39, 0x1b, 0xa1, 0xe2, 0x30, 0x63, 0x53, 0x3a, 0x1b, 0x0b, 0x2a, 0xa4, 0x3a, 0x6e, 0x06,
-	0xe3, 0x36, 0xf1, 0x02, 0x1e, 0xd3, 0x85, 0x88, 0xa9, 0x06, 0xce, 0x60, 0xdd, 0x47, 0x50, 0x91,
-	0xef, 0x81, 0x6e, 0x40, 0x73, 0xaf, 0xdf, 0xc7, 0x83, 0xf1, 0xf8, 0x29, 0x1e, 0x7c, 0xf3, 0x74,
-	0x30, 0x9e, 0xb4, 0xd6, 0x10, 0x40, 0xa5, 0x3f, 0xc4, 0x83, 0xde, 0xa4, 0x65, 0xa0, 0x0d, 0xa8,
-	0x3f, 0x39, 0xe9, 0x0f, 0xf0, 0xde, 0x64, 0xd0, 0x6f, 0x15, 0xba, 0xff, 0x30, 0x60, 0x6b, 0xf9,
-	0x7e, 0xa1, 0x0d, 0x55, 0x9f, 0x83, 0xc3, 0xbe, 0x6e, 0x2b, 0x4a, 0xcc, 0xd6, 0xa1, 0xc2, 0xab,
-	0xd4, 0x21, 0x4e, 0xb1, 0xa5, 0xcf, 0x75, 0x49, 0xd5, 0x14, 0x3b, 0x83, 0xf2, 0xb3, 0x4b, 0x40,
-	0x3f, 0x8b, 0x68, 0xc8, 0xa8, 0xbd, 0x27, 0x9d, 0x2d, 0x7b, 0x67, 0x1e, 0x46, 0x5f, 0x87, 0x96,
-	0x2c, 0x3d, 0xe3, 0xe4, 0xc4, 0x2e, 0x29, 0x41, 0xcb, 0xc4, 0x59, 0x05, 0x5e, 0xb2, 0xec, 0xfe,
-	0xc8, 0x80, 0x75, 0x79, 0x4f, 0x43, 0xbf, 0x47, 0x2d, 0xf6, 0x1f, 0x79, 0x67, 0xce, 0x9f, 0x9d,
-	0xa9, 0xce, 0xd0, 0x2d, 0x73, 0xdf, 0x61, 0x96, 0xef, 0x78, 0xc9, 0xb2, 0x84, 0xba, 0xfb, 0x45,
-	0x11, 0x9a, 0xb9, 0x05, 0xa3, 0x8f, 0x52, 0x77, 0x03, 0x86, 0x78, 0xe6, 0x9d, 0xfc, 0x4b, 0x99,
-	0x93, 0x80, 0x78, 0x21, 0xb1, 0xf8, 0x96, 0xad, 0xb8, 0x2e, 0xe0, 0x34, 0x54, 0x9b, 0x8a, 0x65,
-	0x37, 0x70, 0x02, 0x74, 0xfe, 0x5c, 0x80, 0x1b, 0x2b, 0xc6, 0xa7, 0xaa, 0xd2, 0x38, 0xb9, 0xcf,
-	0x48, 0x43, 0xa2, 0xe9, 0xe9, 0x8a, 0xaf, 0xe7, 0x8d, 0x81, 0xa5, 0x70, 0x2d, 0x2e, 0x87, 0x2b,
-	0xb7, 0x51, 0x13, 0x4e, 0x04, 0x4f, 0x90, 0x19, 0x93, 0xc1, 0xd0, 0x21, 0xd4, 0xd9, 0x45, 0x34,
-	0x3b, 0xf3, 0x88, 0xe3, 0xaa, 0x86, 0x77, 0xff, 0x65, 0x1c, 0xa0, 0x48, 0x7d, 0x32, 0xb8, 0xf3,
-	0x03, 0xcd, 0xa9, 0x35, 0xaf, 0x35, 0x12, 0x5e, 0x9b, 0x30, 0xe0, 0x42, 0x9a, 0x01, 0x27, 0x7c,
-	0xb9, 0x98, 0xe7, 0xcb, 0x92, 0x5d, 0x97, 0xd2, 0xec, 0x3a, 0xcd, 0xc7, 0xcb, 0x59, 0x3e, 0xde,
-	0x1d, 0x41, 0x2b, 0xbf, 0xe9, 0xbc, 0xdb, 0x38, 0xde, 0x3c, 0x62, 0x43, 0xcf, 0xa6, 0x57, 0xea,
-	0x52, 0x22, 0x85, 0xbc, 0x78, 0xe3, 0xba, 0xbf, 0x28, 0x43, 0x6b, 0xe9, 0x16, 0x2f, 0x0e, 0x5e,
-	0x3b, 0x1b, 0xbc, 0x76, 0x7c, 0x31, 0x55, 0x48, 0x5d, 0x4c, 0x65, 0x02, 0xba, 0xf8, 0x2a, 0x01,
-	0x7d, 0x0c, 0xad, 0xf9, 0xc5, 0x22, 0x74, 0x2c, 0xe2, 0xc6, 0x4c, 0x58, 0x5e, 0x39, 0x76, 0x97,
-	0xae, 0x1c, 0xcd, 0x51, 0xce, 0x12, 0x2f, 0x8d, 0x45, 0x8f, 0xa1, 0x69, 0x3b, 0x53, 0x87, 0xa5,
-	0xa6, 0x93, 0x19, 0x7c, 0x7b, 0x79, 0xba, 0x7e, 0xd6, 0x10, 0xe7, 0x47, 0xa2, 0x87, 0x50, 0x99,
-	0x93, 0x85, 0x1f, 0x31, 0x75, 0x07, 0xd9, 0x5e, 0xb1, 0x24, 0xa1, 0xc7, 0xca, 0x0e, 0x7d, 0x0d,
-	0x9a, 0xb9, 0xba, 0xa0, 0x08, 0xd0, 0x72, 0x01, 0xc9, 0x1b, 0x8a, 0x96, 0xe4, 0x33, 0x79, 0xff,
-	0xc8, 0x5b, 0x92, 0xcf, 0x68, 0x67, 0x02, 0xad, 0xfc, 0x4b, 0x8b, 0x36, 0xc5, 0x9b, 0x19, 0x0d,
-	0xf4, 0xd6, 0x28, 0x91, 0x57, 0x44, 0x16, 0x10, 0xeb, 0x99, 0xe3, 0x4d, 0x8f, 0xa3, 0xd9, 0x19,
-	0xd5, 0x0d, 0x27, 0x87, 0x76, 0x3e, 0x84, 0x66, 0xee, 0xdd, 0x51, 0x0b, 0x8a, 0x51, 0xe0, 0xaa,
-	0x09, 0xf9, 0x4f, 0x1e, 0x84, 0x73, 0x12, 0x86, 0xcf, 0xfd, 0xc0, 0xd6, 0x87, 0x42, 0x2d, 0xf3,
-	0xa3, 0x6d, 0x45, 0xbe, 0x79, 0x5c, 0x91, 0x8c, 0x17, 0x56, 0x24, 0x4e, 0x7f, 0xa5, 0x8b, 0xf6,
-	0x32, 0xd4, 0x2a, 0x0b, 0xa2, 0xfb, 0xd0, 0x92, 0xc0, 0x01, 0xa5, 0x23, 0x1a, 0xec, 0x2f, 0x18,
-	0x55, 0x8d, 0x71, 0x09, 0xef, 0xfe, 0xd2, 0x80, 0x66, 0xfe, 0xd6, 0xf8, 0xfa, 0xa8, 0xfd, 0xea,
-	0x25, 0xf7, 0x7d, 0x00, 0xf9, 0xec, 0xf1, 0x0b, 0x0b, 0x6f, 0xca, 0x08, 0xdd, 0x86, 0xaa, 0xdc,
-	0xdc, 0x50, 0xc5, 0x72, 0x55, 0xed, 0x3e, 0xd6, 0x78, 0xf7, 0xb7, 0x25, 0xa8, 0x48, 0x0c, 0xed,
-	0x6a, 0x0a, 0xdc, 0x4f, 0x4a, 0x33, 0x52, 0x03, 0xd4, 0x1f, 0xae, 0xc1, 0x29, 0xab, 0x2f, 0x29,
-	0xc5, 0x7f, 0x2b, 0x02, 0xe0, 0x8c, 0x71, 0x52, 0x5f, 0x8d, 0x7c, 0x7d, 0xfd, 0xd2, 0x4b, 0x65,
-	0x13, 0xea, 0xf2, 0xf7, 0xd8, 0xd1, 0xc7, 0x8e, 0xe5, 0x68, 0x4e, 0x4c, 0xbe, 0xec, 0xe0, 0xf1,
-	0x06, 0xd4, 0xc5, 0xcf, 0x63, 0x4e, 0xbf, 0x64, 0x75, 0x4b, 0x00, 0x1e, 0x75, 0x42, 0xe0, 0xcf,
-	0xaa, 0x88, 0xa5, 0xc6, 0x72, 0xa6, 0x13, 0x70, 0x7d, 0x9e, 0xb8, 0x70, 0x9b, 0xcc, 0x3e, 0xd7,
-	0x5e, 0x65, 0x9f, 0x79, 0xec, 0x5c, 0xd2, 0x80, 0x97, 0xee, 0xba, 0x3c, 0x35, 0x28, 0x91, 0x6b,
-	0x3e, 0x8b, 0x88, 0xcb, 0x49, 0xb3, 0x3a, 0x4f, 0x28, 0x31, 0x7f, 0x11, 0xb5, 0x2e, 0xb4, 0x99,
-	0x8b, 0xa8, 0x3b, 0xb0, 0x61, 0xab, 0x1c, 0x1b, 0xcf, 0x29, 0xb5, 0xdb, 0x0d, 0x61, 0x93, 0x05,
-	0x39, 0x45, 0xb1, 0xa2, 0x90, 0xf9, 0x33, 0x1a, 0xa8, 0xdb, 0x84, 0xf6, 0x86, 0xb0, 0xcb, 0xc3,
-	0xbc, 0x91, 0x04, 0xf4, 0xd2, 0xa1, 0xcf, 0xdb, 0x9b, 0xb2, 0x91, 0x48, 0xa9, 0xfb, 0x85, 0x01,
-	0x55, 0xf5, 0xc1, 0x22, 0xeb, 0x03, 0xe3, 0x55, 0x7c, 0xb0, 0x0d, 0x65, 0xcb, 0x25, 0xce, 0x4c,
-	0x37, 0x2f, 0x21, 0x2c, 0xe7, 0x6e, 0x71, 0x55, 0xee, 0xfe, 0x1f, 0xd4, 0xfd, 0x88, 0xcd, 0x7d,
-	0xc7, 0x63, 0x3a, 0xec, 0xeb, 0xe6, 0x89, 0x42, 0x70, 0xa2, 0x43, 0x26, 0xa0, 0x90, 0x06, 0x0e,
-	0x71, 0x9d, 0xef, 0x53, 0x5b, 0xdf, 0xef, 0x8a, 0x48, 0x68, 0xe0, 0x15, 0x9a, 0xee, 0xdf, 0x4b,
-	0xb0, 0xb5, 0xf4, 0x35, 0xe7, 0xdf, 0x78, 0xc9, 0x54, 0x91, 0x28, 0x64, 0x8b, 0x04, 0x3f, 0xb5,
-	0x05, 0xfe, 0xdc, 0x0f, 0xa9, 0xbd, 0xaf, 0x4f, 0x79, 0x29, 0x44, 0x1c, 0x56, 0x93, 0x2f, 0x4d,
-	0xb2, 0x65, 0xa7, 0x10, 0xf4, 0x7e, 0xdc, 0x2f, 0x24, 0xbf, 0xf8, 0x9f, 0xe5, 0xaf, 0x50, 0xf9,
-	0x86, 0xf1, 0x10, 0x6e, 0xc4, 0xf1, 0x1b, 0xe7, 0x94, 0x3c, 0xf7, 0x34, 0xf0, 0x2a, 0x55, 0xe7,
-	0x4f, 0x85, 0x57, 0xad, 0xbd, 0xb7, 0xa1, 0x22, 0xc8, 0x80, 0xbc, 0xb5, 0xc9, 0x6c, 0x8b, 0x52,
-	0xa0, 0x7d, 0x58, 0x97, 0x9f, 0xe1, 0x22, 0x36, 0x8f, 0x98, 0xca, 0xf2, 0x9d, 0x6b, 0x97, 0x6f,
-	0x4a, 0x3b, 0x9c, 0x1e, 0x84, 0xfa, 0xd0, 0x50, 0x9f, 0x04, 0xe5, 0x24, 0xa5, 0x97, 0x9c, 0x24,
-	0x33, 0x0a, 0x7d, 0x02, 0xcd, 0xf8, 0xad, 0xd5, 0x44, 0xe5, 0x97, 0x9c, 0x28, 0x3f, 0xb0, 0xf3,
-	0x08, 0x2a, 0x6a, 0x56, 0x7e, 0xd6, 0x97, 0x27, 0x22, 0x7d, 0xd6, 0x97, 0x67, 0xa1, 0xe4, 0xfc,
-	0x55, 0x48, 0x9f, 0xbf, 0xba, 0x4e, 0x1c, 0x72, 0xa9, 0x6f, 0x7d, 0x5f, 0x3d, 0xe4, 0x3a, 0x50,
-	0xb3, 0x5c, 0x15, 0x56, 0xaa, 0x97, 0x6a, 0xb9, 0xfb, 0x09, 0xd4, 0xf4, 0x76, 0x70, 0x0a, 0x70,
-	0x91, 0x1c, 0xdd, 0xe5, 0x85, 0xd6, 0x36, 0x94, 0x1d, 0xc1, 0xeb, 0xe4, 0x81, 0x5d, 0x0a, 0xc9,
-	0x39, 0x57, 0xb6, 0x47, 0x29, 0x74, 0x7f, 0x56, 0x80, 0x8a, 0xfc, 0xfe, 0xf8, 0x5f, 0x3c, 0x7d,
-	0xa0, 0x01, 0x6c, 0xc9, 0x3b, 0xab, 0x14, 0x9b, 0x56, 0xd1, 0xf0, 0xba, 0xfa, 0x3c, 0x9a, 0x26,
-	0xda, 0x43, 0xef, 0xdc, 0xc7, 0xcb, 0x23, 0x56, 0x5d, 0x0f, 0x74, 0x3e, 0x80, 0x66, 0x6e, 0xa4,
-	0x20, 0xe1, 0x57, 0x8e, 0x1d, 0x93, 0xf0, 0x2b, 0xc7, 0xce, 0xde, 0x02, 0xc4, 0xde, 0xf9, 0x95,
-	0x01, 0x85, 0x61, 0x9f, 0xef, 0xf9, 0x9c, 0xa6, 0x1c, 0xa3, 0x24, 0x8e, 0x5f, 0x10, 0xcf, 0x76,
-	0xf5, 0xe9, 0x5f, 0x49, 0xe8, 0x2d, 0xa8, 0xce, 0xa3, 0xb3, 0x67, 0x74, 0x11, 0xaa, 0xbc, 0x58,
-	0x37, 0x87, 0x7d, 0x73, 0x24, 0x21, 0xac, 0x75, 0xbc, 0x38, 0x9c, 0xc5, 0xfe, 0x10, 0xaf, 0xdb,
-	0xc0, 0x29, 0xa4, 0xf3, 0x21, 0x54, 0xd5, 0x18, 0x1e, 0x0e, 0x8e, 0x4d, 0xe5, 0x35, 0x8c, 0xec,
-	0xc7, 0xb1, 0xcc, 0xf7, 0x4d, 0x0d, 0x52, 0x7d, 0x5d, 0x8b, 0xdd, 0x7f, 0x1a, 0x50, 0x4f, 0xd8,
-	0xe2, 0x03, 0xa8, 0x86, 0x54, 0xba, 0x56, 0xde, 0x43, 0xa0, 0xe4, 0xa3, 0xb2, 0x39, 0x96, 0x1a,
-	0xac, 0x4d, 0x38, 0x33, 0x8c, 0xe9, 0x01, 0x67, 0x4f, 0xa1, 0x9a, 0x3c, 0x87, 0x76, 0x3f, 0x17,
-	0xf7, 0xd9, 0x72, 0xcc, 0x3a, 0x54, 0x8f, 0x86, 0xe3, 0xc9, 0xf0, 0xf8, 0xe3, 0xd6, 0x1a, 0xaa,
-	0x43, 0xf9, 0x04, 0xf7, 0x07, 0xb8, 0x65, 0xa0, 0x9b, 0x80, 0xc4, 0xcf, 0xa7, 0xbd, 0x93, 0xe3,
-	0x83, 0x21, 0x7e, 0xb2, 0x27, 0xbe, 0x99, 0x15, 0xd0, 0x6b, 0xb0, 0x25, 0xf1, 0x83, 0xd3, 0xa3,
-	0x83, 0xe1, 0xd1, 0xd1, 0x93, 0xc1, 0xf1, 0xa4, 0x55, 0x44, 0xdb, 0xd0, 0xd2, 0xe6, 0x4f, 0x46,
-	0x47, 0x03, 0x61, 0x5c, 0xe2, 0x93, 0xf7, 0x87, 0xe3, 0xd1, 0xe9, 0x64, 0xd0, 0x2a, 0xf3, 0x19,
-	0x95, 0xf0, 0x14, 0x0f, 0xc6, 0x27, 0x47, 0xa7, 0xc2, 0xa8, 0x82, 0x00, 0x2a, 0x78, 0x20, 0xbe,
-	0xdc, 0x55, 0xbb, 0x14, 0x36, 0xf8, 0xfb, 0x51, 0x5b, 0x7f, 0x20, 0xef, 0x42, 0x55, 0x9d, 0xef,
-	0x54, 0x2e, 0x26, 0xff, 0x53, 0xa1, 0x15, 0x71, 0x3e, 0x15, 0x52, 0xf9, 0x94, 0xa1, 0x4e, 0xc5,
-	0x1c, 0x75, 0xda, 0x2f, 0x7d, 0xb7, 0x30, 0x3f, 0x3b, 0xab, 0x88, 0x3c, 0xf8, 0xff, 0x7f, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x0e, 0x14, 0xeb, 0x7b, 0x1b, 0x22, 0x00, 0x00,
+	// 3382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0xf7, 0xec, 0xf7, 0xbe, 0x5d, 0x49, 0xab, 0xb6, 0xe2, 0x2c, 0x5b, 0x26, 0xb6, 0xb7, 0x1c,
+	0xe3, 0x38, 0xce, 0xc4, 0x11, 0x1c, 0x5c, 0x84, 0x22, 0x91, 0x76, 0x57, 0xd6, 0xc6, 0xb2, 0x24,
+	0x7a, 0xd7, 0xe1, 0xe3, 0xe2, 0x1a, 0xed, 0xb4, 0x56, 0x83, 0x67, 0x67, 0x26, 0xf3, 0x21, 0x6b,
+	0xe1, 0x02, 0xb7, 0x1c, 0xa8, 0xe2, 0xc0, 0x21, 0x55, 0xc0, 0x5f, 0xc0, 0x99, 0x1b, 0x5c, 0xc2,
+	0x89, 0x0b, 0x05, 0xc5, 0x29, 0x37, 0x28, 0x8a, 0x33, 0x05, 0x67, 0x8a, 0x2a, 0xea, 0xf5, 0xc7,
+	0x7c, 0xed, 0x5a, 0xb2, 0x93, 0xa2, 0xb8, 0xcd, 0xfb, 0xbd, 0xd7, 0x3d, 0xdd, 0xaf, 0xdf, 0x57,
+	0xbf, 0x19, 0x58, 0x9b, 0xb8, 0x4e, 0xe8, 0x1b, 0x93, 0x30, 0xd0, 0x3d, 0xdf, 0x0d, 0xdd, 0x0e,
+	0x99, 0xb8, 0x91, 0x13, 0xfa, 0xf3, 0x89, 0x6b, 0x32, 0x85, 0x5d, 0x9b, 0xba, 0xee, 0xd4, 0x66,
+	0x6f, 0x73, 0xea, 0x28, 0x3a, 0x7e, 0x3b, 0xb4, 0x66, 0x2c, 0x08, 0x8d, 0x99, 0x27, 0x04, 0xba,
+	0xff, 0x29, 0xc1, 0x3a, 0xb5, 0x26, 0x86, 0x6f, 0x5a, 0x86, 0xd3, 0x93, 0x33, 0x92, 0x7b, 0xb0,
+	0x7a, 0xca, 0x1c, 0xd3, 0xf5, 0xf7, 0xac, 0x20, 0xb4, 0x9c, 0x69, 0xd0, 0xd6, 0xae, 0x17, 0x6f,
+	0x37, 0x36, 0x6b, 0xba, 0x04, 0x68, 0x8e, 0x4f, 0x6e, 0x01, 0x1c, 0x45, 0x73, 0xe6, 0x1f, 0xf8,
+	0x26, 0xf3, 0xdb, 0x85, 0xeb, 0xda, 0xed, 0xc6, 0x66, 0x45, 0xe7, 0x14, 0x4d, 0x71, 0xc8, 0x1e,
+	0xbc, 0x2a, 0x46, 0x72, 0xb2, 0xe7, 0x3a, 0xc7, 0x96, 0x3f, 0x33, 0x42, 0xcb, 0x75, 0xda, 0x45,
+	0x3e, 0x88, 0xe8, 0x0b, 0x1c, 0xfa, 0xbc, 0x21, 0x64, 0x08, 0x57, 0x52, 0xac, 0x9d, 0xc8, 0x3e,
+	0xb6, 0x6c, 0x7b, 0xc6, 0x9c, 0xb0, 0x5d, 0xe2, 0xeb, 0x5d, 0xd7, 0xf3, 0x0c, 0xfa, 0x9c, 0x01,
+	0xa4, 0x0f, 0x1b, 0xc9, 0x32, 0x7b, 0xee, 0xcc, 0xb3, 0x19, 0x5f, 0x55, 0x99, 0xaf, 0xaa, 0xa5,
+	0xe7, 0x70, 0xba, 0x54, 0x9a, 0x74, 0xa1, 0x6a, 0x5a, 0x81, 0x17, 0x85, 0xac, 0x5d, 0xe1, 0x03,
+	0x6b, 0x7a, 0x5f, 0xd0, 0x54, 0x31, 0xc8, 0xfb, 0xb0, 0x2e, 0x1f, 0x29, 0x0b, 0x5c, 0x3b, 0xe2,
+	0xaf, 0xa9, 0xca, 0xcd, 0xf7, 0xf3, 0x1c, 0xba, 0x28, 0x9c, 0x9a, 0x61, 0x6b, 0x32, 0x61, 0x5e,
+	0x68, 0x38, 0x13, 0xd6, 0xae, 0x65, 0x67, 0x48, 0x38, 0x74, 0x51, 0x98, 0x5c, 0x83, 0x8a, 0xcf,
+	0x8e, 0x23, 0xc7, 0x6c, 0xd7, 0xf9, 0xb0, 0xaa, 0x4e, 0x39, 0x49, 0x25, 0x4c, 0xee, 0x00, 0x04,
+	0xd6, 0xd4, 0x31, 0xc2, 0xc8, 0x67, 0x41, 0x1b, 0xb8, 0x36, 0x41, 0x1f, 0x29, 0x88, 0xa6, 0xb8,
+	0xe4, 0xeb, 0xb0, 0xe6, 0x19, 0x0e, 0xb3, 0x53, 0xdb, 0x69, 0x48, 0xad, 0x1d, 0x66, 0x71, 0x9a,
+	0x17, 0xec, 0xfe, 0xf1, 0x32, 0x54, 0xa5, 0x11, 0x11, 0x02, 0xa5, 0xc0, 0x8e, 0xa6, 0x6d, 0xed,
+	0xba, 0x76, 0xbb, 0x4e, 0xf9, 0x33, 0xb9, 0x06, 0x35, 0x71, 0x60, 0xc3, 0xbe, 0xb4, 0xaa, 0xa2,
+	0x3e, 0xec, 0xd3, 0x18, 0x24, 0x6f, 0x41, 0x6d, 0xc6, 0x42, 0xc3, 0x34, 0x42, 0x43, 0x5a, 0xd0,
+	0xba, 0x32, 0x52, 0xfd, 0x91, 0x64, 0xd0, 0x58, 0x84, 0xdc, 0x80, 0x92, 0x15, 0xb2, 0x59, 0xbb,
+	0xc4, 0x45, 0x57, 0x62, 0xd1, 0x61, 0xc8, 0x66, 0x94, 0xb3, 0xc8, 0x16, 0xac, 0x05, 0x27, 0x96,
+	0xe7, 0x59, 0xce, 0xf4, 0xc0, 0xc3, 0x45, 0x06, 0xed, 0x32, 0xdf, 0xff, 0xab, 0xb1, 0xf4, 0x28,
+	0xc3, 0xa7, 0x79, 0x79, 0xd2, 0x85, 0x72, 0x68, 0x9c, 0xb1, 0xa0, 0x5d, 0xe1, 0x03, 0x9b, 0xf1,
+	0xc0, 0xb1, 0x71, 0x46, 0x05, 0x8b, 0xbc, 0x01, 0xd5, 0x89, 0x1b, 0x79, 0x38, 0x7d, 0x95, 0x4b,
+	0xad, 0xc5, 0x52, 0x3d, 0x8e, 0x53, 0xc5, 0x27, 0xaf, 0x01, 0xcc, 0x5c, 0x93, 0xf9, 0x46, 0xe8,
+	0xfa, 0x41, 0xbb, 0x76, 0xbd, 0x78, 0xbb, 0x4e, 0x53, 0x08, 0xd1, 0x81, 0x84, 0xcc, 0x9f, 0x05,
+	0x5b, 0x8e, 0xd9, 0x73, 0x1d, 0xd3, 0x12, 0x8b, 0xae, 0x73, 0x35, 0x2e, 0xe1, 0x90, 0x2e, 0x34,
+	0xc5, 0x31, 0x1f, 0xba, 0xb6, 0x35, 0x99, 0xb7, 0x81, 0x4b, 0x66, 0xb0, 0xce, 0x3f, 0x8a, 0x50,
+	0x53, 0xfa, 0x23, 0x6d, 0xa8, 0x9e, 0x32, 0x3f, 0xc0, 0x93, 0xc5, 0xc3, 0x59, 0xa1, 0x8a, 0x24,
+	0xdb, 0xd0, 0x54, 0x71, 0x68, 0x3c, 0xf7, 0x18, 0x3f, 0xa3, 0xd5, 0xcd, 0xd7, 0x16, 0x8e, 0x40,
+	0xef, 0xa5, 0xa4, 0x68, 0x66, 0x0c, 0xb9, 0x07, 0x95, 0x63, 0x17, 0x5d, 0x9a, 0x1f, 0xe0, 0xea,
+	0x66, 0x7b, 0x71, 0xf4, 0x0e, 0xe7, 0x53, 0x29, 0x47, 0x36, 0xa1, 0xc2, 0xce, 0x3c, 0xcb, 0x9f,
+	0xcb, 0x73, 0xec, 0xe8, 0x22, 0xce, 0xe9, 0x2a, 0xce, 0xe9, 0x63, 0x15, 0xe7, 0xa8, 0x94, 0x44,
+	0x25, 0x19, 0xdc, 0x01, 0x98, 0xd9, 0x8b, 0x7c, 0x9f, 0x39, 0x13, 0x8b, 0x89, 0x93, 0xad, 0xd3,
+	0x25, 0x1c, 0x72, 0x1b, 0xd6, 0x3c, 0xdf, 0x9a, 0x58, 0xce, 0x54, 0x82, 0x73, 0xee, 0xd2, 0x75,
+	0x9a, 0x87, 0x49, 0x07, 0x6a, 0xb6, 0xe1, 0x4c, 0x23, 0x63, 0xca, 0xb8, 0x1f, 0xd7, 0x69, 0x4c,
+	0xe3, 0x5b, 0x59, 0x30, 0xf1, 0xdd, 0x67, 0xb8, 0x20, 0x37, 0x0a, 0x77, 0xdd, 0x88, 0x1f, 0x21,
+	0x2a, 0x71, 0x09, 0xa7, 0x7b, 0x08, 0xcd, 0xb4, 0xa6, 0xc8, 0x3a, 0xac, 0x1c, 0xee, 0x7e, 0x77,
+	0x34, 0xec, 0x6d, 0xed, 0x3d, 0x79, 0x70, 0x70, 0xd0, 0x6f, 0x5d, 0x22, 0x2d, 0x68, 0xf6, 0x87,
+	0x0f, 0x86, 0x63, 0x85, 0x68, 0xa4, 0x01, 0xd5, 0xd1, 0x80, 0x7e, 0x38, 0xec, 0x0d, 0x5a, 0x05,
+	0xb2, 0x0a, 0xd0, 0xa3, 0x07, 0xdf, 0xee, 0x3f, 0xd9, 0x79, 0xbc, 0xdf, 0x6f, 0x15, 0xbb, 0xb7,
+	0xa0, 0x22, 0xb4, 0x47, 0xd6, 0xa0, 0xb1, 0x33, 0xfc, 0xce, 0xa0, 0xff, 0xe4, 0x90, 0xa2, 0xe8,
+	0x25, 0x1c, 0xb7, 0xf5, 0xb8, 0x37, 0x1e, 0x1e, 0xec, 0xb7, 0xb4, 0xce, 0x5f, 0x2a, 0x50, 0x42,
+	0x2f, 0x20, 0x1b, 0x50, 0x0e, 0xad, 0xd0, 0x66, 0xd2, 0x0f, 0x05, 0x41, 0xae, 0x43, 0xc3, 0xc4,
+	0xf5, 0x5a, 0xdc, 0xc4, 0xf9, 0x39, 0xd7, 0x69, 0x1a, 0x22, 0xb7, 0x60, 0xd5, 0xf3, 0xdd, 0x09,
+	0x0b, 0x02, 0xcb, 0x99, 0xe2, 0xa6, 0xf8, 0x71, 0xd6, 0x69, 0x0e, 0xc5, 0xf9, 0x51, 0x83, 0x8c,
+	0x9f, 0x5d, 0x89, 0x0a, 0x02, 0x9d, 0xdf, 0x09, 0x8e, 0x9f, 0xf1, 0x78, 0x5b, 0xa3, 0xfc, 0x19,
+	0xb1, 0xd0, 0x98, 0x0a, 0x2f, 0xaa, 0x53, 0xfe, 0x4c, 0xde, 0x84, 0x8a, 0x35, 0x33, 0xa6, 0x4c,
+	0x79, 0xcd, 0xe5, 0x8c, 0x0b, 0xeb, 0x43, 0xe4, 0x51, 0x29, 0x82, 0x8e, 0x33, 0x31, 0x42, 0x36,
+	0x75, 0x7d, 0x8b, 0xc5, 0x8e, 0x93, 0x20, 0xb8, 0x94, 0xa9, 0x6f, 0xcc, 0x84, 0xaf, 0x14, 0xa8,
+	0x20, 0xc8, 0x55, 0xa8, 0x4f, 0x94, 0xb3, 0x48, 0xdf, 0x48, 0x00, 0xa2, 0x43, 0xd5, 0x95, 0x61,
+	0xa1, 0xc1, 0x57, 0xb0, 0x91, 0x5d, 0x81, 0x8c, 0x09, 0x4a, 0x88, 0xbc, 0x0e, 0xa5, 0xe0, 0x69,
+	0x14, 0xb4, 0x9b, 0x32, 0x23, 0x65, 0x84, 0x47, 0x4f, 0x23, 0xca, 0xd9, 0x9d, 0xdf, 0x69, 0x50,
+	0x11, 0x43, 0xb9, 0x2a, 0x8c, 0x99, 0xd2, 0x3f, 0x7f, 0x7e, 0x01, 0xf5, 0xdf, 0x87, 0xda, 0xa9,
+	0xe1, 0x5b, 0x86, 0x13, 0x06, 0xed, 0x22, 0x7f, 0xd7, 0xd5, 0x65, 0x0b, 0xd3, 0x3f, 0x14, 0x42,
+	0x34, 0x96, 0xee, 0xec, 0x42, 0x55, 0x82, 0x4b, 0x5f, 0xfd, 0x06, 0x94, 0xb9, 0x3a, 0x65, 0xfc,
+	0x5d, 0xaa, 0x70, 0x21, 0xd1, 0xf9, 0xb1, 0x06, 0xc5, 0xd1, 0xd3, 0x08, 0x03, 0x8c, 0x9c, 0xbd,
+	0xe7, 0xce, 0x8e, 0x5c, 0x5e, 0x3d, 0xac, 0xd0, 0x0c, 0x86, 0x5a, 0xf6, 0x7c, 0xd7, 0x8c, 0x26,
+	0xa1, 0x0c, 0xed, 0x75, 0x9a, 0x00, 0xc8, 0x0d, 0x22, 0x7f, 0x72, 0x62, 0xf8, 0x53, 0x61, 0x47,
+	0x45, 0x9a, 0x00, 0xe8, 0x71, 0x1f, 0x45, 0x86, 0x13, 0x5a, 0xa1, 0x88, 0x00, 0x45, 0x1a, 0xd3,
+	0x9d, 0x4f, 0x34, 0x28, 0xf3, 0x45, 0xa1, 0xd4, 0xb1, 0x65, 0xb3, 0xd4, 0x86, 0x62, 0x1a, 0x79,
+	0xae, 0x6f, 0x4d, 0x2d, 0xc7, 0xb0, 0xe5, 0xcb, 0x63, 0x1a, 0xad, 0xc2, 0x8e, 0xdf, 0x5b, 0xa7,
+	0x82, 0x20, 0x57, 0xa0, 0x32, 0x63, 0xa6, 0x15, 0x89, 0xdc, 0x51, 0xa7, 0x92, 0x42, 0xe9, 0x60,
+	0x66, 0xd8, 0x36, 0xb7, 0xdc, 0x3a, 0x15, 0x04, 0x37, 0x5d, 0xcb, 0x51, 0x21, 0x83, 0x3f, 0x77,
+	0x7e, 0x52, 0x84, 0xd5, 0x6c, 0xe6, 0x58, 0xaa, 0xef, 0xfb, 0x50, 0x0a, 0x93, 0x50, 0x7a, 0xf3,
+	0x39, 0x49, 0x27, 0x26, 0x79, 0x40, 0xe5, 0x23, 0xc8, 0x2d, 0xa8, 0xfa, 0x6c, 0xca, 0x4d, 0x13,
+	0x2d, 0x60, 0x75, 0xb3, 0xa9, 0xf7, 0x44, 0x4d, 0xd8, 0x73, 0x4d, 0x46, 0x15, 0x93, 0xbc, 0x0b,
+	0xb5, 0x80, 0xf9, 0xa7, 0xd6, 0x84, 0xa9, 0xd4, 0x76, 0xed, 0xb9, 0x6f, 0x11, 0x72, 0x34, 0x1e,
+	0xd0, 0xf9, 0x99, 0x06, 0x55, 0x89, 0x2e, 0x5d, 0x7e, 0xec, 0xde, 0x85, 0xb4, 0x7b, 0xdf, 0x85,
+	0x75, 0x16, 0x84, 0xd6, 0xcc, 0x08, 0x99, 0xd9, 0x67, 0xb6, 0x75, 0xca, 0xfc, 0xb9, 0xd4, 0xef,
+	0x22, 0x83, 0xdc, 0x83, 0xcb, 0x86, 0x29, 0xfc, 0xcd, 0xb0, 0xd1, 0xcc, 0x0e, 0x53, 0x01, 0x63,
+	0x19, 0xab, 0xfb, 0x0e, 0x34, 0xd3, 0x0a, 0xc1, 0x20, 0xb9, 0x77, 0x80, 0x41, 0xf3, 0x70, 0xd8,
+	0x7b, 0xf8, 0xf8, 0xb0, 0x75, 0x29, 0x1f, 0xfd, 0xb4, 0xce, 0x4f, 0x35, 0x28, 0x8e, 0x8d, 0x33,
+	0x4c, 0x6e, 0xa1, 0x71, 0x86, 0xa3, 0xe4, 0x3e, 0x14, 0x49, 0xee, 0x02, 0x84, 0xc6, 0x19, 0x95,
+	0x2a, 0x2d, 0x2c, 0x51, 0x69, 0x8a, 0x8f, 0x2e, 0x1a, 0x1a, 0x67, 0x6a, 0x15, 0x7c, 0x73, 0x35,
+	0x9a, 0x86, 0x30, 0x1c, 0x79, 0xcc, 0x9f, 0x30, 0x27, 0x34, 0xa6, 0x62, 0x37, 0x05, 0x9a, 0x42,
+	0x78, 0x0c, 0x10, 0xb9, 0xff, 0x39, 0x41, 0x78, 0x03, 0x4a, 0x27, 0x46, 0x70, 0x22, 0x2c, 0x76,
+	0xf7, 0x12, 0xe5, 0x14, 0xb9, 0x09, 0x4d, 0xd3, 0x0a, 0x78, 0xf5, 0x8f, 0x8b, 0x12, 0x6a, 0xdd,
+	0xbd, 0x44, 0x33, 0x28, 0xb9, 0x03, 0x6b, 0xf2, 0x55, 0x7d, 0x09, 0x73, 0x8b, 0x2d, 0xec, 0x6a,
+	0x34, 0xcf, 0x20, 0xb7, 0x60, 0x85, 0x1f, 0x5b, 0x2c, 0x89, 0x66, 0x5c, 0xda, 0xd5, 0x68, 0x16,
+	0xde, 0xae, 0x40, 0x09, 0x6f, 0x1b, 0xdb, 0x00, 0x35, 0xf5, 0xae, 0xee, 0xc7, 0x0d, 0x28, 0x8b,
+	0x5a, 0xff, 0x26, 0xac, 0x88, 0x92, 0x62, 0xcb, 0x34, 0x7d, 0x16, 0x04, 0x72, 0x2f, 0x59, 0x10,
+	0x3d, 0x5d, 0x00, 0x3b, 0x4c, 0xd9, 0x4c, 0x02, 0x90, 0x37, 0xa1, 0x16, 0xa4, 0x35, 0x8a, 0x65,
+	0x12, 0x9f, 0x3d, 0x36, 0x54, 0x1a, 0x0b, 0x90, 0x2f, 0x43, 0x95, 0x57, 0xe5, 0xc3, 0x7e, 0xbb,
+	0x94, 0xd4, 0x8a, 0x0a, 0x23, 0xf7, 0xa1, 0x1e, 0x5f, 0x7f, 0xda, 0xe5, 0x0b, 0x0b, 0x87, 0x44,
+	0x98, 0xdc, 0x80, 0xb2, 0x15, 0xb2, 0x99, 0xaa, 0xe7, 0x1a, 0x72, 0x09, 0xbc, 0x68, 0x14, 0x1c,
+	0x72, 0x1b, 0xaa, 0x9e, 0x31, 0xe7, 0x77, 0x0f, 0x51, 0xcb, 0xaf, 0x4a, 0xa1, 0x43, 0x81, 0x52,
+	0xc5, 0x46, 0x2b, 0xf0, 0x0d, 0xf4, 0xb5, 0x87, 0x6c, 0x2e, 0x92, 0x52, 0x93, 0xa6, 0x10, 0xb2,
+	0x09, 0x1b, 0x86, 0x1d, 0x32, 0xdf, 0x31, 0x42, 0x86, 0xb5, 0x80, 0x31, 0x09, 0x87, 0xce, 0xb1,
+	0x2b, 0xeb, 0xb9, 0xa5, 0xbc, 0x74, 0x81, 0x06, 0x99, 0x02, 0xad, 0xf3, 0x67, 0x0d, 0x6a, 0xb1,
+	0x01, 0x5e, 0x81, 0x0a, 0x2a, 0x6b, 0xec, 0xca, 0xa3, 0x90, 0x14, 0x0e, 0x37, 0xe4, 0x19, 0x89,
+	0x60, 0xa8, 0x48, 0xf4, 0xf0, 0x09, 0x46, 0x59, 0xe1, 0xaa, 0xfc, 0x99, 0x47, 0xbc, 0xd0, 0x08,
+	0x99, 0x0c, 0x84, 0x82, 0xe0, 0xc6, 0xed, 0x06, 0xa1, 0x61, 0x73, 0x1b, 0x14, 0xc1, 0x30, 0x85,
+	0x60, 0x70, 0x92, 0x17, 0x54, 0x6e, 0x4d, 0x0b, 0xc1, 0x49, 0x32, 0x31, 0x77, 0xc8, 0x97, 0xef,
+	0xbb, 0x21, 0x4f, 0xf3, 0xbc, 0x38, 0x4d, 0x63, 0x9d, 0xbf, 0x16, 0x64, 0xad, 0x72, 0x1d, 0x1a,
+	0xb6, 0x08, 0x5c, 0xbb, 0xe8, 0x17, 0x62, 0x57, 0x69, 0x28, 0x93, 0x2a, 0x0a, 0x5c, 0x35, 0x31,
+	0x4d, 0xee, 0x26, 0xa9, 0x5c, 0x64, 0x4c, 0x92, 0x3a, 0xd8, 0x85, 0x44, 0xbe, 0x0d, 0xab, 0xd9,
+	0x3a, 0x3f, 0x2e, 0x3e, 0x53, 0x83, 0x72, 0x37, 0x83, 0xdc, 0x08, 0x54, 0xe7, 0x8c, 0xcd, 0x5c,
+	0xa9, 0x1e, 0xfe, 0x8c, 0x7b, 0x10, 0x85, 0x3e, 0xea, 0x41, 0x15, 0x3b, 0x69, 0xa8, 0xb3, 0x79,
+	0x6e, 0x69, 0xb0, 0x01, 0xe5, 0x53, 0xc3, 0x8e, 0x98, 0x3c, 0x3a, 0x41, 0x74, 0xbe, 0xf9, 0x42,
+	0xb9, 0xa6, 0x0d, 0x55, 0x19, 0xd8, 0xd5, 0xc1, 0x4b, 0xb2, 0xf3, 0x69, 0x11, 0xaa, 0xd2, 0x74,
+	0xc9, 0x5b, 0x98, 0xfa, 0xc2, 0x13, 0xd7, 0xe4, 0x63, 0x57, 0x37, 0x5f, 0xc9, 0x9a, 0x36, 0x96,
+	0xe9, 0x27, 0xae, 0x49, 0xa5, 0x10, 0x7a, 0x74, 0x7c, 0x39, 0x51, 0x99, 0x3d, 0x06, 0xd0, 0x06,
+	0x8d, 0x19, 0x0f, 0x2a, 0x45, 0xee, 0xec, 0x92, 0xc2, 0x51, 0x93, 0x13, 0xc3, 0x72, 0x30, 0xa0,
+	0x48, 0xcb, 0x4a, 0x80, 0xb4, 0x85, 0x96, 0xb3, 0x16, 0xca, 0x2f, 0x33, 0x26, 0x63, 0xb3, 0x11,
+	0x2f, 0x85, 0x64, 0xc6, 0xcd, 0x60, 0x28, 0x13, 0x2f, 0xe0, 0x21, 0x9b, 0x73, 0x9b, 0x6a, 0xd2,
+	0x0c, 0x76, 0xe1, 0x25, 0xeb, 0x26, 0xac, 0xa4, 0xe5, 0xb1, 0x66, 0x44, 0xcf, 0xcd, 0x82, 0x58,
+	0xef, 0xc7, 0xc0, 0xf8, 0xc4, 0x67, 0xc1, 0x89, 0x6b, 0x9b, 0xd2, 0x27, 0x97, 0x70, 0xb8, 0x7f,
+	0xb9, 0x96, 0xb8, 0x30, 0xa3, 0x7f, 0xb9, 0x96, 0xd3, 0x7d, 0x00, 0x15, 0xa1, 0x51, 0x72, 0x19,
+	0xd6, 0xb6, 0xfa, 0x7d, 0x3a, 0x18, 0x8d, 0x9e, 0xd0, 0xc1, 0xb7, 0x1e, 0x0f, 0x46, 0xe3, 0xd6,
+	0x25, 0x02, 0x50, 0xe9, 0x0f, 0xe9, 0xa0, 0x37, 0x6e, 0x69, 0x64, 0x05, 0xea, 0x8f, 0x0e, 0xfa,
+	0x03, 0xba, 0x35, 0x1e, 0xf4, 0x5b, 0x05, 0x24, 0xf7, 0x86, 0x0f, 0x76, 0xc7, 0xfb, 0xc3, 0xfd,
+	0x07, 0xad, 0x62, 0xf7, 0xd3, 0x02, 0xac, 0x2f, 0x36, 0x4d, 0xda, 0x50, 0x75, 0x11, 0x1c, 0xf6,
+	0x55, 0xbe, 0x93, 0x64, 0x36, 0x40, 0x16, 0x5e, 0x26, 0x40, 0x62, 0xed, 0x2f, 0x8c, 0x41, 0xc5,
+	0x7a, 0x55, 0xfb, 0x67, 0x50, 0xbc, 0x54, 0xf9, 0xec, 0xa3, 0x88, 0x05, 0x21, 0x33, 0xb7, 0x84,
+	0x15, 0x88, 0xa4, 0x9e, 0x87, 0xc9, 0x37, 0xa0, 0x25, 0x62, 0xe2, 0x28, 0x69, 0x43, 0x88, 0x5a,
+	0xa5, 0xa5, 0xd3, 0x2c, 0x83, 0x2e, 0x48, 0xa6, 0xd6, 0x43, 0xc5, 0xbc, 0xd2, 0x2c, 0x72, 0x28,
+	0xfa, 0x9e, 0x44, 0x78, 0xfc, 0x10, 0xb1, 0x26, 0x0d, 0x75, 0x3f, 0xd6, 0xa0, 0x21, 0xda, 0x58,
+	0xec, 0xfb, 0x6c, 0x12, 0xfe, 0x4f, 0xb4, 0x87, 0x57, 0x04, 0x6b, 0xaa, 0x82, 0xd0, 0xba, 0xbe,
+	0x6d, 0x85, 0x68, 0x08, 0xc9, 0x06, 0x39, 0xbb, 0xfb, 0x59, 0x11, 0xd6, 0x72, 0x5b, 0x27, 0xef,
+	0xa7, 0xda, 0x1f, 0x1a, 0x7f, 0xe7, 0xcd, 0xbc, 0x7a, 0xf4, 0xb1, 0x6f, 0x38, 0x81, 0x31, 0xc1,
+	0xc3, 0x5f, 0xd2, 0x11, 0xc1, 0x4a, 0x5b, 0x89, 0xf2, 0x65, 0x37, 0x69, 0x02, 0x74, 0xfe, 0x5e,
+	0x80, 0xcb, 0x4b, 0xc6, 0xa7, 0x02, 0xef, 0x28, 0x69, 0xd9, 0xa4, 0x21, 0x9e, 0xd7, 0x55, 0x52,
+	0x53, 0xf3, 0xc6, 0xc0, 0x82, 0x47, 0x16, 0x97, 0x78, 0x64, 0x17, 0x9a, 0x72, 0xc2, 0x31, 0x2f,
+	0x85, 0x44, 0x50, 0xc8, 0x60, 0x64, 0x17, 0xea, 0xe1, 0x49, 0x34, 0x3b, 0x72, 0x0c, 0xcb, 0x96,
+	0x39, 0xfd, 0xce, 0x8b, 0x28, 0x40, 0xde, 0x5b, 0x92, 0xc1, 0x9d, 0x1f, 0xaa, 0x6b, 0x83, 0x2a,
+	0xdd, 0xb5, 0xa4, 0x74, 0x4f, 0x8a, 0xfc, 0x42, 0xba, 0xc8, 0x4f, 0xae, 0x04, 0xc5, 0xfc, 0x95,
+	0x40, 0x5c, 0x20, 0x4a, 0xe9, 0x0b, 0x44, 0xfa, 0xca, 0x51, 0xce, 0x5e, 0x39, 0xba, 0x87, 0xd0,
+	0xca, 0x1f, 0x3a, 0x06, 0x24, 0xcb, 0xf1, 0xa2, 0x70, 0xe8, 0x98, 0xec, 0x4c, 0xf6, 0x5d, 0x52,
+	0xc8, 0xf9, 0x07, 0xd7, 0xfd, 0x75, 0x19, 0x5a, 0x0b, 0x4d, 0xce, 0xd8, 0x78, 0xcd, 0xac, 0xf1,
+	0x9a, 0x71, 0xef, 0xad, 0x90, 0xea, 0xbd, 0x65, 0x0c, 0xba, 0xf8, 0x32, 0x06, 0xbd, 0x0f, 0x2d,
+	0xef, 0x64, 0x1e, 0x58, 0x13, 0xc3, 0x8e, 0x8b, 0x7d, 0xd1, 0x91, 0xed, 0x2e, 0x74, 0x64, 0xf5,
+	0xc3, 0x9c, 0x24, 0x5d, 0x18, 0x4b, 0x1e, 0xc2, 0x9a, 0x69, 0x4d, 0xad, 0x30, 0x35, 0x9d, 0x88,
+	0x05, 0x37, 0x16, 0xa7, 0xeb, 0x67, 0x05, 0x69, 0x7e, 0x24, 0xb6, 0x9b, 0x3c, 0x63, 0xee, 0x46,
+	0xa1, 0x6c, 0xd1, 0xb6, 0x97, 0x2c, 0x89, 0xf3, 0xa9, 0x94, 0xc3, 0x06, 0x67, 0x2e, 0xc2, 0xc8,
+	0x1a, 0x6f, 0x31, 0x14, 0xe5, 0x05, 0x79, 0xd6, 0x75, 0x43, 0xd1, 0x9e, 0xc5, 0xac, 0xeb, 0x86,
+	0xac, 0x33, 0x86, 0x56, 0x7e, 0xd3, 0x3c, 0x13, 0x63, 0xbe, 0x66, 0xbe, 0x3a, 0x1a, 0x49, 0x62,
+	0x2c, 0xc3, 0x7e, 0xd0, 0x53, 0xcb, 0x99, 0xee, 0x47, 0xb3, 0x23, 0xa6, 0x72, 0x6a, 0x0e, 0xed,
+	0xbc, 0x07, 0x6b, 0xb9, 0xbd, 0x93, 0x16, 0x14, 0x23, 0xdf, 0x96, 0x13, 0xe2, 0x23, 0x1a, 0xa1,
+	0x67, 0x04, 0xc1, 0x33, 0xd7, 0x37, 0xd5, 0xbd, 0x57, 0xd1, 0x78, 0x7b, 0xaf, 0x88, 0x9d, 0xc7,
+	0x11, 0x49, 0x3b, 0x37, 0x22, 0x61, 0x4e, 0x14, 0x2a, 0xda, 0xca, 0x54, 0x8f, 0x59, 0x90, 0xdc,
+	0x81, 0x96, 0x00, 0x76, 0x18, 0x3b, 0x64, 0xfe, 0xf6, 0x3c, 0x64, 0x32, 0xf7, 0x2f, 0xe0, 0xdd,
+	0xdf, 0x68, 0xb0, 0x96, 0x6f, 0xaa, 0x3f, 0xdf, 0x6a, 0x3f, 0x7f, 0xc8, 0x7d, 0x07, 0x40, 0xbc,
+	0x7b, 0x74, 0x6e, 0xe0, 0x4d, 0x09, 0x91, 0x1b, 0x50, 0x15, 0x87, 0x1b, 0x48, 0x5b, 0xae, 0xca,
+	0xd3, 0xa7, 0x0a, 0xef, 0xfe, 0xa1, 0x04, 0x15, 0x81, 0x91, 0x4d, 0x55, 0xe5, 0xf7, 0x93, 0xd0,
+	0x4c, 0xe4, 0x00, 0x9d, 0xc6, 0x1c, 0x9a, 0x92, 0xba, 0x20, 0x14, 0xff, 0xb3, 0x08, 0x40, 0x33,
+	0xc2, 0x49, 0x7c, 0xd5, 0xf2, 0xf1, 0xf5, 0xc2, 0xbe, 0xb9, 0x0e, 0x75, 0xf1, 0x3c, 0xb2, 0xd4,
+	0xcd, 0x6a, 0xd1, 0x9a, 0x13, 0x91, 0x8b, 0xee, 0x56, 0x57, 0xa1, 0xce, 0x1f, 0xf7, 0xb1, 0xc2,
+	0x14, 0xd1, 0x2d, 0x01, 0xd0, 0xea, 0x38, 0x81, 0xef, 0xaa, 0xf0, 0xa5, 0xc6, 0x74, 0x26, 0x13,
+	0x20, 0x3f, 0x5f, 0x9b, 0xa1, 0x4c, 0xe6, 0x9c, 0x6b, 0x2f, 0x73, 0xce, 0x68, 0x3b, 0xa7, 0xcc,
+	0xc7, 0xd0, 0x5d, 0x17, 0x17, 0x23, 0x49, 0x22, 0xe7, 0xa3, 0xc8, 0xb0, 0xf1, 0x5e, 0x20, 0xaf,
+	0x4c, 0x92, 0xcc, 0xf7, 0xda, 0x1a, 0x9c, 0x9b, 0x86, 0xd0, 0xee, 0x4d, 0xe9, 0x63, 0x23, 0x8f,
+	0x31, 0xb3, 0xdd, 0xe4, 0x32, 0x59, 0x10, 0x8b, 0x9d, 0x49, 0x14, 0x84, 0xee, 0x8c, 0xf9, 0xb2,
+	0x61, 0xd2, 0x5e, 0xe1, 0x72, 0x79, 0x18, 0x13, 0x89, 0xcf, 0x4e, 0x2d, 0xf6, 0xac, 0xbd, 0x2a,
+	0x12, 0x89, 0xa0, 0xba, 0x3f, 0x2a, 0x40, 0x43, 0xda, 0x18, 0xf3, 0xec, 0x39, 0xf9, 0x1a, 0xde,
+	0x95, 0x3d, 0x7b, 0x9e, 0xb2, 0xa9, 0x2b, 0x7a, 0x4a, 0x40, 0xa7, 0x8a, 0x4b, 0x13, 0xc1, 0x0b,
+	0xcc, 0xea, 0x97, 0x1a, 0xd4, 0xe3, 0x61, 0xc9, 0xe5, 0x34, 0x75, 0x9f, 0x4a, 0x21, 0x17, 0xdb,
+	0xd5, 0x06, 0x94, 0xf9, 0x9b, 0x55, 0xf3, 0x8c, 0x13, 0xd9, 0x03, 0x2c, 0xbd, 0xc4, 0x01, 0x76,
+	0x7f, 0x5f, 0x50, 0x45, 0xcf, 0xd6, 0x8c, 0x39, 0xa6, 0xfc, 0x56, 0xb7, 0x62, 0x28, 0x22, 0xa5,
+	0x8a, 0xd7, 0xf4, 0x9c, 0xa0, 0xbe, 0x95, 0x96, 0xa2, 0xd9, 0x41, 0x17, 0xa8, 0xe5, 0x4f, 0x1a,
+	0xac, 0x64, 0x86, 0x9f, 0x13, 0x86, 0xee, 0xc2, 0xba, 0xca, 0xe4, 0xa3, 0xdc, 0x8c, 0x8b, 0x0c,
+	0xfe, 0xed, 0x8d, 0xaf, 0x53, 0xba, 0x5d, 0x1c, 0x46, 0x24, 0x2c, 0x3a, 0x22, 0xfc, 0x13, 0x00,
+	0x33, 0xb9, 0xb2, 0x6a, 0x34, 0x01, 0x3e, 0x7f, 0x17, 0xa3, 0xfb, 0x99, 0x06, 0x55, 0xf9, 0x75,
+	0x30, 0x3b, 0x8b, 0xf6, 0x32, 0x1e, 0xb5, 0x01, 0xe5, 0x89, 0x6d, 0x58, 0x33, 0x55, 0x0a, 0x71,
+	0x62, 0x31, 0x13, 0x14, 0x97, 0x65, 0x82, 0xaf, 0x40, 0xdd, 0x8d, 0x42, 0xcf, 0xb5, 0x9c, 0x50,
+	0x05, 0xd1, 0xba, 0x7e, 0x20, 0x11, 0x9a, 0xf0, 0xf0, 0x1a, 0x15, 0x30, 0xdf, 0x32, 0x6c, 0xeb,
+	0x07, 0xcc, 0x54, 0x1f, 0x44, 0xf8, 0x6e, 0x9b, 0x74, 0x09, 0xa7, 0xfb, 0xaf, 0x12, 0xac, 0x2f,
+	0x7c, 0x3a, 0xfd, 0x02, 0x9b, 0x4c, 0x9d, 0x75, 0x21, 0x7b, 0xd6, 0xd8, 0xe6, 0xf0, 0x5d, 0xcf,
+	0x0d, 0x98, 0xb9, 0xad, 0x8c, 0x3c, 0x85, 0x70, 0x07, 0x8a, 0x57, 0x20, 0x0b, 0xc0, 0x14, 0x42,
+	0xde, 0x89, 0xab, 0x0f, 0x71, 0x76, 0x5f, 0x5a, 0xfc, 0xe4, 0x9b, 0x2f, 0x3f, 0xee, 0xc1, 0xe5,
+	0x38, 0x1a, 0xc6, 0x11, 0x5a, 0x34, 0x0a, 0x9a, 0x74, 0x19, 0xab, 0xf3, 0xb7, 0xc2, 0xcb, 0x66,
+	0xf2, 0x1b, 0x50, 0xe1, 0xa5, 0xa5, 0x68, 0x73, 0x66, 0x8e, 0x45, 0x32, 0xc8, 0x36, 0x34, 0xc4,
+	0x37, 0xef, 0x28, 0xf4, 0xa2, 0x50, 0x1a, 0xef, 0xf5, 0xe7, 0x2e, 0x5f, 0x17, 0x72, 0x34, 0x3d,
+	0x88, 0xf4, 0xa1, 0x29, 0xbf, 0xbf, 0x8b, 0x49, 0x4a, 0x2f, 0x38, 0x49, 0x66, 0x14, 0xf9, 0x00,
+	0xd6, 0xe2, 0x5d, 0xcb, 0x89, 0xca, 0x2f, 0x38, 0x51, 0x7e, 0x60, 0xe7, 0x3e, 0x54, 0xe4, 0xac,
+	0xd8, 0x1c, 0x13, 0x2d, 0x04, 0xd5, 0x1c, 0xe3, 0x54, 0xaa, 0x61, 0x51, 0x48, 0x37, 0x2c, 0xba,
+	0x3f, 0x2f, 0xc0, 0x5a, 0xee, 0xfb, 0xf6, 0x39, 0x31, 0xe2, 0x7d, 0x68, 0xf2, 0x2d, 0x04, 0x0c,
+	0x03, 0x8a, 0x52, 0xf3, 0xd5, 0xfc, 0x17, 0x72, 0x7d, 0x90, 0x08, 0xd1, 0xcc, 0x88, 0xce, 0xaf,
+	0x34, 0x68, 0xa4, 0xb8, 0xd9, 0x36, 0x8b, 0x96, 0x6f, 0xb3, 0xa8, 0x73, 0x2f, 0x9c, 0x7f, 0xee,
+	0x99, 0x20, 0x58, 0xcc, 0x05, 0xc1, 0x2f, 0x10, 0xb6, 0xad, 0xd8, 0x1f, 0x53, 0x7f, 0x1d, 0x7c,
+	0x7e, 0x7f, 0xec, 0x40, 0x6d, 0x62, 0x4b, 0x9f, 0x93, 0x65, 0xab, 0xa2, 0xbb, 0x1f, 0x40, 0x4d,
+	0xd9, 0x2a, 0x56, 0xdb, 0x27, 0x49, 0xe2, 0xe2, 0xcf, 0x18, 0xb0, 0x2c, 0x7e, 0x85, 0x12, 0xed,
+	0x3f, 0x41, 0x24, 0x5d, 0x33, 0x51, 0x89, 0x0a, 0xa2, 0xfb, 0x8b, 0x02, 0x54, 0xc4, 0x9f, 0x10,
+	0xff, 0xc7, 0x8b, 0x3e, 0x19, 0xc0, 0xba, 0xe8, 0x80, 0xa7, 0x2e, 0xae, 0x52, 0xfd, 0xaf, 0xca,
+	0x1f, 0x35, 0xd2, 0x77, 0x5a, 0xec, 0x00, 0xd3, 0xc5, 0x11, 0xcb, 0x9a, 0x8d, 0x9d, 0x77, 0x61,
+	0x2d, 0x37, 0x12, 0xc5, 0xc2, 0x33, 0xcb, 0x8c, 0xef, 0xbb, 0x67, 0x96, 0x99, 0xed, 0x29, 0xc6,
+	0xda, 0xf9, 0xad, 0x06, 0x85, 0x61, 0x1f, 0x1d, 0xc2, 0x63, 0x29, 0xc5, 0x48, 0x0a, 0xf1, 0x13,
+	0xc3, 0x31, 0x6d, 0xd5, 0x4b, 0x94, 0x14, 0x79, 0x1d, 0xaa, 0x5e, 0x74, 0xf4, 0x14, 0x7b, 0x66,
+	0x22, 0x68, 0x34, 0xf4, 0x61, 0x5f, 0x3f, 0x14, 0x10, 0x55, 0x3c, 0x8c, 0x9c, 0x47, 0xb1, 0x3e,
+	0xf8, 0x76, 0x9b, 0x34, 0x85, 0x74, 0xde, 0x83, 0xaa, 0x1c, 0x83, 0xe6, 0x60, 0x99, 0x4c, 0x34,
+	0x75, 0x45, 0xe9, 0x1b, 0xd3, 0x78, 0x6e, 0x72, 0x90, 0x4c, 0xc1, 0x8a, 0xec, 0xfe, 0x5b, 0x83,
+	0x7a, 0x92, 0x86, 0xef, 0x62, 0xeb, 0x53, 0xa8, 0x56, 0x74, 0x35, 0x49, 0xf2, 0x7b, 0x8b, 0x3e,
+	0x12, 0x1c, 0xaa, 0x44, 0xf0, 0x12, 0x16, 0xbb, 0x05, 0x5e, 0x54, 0x02, 0x39, 0x79, 0x0e, 0xed,
+	0x7e, 0xc2, 0xbf, 0x8e, 0x89, 0x31, 0x0d, 0xa8, 0xee, 0x0d, 0x47, 0x63, 0xec, 0xc5, 0x5d, 0x22,
+	0x75, 0x28, 0x1f, 0xd0, 0xfe, 0x80, 0xb6, 0x34, 0x72, 0x05, 0x08, 0x7f, 0x7c, 0xd2, 0x3b, 0xd8,
+	0xdf, 0x19, 0xd2, 0x47, 0x5b, 0xfc, 0x0b, 0x7c, 0x81, 0xbc, 0x02, 0xeb, 0x02, 0xdf, 0x79, 0xbc,
+	0xb7, 0x33, 0xdc, 0xdb, 0x7b, 0x34, 0xd8, 0x1f, 0xb7, 0x8a, 0x64, 0x03, 0x5a, 0x4a, 0xfc, 0xd1,
+	0xe1, 0xde, 0x80, 0x0b, 0x97, 0x70, 0xf2, 0xfe, 0x70, 0x74, 0xf8, 0x78, 0x3c, 0x68, 0x95, 0x71,
+	0x46, 0x49, 0x3c, 0xa1, 0x83, 0xd1, 0xc1, 0xde, 0x63, 0x2e, 0x54, 0xc1, 0x56, 0x21, 0x1d, 0xf0,
+	0xff, 0x00, 0xaa, 0x5d, 0x06, 0x2b, 0xb8, 0x3f, 0x66, 0xaa, 0xdf, 0x6d, 0xba, 0x50, 0x95, 0xad,
+	0x14, 0xe9, 0x8b, 0xc9, 0xdf, 0x5d, 0x8a, 0x11, 0xfb, 0x53, 0x21, 0xe5, 0x4f, 0xe7, 0x86, 0x8c,
+	0xed, 0xd2, 0xf7, 0x0a, 0xde, 0xd1, 0x51, 0x85, 0xfb, 0xc1, 0x57, 0xff, 0x3b, 0x00, 0xdf, 0x53,
+	0xd7, 0x50, 0xa5, 0x26, 0x00, 0x00,
 }
@@ -37,6 +37,8 @@ const (
 	Message_MODERATOR_REMOVE   Message_MessageType = 17
 	Message_STORE              Message_MessageType = 18
 	Message_BLOCK              Message_MessageType = 19
+	Message_PANEL_RESOLUTION   Message_MessageType = 20
+	Message_RATING_AMENDMENT   Message_MessageType = 21
 	Message_ERROR              Message_MessageType = 500
 )
 
@@ -61,6 +63,8 @@ var Message_MessageType_name = map[int32]string{
 	17:  "MODERATOR_REMOVE",
 	18:  "STORE",
 	19:  "BLOCK",
+	20:  "PANEL_RESOLUTION",
+	21:  "RATING_AMENDMENT",
 	500: "ERROR",
 }
 var Message_MessageType_value = map[string]int32{
@@ -84,6 +88,8 @@ var Message_MessageType_value = map[string]int32{
 	"MODERATOR_REMOVE":   17,
 	"STORE":              18,
 	"BLOCK":              19,
+	"PANEL_RESOLUTION":   20,
+	"RATING_AMENDMENT":   21,
 	"ERROR":              500,
 }
 
@@ -355,51 +361,53 @@ func init() {
 func init() { proto.RegisterFile("message.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 726 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xf3, 0x44,
-	0x10, 0xad, 0x13, 0x27, 0x4e, 0xc6, 0x69, 0xba, 0x5d, 0x4a, 0x15, 0x2a, 0x28, 0x91, 0x0f, 0x28,
-	0x5c, 0x5c, 0x29, 0x95, 0x10, 0x57, 0xd7, 0x5e, 0x17, 0x53, 0xc7, 0x8e, 0x36, 0x0e, 0xa8, 0x5c,
-	0x22, 0x27, 0xde, 0x06, 0xd3, 0xc4, 0x36, 0xb1, 0x03, 0x0a, 0x77, 0x7e, 0x02, 0x3f, 0x8f, 0x13,
-	0x7f, 0x81, 0x33, 0x42, 0xbb, 0xb6, 0x49, 0x5b, 0xa4, 0x4a, 0xdf, 0x29, 0x33, 0x6f, 0x5e, 0x66,
-	0xc6, 0x6f, 0xde, 0xc2, 0xe9, 0x96, 0xe5, 0x79, 0xb8, 0x66, 0x7a, 0xb6, 0x4b, 0x8b, 0xf4, 0xea,
-	0x93, 0x75, 0x9a, 0xae, 0x37, 0xec, 0x46, 0x64, 0xcb, 0xfd, 0xd3, 0x4d, 0x98, 0x1c, 0xaa, 0xd2,
-	0xe7, 0x6f, 0x4b, 0x45, 0xbc, 0x65, 0x79, 0x11, 0x6e, 0xb3, 0x92, 0xa0, 0xfd, 0x21, 0x83, 0x32,
-	0x29, 0xbb, 0xe1, 0xaf, 0x40, 0xad, 0x1a, 0x07, 0x87, 0x8c, 0x0d, 0xa4, 0xa1, 0x34, 0xea, 0x8f,
-	0x2f, 0xf4, 0xaa, 0x5c, 0xff, 0xf2, 0x1a, 0x7d, 0x49, 0xc4, 0x3a, 0x28, 0x59, 0x78, 0xd8, 0xa4,
-	0x61, 0x34, 0x68, 0x0c, 0xa5, 0x91, 0x3a, 0xbe, 0xd0, 0xcb, 0xb1, 0x7a, 0x3d, 0x56, 0x37, 0x92,
-	0x03, 0xad, 0x49, 0xf8, 0x53, 0xe8, 0xee, 0xd8, 0xcf, 0x7b, 0x96, 0x17, 0x4e, 0x34, 0x68, 0x0e,
-	0xa5, 0x51, 0x8b, 0x1e, 0x01, 0x7c, 0x0d, 0x10, 0xe7, 0x94, 0xe5, 0x59, 0x9a, 0xe4, 0x6c, 0x20,
-	0x0f, 0xa5, 0x51, 0x87, 0xbe, 0x40, 0xb4, 0xbf, 0x1a, 0xa0, 0xbe, 0x58, 0x05, 0x77, 0x40, 0x9e,
-	0x3a, 0xde, 0x3d, 0x3a, 0xe1, 0x91, 0xf9, 0x8d, 0x11, 0x20, 0x09, 0x03, 0xb4, 0x6d, 0xdf, 0x75,
-	0xfd, 0xef, 0x51, 0x03, 0xf7, 0xa0, 0x33, 0xf7, 0xaa, 0xac, 0x89, 0xbb, 0xd0, 0xf2, 0xa9, 0x45,
-	0x28, 0x92, 0x31, 0x82, 0x9e, 0x08, 0x17, 0x94, 0x7c, 0x4b, 0xcc, 0x00, 0xb5, 0x8e, 0x88, 0x69,
-	0x78, 0x26, 0x71, 0x51, 0x1b, 0x5f, 0x02, 0xae, 0x10, 0xdf, 0xb3, 0x1d, 0x3a, 0x31, 0x02, 0xc7,
-	0xf7, 0x90, 0x82, 0x3f, 0x86, 0xf3, 0x12, 0xb7, 0xe7, 0xae, 0xed, 0xb8, 0xee, 0x84, 0x78, 0x01,
-	0xea, 0xe0, 0x0b, 0x40, 0x35, 0x7d, 0x32, 0x75, 0x89, 0x20, 0x77, 0x79, 0x5b, 0xcb, 0x99, 0x4d,
-	0xe7, 0x01, 0x59, 0xf8, 0x53, 0xe2, 0x21, 0xc0, 0x18, 0xfa, 0x35, 0x32, 0x9f, 0x5a, 0x46, 0x40,
-	0x90, 0x8a, 0xcf, 0xe1, 0xb4, 0xc6, 0x4c, 0xd7, 0x9f, 0x11, 0xd4, 0xe3, 0x9f, 0x41, 0x89, 0x3d,
-	0xf7, 0x2c, 0x74, 0x8a, 0xcf, 0x40, 0xf5, 0x6d, 0xdb, 0x75, 0x3c, 0xb2, 0x30, 0xcc, 0x07, 0xd4,
-	0xe7, 0xfc, 0x1a, 0xa0, 0xc4, 0x35, 0x1e, 0xd1, 0x19, 0x87, 0x26, 0xbe, 0x45, 0xa8, 0x11, 0xf8,
-	0x74, 0x61, 0x58, 0x16, 0x42, 0x7c, 0xa3, 0x23, 0x44, 0xc9, 0xc4, 0xff, 0x8e, 0xa0, 0x73, 0xae,
-	0xc2, 0x2c, 0xf0, 0x29, 0x41, 0x98, 0x87, 0x77, 0xae, 0x6f, 0x3e, 0xa0, 0x8f, 0x30, 0x40, 0x8b,
-	0x50, 0xea, 0x53, 0xf4, 0x77, 0x53, 0x8b, 0xa0, 0x43, 0x92, 0x5f, 0xd8, 0x26, 0xcd, 0x18, 0xd6,
-	0x40, 0xa9, 0xce, 0x2d, 0x3c, 0xa1, 0x8e, 0x3b, 0xb5, 0x17, 0x68, 0x5d, 0xc0, 0x97, 0xd0, 0xce,
-	0xf6, 0xcb, 0x67, 0x76, 0x10, 0x16, 0xe8, 0xd1, 0x2a, 0xe3, 0xb7, 0xce, 0xe3, 0x75, 0x12, 0x16,
-	0xfb, 0x1d, 0x13, 0xb7, 0xee, 0xd1, 0x23, 0xa0, 0xfd, 0x29, 0x81, 0x6c, 0xfe, 0x18, 0x16, 0x9c,
-	0x56, 0x75, 0x72, 0x22, 0x31, 0xa4, 0x4b, 0x8f, 0x00, 0x1e, 0x80, 0x92, 0xef, 0x97, 0x3f, 0xb1,
-	0x55, 0x21, 0xba, 0x77, 0x69, 0x9d, 0xf2, 0x4a, 0xbd, 0x5a, 0xb3, 0xac, 0xd4, 0x0b, 0x7d, 0x0d,
-	0xdd, 0xff, 0xbc, 0x2e, 0x5c, 0xa4, 0x8e, 0xaf, 0xfe, 0x67, 0xcb, 0xa0, 0x66, 0xd0, 0x23, 0x19,
-	0x5f, 0x83, 0xfc, 0xb4, 0x09, 0xd7, 0x83, 0x96, 0xf0, 0x3f, 0xe8, 0x7c, 0x41, 0xdd, 0xde, 0x84,
-	0x6b, 0x2a, 0x70, 0xed, 0x4b, 0x90, 0x79, 0x86, 0x55, 0x50, 0x26, 0x64, 0x36, 0x33, 0xee, 0x09,
-	0x3a, 0xe1, 0xa7, 0x0a, 0x1e, 0x85, 0x0f, 0x25, 0xee, 0x43, 0x4a, 0x0c, 0x0b, 0x35, 0xb4, 0x7f,
-	0x24, 0x80, 0x59, 0xbc, 0x4e, 0x58, 0x64, 0x85, 0x45, 0x88, 0x35, 0xe8, 0xe5, 0x2c, 0x89, 0xd8,
-	0x6e, 0x5a, 0x4a, 0x25, 0x09, 0x3d, 0x5e, 0x61, 0xf8, 0x0b, 0xe8, 0xe7, 0x6c, 0x17, 0x87, 0x9b,
-	0xf8, 0xb7, 0xf2, 0x5f, 0x95, 0xa0, 0x6f, 0xd0, 0xf7, 0x85, 0xbd, 0xfa, 0x5d, 0x02, 0xc5, 0x4c,
-	0xb7, 0xdb, 0x30, 0x89, 0xc4, 0x69, 0x18, 0xdb, 0x39, 0x56, 0x25, 0x6c, 0x95, 0xe1, 0x11, 0xc8,
-	0x05, 0x7f, 0xe7, 0x8d, 0x77, 0xde, 0xb9, 0x60, 0xbc, 0xd6, 0xb2, 0xf9, 0x01, 0x5a, 0x6a, 0x9f,
-	0x81, 0x62, 0xc6, 0x91, 0x1b, 0xe7, 0x05, 0xc6, 0x20, 0xaf, 0xe2, 0x28, 0x1f, 0x48, 0xc3, 0xe6,
-	0xa8, 0x4b, 0x45, 0xac, 0xdd, 0x42, 0xeb, 0x6e, 0x93, 0xae, 0x9e, 0xf9, 0x1d, 0x77, 0xe1, 0xaf,
-	0xe2, 0x73, 0x4b, 0x51, 0xea, 0x14, 0x23, 0x68, 0xae, 0xe2, 0xa8, 0xba, 0x3b, 0x0f, 0xef, 0xe4,
-	0x1f, 0x1a, 0xd9, 0x72, 0xd9, 0x16, 0x83, 0x6f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xc7,
-	0x6a, 0x70, 0x0c, 0x05, 0x00, 0x00,
+	// 754 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x8f, 0xe3, 0x44,
+	0x10, 0x5d, 0xc7, 0x4e, 0x9c, 0x94, 0x33, 0xd9, 0x9e, 0x26, 0xbb, 0x0a, 0x23, 0x58, 0x22, 0x1f,
+	0x50, 0xb8, 0x78, 0xa5, 0xac, 0x84, 0xb8, 0x7a, 0xec, 0xf6, 0x60, 0xd6, 0x1f, 0x51, 0xc7, 0x01,
+	0x2d, 0x97, 0xc8, 0x89, 0x7b, 0x83, 0xd9, 0xc4, 0x36, 0xb1, 0x03, 0x0a, 0x77, 0xce, 0xfc, 0x26,
+	0x7e, 0x08, 0xff, 0x82, 0x33, 0x42, 0xdd, 0xb6, 0xc9, 0xcc, 0x20, 0x8d, 0xc4, 0xad, 0xea, 0xd5,
+	0x73, 0x75, 0xf5, 0xab, 0xe7, 0x86, 0xab, 0x03, 0x2b, 0xcb, 0x78, 0xc7, 0x8c, 0xe2, 0x98, 0x57,
+	0xf9, 0xcd, 0xc7, 0xbb, 0x3c, 0xdf, 0xed, 0xd9, 0x6b, 0x91, 0x6d, 0x4e, 0xef, 0x5f, 0xc7, 0xd9,
+	0xb9, 0x29, 0x7d, 0xf6, 0xb8, 0x54, 0xa5, 0x07, 0x56, 0x56, 0xf1, 0xa1, 0xa8, 0x09, 0xfa, 0x1f,
+	0x0a, 0xa8, 0x7e, 0xdd, 0x0d, 0x7f, 0x09, 0x5a, 0xd3, 0x38, 0x3a, 0x17, 0x6c, 0x22, 0x4d, 0xa5,
+	0xd9, 0x68, 0x3e, 0x36, 0x9a, 0xb2, 0xe1, 0x5f, 0x6a, 0xf4, 0x3e, 0x11, 0x1b, 0xa0, 0x16, 0xf1,
+	0x79, 0x9f, 0xc7, 0xc9, 0xa4, 0x33, 0x95, 0x66, 0xda, 0x7c, 0x6c, 0xd4, 0xc7, 0x1a, 0xed, 0xb1,
+	0x86, 0x99, 0x9d, 0x69, 0x4b, 0xc2, 0x9f, 0xc0, 0xe0, 0xc8, 0x7e, 0x3a, 0xb1, 0xb2, 0x72, 0x93,
+	0x89, 0x3c, 0x95, 0x66, 0x5d, 0x7a, 0x01, 0xf0, 0x2b, 0x80, 0xb4, 0xa4, 0xac, 0x2c, 0xf2, 0xac,
+	0x64, 0x13, 0x65, 0x2a, 0xcd, 0xfa, 0xf4, 0x1e, 0xa2, 0xff, 0x2e, 0x83, 0x76, 0x6f, 0x14, 0xdc,
+	0x07, 0x65, 0xe1, 0x06, 0x77, 0xe8, 0x19, 0x8f, 0xac, 0xaf, 0xcd, 0x08, 0x49, 0x18, 0xa0, 0xe7,
+	0x84, 0x9e, 0x17, 0x7e, 0x87, 0x3a, 0x78, 0x08, 0xfd, 0x55, 0xd0, 0x64, 0x32, 0x1e, 0x40, 0x37,
+	0xa4, 0x36, 0xa1, 0x48, 0xc1, 0x08, 0x86, 0x22, 0x5c, 0x53, 0xf2, 0x0d, 0xb1, 0x22, 0xd4, 0xbd,
+	0x20, 0x96, 0x19, 0x58, 0xc4, 0x43, 0x3d, 0xfc, 0x12, 0x70, 0x83, 0x84, 0x81, 0xe3, 0x52, 0xdf,
+	0x8c, 0xdc, 0x30, 0x40, 0x2a, 0x7e, 0x01, 0xd7, 0x35, 0xee, 0xac, 0x3c, 0xc7, 0xf5, 0x3c, 0x9f,
+	0x04, 0x11, 0xea, 0xe3, 0x31, 0xa0, 0x96, 0xee, 0x2f, 0x3c, 0x22, 0xc8, 0x03, 0xde, 0xd6, 0x76,
+	0x97, 0x8b, 0x55, 0x44, 0xd6, 0xe1, 0x82, 0x04, 0x08, 0x30, 0x86, 0x51, 0x8b, 0xac, 0x16, 0xb6,
+	0x19, 0x11, 0xa4, 0xe1, 0x6b, 0xb8, 0x6a, 0x31, 0xcb, 0x0b, 0x97, 0x04, 0x0d, 0xf9, 0x35, 0x28,
+	0x71, 0x56, 0x81, 0x8d, 0xae, 0xf0, 0x73, 0xd0, 0x42, 0xc7, 0xf1, 0xdc, 0x80, 0xac, 0x4d, 0xeb,
+	0x2d, 0x1a, 0x71, 0x7e, 0x0b, 0x50, 0xe2, 0x99, 0xef, 0xd0, 0x73, 0x0e, 0xf9, 0xa1, 0x4d, 0xa8,
+	0x19, 0x85, 0x74, 0x6d, 0xda, 0x36, 0x42, 0x7c, 0xa2, 0x0b, 0x44, 0x89, 0x1f, 0x7e, 0x4b, 0xd0,
+	0x35, 0x57, 0x61, 0x19, 0x85, 0x94, 0x20, 0xcc, 0xc3, 0x5b, 0x2f, 0xb4, 0xde, 0xa2, 0x8f, 0x38,
+	0x77, 0x61, 0x06, 0xc4, 0x5b, 0x53, 0xb2, 0x0c, 0xbd, 0x95, 0x98, 0x7e, 0xcc, 0x51, 0x6a, 0x46,
+	0x6e, 0x70, 0xb7, 0x36, 0x7d, 0x12, 0xd8, 0xe2, 0xa6, 0x2f, 0x30, 0x40, 0x97, 0x50, 0x1a, 0x52,
+	0xf4, 0x97, 0xac, 0x27, 0xd0, 0x27, 0xd9, 0xcf, 0x6c, 0x9f, 0x17, 0x0c, 0xeb, 0xa0, 0x36, 0xd6,
+	0x10, 0xfe, 0xd1, 0xe6, 0xfd, 0xd6, 0x37, 0xb4, 0x2d, 0xe0, 0x97, 0xd0, 0x2b, 0x4e, 0x9b, 0x0f,
+	0xec, 0x2c, 0xec, 0x32, 0xa4, 0x4d, 0xc6, 0x7d, 0x51, 0xa6, 0xbb, 0x2c, 0xae, 0x4e, 0x47, 0x26,
+	0x7c, 0x31, 0xa4, 0x17, 0x40, 0xff, 0x53, 0x02, 0xc5, 0xfa, 0x21, 0xae, 0x38, 0xad, 0xe9, 0xe4,
+	0x26, 0xe2, 0x90, 0x01, 0xbd, 0x00, 0x78, 0x02, 0x6a, 0x79, 0xda, 0xfc, 0xc8, 0xb6, 0x95, 0xe8,
+	0x3e, 0xa0, 0x6d, 0xca, 0x2b, 0xed, 0x68, 0x72, 0x5d, 0x69, 0x07, 0xfa, 0x0a, 0x06, 0xff, 0xfe,
+	0x17, 0xc2, 0x71, 0xda, 0xfc, 0xe6, 0x3f, 0x16, 0x8e, 0x5a, 0x06, 0xbd, 0x90, 0xf1, 0x2b, 0x50,
+	0xde, 0xef, 0xe3, 0xdd, 0xa4, 0x2b, 0xfe, 0x15, 0x30, 0xf8, 0x80, 0x86, 0xb3, 0x8f, 0x77, 0x54,
+	0xe0, 0xfa, 0x17, 0xa0, 0xf0, 0x0c, 0x6b, 0xa0, 0xfa, 0x64, 0xb9, 0x34, 0xef, 0x08, 0x7a, 0xc6,
+	0xd7, 0x1a, 0xbd, 0x13, 0x9e, 0x95, 0xb8, 0x67, 0x29, 0x31, 0x6d, 0xd4, 0xd1, 0xff, 0x96, 0x00,
+	0x96, 0xe9, 0x2e, 0x63, 0x89, 0x1d, 0x57, 0x31, 0xd6, 0x61, 0x58, 0xb2, 0x2c, 0x61, 0xc7, 0x45,
+	0x2d, 0x95, 0x24, 0xf4, 0x78, 0x80, 0xe1, 0xcf, 0x61, 0x54, 0xb2, 0x63, 0x1a, 0xef, 0xd3, 0x5f,
+	0xeb, 0xaf, 0x1a, 0x41, 0x1f, 0xa1, 0x4f, 0x0b, 0x7b, 0xf3, 0x9b, 0x04, 0xaa, 0x95, 0x1f, 0x0e,
+	0x71, 0x96, 0x88, 0xd5, 0x30, 0x76, 0x74, 0xed, 0x46, 0xd8, 0x26, 0xc3, 0x33, 0x50, 0x2a, 0xfe,
+	0x26, 0x74, 0x9e, 0x78, 0x13, 0x04, 0xe3, 0xa1, 0x96, 0xf2, 0xff, 0xd0, 0x52, 0xff, 0x14, 0x54,
+	0x2b, 0x4d, 0xbc, 0xb4, 0xac, 0x30, 0x06, 0x65, 0x9b, 0x26, 0xe5, 0x44, 0x9a, 0xca, 0xb3, 0x01,
+	0x15, 0xb1, 0xfe, 0x06, 0xba, 0xb7, 0xfb, 0x7c, 0xfb, 0x81, 0xef, 0xf1, 0x18, 0xff, 0x22, 0xae,
+	0x5b, 0x8b, 0xd2, 0xa6, 0x18, 0x81, 0xbc, 0x4d, 0x93, 0x66, 0xef, 0x3c, 0xbc, 0x55, 0xbe, 0xef,
+	0x14, 0x9b, 0x4d, 0x4f, 0x1c, 0xfc, 0xe6, 0x9f, 0x01, 0x00, 0x80, 0xf8, 0x1a, 0x4e, 0x38, 0x05,
+	0x00, 0x00,
 }
@@ -14,23 +14,25 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 type Profile struct {
-	PeerID           string                     `protobuf:"bytes,1,opt,name=peerID" json:"peerID,omitempty"`
-	Handle           string                     `protobuf:"bytes,2,opt,name=handle" json:"handle,omitempty"`
-	Name             string                     `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
-	Location         string                     `protobuf:"bytes,4,opt,name=location" json:"location,omitempty"`
-	About            string                     `protobuf:"bytes,5,opt,name=about" json:"about,omitempty"`
-	ShortDescription string                     `protobuf:"bytes,6,opt,name=shortDescription" json:"shortDescription,omitempty"`
-	Nsfw             bool                       `protobuf:"varint,7,opt,name=nsfw" json:"nsfw,omitempty"`
-	Vendor           bool                       `protobuf:"varint,8,opt,name=vendor" json:"vendor,omitempty"`
-	Moderator        bool                       `protobuf:"varint,9,opt,name=moderator" json:"moderator,omitempty"`
-	ModeratorInfo    *Moderator                 `protobuf:"bytes,10,opt,name=moderatorInfo" json:"moderatorInfo,omitempty"`
-	ContactInfo      *Profile_Contact           `protobuf:"bytes,11,opt,name=contactInfo" json:"contactInfo,omitempty"`
-	Colors           *Profile_Colors            `protobuf:"bytes,12,opt,name=colors" json:"colors,omitempty"`
-	AvatarHashes     *Profile_Image             `protobuf:"bytes,13,opt,name=avatarHashes" json:"avatarHashes,omitempty"`
-	HeaderHashes     *Profile_Image             `protobuf:"bytes,14,opt,name=headerHashes" json:"headerHashes,omitempty"`
-	Stats            *Profile_Stats             `protobuf:"bytes,15,opt,name=stats" json:"stats,omitempty"`
-	BitcoinPubkey    string                     `protobuf:"bytes,16,opt,name=bitcoinPubkey" json:"bitcoinPubkey,omitempty"`
-	LastModified     *google_protobuf.Timestamp `protobuf:"bytes,17,opt,name=lastModified" json:"lastModified,omitempty"`
+	PeerID           string           `protobuf:"bytes,1,opt,name=peerID" json:"peerID,omitempty"`
+	Handle           string           `protobuf:"bytes,2,opt,name=handle" json:"handle,omitempty"`
+	Name             string           `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Location         string           `protobuf:"bytes,4,opt,name=location" json:"location,omitempty"`
+	About            string           `protobuf:"bytes,5,opt,name=about" json:"about,omitempty"`
+	ShortDescription string           `protobuf:"bytes,6,opt,name=shortDescription" json:"shortDescription,omitempty"`
+	Nsfw             bool             `protobuf:"varint,7,opt,name=nsfw" json:"nsfw,omitempty"`
+	Vendor           bool             `protobuf:"varint,8,opt,name=vendor" json:"vendor,omitempty"`
+	Moderator        bool             `protobuf:"varint,9,opt,name=moderator" json:"moderator,omitempty"`
+	ModeratorInfo    *Moderator       `protobuf:"bytes,10,opt,name=moderatorInfo" json:"moderatorInfo,omitempty"`
+	ContactInfo      *Profile_Contact `protobuf:"bytes,11,opt,name=contactInfo" json:"contactInfo,omitempty"`
+	Colors           *Profile_Colors  `protobuf:"bytes,12,opt,name=colors" json:"colors,omitempty"`
+	// BCP 47 tag of the language notifications are sent in
+	Language      string                     `protobuf:"bytes,18,opt,name=language" json:"language,omitempty"`
+	AvatarHashes  *Profile_Image             `protobuf:"bytes,13,opt,name=avatarHashes" json:"avatarHashes,omitempty"`
+	HeaderHashes  *Profile_Image             `protobuf:"bytes,14,opt,name=headerHashes" json:"headerHashes,omitempty"`
+	Stats         *Profile_Stats             `protobuf:"bytes,15,opt,name=stats" json:"stats,omitempty"`
+	BitcoinPubkey string                     `protobuf:"bytes,16,opt,name=bitcoinPubkey" json:"bitcoinPubkey,omitempty"`
+	LastModified  *google_protobuf.Timestamp `protobuf:"bytes,17,opt,name=lastModified" json:"lastModified,omitempty"`
 }
 
 func (m *Profile) Reset()                    { *m = Profile{} }
@@ -122,6 +124,13 @@ func (m *Profile) GetColors() *Profile_Colors {
 	return nil
 }
 
+func (m *Profile) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
 func (m *Profile) GetAvatarHashes() *Profile_Image {
 	if m != nil {
 		return m.AvatarHashes
@@ -393,48 +402,49 @@ func init() {
 func init() { proto.RegisterFile("profile.proto", fileDescriptor7) }
 
 var fileDescriptor7 = []byte{
-	// 687 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0x4d, 0x6e, 0xdb, 0x3a,
-	0x14, 0x85, 0x61, 0xc7, 0x3f, 0x09, 0x6d, 0x27, 0x79, 0xc4, 0x43, 0x40, 0x08, 0x0f, 0x78, 0x46,
-	0x10, 0xb4, 0x46, 0x07, 0x4a, 0xe1, 0xce, 0x0b, 0xb4, 0xc9, 0xa0, 0x19, 0xa4, 0x08, 0x94, 0x74,
-	0xd2, 0x19, 0x25, 0xd1, 0x12, 0x51, 0x8a, 0x14, 0x48, 0x2a, 0xa9, 0xd1, 0x25, 0x74, 0x03, 0xdd,
-	0x59, 0x87, 0xdd, 0x4a, 0xc1, 0x4b, 0x4a, 0xb6, 0xd2, 0xce, 0x78, 0xbe, 0x7b, 0x2e, 0x7d, 0x49,
-	0x1d, 0x1a, 0x2d, 0x6a, 0xad, 0x36, 0x5c, 0xb0, 0xb8, 0xd6, 0xca, 0xaa, 0xe8, 0xff, 0x42, 0xa9,
-	0x42, 0xb0, 0x4b, 0x50, 0x69, 0xb3, 0xb9, 0xb4, 0xbc, 0x62, 0xc6, 0xd2, 0xaa, 0x0e, 0x86, 0x93,
-	0x4a, 0xe5, 0x4c, 0x53, 0xab, 0xb4, 0x07, 0xe7, 0x3f, 0x11, 0x9a, 0xde, 0xf9, 0x3d, 0xf0, 0x19,
-	0x9a, 0xd4, 0x8c, 0xe9, 0x9b, 0x6b, 0x32, 0x58, 0x0e, 0x56, 0x47, 0x49, 0x50, 0x8e, 0x97, 0x54,
-	0xe6, 0x82, 0x91, 0xa1, 0xe7, 0x5e, 0x61, 0x8c, 0x46, 0x92, 0x56, 0x8c, 0x1c, 0x00, 0x85, 0x35,
-	0x8e, 0xd0, 0xa1, 0x50, 0x19, 0xb5, 0x5c, 0x49, 0x32, 0x02, 0xde, 0x69, 0xfc, 0x2f, 0x1a, 0xd3,
-	0x54, 0x35, 0x96, 0x8c, 0xa1, 0xe0, 0x05, 0x7e, 0x85, 0x4e, 0x4d, 0xa9, 0xb4, 0xbd, 0x66, 0x26,
-	0xd3, 0xbc, 0x86, 0xce, 0x09, 0x18, 0xfe, 0xe0, 0xf0, 0x8b, 0x66, 0xf3, 0x44, 0xa6, 0xcb, 0xc1,
-	0xea, 0x30, 0x81, 0xb5, 0x9b, 0xee, 0x91, 0xc9, 0x5c, 0x69, 0x72, 0x08, 0x34, 0x28, 0xfc, 0x1f,
-	0x3a, 0xea, 0x0e, 0x4b, 0x8e, 0xa0, 0xb4, 0x03, 0xf8, 0x35, 0x5a, 0x74, 0xe2, 0x46, 0x6e, 0x14,
-	0x41, 0xcb, 0xc1, 0x6a, 0xb6, 0x46, 0xf1, 0x6d, 0x4b, 0x93, 0xbe, 0x01, 0xaf, 0xd1, 0x2c, 0x53,
-	0xd2, 0xd2, 0xcc, 0x82, 0x7f, 0x06, 0xfe, 0xd3, 0x38, 0x5c, 0x5e, 0x7c, 0xe5, 0x6b, 0xc9, 0xbe,
-	0x09, 0xbf, 0x44, 0x93, 0x4c, 0x09, 0xa5, 0x0d, 0x99, 0x83, 0xfd, 0x64, 0xcf, 0xee, 0x70, 0x12,
-	0xca, 0x78, 0x8d, 0xe6, 0xf4, 0x91, 0x5a, 0xaa, 0x3f, 0x50, 0x53, 0x32, 0x43, 0x16, 0x60, 0x3f,
-	0xee, 0xec, 0x37, 0x15, 0x2d, 0x58, 0xd2, 0xf3, 0xb8, 0x9e, 0x92, 0xd1, 0x9c, 0xb5, 0x3d, 0xc7,
-	0x7f, 0xef, 0xd9, 0xf7, 0xe0, 0x0b, 0x34, 0x36, 0x96, 0x5a, 0x43, 0x4e, 0x9e, 0x99, 0xef, 0x1d,
-	0x4d, 0x7c, 0x11, 0x5f, 0xa0, 0x45, 0xca, 0x6d, 0xa6, 0xb8, 0xbc, 0x6b, 0xd2, 0x2f, 0x6c, 0x4b,
-	0x4e, 0xe1, 0x7b, 0xf4, 0x21, 0x7e, 0x8b, 0xe6, 0x82, 0x1a, 0x7b, 0xab, 0x72, 0xbe, 0xe1, 0x2c,
-	0x27, 0xff, 0xc0, 0x96, 0x51, 0xec, 0x33, 0x18, 0xb7, 0x19, 0x8c, 0x1f, 0xda, 0x0c, 0x26, 0x3d,
-	0x7f, 0xf4, 0x7d, 0x80, 0xa6, 0xe1, 0xd6, 0x30, 0x41, 0xd3, 0x27, 0x96, 0x1a, 0x6e, 0x59, 0xc8,
-	0x5e, 0x2b, 0x5d, 0x68, 0x58, 0x45, 0xb9, 0x08, 0xd9, 0xf3, 0x02, 0x2f, 0xd1, 0xac, 0x2e, 0x95,
-	0x64, 0x1f, 0x9b, 0x2a, 0x65, 0x3a, 0x24, 0x70, 0x1f, 0xe1, 0x18, 0x4d, 0x8c, 0xca, 0x38, 0x15,
-	0x64, 0xb4, 0x3c, 0x58, 0xcd, 0xd6, 0x67, 0xbb, 0xa3, 0x02, 0x7e, 0x97, 0x65, 0xaa, 0x91, 0x36,
-	0x09, 0xae, 0xe8, 0x13, 0x5a, 0xf4, 0x0a, 0x2e, 0x6b, 0x76, 0x5b, 0xb7, 0xf3, 0xc0, 0xda, 0xa5,
-	0xbb, 0x31, 0x4c, 0x43, 0xea, 0xfd, 0x3c, 0x9d, 0x76, 0x83, 0xd6, 0x5a, 0xa9, 0x4d, 0x18, 0xc6,
-	0x8b, 0xe8, 0x1b, 0x1a, 0xc3, 0x77, 0x80, 0xed, 0xb8, 0xdc, 0x76, 0xdb, 0x71, 0xb9, 0x75, 0x2d,
-	0xa6, 0xa2, 0xa2, 0x3b, 0x1b, 0x08, 0x17, 0xe8, 0x8a, 0xe5, 0xbc, 0xa9, 0xc2, 0x4e, 0x41, 0x39,
-	0xb7, 0xa0, 0xba, 0x60, 0xe1, 0x5d, 0x79, 0xe1, 0x46, 0x52, 0x9a, 0x17, 0x5c, 0x52, 0x11, 0xde,
-	0x55, 0xa7, 0xa3, 0x1f, 0x03, 0x34, 0xf1, 0x41, 0x73, 0x17, 0x5c, 0x6b, 0x5e, 0x51, 0xdd, 0x4e,
-	0xd0, 0x4a, 0xf7, 0x4e, 0x0c, 0xcb, 0x94, 0xcc, 0x5d, 0xcd, 0x0f, 0xb2, 0x03, 0x30, 0x36, 0xfb,
-	0x6a, 0xdb, 0x37, 0xee, 0xd6, 0xae, 0xa3, 0xe4, 0x45, 0x29, 0x78, 0x51, 0xda, 0x30, 0xcc, 0x0e,
-	0xb8, 0xf0, 0x74, 0xe2, 0xc1, 0xb5, 0xfa, 0xa9, 0xfa, 0x30, 0xfa, 0x35, 0x40, 0xe3, 0xfb, 0x36,
-	0x6c, 0x1b, 0x25, 0x84, 0x7a, 0x62, 0xfa, 0xca, 0x5d, 0x3c, 0xcc, 0xb7, 0x48, 0xfa, 0x10, 0xbf,
-	0x40, 0xc7, 0x1e, 0x70, 0x59, 0x78, 0xdb, 0x10, 0x6c, 0xcf, 0x28, 0x3e, 0x47, 0x73, 0xc1, 0x8d,
-	0xed, 0x5c, 0x07, 0xe0, 0xea, 0x31, 0x17, 0x1e, 0x4d, 0x77, 0x96, 0x11, 0x58, 0xf6, 0x91, 0x3b,
-	0x61, 0xad, 0x8c, 0xf5, 0xf5, 0x31, 0xd4, 0x77, 0xc0, 0x4d, 0x4c, 0x1f, 0x99, 0x76, 0xaf, 0x0b,
-	0x7a, 0xe0, 0xef, 0x6a, 0x98, 0xf4, 0xe1, 0xfb, 0xd1, 0xe7, 0x61, 0x9d, 0xa6, 0x13, 0x78, 0x06,
-	0x6f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xb9, 0x0d, 0xd3, 0xa9, 0x05, 0x00, 0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4d, 0x6e, 0xdb, 0x3c,
+	0x10, 0x85, 0x1d, 0xff, 0x24, 0xb4, 0x9d, 0xe4, 0x23, 0x3e, 0x04, 0x84, 0xf0, 0x01, 0x9f, 0x11,
+	0x04, 0xad, 0xd1, 0x85, 0x52, 0xb8, 0xfb, 0x02, 0x6d, 0xb2, 0x68, 0x16, 0x29, 0x02, 0x25, 0xdd,
+	0x74, 0x47, 0x49, 0xb4, 0x44, 0x94, 0x22, 0x05, 0x92, 0x4a, 0x6a, 0xf4, 0x08, 0xbd, 0x40, 0x6f,
+	0xd7, 0x43, 0xf4, 0x02, 0x05, 0x87, 0x94, 0x6c, 0xa5, 0xdd, 0xf1, 0xbd, 0x79, 0x33, 0x7e, 0xa4,
+	0xde, 0x18, 0x2d, 0x6a, 0xad, 0x36, 0x5c, 0xb0, 0xb8, 0xd6, 0xca, 0xaa, 0xe8, 0xff, 0x42, 0xa9,
+	0x42, 0xb0, 0x4b, 0x40, 0x69, 0xb3, 0xb9, 0xb4, 0xbc, 0x62, 0xc6, 0xd2, 0xaa, 0x0e, 0x82, 0x93,
+	0x4a, 0xe5, 0x4c, 0x53, 0xab, 0xb4, 0x27, 0xce, 0x7f, 0x21, 0x34, 0xbd, 0xf3, 0x33, 0xf0, 0x19,
+	0x9a, 0xd4, 0x8c, 0xe9, 0x9b, 0x6b, 0x32, 0x58, 0x0e, 0x56, 0x47, 0x49, 0x40, 0x8e, 0x2f, 0xa9,
+	0xcc, 0x05, 0x23, 0x43, 0xcf, 0x7b, 0x84, 0x31, 0x1a, 0x49, 0x5a, 0x31, 0x72, 0x00, 0x2c, 0x9c,
+	0x71, 0x84, 0x0e, 0x85, 0xca, 0xa8, 0xe5, 0x4a, 0x92, 0x11, 0xf0, 0x1d, 0xc6, 0xff, 0xa2, 0x31,
+	0x4d, 0x55, 0x63, 0xc9, 0x18, 0x0a, 0x1e, 0xe0, 0x57, 0xe8, 0xd4, 0x94, 0x4a, 0xdb, 0x6b, 0x66,
+	0x32, 0xcd, 0x6b, 0xe8, 0x9c, 0x80, 0xe0, 0x0f, 0x1e, 0x7e, 0xd1, 0x6c, 0x9e, 0xc8, 0x74, 0x39,
+	0x58, 0x1d, 0x26, 0x70, 0x76, 0xee, 0x1e, 0x99, 0xcc, 0x95, 0x26, 0x87, 0xc0, 0x06, 0x84, 0xff,
+	0x43, 0x47, 0xdd, 0x65, 0xc9, 0x11, 0x94, 0x76, 0x04, 0x7e, 0x8d, 0x16, 0x1d, 0xb8, 0x91, 0x1b,
+	0x45, 0xd0, 0x72, 0xb0, 0x9a, 0xad, 0x51, 0x7c, 0xdb, 0xb2, 0x49, 0x5f, 0x80, 0xd7, 0x68, 0x96,
+	0x29, 0x69, 0x69, 0x66, 0x41, 0x3f, 0x03, 0xfd, 0x69, 0x1c, 0x1e, 0x2f, 0xbe, 0xf2, 0xb5, 0x64,
+	0x5f, 0x84, 0x5f, 0xa2, 0x49, 0xa6, 0x84, 0xd2, 0x86, 0xcc, 0x41, 0x7e, 0xb2, 0x27, 0x77, 0x74,
+	0x12, 0xca, 0xf0, 0x6c, 0x54, 0x16, 0x0d, 0x2d, 0x18, 0xc1, 0xe1, 0xd9, 0x02, 0xc6, 0x6b, 0x34,
+	0xa7, 0x8f, 0xd4, 0x52, 0xfd, 0x81, 0x9a, 0x92, 0x19, 0xb2, 0x80, 0x51, 0xc7, 0xdd, 0xa8, 0x9b,
+	0x8a, 0x16, 0x2c, 0xe9, 0x69, 0x5c, 0x4f, 0xc9, 0x68, 0xce, 0xda, 0x9e, 0xe3, 0xbf, 0xf7, 0xec,
+	0x6b, 0xf0, 0x05, 0x1a, 0x1b, 0x4b, 0xad, 0x21, 0x27, 0xcf, 0xc4, 0xf7, 0x8e, 0x4d, 0x7c, 0x11,
+	0x5f, 0xa0, 0x45, 0xca, 0x6d, 0xa6, 0xb8, 0xbc, 0x6b, 0xd2, 0x2f, 0x6c, 0x4b, 0x4e, 0xc1, 0x6e,
+	0x9f, 0xc4, 0x6f, 0xd1, 0x5c, 0x50, 0x63, 0x6f, 0x55, 0xce, 0x37, 0x9c, 0xe5, 0xe4, 0x1f, 0x18,
+	0x19, 0xc5, 0x3e, 0x9f, 0x71, 0x9b, 0xcf, 0xf8, 0xa1, 0xcd, 0x67, 0xd2, 0xd3, 0x47, 0xdf, 0x07,
+	0x68, 0x1a, 0x5e, 0x14, 0x13, 0x34, 0x7d, 0x62, 0xa9, 0xe1, 0x96, 0x85, 0x5c, 0xb6, 0xd0, 0x05,
+	0x8a, 0x55, 0x94, 0x8b, 0x90, 0x4b, 0x0f, 0xf0, 0x12, 0xcd, 0xea, 0x52, 0x49, 0xf6, 0xb1, 0xa9,
+	0x52, 0xa6, 0x43, 0x3a, 0xf7, 0x29, 0x1c, 0xa3, 0x89, 0x51, 0x19, 0xa7, 0x82, 0x8c, 0x96, 0x07,
+	0xab, 0xd9, 0xfa, 0x6c, 0x77, 0x55, 0xa0, 0xdf, 0x65, 0x99, 0x6a, 0xa4, 0x4d, 0x82, 0x2a, 0xfa,
+	0x84, 0x16, 0xbd, 0x82, 0xcb, 0xa1, 0xdd, 0xd6, 0xad, 0x1f, 0x38, 0xbb, 0x4f, 0xd8, 0x18, 0xa6,
+	0x61, 0x23, 0xbc, 0x9f, 0x0e, 0x3b, 0xa3, 0xb5, 0x56, 0x6a, 0x13, 0xcc, 0x78, 0x10, 0x7d, 0x43,
+	0x63, 0xf8, 0x0e, 0x30, 0x8e, 0xcb, 0x6d, 0x37, 0x8e, 0xcb, 0xad, 0x6b, 0x31, 0x15, 0x15, 0xdd,
+	0xdd, 0x00, 0xb8, 0xb0, 0x57, 0x2c, 0xe7, 0x4d, 0x15, 0x26, 0x05, 0xe4, 0xd4, 0x82, 0xea, 0x82,
+	0x85, 0x9d, 0xf3, 0xc0, 0x59, 0x52, 0x9a, 0x17, 0x5c, 0x52, 0x11, 0x76, 0xae, 0xc3, 0xd1, 0x8f,
+	0x01, 0x9a, 0xf8, 0x10, 0xba, 0x07, 0xae, 0x35, 0xaf, 0xa8, 0x6e, 0x1d, 0xb4, 0xd0, 0xed, 0x90,
+	0x61, 0x99, 0x92, 0xb9, 0xab, 0x79, 0x23, 0x3b, 0x02, 0x6c, 0xb3, 0xaf, 0xb6, 0xdd, 0x7f, 0x77,
+	0x76, 0x1d, 0x25, 0x2f, 0x4a, 0xc1, 0x8b, 0xd2, 0x06, 0x33, 0x3b, 0xc2, 0x85, 0xa7, 0x03, 0x0f,
+	0xae, 0xd5, 0xbb, 0xea, 0x93, 0xd1, 0xcf, 0x01, 0x1a, 0xdf, 0xb7, 0x61, 0xdb, 0x28, 0x21, 0xd4,
+	0x13, 0xd3, 0x57, 0xee, 0xe1, 0xc1, 0xdf, 0x22, 0xe9, 0x93, 0xf8, 0x05, 0x3a, 0xf6, 0x04, 0x97,
+	0x85, 0x97, 0x0d, 0x41, 0xf6, 0x8c, 0xc5, 0xe7, 0x68, 0x2e, 0xb8, 0xb1, 0x9d, 0xea, 0x00, 0x54,
+	0x3d, 0xce, 0x85, 0x47, 0xd3, 0x9d, 0x64, 0x04, 0x92, 0x7d, 0xca, 0xdd, 0xb0, 0x56, 0xc6, 0xfa,
+	0xfa, 0x18, 0xea, 0x3b, 0xc2, 0x39, 0xa6, 0x8f, 0x4c, 0xbb, 0xed, 0x82, 0x1e, 0xf8, 0x2b, 0x1b,
+	0x26, 0x7d, 0xf2, 0xfd, 0xe8, 0xf3, 0xb0, 0x4e, 0xd3, 0x09, 0xac, 0xc1, 0x9b, 0xdf, 0x03, 0x00,
+	0x4b, 0xa0, 0x8a, 0xc2, 0xc5, 0x05, 0x00, 0x00,
 }
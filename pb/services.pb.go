@@ -0,0 +1,2501 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: services.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type Empty struct {
+}
+
+func (m *Empty) Reset()                    { *m = Empty{} }
+func (m *Empty) String() string            { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()               {}
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
+
+type ListingsRequest struct {
+	PeerID       string                     `protobuf:"bytes,1,opt,name=peerID" json:"peerID,omitempty"`
+	Tags         []string                   `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+	Categories   []string                   `protobuf:"bytes,3,rep,name=categories" json:"categories,omitempty"`
+	ContractType string                     `protobuf:"bytes,4,opt,name=contractType" json:"contractType,omitempty"`
+	Currency     string                     `protobuf:"bytes,5,opt,name=currency" json:"currency,omitempty"`
+	MinPrice     uint64                     `protobuf:"varint,6,opt,name=minPrice" json:"minPrice,omitempty"`
+	MaxPrice     uint64                     `protobuf:"varint,7,opt,name=maxPrice" json:"maxPrice,omitempty"`
+	From         *google_protobuf.Timestamp `protobuf:"bytes,8,opt,name=from" json:"from,omitempty"`
+	To           *google_protobuf.Timestamp `protobuf:"bytes,9,opt,name=to" json:"to,omitempty"`
+	SortBy       string                     `protobuf:"bytes,10,opt,name=sortBy" json:"sortBy,omitempty"`
+	OffsetId     string                     `protobuf:"bytes,11,opt,name=offsetId" json:"offsetId,omitempty"`
+	Limit        int32                      `protobuf:"varint,12,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ListingsRequest) Reset()                    { *m = ListingsRequest{} }
+func (m *ListingsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListingsRequest) ProtoMessage()               {}
+func (*ListingsRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{1} }
+
+func (m *ListingsRequest) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *ListingsRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ListingsRequest) GetCategories() []string {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *ListingsRequest) GetContractType() string {
+	if m != nil {
+		return m.ContractType
+	}
+	return ""
+}
+
+func (m *ListingsRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *ListingsRequest) GetMinPrice() uint64 {
+	if m != nil {
+		return m.MinPrice
+	}
+	return 0
+}
+
+func (m *ListingsRequest) GetMaxPrice() uint64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *ListingsRequest) GetFrom() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *ListingsRequest) GetTo() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *ListingsRequest) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
+}
+
+func (m *ListingsRequest) GetOffsetId() string {
+	if m != nil {
+		return m.OffsetId
+	}
+	return ""
+}
+
+func (m *ListingsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListingIndex struct {
+	Listings []*ListingIndex_Entry `protobuf:"bytes,1,rep,name=listings" json:"listings,omitempty"`
+	Total    uint32                `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (m *ListingIndex) Reset()                    { *m = ListingIndex{} }
+func (m *ListingIndex) String() string            { return proto.CompactTextString(m) }
+func (*ListingIndex) ProtoMessage()               {}
+func (*ListingIndex) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{2} }
+
+func (m *ListingIndex) GetListings() []*ListingIndex_Entry {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+func (m *ListingIndex) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type ListingIndex_Entry struct {
+	Hash          string                  `protobuf:"bytes,1,opt,name=hash" json:"hash,omitempty"`
+	Slug          string                  `protobuf:"bytes,2,opt,name=slug" json:"slug,omitempty"`
+	Title         string                  `protobuf:"bytes,3,opt,name=title" json:"title,omitempty"`
+	Categories    []string                `protobuf:"bytes,4,rep,name=categories" json:"categories,omitempty"`
+	Nsfw          bool                    `protobuf:"varint,5,opt,name=nsfw" json:"nsfw,omitempty"`
+	ContractType  string                  `protobuf:"bytes,6,opt,name=contractType" json:"contractType,omitempty"`
+	Description   string                  `protobuf:"bytes,7,opt,name=description" json:"description,omitempty"`
+	Thumbnail     *ListingIndex_Thumbnail `protobuf:"bytes,8,opt,name=thumbnail" json:"thumbnail,omitempty"`
+	Price         *ListingIndex_Price     `protobuf:"bytes,9,opt,name=price" json:"price,omitempty"`
+	ShipsTo       []string                `protobuf:"bytes,10,rep,name=shipsTo" json:"shipsTo,omitempty"`
+	FreeShipping  []string                `protobuf:"bytes,11,rep,name=freeShipping" json:"freeShipping,omitempty"`
+	Language      string                  `protobuf:"bytes,12,opt,name=language" json:"language,omitempty"`
+	AverageRating float32                 `protobuf:"fixed32,13,opt,name=averageRating" json:"averageRating,omitempty"`
+	RatingCount   uint32                  `protobuf:"varint,14,opt,name=ratingCount" json:"ratingCount,omitempty"`
+	Tags          []string                `protobuf:"bytes,15,rep,name=tags" json:"tags,omitempty"`
+	CreatedAt     string                  `protobuf:"bytes,16,opt,name=createdAt" json:"createdAt,omitempty"`
+}
+
+func (m *ListingIndex_Entry) Reset()                    { *m = ListingIndex_Entry{} }
+func (m *ListingIndex_Entry) String() string            { return proto.CompactTextString(m) }
+func (*ListingIndex_Entry) ProtoMessage()               {}
+func (*ListingIndex_Entry) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{2, 0} }
+
+func (m *ListingIndex_Entry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ListingIndex_Entry) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *ListingIndex_Entry) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ListingIndex_Entry) GetCategories() []string {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *ListingIndex_Entry) GetNsfw() bool {
+	if m != nil {
+		return m.Nsfw
+	}
+	return false
+}
+
+func (m *ListingIndex_Entry) GetContractType() string {
+	if m != nil {
+		return m.ContractType
+	}
+	return ""
+}
+
+func (m *ListingIndex_Entry) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ListingIndex_Entry) GetThumbnail() *ListingIndex_Thumbnail {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return nil
+}
+
+func (m *ListingIndex_Entry) GetPrice() *ListingIndex_Price {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *ListingIndex_Entry) GetShipsTo() []string {
+	if m != nil {
+		return m.ShipsTo
+	}
+	return nil
+}
+
+func (m *ListingIndex_Entry) GetFreeShipping() []string {
+	if m != nil {
+		return m.FreeShipping
+	}
+	return nil
+}
+
+func (m *ListingIndex_Entry) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *ListingIndex_Entry) GetAverageRating() float32 {
+	if m != nil {
+		return m.AverageRating
+	}
+	return 0
+}
+
+func (m *ListingIndex_Entry) GetRatingCount() uint32 {
+	if m != nil {
+		return m.RatingCount
+	}
+	return 0
+}
+
+func (m *ListingIndex_Entry) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ListingIndex_Entry) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+type ListingIndex_Thumbnail struct {
+	Tiny   string `protobuf:"bytes,1,opt,name=tiny" json:"tiny,omitempty"`
+	Small  string `protobuf:"bytes,2,opt,name=small" json:"small,omitempty"`
+	Medium string `protobuf:"bytes,3,opt,name=medium" json:"medium,omitempty"`
+}
+
+func (m *ListingIndex_Thumbnail) Reset()                    { *m = ListingIndex_Thumbnail{} }
+func (m *ListingIndex_Thumbnail) String() string            { return proto.CompactTextString(m) }
+func (*ListingIndex_Thumbnail) ProtoMessage()               {}
+func (*ListingIndex_Thumbnail) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{2, 1} }
+
+func (m *ListingIndex_Thumbnail) GetTiny() string {
+	if m != nil {
+		return m.Tiny
+	}
+	return ""
+}
+
+func (m *ListingIndex_Thumbnail) GetSmall() string {
+	if m != nil {
+		return m.Small
+	}
+	return ""
+}
+
+func (m *ListingIndex_Thumbnail) GetMedium() string {
+	if m != nil {
+		return m.Medium
+	}
+	return ""
+}
+
+type ListingIndex_Price struct {
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currencyCode" json:"currencyCode,omitempty"`
+	Amount       uint64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+}
+
+func (m *ListingIndex_Price) Reset()                    { *m = ListingIndex_Price{} }
+func (m *ListingIndex_Price) String() string            { return proto.CompactTextString(m) }
+func (*ListingIndex_Price) ProtoMessage()               {}
+func (*ListingIndex_Price) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{2, 2} }
+
+func (m *ListingIndex_Price) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *ListingIndex_Price) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ListingRequest struct {
+	PeerID string `protobuf:"bytes,1,opt,name=peerID" json:"peerID,omitempty"`
+	Slug   string `protobuf:"bytes,2,opt,name=slug" json:"slug,omitempty"`
+}
+
+func (m *ListingRequest) Reset()                    { *m = ListingRequest{} }
+func (m *ListingRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListingRequest) ProtoMessage()               {}
+func (*ListingRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{3} }
+
+func (m *ListingRequest) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *ListingRequest) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+type ListingSlug struct {
+	Slug string `protobuf:"bytes,1,opt,name=slug" json:"slug,omitempty"`
+}
+
+func (m *ListingSlug) Reset()                    { *m = ListingSlug{} }
+func (m *ListingSlug) String() string            { return proto.CompactTextString(m) }
+func (*ListingSlug) ProtoMessage()               {}
+func (*ListingSlug) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{4} }
+
+func (m *ListingSlug) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+type PurchaseRequest struct {
+	ShipTo               string                  `protobuf:"bytes,1,opt,name=shipTo" json:"shipTo,omitempty"`
+	Address              string                  `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	City                 string                  `protobuf:"bytes,3,opt,name=city" json:"city,omitempty"`
+	State                string                  `protobuf:"bytes,4,opt,name=state" json:"state,omitempty"`
+	PostalCode           string                  `protobuf:"bytes,5,opt,name=postalCode" json:"postalCode,omitempty"`
+	CountryCode          string                  `protobuf:"bytes,6,opt,name=countryCode" json:"countryCode,omitempty"`
+	AddressNotes         string                  `protobuf:"bytes,7,opt,name=addressNotes" json:"addressNotes,omitempty"`
+	Moderator            string                  `protobuf:"bytes,8,opt,name=moderator" json:"moderator,omitempty"`
+	Moderators           []string                `protobuf:"bytes,9,rep,name=moderators" json:"moderators,omitempty"`
+	Lightning            bool                    `protobuf:"varint,10,opt,name=lightning" json:"lightning,omitempty"`
+	ModeratorThreshold   uint32                  `protobuf:"varint,11,opt,name=moderatorThreshold" json:"moderatorThreshold,omitempty"`
+	Items                []*PurchaseRequest_Item `protobuf:"bytes,12,rep,name=items" json:"items,omitempty"`
+	AlternateContactInfo string                  `protobuf:"bytes,13,opt,name=alternateContactInfo" json:"alternateContactInfo,omitempty"`
+	RefundAddress        string                  `protobuf:"bytes,14,opt,name=refundAddress" json:"refundAddress,omitempty"`
+	PaymentCoin          string                  `protobuf:"bytes,15,opt,name=paymentCoin" json:"paymentCoin,omitempty"`
+}
+
+func (m *PurchaseRequest) Reset()                    { *m = PurchaseRequest{} }
+func (m *PurchaseRequest) String() string            { return proto.CompactTextString(m) }
+func (*PurchaseRequest) ProtoMessage()               {}
+func (*PurchaseRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{5} }
+
+func (m *PurchaseRequest) GetShipTo() string {
+	if m != nil {
+		return m.ShipTo
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetCountryCode() string {
+	if m != nil {
+		return m.CountryCode
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetAddressNotes() string {
+	if m != nil {
+		return m.AddressNotes
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetModerator() string {
+	if m != nil {
+		return m.Moderator
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetModerators() []string {
+	if m != nil {
+		return m.Moderators
+	}
+	return nil
+}
+
+func (m *PurchaseRequest) GetLightning() bool {
+	if m != nil {
+		return m.Lightning
+	}
+	return false
+}
+
+func (m *PurchaseRequest) GetModeratorThreshold() uint32 {
+	if m != nil {
+		return m.ModeratorThreshold
+	}
+	return 0
+}
+
+func (m *PurchaseRequest) GetItems() []*PurchaseRequest_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *PurchaseRequest) GetAlternateContactInfo() string {
+	if m != nil {
+		return m.AlternateContactInfo
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+func (m *PurchaseRequest) GetPaymentCoin() string {
+	if m != nil {
+		return m.PaymentCoin
+	}
+	return ""
+}
+
+type PurchaseRequest_Item struct {
+	ListingHash string                    `protobuf:"bytes,1,opt,name=listingHash" json:"listingHash,omitempty"`
+	Quantity    uint32                    `protobuf:"varint,2,opt,name=quantity" json:"quantity,omitempty"`
+	Options     []*PurchaseRequest_Option `protobuf:"bytes,3,rep,name=options" json:"options,omitempty"`
+	Shipping    *PurchaseRequest_Shipping `protobuf:"bytes,4,opt,name=shipping" json:"shipping,omitempty"`
+	Memo        string                    `protobuf:"bytes,5,opt,name=memo" json:"memo,omitempty"`
+	Coupons     []string                  `protobuf:"bytes,6,rep,name=coupons" json:"coupons,omitempty"`
+}
+
+func (m *PurchaseRequest_Item) Reset()                    { *m = PurchaseRequest_Item{} }
+func (m *PurchaseRequest_Item) String() string            { return proto.CompactTextString(m) }
+func (*PurchaseRequest_Item) ProtoMessage()               {}
+func (*PurchaseRequest_Item) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{5, 0} }
+
+func (m *PurchaseRequest_Item) GetListingHash() string {
+	if m != nil {
+		return m.ListingHash
+	}
+	return ""
+}
+
+func (m *PurchaseRequest_Item) GetQuantity() uint32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *PurchaseRequest_Item) GetOptions() []*PurchaseRequest_Option {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *PurchaseRequest_Item) GetShipping() *PurchaseRequest_Shipping {
+	if m != nil {
+		return m.Shipping
+	}
+	return nil
+}
+
+func (m *PurchaseRequest_Item) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *PurchaseRequest_Item) GetCoupons() []string {
+	if m != nil {
+		return m.Coupons
+	}
+	return nil
+}
+
+type PurchaseRequest_Option struct {
+	Name  string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *PurchaseRequest_Option) Reset()                    { *m = PurchaseRequest_Option{} }
+func (m *PurchaseRequest_Option) String() string            { return proto.CompactTextString(m) }
+func (*PurchaseRequest_Option) ProtoMessage()               {}
+func (*PurchaseRequest_Option) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{5, 1} }
+
+func (m *PurchaseRequest_Option) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PurchaseRequest_Option) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type PurchaseRequest_Shipping struct {
+	Name    string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Service string `protobuf:"bytes,2,opt,name=service" json:"service,omitempty"`
+}
+
+func (m *PurchaseRequest_Shipping) Reset()                    { *m = PurchaseRequest_Shipping{} }
+func (m *PurchaseRequest_Shipping) String() string            { return proto.CompactTextString(m) }
+func (*PurchaseRequest_Shipping) ProtoMessage()               {}
+func (*PurchaseRequest_Shipping) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{5, 2} }
+
+func (m *PurchaseRequest_Shipping) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PurchaseRequest_Shipping) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+type PurchaseResponse struct {
+	PaymentAddress string `protobuf:"bytes,1,opt,name=paymentAddress" json:"paymentAddress,omitempty"`
+	Amount         uint64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	VendorOnline   bool   `protobuf:"varint,3,opt,name=vendorOnline" json:"vendorOnline,omitempty"`
+	OrderId        string `protobuf:"bytes,4,opt,name=orderId" json:"orderId,omitempty"`
+}
+
+func (m *PurchaseResponse) Reset()                    { *m = PurchaseResponse{} }
+func (m *PurchaseResponse) String() string            { return proto.CompactTextString(m) }
+func (*PurchaseResponse) ProtoMessage()               {}
+func (*PurchaseResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{6} }
+
+func (m *PurchaseResponse) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+func (m *PurchaseResponse) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PurchaseResponse) GetVendorOnline() bool {
+	if m != nil {
+		return m.VendorOnline
+	}
+	return false
+}
+
+func (m *PurchaseResponse) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type OrderRequest struct {
+	OrderId string `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
+}
+
+func (m *OrderRequest) Reset()                    { *m = OrderRequest{} }
+func (m *OrderRequest) String() string            { return proto.CompactTextString(m) }
+func (*OrderRequest) ProtoMessage()               {}
+func (*OrderRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{7} }
+
+func (m *OrderRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type OrderConfirmationRequest struct {
+	OrderId string `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
+	Reject  bool   `protobuf:"varint,2,opt,name=reject" json:"reject,omitempty"`
+}
+
+func (m *OrderConfirmationRequest) Reset()                    { *m = OrderConfirmationRequest{} }
+func (m *OrderConfirmationRequest) String() string            { return proto.CompactTextString(m) }
+func (*OrderConfirmationRequest) ProtoMessage()               {}
+func (*OrderConfirmationRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{8} }
+
+func (m *OrderConfirmationRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OrderConfirmationRequest) GetReject() bool {
+	if m != nil {
+		return m.Reject
+	}
+	return false
+}
+
+type OrderCompletionRequest struct {
+	OrderId string                               `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
+	Ratings []*OrderCompletionRequest_RatingData `protobuf:"bytes,2,rep,name=ratings" json:"ratings,omitempty"`
+}
+
+func (m *OrderCompletionRequest) Reset()                    { *m = OrderCompletionRequest{} }
+func (m *OrderCompletionRequest) String() string            { return proto.CompactTextString(m) }
+func (*OrderCompletionRequest) ProtoMessage()               {}
+func (*OrderCompletionRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{9} }
+
+func (m *OrderCompletionRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OrderCompletionRequest) GetRatings() []*OrderCompletionRequest_RatingData {
+	if m != nil {
+		return m.Ratings
+	}
+	return nil
+}
+
+type OrderCompletionRequest_RatingData struct {
+	Slug            string `protobuf:"bytes,1,opt,name=slug" json:"slug,omitempty"`
+	Overall         int32  `protobuf:"varint,2,opt,name=overall" json:"overall,omitempty"`
+	Quality         int32  `protobuf:"varint,3,opt,name=quality" json:"quality,omitempty"`
+	Description     int32  `protobuf:"varint,4,opt,name=description" json:"description,omitempty"`
+	DeliverySpeed   int32  `protobuf:"varint,5,opt,name=deliverySpeed" json:"deliverySpeed,omitempty"`
+	CustomerService int32  `protobuf:"varint,6,opt,name=customerService" json:"customerService,omitempty"`
+	Review          string `protobuf:"bytes,7,opt,name=review" json:"review,omitempty"`
+	Anonymous       bool   `protobuf:"varint,8,opt,name=anonymous" json:"anonymous,omitempty"`
+}
+
+func (m *OrderCompletionRequest_RatingData) Reset()         { *m = OrderCompletionRequest_RatingData{} }
+func (m *OrderCompletionRequest_RatingData) String() string { return proto.CompactTextString(m) }
+func (*OrderCompletionRequest_RatingData) ProtoMessage()    {}
+func (*OrderCompletionRequest_RatingData) Descriptor() ([]byte, []int) {
+	return fileDescriptor8, []int{9, 0}
+}
+
+func (m *OrderCompletionRequest_RatingData) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *OrderCompletionRequest_RatingData) GetOverall() int32 {
+	if m != nil {
+		return m.Overall
+	}
+	return 0
+}
+
+func (m *OrderCompletionRequest_RatingData) GetQuality() int32 {
+	if m != nil {
+		return m.Quality
+	}
+	return 0
+}
+
+func (m *OrderCompletionRequest_RatingData) GetDescription() int32 {
+	if m != nil {
+		return m.Description
+	}
+	return 0
+}
+
+func (m *OrderCompletionRequest_RatingData) GetDeliverySpeed() int32 {
+	if m != nil {
+		return m.DeliverySpeed
+	}
+	return 0
+}
+
+func (m *OrderCompletionRequest_RatingData) GetCustomerService() int32 {
+	if m != nil {
+		return m.CustomerService
+	}
+	return 0
+}
+
+func (m *OrderCompletionRequest_RatingData) GetReview() string {
+	if m != nil {
+		return m.Review
+	}
+	return ""
+}
+
+func (m *OrderCompletionRequest_RatingData) GetAnonymous() bool {
+	if m != nil {
+		return m.Anonymous
+	}
+	return false
+}
+
+type OpenDisputeRequest struct {
+	OrderId string `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
+	Claim   string `protobuf:"bytes,2,opt,name=claim" json:"claim,omitempty"`
+}
+
+func (m *OpenDisputeRequest) Reset()                    { *m = OpenDisputeRequest{} }
+func (m *OpenDisputeRequest) String() string            { return proto.CompactTextString(m) }
+func (*OpenDisputeRequest) ProtoMessage()               {}
+func (*OpenDisputeRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{10} }
+
+func (m *OpenDisputeRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OpenDisputeRequest) GetClaim() string {
+	if m != nil {
+		return m.Claim
+	}
+	return ""
+}
+
+type CloseDisputeRequest struct {
+	OrderId          string  `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
+	Resolution       string  `protobuf:"bytes,2,opt,name=resolution" json:"resolution,omitempty"`
+	BuyerPercentage  float32 `protobuf:"fixed32,3,opt,name=buyerPercentage" json:"buyerPercentage,omitempty"`
+	VendorPercentage float32 `protobuf:"fixed32,4,opt,name=vendorPercentage" json:"vendorPercentage,omitempty"`
+}
+
+func (m *CloseDisputeRequest) Reset()                    { *m = CloseDisputeRequest{} }
+func (m *CloseDisputeRequest) String() string            { return proto.CompactTextString(m) }
+func (*CloseDisputeRequest) ProtoMessage()               {}
+func (*CloseDisputeRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{11} }
+
+func (m *CloseDisputeRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *CloseDisputeRequest) GetResolution() string {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
+}
+
+func (m *CloseDisputeRequest) GetBuyerPercentage() float32 {
+	if m != nil {
+		return m.BuyerPercentage
+	}
+	return 0
+}
+
+func (m *CloseDisputeRequest) GetVendorPercentage() float32 {
+	if m != nil {
+		return m.VendorPercentage
+	}
+	return 0
+}
+
+type CoinRequest struct {
+	Coin string `protobuf:"bytes,1,opt,name=coin" json:"coin,omitempty"`
+}
+
+func (m *CoinRequest) Reset()                    { *m = CoinRequest{} }
+func (m *CoinRequest) String() string            { return proto.CompactTextString(m) }
+func (*CoinRequest) ProtoMessage()               {}
+func (*CoinRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{12} }
+
+func (m *CoinRequest) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
+type AddressResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *AddressResponse) Reset()                    { *m = AddressResponse{} }
+func (m *AddressResponse) String() string            { return proto.CompactTextString(m) }
+func (*AddressResponse) ProtoMessage()               {}
+func (*AddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{13} }
+
+func (m *AddressResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type BalanceResponse struct {
+	Confirmed   int64 `protobuf:"varint,1,opt,name=confirmed" json:"confirmed,omitempty"`
+	Unconfirmed int64 `protobuf:"varint,2,opt,name=unconfirmed" json:"unconfirmed,omitempty"`
+}
+
+func (m *BalanceResponse) Reset()                    { *m = BalanceResponse{} }
+func (m *BalanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*BalanceResponse) ProtoMessage()               {}
+func (*BalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{14} }
+
+func (m *BalanceResponse) GetConfirmed() int64 {
+	if m != nil {
+		return m.Confirmed
+	}
+	return 0
+}
+
+func (m *BalanceResponse) GetUnconfirmed() int64 {
+	if m != nil {
+		return m.Unconfirmed
+	}
+	return 0
+}
+
+type SpendRequest struct {
+	Address  string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Amount   int64  `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	FeeLevel string `protobuf:"bytes,3,opt,name=feeLevel" json:"feeLevel,omitempty"`
+	Memo     string `protobuf:"bytes,4,opt,name=memo" json:"memo,omitempty"`
+	Coin     string `protobuf:"bytes,5,opt,name=coin" json:"coin,omitempty"`
+}
+
+func (m *SpendRequest) Reset()                    { *m = SpendRequest{} }
+func (m *SpendRequest) String() string            { return proto.CompactTextString(m) }
+func (*SpendRequest) ProtoMessage()               {}
+func (*SpendRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{15} }
+
+func (m *SpendRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SpendRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SpendRequest) GetFeeLevel() string {
+	if m != nil {
+		return m.FeeLevel
+	}
+	return ""
+}
+
+func (m *SpendRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *SpendRequest) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
+type SpendResponse struct {
+	Txid               string                     `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
+	Amount             int64                      `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	ConfirmedBalance   int64                      `protobuf:"varint,3,opt,name=confirmedBalance" json:"confirmedBalance,omitempty"`
+	UnconfirmedBalance int64                      `protobuf:"varint,4,opt,name=unconfirmedBalance" json:"unconfirmedBalance,omitempty"`
+	Timestamp          *google_protobuf.Timestamp `protobuf:"bytes,5,opt,name=timestamp" json:"timestamp,omitempty"`
+	Memo               string                     `protobuf:"bytes,6,opt,name=memo" json:"memo,omitempty"`
+}
+
+func (m *SpendResponse) Reset()                    { *m = SpendResponse{} }
+func (m *SpendResponse) String() string            { return proto.CompactTextString(m) }
+func (*SpendResponse) ProtoMessage()               {}
+func (*SpendResponse) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{16} }
+
+func (m *SpendResponse) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *SpendResponse) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SpendResponse) GetConfirmedBalance() int64 {
+	if m != nil {
+		return m.ConfirmedBalance
+	}
+	return 0
+}
+
+func (m *SpendResponse) GetUnconfirmedBalance() int64 {
+	if m != nil {
+		return m.UnconfirmedBalance
+	}
+	return 0
+}
+
+func (m *SpendResponse) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *SpendResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+type ChatMessage struct {
+	MessageId string                     `protobuf:"bytes,1,opt,name=messageId" json:"messageId,omitempty"`
+	PeerId    string                     `protobuf:"bytes,2,opt,name=peerId" json:"peerId,omitempty"`
+	Subject   string                     `protobuf:"bytes,3,opt,name=subject" json:"subject,omitempty"`
+	Message   string                     `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
+	Read      bool                       `protobuf:"varint,5,opt,name=read" json:"read,omitempty"`
+	Outgoing  bool                       `protobuf:"varint,6,opt,name=outgoing" json:"outgoing,omitempty"`
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,7,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *ChatMessage) Reset()                    { *m = ChatMessage{} }
+func (m *ChatMessage) String() string            { return proto.CompactTextString(m) }
+func (*ChatMessage) ProtoMessage()               {}
+func (*ChatMessage) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{17} }
+
+func (m *ChatMessage) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ChatMessage) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *ChatMessage) GetOutgoing() bool {
+	if m != nil {
+		return m.Outgoing
+	}
+	return false
+}
+
+func (m *ChatMessage) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type SendMessageRequest struct {
+	PeerId  string `protobuf:"bytes,1,opt,name=peerId" json:"peerId,omitempty"`
+	Subject string `protobuf:"bytes,2,opt,name=subject" json:"subject,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *SendMessageRequest) Reset()                    { *m = SendMessageRequest{} }
+func (m *SendMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendMessageRequest) ProtoMessage()               {}
+func (*SendMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{18} }
+
+func (m *SendMessageRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *SendMessageRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *SendMessageRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ChatMessageID struct {
+	MessageId string `protobuf:"bytes,1,opt,name=messageId" json:"messageId,omitempty"`
+}
+
+func (m *ChatMessageID) Reset()                    { *m = ChatMessageID{} }
+func (m *ChatMessageID) String() string            { return proto.CompactTextString(m) }
+func (*ChatMessageID) ProtoMessage()               {}
+func (*ChatMessageID) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{19} }
+
+func (m *ChatMessageID) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+type ChatMessages struct {
+	Messages []*ChatMessage `protobuf:"bytes,1,rep,name=messages" json:"messages,omitempty"`
+}
+
+func (m *ChatMessages) Reset()                    { *m = ChatMessages{} }
+func (m *ChatMessages) String() string            { return proto.CompactTextString(m) }
+func (*ChatMessages) ProtoMessage()               {}
+func (*ChatMessages) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{20} }
+
+func (m *ChatMessages) GetMessages() []*ChatMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type ChatMessagesRequest struct {
+	PeerId   string `protobuf:"bytes,1,opt,name=peerId" json:"peerId,omitempty"`
+	Subject  string `protobuf:"bytes,2,opt,name=subject" json:"subject,omitempty"`
+	OffsetId string `protobuf:"bytes,3,opt,name=offsetId" json:"offsetId,omitempty"`
+	Limit    int32  `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *ChatMessagesRequest) Reset()                    { *m = ChatMessagesRequest{} }
+func (m *ChatMessagesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChatMessagesRequest) ProtoMessage()               {}
+func (*ChatMessagesRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{21} }
+
+func (m *ChatMessagesRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *ChatMessagesRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ChatMessagesRequest) GetOffsetId() string {
+	if m != nil {
+		return m.OffsetId
+	}
+	return ""
+}
+
+func (m *ChatMessagesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ChatConversations struct {
+	Conversations []*ChatConversations_Conversation `protobuf:"bytes,1,rep,name=conversations" json:"conversations,omitempty"`
+}
+
+func (m *ChatConversations) Reset()                    { *m = ChatConversations{} }
+func (m *ChatConversations) String() string            { return proto.CompactTextString(m) }
+func (*ChatConversations) ProtoMessage()               {}
+func (*ChatConversations) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{22} }
+
+func (m *ChatConversations) GetConversations() []*ChatConversations_Conversation {
+	if m != nil {
+		return m.Conversations
+	}
+	return nil
+}
+
+type ChatConversations_Conversation struct {
+	PeerId      string                     `protobuf:"bytes,1,opt,name=peerId" json:"peerId,omitempty"`
+	Unread      int32                      `protobuf:"varint,2,opt,name=unread" json:"unread,omitempty"`
+	LastMessage string                     `protobuf:"bytes,3,opt,name=lastMessage" json:"lastMessage,omitempty"`
+	Timestamp   *google_protobuf.Timestamp `protobuf:"bytes,4,opt,name=timestamp" json:"timestamp,omitempty"`
+	Outgoing    bool                       `protobuf:"varint,5,opt,name=outgoing" json:"outgoing,omitempty"`
+}
+
+func (m *ChatConversations_Conversation) Reset()         { *m = ChatConversations_Conversation{} }
+func (m *ChatConversations_Conversation) String() string { return proto.CompactTextString(m) }
+func (*ChatConversations_Conversation) ProtoMessage()    {}
+func (*ChatConversations_Conversation) Descriptor() ([]byte, []int) {
+	return fileDescriptor8, []int{22, 0}
+}
+
+func (m *ChatConversations_Conversation) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *ChatConversations_Conversation) GetUnread() int32 {
+	if m != nil {
+		return m.Unread
+	}
+	return 0
+}
+
+func (m *ChatConversations_Conversation) GetLastMessage() string {
+	if m != nil {
+		return m.LastMessage
+	}
+	return ""
+}
+
+func (m *ChatConversations_Conversation) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *ChatConversations_Conversation) GetOutgoing() bool {
+	if m != nil {
+		return m.Outgoing
+	}
+	return false
+}
+
+type SubscribeRequest struct {
+	Replay bool  `protobuf:"varint,1,opt,name=replay" json:"replay,omitempty"`
+	Since  int64 `protobuf:"varint,2,opt,name=since" json:"since,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()               {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{23} }
+
+func (m *SubscribeRequest) GetReplay() bool {
+	if m != nil {
+		return m.Replay
+	}
+	return false
+}
+
+func (m *SubscribeRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+type Event struct {
+	Seq     int64  `protobuf:"varint,1,opt,name=seq" json:"seq,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	Payload string `protobuf:"bytes,3,opt,name=payload" json:"payload,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{24} }
+
+func (m *Event) GetSeq() int64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *Event) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Event) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "Empty")
+	proto.RegisterType((*ListingsRequest)(nil), "ListingsRequest")
+	proto.RegisterType((*ListingIndex)(nil), "ListingIndex")
+	proto.RegisterType((*ListingIndex_Entry)(nil), "ListingIndex.Entry")
+	proto.RegisterType((*ListingIndex_Thumbnail)(nil), "ListingIndex.Thumbnail")
+	proto.RegisterType((*ListingIndex_Price)(nil), "ListingIndex.Price")
+	proto.RegisterType((*ListingRequest)(nil), "ListingRequest")
+	proto.RegisterType((*ListingSlug)(nil), "ListingSlug")
+	proto.RegisterType((*PurchaseRequest)(nil), "PurchaseRequest")
+	proto.RegisterType((*PurchaseRequest_Item)(nil), "PurchaseRequest.Item")
+	proto.RegisterType((*PurchaseRequest_Option)(nil), "PurchaseRequest.Option")
+	proto.RegisterType((*PurchaseRequest_Shipping)(nil), "PurchaseRequest.Shipping")
+	proto.RegisterType((*PurchaseResponse)(nil), "PurchaseResponse")
+	proto.RegisterType((*OrderRequest)(nil), "OrderRequest")
+	proto.RegisterType((*OrderConfirmationRequest)(nil), "OrderConfirmationRequest")
+	proto.RegisterType((*OrderCompletionRequest)(nil), "OrderCompletionRequest")
+	proto.RegisterType((*OrderCompletionRequest_RatingData)(nil), "OrderCompletionRequest.RatingData")
+	proto.RegisterType((*OpenDisputeRequest)(nil), "OpenDisputeRequest")
+	proto.RegisterType((*CloseDisputeRequest)(nil), "CloseDisputeRequest")
+	proto.RegisterType((*CoinRequest)(nil), "CoinRequest")
+	proto.RegisterType((*AddressResponse)(nil), "AddressResponse")
+	proto.RegisterType((*BalanceResponse)(nil), "BalanceResponse")
+	proto.RegisterType((*SpendRequest)(nil), "SpendRequest")
+	proto.RegisterType((*SpendResponse)(nil), "SpendResponse")
+	proto.RegisterType((*ChatMessage)(nil), "ChatMessage")
+	proto.RegisterType((*SendMessageRequest)(nil), "SendMessageRequest")
+	proto.RegisterType((*ChatMessageID)(nil), "ChatMessageID")
+	proto.RegisterType((*ChatMessages)(nil), "ChatMessages")
+	proto.RegisterType((*ChatMessagesRequest)(nil), "ChatMessagesRequest")
+	proto.RegisterType((*ChatConversations)(nil), "ChatConversations")
+	proto.RegisterType((*ChatConversations_Conversation)(nil), "ChatConversations.Conversation")
+	proto.RegisterType((*SubscribeRequest)(nil), "SubscribeRequest")
+	proto.RegisterType((*Event)(nil), "Event")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ListingService service
+
+type ListingServiceClient interface {
+	GetListings(ctx context.Context, in *ListingsRequest, opts ...grpc.CallOption) (*ListingIndex, error)
+	GetListing(ctx context.Context, in *ListingRequest, opts ...grpc.CallOption) (*SignedListing, error)
+	CreateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*ListingSlug, error)
+	UpdateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*Empty, error)
+	DeleteListing(ctx context.Context, in *ListingSlug, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type listingServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewListingServiceClient(cc *grpc.ClientConn) ListingServiceClient {
+	return &listingServiceClient{cc}
+}
+
+func (c *listingServiceClient) GetListings(ctx context.Context, in *ListingsRequest, opts ...grpc.CallOption) (*ListingIndex, error) {
+	out := new(ListingIndex)
+	err := grpc.Invoke(ctx, "/ListingService/GetListings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) GetListing(ctx context.Context, in *ListingRequest, opts ...grpc.CallOption) (*SignedListing, error) {
+	out := new(SignedListing)
+	err := grpc.Invoke(ctx, "/ListingService/GetListing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) CreateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*ListingSlug, error) {
+	out := new(ListingSlug)
+	err := grpc.Invoke(ctx, "/ListingService/CreateListing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) UpdateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/ListingService/UpdateListing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) DeleteListing(ctx context.Context, in *ListingSlug, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/ListingService/DeleteListing", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ListingService service
+
+type ListingServiceServer interface {
+	GetListings(context.Context, *ListingsRequest) (*ListingIndex, error)
+	GetListing(context.Context, *ListingRequest) (*SignedListing, error)
+	CreateListing(context.Context, *Listing) (*ListingSlug, error)
+	UpdateListing(context.Context, *Listing) (*Empty, error)
+	DeleteListing(context.Context, *ListingSlug) (*Empty, error)
+}
+
+func RegisterListingServiceServer(s *grpc.Server, srv ListingServiceServer) {
+	s.RegisterService(&_ListingService_serviceDesc, srv)
+}
+
+func _ListingService_GetListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).GetListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ListingService/GetListings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).GetListings(ctx, req.(*ListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_GetListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).GetListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ListingService/GetListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).GetListing(ctx, req.(*ListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_CreateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Listing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).CreateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ListingService/CreateListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).CreateListing(ctx, req.(*Listing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_UpdateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Listing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).UpdateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ListingService/UpdateListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).UpdateListing(ctx, req.(*Listing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_DeleteListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListingSlug)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).DeleteListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ListingService/DeleteListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).DeleteListing(ctx, req.(*ListingSlug))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ListingService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ListingService",
+	HandlerType: (*ListingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetListings",
+			Handler:    _ListingService_GetListings_Handler,
+		},
+		{
+			MethodName: "GetListing",
+			Handler:    _ListingService_GetListing_Handler,
+		},
+		{
+			MethodName: "CreateListing",
+			Handler:    _ListingService_CreateListing_Handler,
+		},
+		{
+			MethodName: "UpdateListing",
+			Handler:    _ListingService_UpdateListing_Handler,
+		},
+		{
+			MethodName: "DeleteListing",
+			Handler:    _ListingService_DeleteListing_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services.proto",
+}
+
+// Client API for OrderService service
+
+type OrderServiceClient interface {
+	Purchase(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*PurchaseResponse, error)
+	GetOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderRespApi, error)
+	ConfirmOrder(ctx context.Context, in *OrderConfirmationRequest, opts ...grpc.CallOption) (*Empty, error)
+	FulfillOrder(ctx context.Context, in *OrderFulfillment, opts ...grpc.CallOption) (*Empty, error)
+	CompleteOrder(ctx context.Context, in *OrderCompletionRequest, opts ...grpc.CallOption) (*Empty, error)
+	CancelOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*Empty, error)
+	RefundOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type orderServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOrderServiceClient(cc *grpc.ClientConn) OrderServiceClient {
+	return &orderServiceClient{cc}
+}
+
+func (c *orderServiceClient) Purchase(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*PurchaseResponse, error) {
+	out := new(PurchaseResponse)
+	err := grpc.Invoke(ctx, "/OrderService/Purchase", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderRespApi, error) {
+	out := new(OrderRespApi)
+	err := grpc.Invoke(ctx, "/OrderService/GetOrder", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ConfirmOrder(ctx context.Context, in *OrderConfirmationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/OrderService/ConfirmOrder", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) FulfillOrder(ctx context.Context, in *OrderFulfillment, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/OrderService/FulfillOrder", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CompleteOrder(ctx context.Context, in *OrderCompletionRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/OrderService/CompleteOrder", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CancelOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/OrderService/CancelOrder", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RefundOrder(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/OrderService/RefundOrder", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for OrderService service
+
+type OrderServiceServer interface {
+	Purchase(context.Context, *PurchaseRequest) (*PurchaseResponse, error)
+	GetOrder(context.Context, *OrderRequest) (*OrderRespApi, error)
+	ConfirmOrder(context.Context, *OrderConfirmationRequest) (*Empty, error)
+	FulfillOrder(context.Context, *OrderFulfillment) (*Empty, error)
+	CompleteOrder(context.Context, *OrderCompletionRequest) (*Empty, error)
+	CancelOrder(context.Context, *OrderRequest) (*Empty, error)
+	RefundOrder(context.Context, *OrderRequest) (*Empty, error)
+}
+
+func RegisterOrderServiceServer(s *grpc.Server, srv OrderServiceServer) {
+	s.RegisterService(&_OrderService_serviceDesc, srv)
+}
+
+func _OrderService_Purchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Purchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/Purchase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Purchase(ctx, req.(*PurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrder(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ConfirmOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderConfirmationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ConfirmOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/ConfirmOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ConfirmOrder(ctx, req.(*OrderConfirmationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderFulfillment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).FulfillOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/FulfillOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).FulfillOrder(ctx, req.(*OrderFulfillment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CompleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderCompletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CompleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/CompleteOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CompleteOrder(ctx, req.(*OrderCompletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelOrder(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/RefundOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundOrder(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _OrderService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OrderService",
+	HandlerType: (*OrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Purchase",
+			Handler:    _OrderService_Purchase_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _OrderService_GetOrder_Handler,
+		},
+		{
+			MethodName: "ConfirmOrder",
+			Handler:    _OrderService_ConfirmOrder_Handler,
+		},
+		{
+			MethodName: "FulfillOrder",
+			Handler:    _OrderService_FulfillOrder_Handler,
+		},
+		{
+			MethodName: "CompleteOrder",
+			Handler:    _OrderService_CompleteOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OrderService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _OrderService_RefundOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services.proto",
+}
+
+// Client API for DisputeService service
+
+type DisputeServiceClient interface {
+	OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*Empty, error)
+	CloseDispute(ctx context.Context, in *CloseDisputeRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReleaseFunds(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetCase(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*CaseRespApi, error)
+}
+
+type disputeServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDisputeServiceClient(cc *grpc.ClientConn) DisputeServiceClient {
+	return &disputeServiceClient{cc}
+}
+
+func (c *disputeServiceClient) OpenDispute(ctx context.Context, in *OpenDisputeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/DisputeService/OpenDispute", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disputeServiceClient) CloseDispute(ctx context.Context, in *CloseDisputeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/DisputeService/CloseDispute", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disputeServiceClient) ReleaseFunds(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/DisputeService/ReleaseFunds", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *disputeServiceClient) GetCase(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*CaseRespApi, error) {
+	out := new(CaseRespApi)
+	err := grpc.Invoke(ctx, "/DisputeService/GetCase", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DisputeService service
+
+type DisputeServiceServer interface {
+	OpenDispute(context.Context, *OpenDisputeRequest) (*Empty, error)
+	CloseDispute(context.Context, *CloseDisputeRequest) (*Empty, error)
+	ReleaseFunds(context.Context, *OrderRequest) (*Empty, error)
+	GetCase(context.Context, *OrderRequest) (*CaseRespApi, error)
+}
+
+func RegisterDisputeServiceServer(s *grpc.Server, srv DisputeServiceServer) {
+	s.RegisterService(&_DisputeService_serviceDesc, srv)
+}
+
+func _DisputeService_OpenDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisputeServiceServer).OpenDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DisputeService/OpenDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisputeServiceServer).OpenDispute(ctx, req.(*OpenDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisputeService_CloseDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisputeServiceServer).CloseDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DisputeService/CloseDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisputeServiceServer).CloseDispute(ctx, req.(*CloseDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisputeService_ReleaseFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisputeServiceServer).ReleaseFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DisputeService/ReleaseFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisputeServiceServer).ReleaseFunds(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DisputeService_GetCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DisputeServiceServer).GetCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DisputeService/GetCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DisputeServiceServer).GetCase(ctx, req.(*OrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DisputeService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "DisputeService",
+	HandlerType: (*DisputeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenDispute",
+			Handler:    _DisputeService_OpenDispute_Handler,
+		},
+		{
+			MethodName: "CloseDispute",
+			Handler:    _DisputeService_CloseDispute_Handler,
+		},
+		{
+			MethodName: "ReleaseFunds",
+			Handler:    _DisputeService_ReleaseFunds_Handler,
+		},
+		{
+			MethodName: "GetCase",
+			Handler:    _DisputeService_GetCase_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services.proto",
+}
+
+// Client API for WalletService service
+
+type WalletServiceClient interface {
+	GetAddress(ctx context.Context, in *CoinRequest, opts ...grpc.CallOption) (*AddressResponse, error)
+	GetBalance(ctx context.Context, in *CoinRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
+	Spend(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (*SpendResponse, error)
+}
+
+type walletServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletServiceClient(cc *grpc.ClientConn) WalletServiceClient {
+	return &walletServiceClient{cc}
+}
+
+func (c *walletServiceClient) GetAddress(ctx context.Context, in *CoinRequest, opts ...grpc.CallOption) (*AddressResponse, error) {
+	out := new(AddressResponse)
+	err := grpc.Invoke(ctx, "/WalletService/GetAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetBalance(ctx context.Context, in *CoinRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
+	out := new(BalanceResponse)
+	err := grpc.Invoke(ctx, "/WalletService/GetBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Spend(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (*SpendResponse, error) {
+	out := new(SpendResponse)
+	err := grpc.Invoke(ctx, "/WalletService/Spend", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for WalletService service
+
+type WalletServiceServer interface {
+	GetAddress(context.Context, *CoinRequest) (*AddressResponse, error)
+	GetBalance(context.Context, *CoinRequest) (*BalanceResponse, error)
+	Spend(context.Context, *SpendRequest) (*SpendResponse, error)
+}
+
+func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
+	s.RegisterService(&_WalletService_serviceDesc, srv)
+}
+
+func _WalletService_GetAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WalletService/GetAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetAddress(ctx, req.(*CoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WalletService/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetBalance(ctx, req.(*CoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Spend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Spend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WalletService/Spend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Spend(ctx, req.(*SpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WalletService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "WalletService",
+	HandlerType: (*WalletServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAddress",
+			Handler:    _WalletService_GetAddress_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _WalletService_GetBalance_Handler,
+		},
+		{
+			MethodName: "Spend",
+			Handler:    _WalletService_Spend_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services.proto",
+}
+
+// Client API for ChatService service
+
+type ChatServiceClient interface {
+	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*ChatMessageID, error)
+	GetConversations(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChatConversations, error)
+	GetMessages(ctx context.Context, in *ChatMessagesRequest, opts ...grpc.CallOption) (*ChatMessages, error)
+	MarkChatAsRead(ctx context.Context, in *ChatMessagesRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type chatServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewChatServiceClient(cc *grpc.ClientConn) ChatServiceClient {
+	return &chatServiceClient{cc}
+}
+
+func (c *chatServiceClient) SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*ChatMessageID, error) {
+	out := new(ChatMessageID)
+	err := grpc.Invoke(ctx, "/ChatService/SendMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetConversations(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ChatConversations, error) {
+	out := new(ChatConversations)
+	err := grpc.Invoke(ctx, "/ChatService/GetConversations", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetMessages(ctx context.Context, in *ChatMessagesRequest, opts ...grpc.CallOption) (*ChatMessages, error) {
+	out := new(ChatMessages)
+	err := grpc.Invoke(ctx, "/ChatService/GetMessages", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MarkChatAsRead(ctx context.Context, in *ChatMessagesRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/ChatService/MarkChatAsRead", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ChatService service
+
+type ChatServiceServer interface {
+	SendMessage(context.Context, *SendMessageRequest) (*ChatMessageID, error)
+	GetConversations(context.Context, *Empty) (*ChatConversations, error)
+	GetMessages(context.Context, *ChatMessagesRequest) (*ChatMessages, error)
+	MarkChatAsRead(context.Context, *ChatMessagesRequest) (*Empty, error)
+}
+
+func RegisterChatServiceServer(s *grpc.Server, srv ChatServiceServer) {
+	s.RegisterService(&_ChatService_serviceDesc, srv)
+}
+
+func _ChatService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ChatService/SendMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ChatService/GetConversations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetConversations(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ChatService/GetMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetMessages(ctx, req.(*ChatMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MarkChatAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MarkChatAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ChatService/MarkChatAsRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MarkChatAsRead(ctx, req.(*ChatMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ChatService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ChatService",
+	HandlerType: (*ChatServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendMessage",
+			Handler:    _ChatService_SendMessage_Handler,
+		},
+		{
+			MethodName: "GetConversations",
+			Handler:    _ChatService_GetConversations_Handler,
+		},
+		{
+			MethodName: "GetMessages",
+			Handler:    _ChatService_GetMessages_Handler,
+		},
+		{
+			MethodName: "MarkChatAsRead",
+			Handler:    _ChatService_MarkChatAsRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services.proto",
+}
+
+// Client API for NotificationService service
+
+type NotificationServiceClient interface {
+	// Streams the events sent over the websocket API
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (NotificationService_SubscribeClient, error)
+}
+
+type notificationServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNotificationServiceClient(cc *grpc.ClientConn) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (NotificationService_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_NotificationService_serviceDesc.Streams[0], c.cc, "/NotificationService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NotificationService_SubscribeClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type notificationServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationServiceSubscribeClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for NotificationService service
+
+type NotificationServiceServer interface {
+	// Streams the events sent over the websocket API
+	Subscribe(*SubscribeRequest, NotificationService_SubscribeServer) error
+}
+
+func RegisterNotificationServiceServer(s *grpc.Server, srv NotificationServiceServer) {
+	s.RegisterService(&_NotificationService_serviceDesc, srv)
+}
+
+func _NotificationService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationServiceServer).Subscribe(m, &notificationServiceSubscribeServer{stream})
+}
+
+type NotificationService_SubscribeServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type notificationServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationServiceSubscribeServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _NotificationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _NotificationService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
+
+func init() { proto.RegisterFile("services.proto", fileDescriptor8) }
+
+var fileDescriptor8 = []byte{
+	// 2121 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x8e, 0xdb, 0xc6,
+	0x15, 0x06, 0xf5, 0xb7, 0xd2, 0x91, 0xb4, 0xbb, 0x9e, 0x75, 0x13, 0x86, 0x28, 0x92, 0x0d, 0x91,
+	0xa6, 0x8a, 0x93, 0xd0, 0x89, 0x8a, 0x00, 0xb9, 0x08, 0xd0, 0xda, 0x5a, 0xc7, 0x5d, 0xc0, 0x8e,
+	0x5d, 0xee, 0x16, 0x05, 0x7a, 0xd5, 0x59, 0xf2, 0x48, 0xcb, 0x96, 0x7f, 0xe6, 0x0c, 0x37, 0xd6,
+	0x7d, 0x1f, 0xa0, 0x40, 0x9e, 0xa1, 0xaf, 0x50, 0xa0, 0x0f, 0xd0, 0x47, 0xc8, 0x5d, 0x6f, 0x52,
+	0xa0, 0xd7, 0xbd, 0xea, 0x55, 0x7b, 0x51, 0xcc, 0x1f, 0x35, 0x94, 0xb4, 0xb1, 0xd3, 0xbb, 0x39,
+	0x3f, 0xc3, 0x99, 0xf3, 0xf7, 0x9d, 0x33, 0x84, 0x43, 0x86, 0xd5, 0x4d, 0x12, 0x21, 0x0b, 0xca,
+	0xaa, 0xe0, 0x85, 0x77, 0x14, 0x15, 0x39, 0xaf, 0x68, 0xc4, 0x0d, 0x63, 0x44, 0xcb, 0x44, 0x2f,
+	0xdf, 0x59, 0x15, 0xc5, 0x2a, 0xc5, 0xfb, 0x92, 0xba, 0xaa, 0x97, 0xf7, 0x79, 0x92, 0x21, 0xe3,
+	0x34, 0x2b, 0x95, 0x82, 0x7f, 0x00, 0xfd, 0x47, 0x59, 0xc9, 0xd7, 0xfe, 0x7f, 0x3a, 0x70, 0xf4,
+	0x24, 0x61, 0x3c, 0xc9, 0x57, 0x2c, 0xc4, 0x17, 0x35, 0x32, 0x4e, 0xde, 0x80, 0x41, 0x89, 0x58,
+	0x9d, 0x9f, 0xb9, 0xce, 0xa9, 0x33, 0x1b, 0x85, 0x9a, 0x22, 0x04, 0x7a, 0x9c, 0xae, 0x98, 0xdb,
+	0x39, 0xed, 0xce, 0x46, 0xa1, 0x5c, 0x93, 0xb7, 0x01, 0x22, 0xca, 0x71, 0x55, 0x54, 0x09, 0x32,
+	0xb7, 0x2b, 0x25, 0x16, 0x87, 0xf8, 0x30, 0x31, 0xf7, 0xbc, 0x5c, 0x97, 0xe8, 0xf6, 0xe4, 0x17,
+	0x5b, 0x3c, 0xe2, 0xc1, 0x30, 0xaa, 0xab, 0x0a, 0xf3, 0x68, 0xed, 0xf6, 0xa5, 0xbc, 0xa1, 0x85,
+	0x2c, 0x4b, 0xf2, 0xe7, 0x55, 0x12, 0xa1, 0x3b, 0x38, 0x75, 0x66, 0xbd, 0xb0, 0xa1, 0xa5, 0x8c,
+	0xbe, 0x54, 0xb2, 0x03, 0x2d, 0xd3, 0x34, 0x09, 0xa0, 0xb7, 0xac, 0x8a, 0xcc, 0x1d, 0x9e, 0x3a,
+	0xb3, 0xf1, 0xdc, 0x0b, 0x94, 0x43, 0x02, 0xe3, 0x90, 0xe0, 0xd2, 0x38, 0x24, 0x94, 0x7a, 0xe4,
+	0x1e, 0x74, 0x78, 0xe1, 0x8e, 0x5e, 0xa9, 0xdd, 0xe1, 0x85, 0xf0, 0x0f, 0x2b, 0x2a, 0xfe, 0x70,
+	0xed, 0x82, 0xf2, 0x8f, 0xa2, 0xc4, 0x7d, 0x8a, 0xe5, 0x92, 0x21, 0x3f, 0x8f, 0xdd, 0xb1, 0xb2,
+	0xc3, 0xd0, 0xe4, 0x2e, 0xf4, 0xd3, 0x24, 0x4b, 0xb8, 0x3b, 0x39, 0x75, 0x66, 0xfd, 0x50, 0x11,
+	0xfe, 0x77, 0x7d, 0x98, 0x68, 0xef, 0x9f, 0xe7, 0x31, 0xbe, 0x24, 0xf7, 0x61, 0x98, 0x2a, 0x9a,
+	0xb9, 0xce, 0x69, 0x77, 0x36, 0x9e, 0x9f, 0x04, 0xb6, 0x42, 0xf0, 0x28, 0xe7, 0xd5, 0x3a, 0x6c,
+	0x94, 0xc4, 0x77, 0x79, 0xc1, 0x69, 0xea, 0x76, 0x4e, 0x9d, 0xd9, 0x34, 0x54, 0x84, 0xf7, 0xef,
+	0x2e, 0xf4, 0xa5, 0xa6, 0x88, 0xd9, 0x35, 0x65, 0xd7, 0x3a, 0x92, 0x72, 0x2d, 0x78, 0x2c, 0xad,
+	0x57, 0x72, 0xcb, 0x28, 0x94, 0x6b, 0xf9, 0x9d, 0x84, 0xa7, 0xe8, 0x76, 0x25, 0x53, 0x11, 0x5b,
+	0xd1, 0xed, 0xed, 0x44, 0x97, 0x40, 0x2f, 0x67, 0xcb, 0xaf, 0x65, 0xd4, 0x86, 0xa1, 0x5c, 0xef,
+	0x44, 0x7c, 0xb0, 0x27, 0xe2, 0xa7, 0x30, 0x8e, 0x91, 0x45, 0x55, 0x52, 0xf2, 0xa4, 0xc8, 0x65,
+	0xf0, 0x46, 0xa1, 0xcd, 0x22, 0x9f, 0xc1, 0x88, 0x5f, 0xd7, 0xd9, 0x55, 0x4e, 0x93, 0x54, 0x07,
+	0xf1, 0xcd, 0xb6, 0x27, 0x2e, 0x8d, 0x38, 0xdc, 0x68, 0x92, 0x0f, 0xa0, 0x5f, 0xca, 0x7c, 0x50,
+	0x91, 0xdc, 0x72, 0x9e, 0x4c, 0x8d, 0x50, 0x69, 0x10, 0x17, 0x0e, 0xd8, 0x75, 0x52, 0xb2, 0xcb,
+	0xc2, 0x05, 0x69, 0x98, 0x21, 0x85, 0x05, 0xcb, 0x0a, 0xf1, 0xe2, 0x3a, 0x29, 0xcb, 0x24, 0x5f,
+	0xb9, 0x63, 0x29, 0x6e, 0xf1, 0x44, 0xac, 0x53, 0x9a, 0xaf, 0x6a, 0xba, 0x42, 0x19, 0xd2, 0x51,
+	0xd8, 0xd0, 0xe4, 0x3d, 0x98, 0xd2, 0x1b, 0xac, 0xe8, 0x0a, 0x43, 0x2a, 0x0e, 0x77, 0xa7, 0xa7,
+	0xce, 0xac, 0x13, 0xb6, 0x99, 0xc2, 0x07, 0x95, 0x5c, 0x2d, 0x8a, 0x3a, 0xe7, 0xee, 0xa1, 0x8c,
+	0x9f, 0xcd, 0x6a, 0xea, 0xed, 0xc8, 0xaa, 0xb7, 0x1f, 0xc3, 0x28, 0xaa, 0x90, 0x72, 0x8c, 0x1f,
+	0x70, 0xf7, 0x58, 0x1e, 0xbc, 0x61, 0x78, 0x4f, 0x61, 0xd4, 0xb8, 0x45, 0x6e, 0x4f, 0xf2, 0xb5,
+	0x09, 0xbd, 0x58, 0x8b, 0x30, 0xb3, 0x8c, 0xa6, 0xa9, 0x8e, 0xbd, 0x22, 0x44, 0x42, 0x67, 0x18,
+	0x27, 0x75, 0xa6, 0xa3, 0xaf, 0x29, 0x6f, 0x01, 0x7d, 0x55, 0x4d, 0x22, 0xa6, 0xba, 0x22, 0x17,
+	0x45, 0x8c, 0xfa, 0x93, 0x2d, 0x9e, 0xf8, 0x08, 0xcd, 0xa4, 0x29, 0x1d, 0x59, 0x8b, 0x9a, 0xf2,
+	0xbf, 0x80, 0x43, 0x1d, 0x84, 0xd7, 0xc0, 0x97, 0xed, 0xbc, 0xf4, 0xdf, 0x85, 0xb1, 0xde, 0x7d,
+	0x21, 0xd2, 0xd4, 0xa8, 0x38, 0x96, 0xca, 0xb7, 0x03, 0x38, 0x7a, 0x5e, 0x57, 0xd1, 0x35, 0x65,
+	0x68, 0x1d, 0x21, 0xa2, 0x79, 0x59, 0x98, 0x23, 0x14, 0x25, 0x82, 0x4e, 0xe3, 0xb8, 0x42, 0xc6,
+	0xf4, 0x29, 0x86, 0x14, 0x5f, 0x8e, 0x12, 0xbe, 0xd6, 0x1e, 0x90, 0x6b, 0xe9, 0x2d, 0x4e, 0xb9,
+	0x41, 0x2d, 0x45, 0x88, 0xa2, 0x28, 0x0b, 0xc6, 0x69, 0x2a, 0x5d, 0xa1, 0x00, 0xcb, 0xe2, 0x88,
+	0xc0, 0x46, 0xc2, 0xf2, 0x4a, 0xf9, 0x4a, 0xe5, 0xbf, 0xcd, 0x12, 0xee, 0xd4, 0xc7, 0x7e, 0x55,
+	0x70, 0x64, 0x3a, 0xff, 0x5b, 0x3c, 0x11, 0xe8, 0xac, 0x88, 0xb1, 0xa2, 0xbc, 0xa8, 0x64, 0x01,
+	0x8c, 0xc2, 0x0d, 0x43, 0xdc, 0xa1, 0x21, 0x98, 0x3b, 0x52, 0x85, 0xb9, 0xe1, 0x88, 0xdd, 0x69,
+	0xb2, 0xba, 0xe6, 0xb9, 0x48, 0x3f, 0x90, 0xd5, 0xb9, 0x61, 0x90, 0x00, 0x48, 0xa3, 0x7b, 0x79,
+	0x5d, 0x21, 0xbb, 0x2e, 0x52, 0x05, 0x59, 0xd3, 0x70, 0x8f, 0x84, 0x7c, 0x08, 0xfd, 0x84, 0x63,
+	0xc6, 0xdc, 0x89, 0x84, 0xa4, 0x1f, 0x05, 0x5b, 0xee, 0x0e, 0xce, 0x39, 0x66, 0xa1, 0xd2, 0x21,
+	0x73, 0xb8, 0x4b, 0x53, 0x8e, 0x55, 0x4e, 0x39, 0x2e, 0x8a, 0x9c, 0xd3, 0x88, 0x9f, 0xe7, 0xcb,
+	0x42, 0x16, 0xc1, 0x28, 0xdc, 0x2b, 0x13, 0x15, 0x53, 0xe1, 0xb2, 0xce, 0xe3, 0x07, 0x3a, 0x38,
+	0x87, 0x52, 0xb9, 0xcd, 0x14, 0x8e, 0x2d, 0xe9, 0x3a, 0xc3, 0x9c, 0x2f, 0x8a, 0x24, 0x77, 0x8f,
+	0x94, 0x63, 0x2d, 0x96, 0xf7, 0x77, 0x07, 0x7a, 0xe2, 0x2e, 0x42, 0x55, 0x43, 0xe4, 0x2f, 0x37,
+	0xe8, 0x67, 0xb3, 0x44, 0x01, 0xbf, 0xa8, 0x69, 0xce, 0x45, 0xcc, 0x15, 0x76, 0x36, 0x34, 0xf9,
+	0x14, 0x0e, 0x0a, 0x09, 0x43, 0xaa, 0xa3, 0x09, 0xe8, 0xd9, 0xb6, 0xf8, 0x99, 0x94, 0x87, 0x46,
+	0x8f, 0x7c, 0x06, 0x43, 0x66, 0xf0, 0xa2, 0x27, 0xb1, 0xe7, 0xad, 0x9d, 0x3d, 0x06, 0x3c, 0xc2,
+	0x46, 0x55, 0x64, 0x5d, 0x86, 0x59, 0xa1, 0xb3, 0x48, 0xae, 0x45, 0x8e, 0x46, 0x45, 0x5d, 0x8a,
+	0xd3, 0x07, 0x0a, 0x98, 0x34, 0xe9, 0xcd, 0x61, 0xa0, 0xce, 0x15, 0xfb, 0x72, 0x9a, 0x99, 0x42,
+	0x94, 0x6b, 0x91, 0xad, 0x37, 0x34, 0xad, 0xd1, 0xd4, 0xb6, 0x24, 0xbc, 0xcf, 0x61, 0x78, 0x61,
+	0x9d, 0xb6, 0xb3, 0x4b, 0xc0, 0xa0, 0x1a, 0x2c, 0x4c, 0x45, 0x68, 0xd2, 0xff, 0x93, 0x03, 0xc7,
+	0x1b, 0x13, 0x98, 0xb8, 0x01, 0x92, 0xf7, 0xe1, 0x50, 0x3b, 0xdc, 0x84, 0x4a, 0x7d, 0x6c, 0x8b,
+	0x7b, 0x1b, 0x1a, 0x88, 0xd4, 0xbf, 0xc1, 0x3c, 0x2e, 0xaa, 0x67, 0x79, 0x9a, 0xe4, 0xaa, 0xdd,
+	0x0c, 0xc3, 0x16, 0x4f, 0x5c, 0xa9, 0xa8, 0x62, 0xac, 0xce, 0x63, 0x5d, 0x78, 0x86, 0xf4, 0x67,
+	0x30, 0x79, 0x26, 0x96, 0xa6, 0xcc, 0x2d, 0x4d, 0xa7, 0xad, 0xf9, 0x04, 0x5c, 0xa9, 0xb9, 0x28,
+	0xf2, 0x65, 0x52, 0x65, 0x54, 0x46, 0xeb, 0x55, 0xbb, 0xc4, 0xad, 0x2b, 0xfc, 0x3d, 0x46, 0xea,
+	0xd6, 0xc3, 0x50, 0x53, 0xfe, 0xbf, 0x3a, 0xf0, 0x86, 0xfe, 0x5c, 0x56, 0xa6, 0xf8, 0x7a, 0x1f,
+	0xfb, 0x02, 0x0e, 0x14, 0x9a, 0xab, 0x89, 0x69, 0x3c, 0xf7, 0x83, 0xfd, 0xdf, 0x08, 0x54, 0x47,
+	0x38, 0xa3, 0x9c, 0x86, 0x66, 0x8b, 0xf7, 0x5f, 0x07, 0x60, 0xc3, 0xdf, 0x07, 0x7c, 0xf2, 0x68,
+	0xd1, 0x52, 0x34, 0x9c, 0xf7, 0x43, 0x43, 0x0a, 0xc9, 0x8b, 0x9a, 0xa6, 0x06, 0xcf, 0xfa, 0xa1,
+	0x21, 0xb7, 0x3b, 0x6f, 0x4f, 0x4a, 0x6d, 0x96, 0xa8, 0xc5, 0x18, 0xd3, 0xe4, 0x06, 0xab, 0xf5,
+	0x45, 0x89, 0x18, 0xcb, 0xdc, 0xec, 0x87, 0x6d, 0x26, 0x99, 0xc1, 0x51, 0x54, 0x33, 0x5e, 0x64,
+	0x58, 0x5d, 0xe8, 0xf4, 0x19, 0x48, 0xbd, 0x6d, 0xb6, 0xf2, 0xe9, 0x4d, 0x82, 0x5f, 0x6b, 0x98,
+	0xd3, 0x94, 0x80, 0x28, 0x9a, 0x17, 0xf9, 0x3a, 0x2b, 0x6a, 0x26, 0x01, 0x6e, 0x18, 0x6e, 0x18,
+	0xfe, 0x19, 0x90, 0x67, 0x25, 0xe6, 0x67, 0x09, 0x2b, 0x6b, 0x8e, 0xaf, 0x76, 0xf6, 0x5d, 0xe8,
+	0x47, 0x29, 0x4d, 0x32, 0x93, 0xfc, 0x92, 0xf0, 0xff, 0xec, 0xc0, 0xc9, 0x22, 0x2d, 0x18, 0xbe,
+	0xf6, 0x77, 0xde, 0x06, 0xa8, 0x90, 0x15, 0x69, 0x2d, 0xdd, 0xa3, 0x3e, 0x66, 0x71, 0x84, 0xdd,
+	0x57, 0xf5, 0x1a, 0xab, 0xe7, 0x58, 0x45, 0x98, 0x73, 0xd1, 0xfe, 0xbb, 0xb2, 0xbb, 0x6f, 0xb3,
+	0xc9, 0x3d, 0x38, 0x56, 0x59, 0x6d, 0xa9, 0xf6, 0xa4, 0xea, 0x0e, 0x5f, 0x74, 0x39, 0x81, 0x5f,
+	0xe6, 0x7a, 0xa2, 0x17, 0x09, 0x84, 0xd3, 0xc1, 0x16, 0x6b, 0xff, 0x43, 0x38, 0xd2, 0xb5, 0xd5,
+	0xd4, 0xa2, 0xd5, 0xcc, 0x9c, 0x56, 0x33, 0xf3, 0x7f, 0x05, 0x47, 0x0f, 0x69, 0x4a, 0xf3, 0x68,
+	0x53, 0xb8, 0x62, 0x70, 0x50, 0xb5, 0x80, 0xca, 0xe8, 0x6e, 0xb8, 0x61, 0x88, 0xb4, 0xa8, 0xf3,
+	0x8d, 0xbc, 0x23, 0xe5, 0x36, 0xcb, 0xff, 0xa3, 0x03, 0x93, 0x8b, 0x12, 0xf3, 0xd8, 0xf2, 0xe1,
+	0xfe, 0xd3, 0xb7, 0x6a, 0xbf, 0xdb, 0xd4, 0xbe, 0x07, 0xc3, 0x25, 0xe2, 0x13, 0xbc, 0xc1, 0x54,
+	0xb7, 0xd9, 0x86, 0x6e, 0x80, 0xb0, 0x67, 0x01, 0xa1, 0x71, 0x43, 0xdf, 0x72, 0xc3, 0x3f, 0x1d,
+	0x98, 0xea, 0x6b, 0x68, 0xc3, 0xc4, 0x98, 0xf3, 0x32, 0x89, 0x9b, 0x31, 0xe7, 0x65, 0x12, 0xdf,
+	0x7a, 0x83, 0x7b, 0x70, 0xdc, 0x58, 0xa4, 0x1d, 0x24, 0x6f, 0xd2, 0x0d, 0x77, 0xf8, 0xa2, 0x49,
+	0xd6, 0xf9, 0x36, 0x57, 0xde, 0xaf, 0x1b, 0xee, 0x91, 0x90, 0xcf, 0x61, 0xd4, 0xbc, 0xb2, 0xdc,
+	0xfe, 0x2b, 0x1f, 0x12, 0x1b, 0xe5, 0xc6, 0xf6, 0xc1, 0xc6, 0x76, 0xff, 0x3b, 0x07, 0xc6, 0x8b,
+	0x6b, 0xca, 0x9f, 0x22, 0x63, 0x22, 0x9b, 0xc4, 0x38, 0xa0, 0x96, 0x4d, 0xce, 0x6e, 0x18, 0xcd,
+	0x44, 0x15, 0xeb, 0x8c, 0xd5, 0x94, 0x04, 0xf7, 0xfa, 0x4a, 0x02, 0x5a, 0x57, 0x83, 0xbb, 0x22,
+	0x85, 0x44, 0x6f, 0x37, 0x18, 0xab, 0x49, 0x71, 0x9b, 0x0a, 0x69, 0x6c, 0x66, 0x7a, 0xb1, 0x96,
+	0x2f, 0x9b, 0x9a, 0xaf, 0x0a, 0xd1, 0xdd, 0x06, 0x92, 0xdf, 0xd0, 0x6d, 0xbb, 0x0f, 0x7e, 0x80,
+	0xdd, 0xfe, 0xef, 0x80, 0x5c, 0x60, 0x1e, 0x6b, 0x13, 0xb7, 0xa7, 0xc3, 0xd8, 0x75, 0x6e, 0xb3,
+	0xa5, 0x73, 0xab, 0x2d, 0xdd, 0x96, 0x2d, 0xfe, 0xc7, 0x30, 0xb5, 0x9c, 0x78, 0x7e, 0xf6, 0xfd,
+	0x6e, 0xf4, 0x3f, 0x87, 0x89, 0xa5, 0xce, 0xc8, 0x0c, 0x86, 0x5a, 0x68, 0x5e, 0x63, 0x93, 0xc0,
+	0x52, 0x08, 0x1b, 0xa9, 0xbf, 0x86, 0x13, 0x7b, 0xe7, 0xff, 0x6f, 0x8b, 0xfd, 0x86, 0xec, 0xde,
+	0xf6, 0x86, 0xec, 0xd9, 0x6f, 0xc8, 0x6f, 0x3a, 0x70, 0x47, 0x9c, 0xbd, 0x28, 0xf2, 0x1b, 0xac,
+	0x18, 0x55, 0xf3, 0xc8, 0x23, 0x98, 0x46, 0x36, 0x43, 0xdf, 0xff, 0x9d, 0x60, 0x47, 0x35, 0xb0,
+	0xa9, 0xb0, 0xbd, 0xcb, 0xfb, 0x8b, 0x03, 0x13, 0x5b, 0x7e, 0xab, 0x45, 0x6f, 0xc0, 0xa0, 0xce,
+	0x65, 0xde, 0xa8, 0x56, 0xa4, 0x29, 0x39, 0x88, 0x51, 0x66, 0x1c, 0xa3, 0x4d, 0xb2, 0x59, 0xed,
+	0xfc, 0xe9, 0xfd, 0x90, 0xba, 0xb1, 0xb3, 0xb2, 0xdf, 0xce, 0x4a, 0xff, 0x17, 0x70, 0x7c, 0x51,
+	0x5f, 0x89, 0xae, 0x76, 0x65, 0x67, 0x56, 0x85, 0x65, 0x4a, 0xd5, 0x93, 0x68, 0x18, 0x6a, 0x4a,
+	0x8e, 0xf9, 0x49, 0xae, 0x07, 0xa0, 0x6e, 0xa8, 0x08, 0xff, 0x31, 0xf4, 0x1f, 0xdd, 0x60, 0xce,
+	0xc9, 0x31, 0x74, 0x19, 0xbe, 0xd0, 0x98, 0x29, 0x96, 0x12, 0x72, 0xd6, 0xa5, 0xd2, 0x17, 0x90,
+	0x23, 0x9e, 0xb4, 0x2e, 0x1c, 0x94, 0x74, 0x9d, 0x16, 0xd4, 0xc4, 0xcd, 0x90, 0xf3, 0x7f, 0x38,
+	0xcd, 0x0b, 0xc8, 0xf4, 0xc4, 0x00, 0xc6, 0x8f, 0x91, 0x3f, 0x31, 0x8f, 0xf8, 0xe3, 0x60, 0xeb,
+	0x17, 0x8c, 0x37, 0x6d, 0x3d, 0x5c, 0xc9, 0xc7, 0x00, 0x1b, 0x7d, 0x72, 0x14, 0xb4, 0x1f, 0x54,
+	0xde, 0x61, 0x70, 0x91, 0xac, 0x72, 0x8c, 0x8d, 0xc2, 0x4f, 0x61, 0xba, 0x90, 0x6f, 0x42, 0xc3,
+	0x18, 0x9a, 0x1d, 0xde, 0x24, 0xb0, 0x9f, 0x53, 0xef, 0xc2, 0xf4, 0xd7, 0x65, 0xbc, 0x57, 0x71,
+	0x10, 0xc8, 0x1f, 0x44, 0xe4, 0x27, 0x30, 0x3d, 0xc3, 0x14, 0x37, 0x2a, 0xad, 0x2f, 0x18, 0xb5,
+	0xf9, 0xdf, 0x3a, 0x7a, 0x34, 0x33, 0x26, 0xde, 0x87, 0xa1, 0x19, 0x1e, 0xc9, 0xf1, 0xf6, 0x28,
+	0xec, 0xdd, 0x09, 0x76, 0x26, 0xcb, 0x19, 0x0c, 0x1f, 0x23, 0x97, 0xdf, 0x20, 0xd3, 0xc0, 0x1e,
+	0xf3, 0xbc, 0x86, 0x64, 0xe5, 0x83, 0x32, 0x21, 0x9f, 0xca, 0x9c, 0x14, 0xe8, 0xab, 0xb4, 0xdf,
+	0x0a, 0x6e, 0x1b, 0xf5, 0x1a, 0x2b, 0x3e, 0x80, 0xc9, 0x97, 0x75, 0xba, 0x4c, 0xd2, 0x54, 0x6d,
+	0xb9, 0xa3, 0xb6, 0x68, 0x9e, 0x18, 0x5c, 0x1b, 0xd5, 0x4f, 0x60, 0xaa, 0x27, 0x34, 0x54, 0xba,
+	0x6f, 0xde, 0x32, 0xb6, 0x35, 0x3b, 0xde, 0x83, 0xf1, 0x42, 0xb4, 0x80, 0x74, 0xef, 0xe5, 0x2d,
+	0xad, 0x50, 0x3e, 0x67, 0xbe, 0x4f, 0x6b, 0xfe, 0x57, 0x07, 0x0e, 0xf5, 0xb0, 0x62, 0x3c, 0x79,
+	0x0f, 0xc6, 0xd6, 0x28, 0x44, 0x4e, 0x82, 0xdd, 0xc1, 0xa8, 0x39, 0xe4, 0x23, 0x98, 0xd8, 0xf3,
+	0x0e, 0xb9, 0x1b, 0xec, 0x19, 0x7f, 0xac, 0xd8, 0x4e, 0x42, 0x4c, 0x91, 0x32, 0xfc, 0xb2, 0xce,
+	0x63, 0x76, 0xdb, 0xcd, 0xdf, 0x87, 0x83, 0xc7, 0xc8, 0x17, 0x22, 0x92, 0x5b, 0x1a, 0x93, 0x60,
+	0xa1, 0x43, 0xf8, 0xa0, 0x4c, 0xe6, 0xdf, 0x38, 0x30, 0xfd, 0x0d, 0x4d, 0x53, 0xe4, 0xe6, 0xea,
+	0x1f, 0xc9, 0xbc, 0x35, 0x6f, 0x82, 0x49, 0x60, 0x0d, 0x39, 0xde, 0x71, 0xb0, 0x3d, 0xcf, 0x28,
+	0x6d, 0xd3, 0x4f, 0xb7, 0xb5, 0xb7, 0x07, 0x9a, 0xf7, 0xa1, 0x2f, 0x07, 0x01, 0x32, 0x0d, 0xec,
+	0xb9, 0xc4, 0x3b, 0x34, 0xa4, 0xd2, 0x9b, 0x7f, 0xab, 0x3b, 0xa9, 0xb9, 0xd3, 0x1c, 0xc6, 0x56,
+	0xd7, 0x21, 0x27, 0xc1, 0x6e, 0x0f, 0xf2, 0x0e, 0x83, 0x76, 0xdb, 0x08, 0xe0, 0x58, 0x78, 0xa0,
+	0x85, 0xb0, 0xda, 0x3b, 0x1e, 0xd9, 0x85, 0x54, 0x71, 0xc6, 0x63, 0xdc, 0xf4, 0x91, 0xbb, 0xc1,
+	0x9e, 0xe6, 0xe0, 0x4d, 0x5b, 0x5c, 0x12, 0xc0, 0xe1, 0x53, 0x5a, 0xfd, 0x41, 0xf0, 0x1e, 0xb0,
+	0x50, 0x60, 0xe7, 0xfe, 0x6d, 0x26, 0x53, 0x7e, 0x0e, 0x27, 0x5f, 0x15, 0x3c, 0x59, 0x26, 0x91,
+	0x3c, 0xd4, 0x98, 0x37, 0x83, 0x51, 0x03, 0x7c, 0xe4, 0x4e, 0xb0, 0x0d, 0x82, 0x62, 0xbb, 0x40,
+	0xb5, 0x4f, 0x9c, 0x87, 0xbd, 0xdf, 0x76, 0xca, 0xab, 0xab, 0x81, 0xc4, 0xd8, 0x9f, 0xfd, 0x6f,
+	0x00, 0x52, 0x48, 0xb1, 0x08, 0x5f, 0x16, 0x00, 0x00,
+}
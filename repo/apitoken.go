@@ -0,0 +1,79 @@
+package repo
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// The permissions an API token can be given
+const (
+	ScopeReadOnly    = "read-only"
+	ScopeOrders      = "orders"
+	ScopeListings    = "listings"
+	ScopeChat        = "chat"
+	ScopeWalletSpend = "wallet-spend"
+	ScopeAdmin       = "admin"
+)
+
+var APITokenScopes = []string{ScopeReadOnly, ScopeOrders, ScopeListings, ScopeChat, ScopeWalletSpend, ScopeAdmin}
+
+var ErrAPITokenExists = errors.New("A token with this name already exists")
+
+/* APIToken is a named credential for the API limited to its scopes. Only the hash of the
+   token is stored so it is shown once when created. */
+type APIToken struct {
+	Name    string    `json:"name"`
+	Hash    string    `json:"-"`
+	Scopes  []string  `json:"scopes"`
+	Created time.Time `json:"created"`
+}
+
+// HasScope returns whether the token may use routes which need the scope. Admin tokens may use every route.
+func (t APIToken) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+func HashAPIToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// NewAPIToken returns a new token record and the token to give to the client
+func NewAPIToken(name string, scopes []string) (APIToken, string, error) {
+	if name == "" {
+		return APIToken{}, "", errors.New("API tokens require a name")
+	}
+	if len(scopes) == 0 {
+		return APIToken{}, "", errors.New("API tokens require at least one scope")
+	}
+	for _, scope := range scopes {
+		valid := false
+		for _, s := range APITokenScopes {
+			if scope == s {
+				valid = true
+			}
+		}
+		if !valid {
+			return APIToken{}, "", errors.New("Unknown API token scope " + scope)
+		}
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return APIToken{}, "", err
+	}
+	token := hex.EncodeToString(b)
+	return APIToken{
+		Name:    name,
+		Hash:    HashAPIToken(token),
+		Scopes:  scopes,
+		Created: time.Now(),
+	}, token, nil
+}
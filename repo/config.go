@@ -3,8 +3,10 @@ package repo
 import (
 	"encoding/json"
 	"errors"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/exchange"
 	"github.com/ipfs/go-ipfs/repo"
 	"github.com/ipfs/go-ipfs/repo/config"
+	"strings"
 	"time"
 )
 
@@ -36,6 +38,36 @@ type APIConfig struct {
 	SSL           bool
 	SSLCert       string
 	SSLKey        string
+
+	// Address of the optional gRPC listener, such as 127.0.0.1:4003. Empty disables it.
+	GRPCAddr string
+
+	RateLimits RateLimitConfig
+}
+
+/* RateLimitConfig limits how fast each IP address and each credential may call the API. A call
+   costs one request unless Costs sets a higher cost for its route, keyed by method and path
+   prefix such as "POST /ob/fetchprofiles". Calls from ExemptIPs aren't limited. */
+type RateLimitConfig struct {
+	Enabled       bool
+	PerIP         RateLimit
+	PerCredential RateLimit
+	ExemptIPs     []string
+	Costs         map[string]int
+}
+
+type RateLimit struct {
+	RequestsPerMinute int
+	Burst             int
+}
+
+// Config files created before rate limiting existed use these limits
+var DefaultRateLimits = RateLimitConfig{
+	Enabled:       true,
+	PerIP:         RateLimit{RequestsPerMinute: 300, Burst: 100},
+	PerCredential: RateLimit{RequestsPerMinute: 600, Burst: 200},
+	ExemptIPs:     []string{"127.0.0.1", "::1"},
+	Costs:         map[string]int{},
 }
 
 type TorConfig struct {
@@ -59,6 +91,61 @@ type WalletConfig struct {
 	TrustedPeer      string
 }
 
+/* ColdStorageConfig moves the wallet keys off the node, which keeps only public keys.
+   MasterPublicKey is the extended public key of the offline machine, AccountPublicKey the
+   BIP44 account key the wallet's addresses come from and BitcoinSig the master key's
+   signature over our peer ID. Signing requests are written to Directory and payouts are
+   sent to PayoutAddress. */
+type ColdStorageConfig struct {
+	Enabled          bool
+	MasterPublicKey  string
+	AccountPublicKey string
+	BitcoinSig       string
+	PayoutAddress    string
+	Directory        string
+}
+
+/* LightningConfig connects the node to a Lightning backend so small orders can be paid with
+   Lightning invoices. Backend is empty when disabled. Orders above MaxOrderAmount satoshis
+   must be paid on chain. */
+type LightningConfig struct {
+	Backend        string
+	Host           string
+	TLSCert        string
+	Macaroon       string
+	MaxOrderAmount int64
+	InvoiceExpiry  time.Duration
+}
+
+/* ExchangeRatesConfig sets the providers bitcoin exchange rates are fetched from. The rate of
+   each currency is the median of the providers, ignoring those more than MaxDeviation (a
+   fraction) from it and those which have not responded within MaxAge. */
+type ExchangeRatesConfig struct {
+	Providers    []exchange.ProviderConfig
+	MaxDeviation float64
+	MaxAge       time.Duration
+}
+
+/* EventLogConfig sets how long events broadcast to websocket clients are kept for replay.
+   Events older than MaxAge are removed, as are all but the latest MaxEvents. Zero disables
+   either limit. */
+type EventLogConfig struct {
+	MaxAge    time.Duration
+	MaxEvents int
+}
+
+/* AutoSweepConfig moves sales proceeds of a coin out of the wallet. Once the confirmed balance
+   less Reserve and the coins owed in pending refunds reaches Threshold it is sent to
+   Destination, an address or an account xpub, at most once per MinInterval. */
+type AutoSweepConfig struct {
+	Coin        string
+	Destination string
+	Threshold   int64
+	Reserve     int64
+	MinInterval time.Duration
+	FeeLevel    string
+}
+
 type DataSharing struct {
 	AcceptStoreRequests bool
 	PushTo              []string
@@ -66,6 +153,9 @@ type DataSharing struct {
 
 var MalformedConfigError error = errors.New("Config file is malformed")
 
+// How long after leaving a rating a buyer may amend or retract it
+var DefaultRatingAmendmentWindow = time.Hour * 24 * 7
+
 func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 	var cfgIface interface{}
 	json.Unmarshal(cfgBytes, &cfgIface)
@@ -183,6 +273,17 @@ func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 	if !ok {
 		return nil, MalformedConfigError
 	}
+	var grpcAddr string
+	if g, ok := api["GRPCAddr"]; ok && g != nil {
+		grpcAddr, ok = g.(string)
+		if !ok {
+			return nil, MalformedConfigError
+		}
+	}
+	rateLimits, err := getRateLimitConfig(api["RateLimits"])
+	if err != nil {
+		return nil, err
+	}
 
 	apiConfig := &APIConfig{
 		Authenticated: authenticatedBool,
@@ -195,6 +296,8 @@ func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 		SSL:           sslEnabledBool,
 		SSLCert:       certFileStr,
 		SSLKey:        keyFileStr,
+		GRPCAddr:      grpcAddr,
+		RateLimits:    *rateLimits,
 	}
 
 	return apiConfig, nil
@@ -212,6 +315,40 @@ func GetWalletConfig(cfgBytes []byte) (*WalletConfig, error) {
 	if !ok {
 		return nil, MalformedConfigError
 	}
+	return parseWalletConfig(walletIface)
+}
+
+/* GetAdditionalWalletConfigs returns the wallets to run alongside the one in the Wallet
+   section so the node can accept several coins at once. Each entry has the same fields as
+   the Wallet section. */
+func GetAdditionalWalletConfigs(cfgBytes []byte) ([]*WalletConfig, error) {
+	var cfgIface interface{}
+	json.Unmarshal(cfgBytes, &cfgIface)
+	cfg, ok := cfgIface.(map[string]interface{})
+	if !ok {
+		return nil, MalformedConfigError
+	}
+
+	walletsIface, ok := cfg["AdditionalWallets"]
+	if !ok || walletsIface == nil {
+		return nil, nil
+	}
+	wallets, ok := walletsIface.([]interface{})
+	if !ok {
+		return nil, MalformedConfigError
+	}
+	var configs []*WalletConfig
+	for _, w := range wallets {
+		wCfg, err := parseWalletConfig(w)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, wCfg)
+	}
+	return configs, nil
+}
+
+func parseWalletConfig(walletIface interface{}) (*WalletConfig, error) {
 	wallet, ok := walletIface.(map[string]interface{})
 	if !ok {
 		return nil, MalformedConfigError
@@ -376,6 +513,30 @@ func GetRepublishInterval(cfgBytes []byte) (time.Duration, error) {
 	return d, nil
 }
 
+// Config files created before rating amendments were added fall back to DefaultRatingAmendmentWindow
+func GetRatingAmendmentWindow(cfgBytes []byte) (time.Duration, error) {
+	var cfgIface interface{}
+	json.Unmarshal(cfgBytes, &cfgIface)
+
+	cfg, ok := cfgIface.(map[string]interface{})
+	if !ok {
+		return time.Duration(0), MalformedConfigError
+	}
+
+	window, ok := cfg["RatingAmendmentWindow"]
+	if !ok {
+		return DefaultRatingAmendmentWindow, nil
+	}
+	windowStr, ok := window.(string)
+	if !ok {
+		return time.Duration(0), MalformedConfigError
+	}
+	if windowStr == "" {
+		return time.Duration(0), nil
+	}
+	return time.ParseDuration(windowStr)
+}
+
 func GetDataSharing(cfgBytes []byte) (*DataSharing, error) {
 	var cfgIface interface{}
 	json.Unmarshal(cfgBytes, &cfgIface)
@@ -424,6 +585,273 @@ func GetDataSharing(cfgBytes []byte) (*DataSharing, error) {
 	return dataSharing, nil
 }
 
+// GetAutoSweepConfigs returns the sweep policies from the AutoSweep section. MinInterval defaults to a day.
+func GetAutoSweepConfigs(cfgBytes []byte) ([]*AutoSweepConfig, error) {
+	var cfgIface interface{}
+	json.Unmarshal(cfgBytes, &cfgIface)
+	cfg, ok := cfgIface.(map[string]interface{})
+	if !ok {
+		return nil, MalformedConfigError
+	}
+
+	sweepsIface, ok := cfg["AutoSweep"]
+	if !ok || sweepsIface == nil {
+		return nil, nil
+	}
+	sweeps, ok := sweepsIface.([]interface{})
+	if !ok {
+		return nil, MalformedConfigError
+	}
+	var configs []*AutoSweepConfig
+	for _, s := range sweeps {
+		sw, ok := s.(map[string]interface{})
+		if !ok {
+			return nil, MalformedConfigError
+		}
+		c := &AutoSweepConfig{MinInterval: time.Hour * 24, FeeLevel: "NORMAL"}
+		for key, field := range map[string]*string{
+			"Coin":        &c.Coin,
+			"Destination": &c.Destination,
+			"FeeLevel":    &c.FeeLevel,
+		} {
+			if v, ok := sw[key]; ok {
+				str, ok := v.(string)
+				if !ok {
+					return nil, MalformedConfigError
+				}
+				*field = str
+			}
+		}
+		for key, field := range map[string]*int64{
+			"Threshold": &c.Threshold,
+			"Reserve":   &c.Reserve,
+		} {
+			if v, ok := sw[key]; ok {
+				f, ok := v.(float64)
+				if !ok || f < 0 {
+					return nil, MalformedConfigError
+				}
+				*field = int64(f)
+			}
+		}
+		if v, ok := sw["MinInterval"]; ok {
+			str, ok := v.(string)
+			if !ok {
+				return nil, MalformedConfigError
+			}
+			interval, err := time.ParseDuration(str)
+			if err != nil {
+				return nil, err
+			}
+			c.MinInterval = interval
+		}
+		if c.Destination == "" {
+			return nil, errors.New("Auto-sweep requires a destination")
+		}
+		configs = append(configs, c)
+	}
+	return configs, nil
+}
+
+// Config files created before Lightning was added return a disabled config
+func GetLightningConfig(cfgBytes []byte) (*LightningConfig, error) {
+	var cfgIface interface{}
+	json.Unmarshal(cfgBytes, &cfgIface)
+	lightning := &LightningConfig{InvoiceExpiry: time.Hour}
+
+	cfg, ok := cfgIface.(map[string]interface{})
+	if !ok {
+		return lightning, MalformedConfigError
+	}
+
+	lncfg, ok := cfg["Lightning"]
+	if !ok {
+		return lightning, nil
+	}
+	ln, ok := lncfg.(map[string]interface{})
+	if !ok {
+		return lightning, MalformedConfigError
+	}
+
+	for key, field := range map[string]*string{
+		"Backend":  &lightning.Backend,
+		"Host":     &lightning.Host,
+		"TLSCert":  &lightning.TLSCert,
+		"Macaroon": &lightning.Macaroon,
+	} {
+		v, ok := ln[key]
+		if !ok {
+			continue
+		}
+		str, ok := v.(string)
+		if !ok {
+			return lightning, MalformedConfigError
+		}
+		*field = str
+	}
+	if v, ok := ln["MaxOrderAmount"]; ok {
+		amount, ok := v.(float64)
+		if !ok {
+			return lightning, MalformedConfigError
+		}
+		lightning.MaxOrderAmount = int64(amount)
+	}
+	if v, ok := ln["InvoiceExpiry"]; ok {
+		s, ok := v.(string)
+		if !ok {
+			return lightning, MalformedConfigError
+		}
+		expiry, err := time.ParseDuration(s)
+		if err != nil {
+			return lightning, err
+		}
+		lightning.InvoiceExpiry = expiry
+	}
+	switch lightning.Backend {
+	case "":
+	case "lnd":
+		if lightning.Host == "" || lightning.TLSCert == "" || lightning.Macaroon == "" {
+			return lightning, errors.New("The lnd Lightning backend requires a host, TLS certificate and macaroon")
+		}
+	default:
+		return lightning, errors.New("Unknown Lightning backend " + lightning.Backend)
+	}
+	return lightning, nil
+}
+
+// Config files created before the providers were configurable use the default providers
+func GetExchangeRatesConfig(cfgBytes []byte) (*ExchangeRatesConfig, error) {
+	var cfgIface interface{}
+	json.Unmarshal(cfgBytes, &cfgIface)
+	rates := &ExchangeRatesConfig{
+		Providers:    exchange.DefaultProviders,
+		MaxDeviation: exchange.DefaultMaxDeviation,
+		MaxAge:       exchange.DefaultMaxAge,
+	}
+
+	cfg, ok := cfgIface.(map[string]interface{})
+	if !ok {
+		return rates, MalformedConfigError
+	}
+
+	ercfg, ok := cfg["ExchangeRates"]
+	if !ok {
+		return rates, nil
+	}
+	er, ok := ercfg.(map[string]interface{})
+	if !ok {
+		return rates, MalformedConfigError
+	}
+
+	if v, ok := er["Providers"]; ok {
+		providers, ok := v.([]interface{})
+		if !ok {
+			return rates, MalformedConfigError
+		}
+		rates.Providers = nil
+		for _, p := range providers {
+			provider, ok := p.(map[string]interface{})
+			if !ok {
+				return rates, MalformedConfigError
+			}
+			var c exchange.ProviderConfig
+			for key, field := range map[string]*string{
+				"Name":    &c.Name,
+				"URL":     &c.URL,
+				"Decoder": &c.Decoder,
+			} {
+				v, ok := provider[key]
+				if !ok {
+					continue
+				}
+				str, ok := v.(string)
+				if !ok {
+					return rates, MalformedConfigError
+				}
+				*field = str
+			}
+			if c.URL == "" || c.Decoder == "" {
+				return rates, errors.New("Exchange rate providers require a URL and decoder")
+			}
+			if c.Name == "" {
+				c.Name = c.URL
+			}
+			rates.Providers = append(rates.Providers, c)
+		}
+		if len(rates.Providers) == 0 {
+			return rates, errors.New("At least one exchange rate provider is required")
+		}
+	}
+	if v, ok := er["MaxDeviation"]; ok {
+		deviation, ok := v.(float64)
+		if !ok || deviation <= 0 {
+			return rates, MalformedConfigError
+		}
+		rates.MaxDeviation = deviation
+	}
+	if v, ok := er["MaxAge"]; ok {
+		s, ok := v.(string)
+		if !ok {
+			return rates, MalformedConfigError
+		}
+		maxAge, err := time.ParseDuration(s)
+		if err != nil {
+			return rates, err
+		}
+		rates.MaxAge = maxAge
+	}
+	return rates, nil
+}
+
+// Config files created before cold storage was added return a disabled config
+func GetColdStorageConfig(cfgBytes []byte) (*ColdStorageConfig, error) {
+	var cfgIface interface{}
+	json.Unmarshal(cfgBytes, &cfgIface)
+	coldStorage := new(ColdStorageConfig)
+
+	cfg, ok := cfgIface.(map[string]interface{})
+	if !ok {
+		return coldStorage, MalformedConfigError
+	}
+
+	cscfg, ok := cfg["ColdStorage"]
+	if !ok {
+		return coldStorage, nil
+	}
+	cs, ok := cscfg.(map[string]interface{})
+	if !ok {
+		return coldStorage, MalformedConfigError
+	}
+
+	enabled, ok := cs["Enabled"].(bool)
+	if !ok {
+		return coldStorage, MalformedConfigError
+	}
+	coldStorage.Enabled = enabled
+
+	for key, field := range map[string]*string{
+		"MasterPublicKey":  &coldStorage.MasterPublicKey,
+		"AccountPublicKey": &coldStorage.AccountPublicKey,
+		"BitcoinSig":       &coldStorage.BitcoinSig,
+		"PayoutAddress":    &coldStorage.PayoutAddress,
+		"Directory":        &coldStorage.Directory,
+	} {
+		v, ok := cs[key]
+		if !ok {
+			continue
+		}
+		str, ok := v.(string)
+		if !ok {
+			return coldStorage, MalformedConfigError
+		}
+		*field = str
+	}
+	if coldStorage.Enabled && (coldStorage.MasterPublicKey == "" || coldStorage.AccountPublicKey == "" || coldStorage.BitcoinSig == "") {
+		return coldStorage, errors.New("Cold storage requires the master and account public keys and a bitcoin signature")
+	}
+	return coldStorage, nil
+}
+
 func GetTestnetBootstrapAddrs(cfgBytes []byte) ([]string, error) {
 	var cfgIface interface{}
 	json.Unmarshal(cfgBytes, &cfgIface)
@@ -584,3 +1012,110 @@ func datastoreConfig(repoRoot string) config.Datastore {
 		},
 	}
 }
+
+func getRateLimitConfig(rlcfg interface{}) (*RateLimitConfig, error) {
+	rateLimits := DefaultRateLimits
+	rateLimits.Costs = make(map[string]int)
+	if rlcfg == nil {
+		return &rateLimits, nil
+	}
+	rl, ok := rlcfg.(map[string]interface{})
+	if !ok {
+		return nil, MalformedConfigError
+	}
+
+	if v, ok := rl["Enabled"]; ok {
+		rateLimits.Enabled, ok = v.(bool)
+		if !ok {
+			return nil, MalformedConfigError
+		}
+	}
+	for key, limit := range map[string]*RateLimit{"PerIP": &rateLimits.PerIP, "PerCredential": &rateLimits.PerCredential} {
+		v, ok := rl[key]
+		if !ok {
+			continue
+		}
+		l, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, MalformedConfigError
+		}
+		rpm, ok := l["RequestsPerMinute"].(float64)
+		if !ok || rpm <= 0 {
+			return nil, MalformedConfigError
+		}
+		burst, ok := l["Burst"].(float64)
+		if !ok || burst < 1 {
+			return nil, MalformedConfigError
+		}
+		*limit = RateLimit{RequestsPerMinute: int(rpm), Burst: int(burst)}
+	}
+	if v, ok := rl["ExemptIPs"]; ok {
+		ips, ok := v.([]interface{})
+		if !ok {
+			return nil, MalformedConfigError
+		}
+		rateLimits.ExemptIPs = nil
+		for _, ip := range ips {
+			ipStr, ok := ip.(string)
+			if !ok {
+				return nil, MalformedConfigError
+			}
+			rateLimits.ExemptIPs = append(rateLimits.ExemptIPs, ipStr)
+		}
+	}
+	if v, ok := rl["Costs"]; ok {
+		costs, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, MalformedConfigError
+		}
+		for route, c := range costs {
+			cost, ok := c.(float64)
+			if !ok || cost < 0 || len(strings.Fields(route)) != 2 {
+				return nil, MalformedConfigError
+			}
+			rateLimits.Costs[route] = int(cost)
+		}
+	}
+	return &rateLimits, nil
+}
+
+// Config files created before the event log existed keep events for a week
+func GetEventLogConfig(cfgBytes []byte) (*EventLogConfig, error) {
+	var cfgIface interface{}
+	json.Unmarshal(cfgBytes, &cfgIface)
+	eventLog := &EventLogConfig{MaxAge: time.Hour * 24 * 7, MaxEvents: 10000}
+
+	cfg, ok := cfgIface.(map[string]interface{})
+	if !ok {
+		return eventLog, MalformedConfigError
+	}
+
+	elcfg, ok := cfg["EventLog"]
+	if !ok {
+		return eventLog, nil
+	}
+	el, ok := elcfg.(map[string]interface{})
+	if !ok {
+		return eventLog, MalformedConfigError
+	}
+
+	if v, ok := el["MaxAge"]; ok {
+		s, ok := v.(string)
+		if !ok {
+			return eventLog, MalformedConfigError
+		}
+		maxAge, err := time.ParseDuration(s)
+		if err != nil {
+			return eventLog, err
+		}
+		eventLog.MaxAge = maxAge
+	}
+	if v, ok := el["MaxEvents"]; ok {
+		n, ok := v.(float64)
+		if !ok || n < 0 {
+			return eventLog, MalformedConfigError
+		}
+		eventLog.MaxEvents = int(n)
+	}
+	return eventLog, nil
+}
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/exchange"
 	"github.com/ipfs/go-ipfs/repo/fsrepo"
 	"io/ioutil"
 	"os"
@@ -51,6 +52,9 @@ func TestGetApiConfig(t *testing.T) {
 	if config.SSLKey == "" {
 		t.Error("Expected test SSL key, got ", config.SSLKey)
 	}
+	if config.GRPCAddr != "127.0.0.1:4003" {
+		t.Error("Expected GRPCAddr = 127.0.0.1:4003, got ", config.GRPCAddr)
+	}
 	if err != nil {
 		t.Error("GetAPIAuthentication threw an unexpected error")
 	}
@@ -61,6 +65,47 @@ func TestGetApiConfig(t *testing.T) {
 	}
 }
 
+func TestGetApiConfigRateLimits(t *testing.T) {
+	configFile, err := ioutil.ReadFile(testConfigPath)
+	if err != nil {
+		t.Error(err)
+	}
+	config, err := GetAPIConfig(configFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rl := config.RateLimits
+	if !rl.Enabled {
+		t.Error("Expected rate limits to be enabled")
+	}
+	if rl.PerIP != (RateLimit{RequestsPerMinute: 60, Burst: 20}) {
+		t.Error("PerIP does not equal expected value")
+	}
+	if rl.PerCredential != (RateLimit{RequestsPerMinute: 120, Burst: 50}) {
+		t.Error("PerCredential does not equal expected value")
+	}
+	if len(rl.ExemptIPs) != 0 {
+		t.Error("Expected no exempt IPs")
+	}
+	if len(rl.Costs) != 2 || rl.Costs["POST /ob/fetchprofiles"] != 25 || rl.Costs["GET /ob/listings/"] != 8 {
+		t.Error("Costs do not equal expected value")
+	}
+
+	// Configs without rate limits use the defaults
+	config, err = GetAPIConfig([]byte(`{"JSON-API": {"Enabled": true, "Authenticated": false, "AllowedIPs": [], "Username": "", "Password": "", "SSL": false, "SSLCert": "", "SSLKey": ""}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !config.RateLimits.Enabled || config.RateLimits.PerIP != DefaultRateLimits.PerIP || len(config.RateLimits.ExemptIPs) != 2 {
+		t.Error("Expected the default rate limits")
+	}
+
+	_, err = GetAPIConfig([]byte(`{"JSON-API": {"Enabled": true, "Authenticated": false, "AllowedIPs": [], "Username": "", "Password": "", "SSL": false, "SSLCert": "", "SSLKey": "", "RateLimits": {"Costs": {"fetchprofiles": 5}}}}`))
+	if err != MalformedConfigError {
+		t.Error("Expected a cost without a method to be rejected")
+	}
+}
+
 func TestGetWalletConfig(t *testing.T) {
 	configFile, err := ioutil.ReadFile(testConfigPath)
 	if err != nil {
@@ -101,6 +146,36 @@ func TestGetWalletConfig(t *testing.T) {
 	}
 }
 
+func TestGetAdditionalWalletConfigs(t *testing.T) {
+	configFile, err := ioutil.ReadFile(testConfigPath)
+	if err != nil {
+		t.Error(err)
+	}
+	configs, err := GetAdditionalWalletConfigs(configFile)
+	if err != nil {
+		t.Error("GetAdditionalWalletConfigs threw an unexpected error")
+	}
+	if len(configs) != 1 {
+		t.Fatal("Expected one additional wallet, got ", len(configs))
+	}
+	if configs[0].Type != "bitcoincash" {
+		t.Error("Type does not equal expected value")
+	}
+	if configs[0].MaxFee != 200 {
+		t.Error("Expected maxFee to be 200, got ", configs[0].MaxFee)
+	}
+
+	configs, err = GetAdditionalWalletConfigs([]byte("{}"))
+	if configs != nil || err != nil {
+		t.Error("Expected no additional wallets when the section is missing")
+	}
+
+	_, err = GetAdditionalWalletConfigs([]byte{})
+	if err == nil {
+		t.Error("GetAdditionalWalletConfigs didn't throw an error")
+	}
+}
+
 func TestGetDropboxApiToken(t *testing.T) {
 	configFile, err := ioutil.ReadFile(testConfigPath)
 	if err != nil {
@@ -145,6 +220,219 @@ func TestRepublishInterval(t *testing.T) {
 	}
 }
 
+func TestGetRatingAmendmentWindow(t *testing.T) {
+	configFile, err := ioutil.ReadFile(testConfigPath)
+	if err != nil {
+		t.Error(err)
+	}
+	window, err := GetRatingAmendmentWindow(configFile)
+	if window != time.Hour*24*7 {
+		t.Error("RatingAmendmentWindow does not equal expected value")
+	}
+	if err != nil {
+		t.Error("RatingAmendmentWindow threw an unexpected error")
+	}
+
+	window, err = GetRatingAmendmentWindow([]byte("{}"))
+	if window != DefaultRatingAmendmentWindow {
+		t.Error("Expected default window, got ", window)
+	}
+	if err != nil {
+		t.Error("GetRatingAmendmentWindow threw an unexpected error")
+	}
+
+	window, err = GetRatingAmendmentWindow([]byte{})
+	if window != time.Second*0 {
+		t.Error("Expected zero duration, got ", window)
+	}
+	if err == nil {
+		t.Error("GetRatingAmendmentWindow didn't throw an error")
+	}
+}
+
+func TestGetAutoSweepConfigs(t *testing.T) {
+	configFile, err := ioutil.ReadFile(testConfigPath)
+	if err != nil {
+		t.Error(err)
+	}
+	configs, err := GetAutoSweepConfigs(configFile)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(configs) != 1 {
+		t.Fatal("Expected one auto-sweep config")
+	}
+	c := configs[0]
+	if c.Coin != "BTC" || c.Destination != "1HkqUSJkGgAGdeN3RvL69VrTQTMdPz2GGL" || c.FeeLevel != "ECONOMIC" {
+		t.Error("Auto-sweep config has the wrong strings")
+	}
+	if c.Threshold != 5000000 || c.Reserve != 100000 || c.MinInterval != time.Hour*12 {
+		t.Error("Auto-sweep config has the wrong amounts or interval")
+	}
+
+	configs, err = GetAutoSweepConfigs([]byte(`{"AutoSweep": [{"Coin": "BTC"}]}`))
+	if err == nil {
+		t.Error("GetAutoSweepConfigs didn't reject a config without a destination")
+	}
+
+	configs, err = GetAutoSweepConfigs([]byte("{}"))
+	if err != nil || len(configs) != 0 {
+		t.Error("Expected no auto-sweep configs")
+	}
+}
+
+func TestGetColdStorageConfig(t *testing.T) {
+	configFile, err := ioutil.ReadFile(testConfigPath)
+	if err != nil {
+		t.Error(err)
+	}
+	cs, err := GetColdStorageConfig(configFile)
+	if err != nil {
+		t.Error("GetColdStorageConfig threw an unexpected error")
+	}
+	if cs.Enabled {
+		t.Error("Cold storage should be disabled")
+	}
+
+	cs, err = GetColdStorageConfig([]byte("{}"))
+	if err != nil || cs.Enabled {
+		t.Error("Expected disabled cold storage for a config without it")
+	}
+
+	_, err = GetColdStorageConfig([]byte(`{"ColdStorage": {"Enabled": true}}`))
+	if err == nil {
+		t.Error("GetColdStorageConfig didn't reject a config without a master public key")
+	}
+
+	_, err = GetColdStorageConfig([]byte(`{"ColdStorage": {"Enabled": true, "MasterPublicKey": "xpub", "BitcoinSig": "00"}}`))
+	if err == nil {
+		t.Error("GetColdStorageConfig didn't reject a config without an account public key")
+	}
+
+	cs, err = GetColdStorageConfig([]byte(`{"ColdStorage": {"Enabled": true, "MasterPublicKey": "xpub", "AccountPublicKey": "xpub2", "BitcoinSig": "00"}}`))
+	if err != nil || cs.AccountPublicKey != "xpub2" {
+		t.Error("GetColdStorageConfig didn't read the account public key")
+	}
+
+	_, err = GetColdStorageConfig([]byte{})
+	if err == nil {
+		t.Error("GetColdStorageConfig didn't throw an error")
+	}
+}
+
+func TestGetExchangeRatesConfig(t *testing.T) {
+	configFile, err := ioutil.ReadFile(testConfigPath)
+	if err != nil {
+		t.Error(err)
+	}
+	rates, err := GetExchangeRatesConfig(configFile)
+	if err != nil {
+		t.Error("GetExchangeRatesConfig threw an unexpected error")
+	}
+	if len(rates.Providers) != 2 {
+		t.Fatal("Providers does not equal expected value")
+	}
+	if rates.Providers[1].Name != "selfhosted" || rates.Providers[1].URL != "http://localhost:8085/rates" || rates.Providers[1].Decoder != "simple" {
+		t.Error("Provider does not equal expected value")
+	}
+	if rates.MaxDeviation != 0.05 {
+		t.Error("MaxDeviation does not equal expected value")
+	}
+	if rates.MaxAge != time.Minute*30 {
+		t.Error("MaxAge does not equal expected value")
+	}
+
+	rates, err = GetExchangeRatesConfig([]byte("{}"))
+	if err != nil || len(rates.Providers) != len(exchange.DefaultProviders) || rates.MaxAge != exchange.DefaultMaxAge {
+		t.Error("Expected the default providers for a config without them")
+	}
+
+	_, err = GetExchangeRatesConfig([]byte(`{"ExchangeRates": {"Providers": [{"Name": "nourl", "Decoder": "bitpay"}]}}`))
+	if err == nil {
+		t.Error("GetExchangeRatesConfig didn't reject a provider without a URL")
+	}
+
+	_, err = GetExchangeRatesConfig([]byte(`{"ExchangeRates": {"Providers": []}}`))
+	if err == nil {
+		t.Error("GetExchangeRatesConfig didn't reject a config without providers")
+	}
+
+	_, err = GetExchangeRatesConfig([]byte{})
+	if err == nil {
+		t.Error("GetExchangeRatesConfig didn't throw an error")
+	}
+}
+
+func TestGetEventLogConfig(t *testing.T) {
+	configFile, err := ioutil.ReadFile(testConfigPath)
+	if err != nil {
+		t.Error(err)
+	}
+	eventLog, err := GetEventLogConfig(configFile)
+	if err != nil {
+		t.Error("GetEventLogConfig threw an unexpected error")
+	}
+	if eventLog.MaxAge != time.Hour*72 {
+		t.Error("MaxAge does not equal expected value")
+	}
+	if eventLog.MaxEvents != 500 {
+		t.Error("MaxEvents does not equal expected value")
+	}
+
+	eventLog, err = GetEventLogConfig([]byte("{}"))
+	if err != nil || eventLog.MaxAge != time.Hour*24*7 || eventLog.MaxEvents != 10000 {
+		t.Error("Expected the default retention for a config without an event log")
+	}
+
+	_, err = GetEventLogConfig([]byte(`{"EventLog": {"MaxAge": "a week"}}`))
+	if err == nil {
+		t.Error("GetEventLogConfig didn't reject an invalid MaxAge")
+	}
+}
+
+func TestGetLightningConfig(t *testing.T) {
+	configFile, err := ioutil.ReadFile(testConfigPath)
+	if err != nil {
+		t.Error(err)
+	}
+	ln, err := GetLightningConfig(configFile)
+	if err != nil {
+		t.Error("GetLightningConfig threw an unexpected error")
+	}
+	if ln.Backend != "lnd" {
+		t.Error("Backend does not equal expected value")
+	}
+	if ln.Host != "localhost:8080" {
+		t.Error("Host does not equal expected value")
+	}
+	if ln.MaxOrderAmount != 500000 {
+		t.Error("MaxOrderAmount does not equal expected value")
+	}
+	if ln.InvoiceExpiry != time.Minute*30 {
+		t.Error("InvoiceExpiry does not equal expected value")
+	}
+
+	ln, err = GetLightningConfig([]byte("{}"))
+	if err != nil || ln.Backend != "" || ln.InvoiceExpiry != time.Hour {
+		t.Error("Expected a disabled Lightning config for a config without it")
+	}
+
+	_, err = GetLightningConfig([]byte(`{"Lightning": {"Backend": "lnd"}}`))
+	if err == nil {
+		t.Error("GetLightningConfig didn't reject an lnd backend without a host")
+	}
+
+	_, err = GetLightningConfig([]byte(`{"Lightning": {"Backend": "eclair"}}`))
+	if err == nil {
+		t.Error("GetLightningConfig didn't reject an unknown backend")
+	}
+
+	_, err = GetLightningConfig([]byte{})
+	if err == nil {
+		t.Error("GetLightningConfig didn't throw an error")
+	}
+}
+
 func TestGetResolverConfig(t *testing.T) {
 	configFile, err := ioutil.ReadFile(testConfigPath)
 	if err != nil {
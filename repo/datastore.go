@@ -26,6 +26,12 @@ type Datastore interface {
 	Notifications() Notifications
 	Coupons() Coupons
 	TxMetadata() TxMetadata
+	UtxoMetadata() UtxoMetadata
+	Sweeps() Sweeps
+	RateHistory() RateHistory
+	APITokens() APITokens
+	Webhooks() Webhooks
+	EventLog() EventLog
 	ModeratedStores() ModeratedStores
 	Ping() error
 	Close()
@@ -39,6 +45,12 @@ type Config interface {
 	// Return the mnemonic string
 	GetMnemonic() (string, error)
 
+	// Remove the mnemonic once the wallet keys are kept in cold storage
+	ClearMnemonic() error
+
+	// Put back a mnemonic removed by ClearMnemonic
+	SetMnemonic(mnemonic string) error
+
 	// Return the identity key
 	GetIdentityKey() ([]byte, error)
 
@@ -176,6 +188,9 @@ type Purchases interface {
 	// Return a purchase given the payment address
 	GetByPaymentAddress(addr btc.Address) (contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord, err error)
 
+	// Return a purchase paid over Lightning given the invoice's payment hash
+	GetByPaymentHash(hash string) (contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord, err error)
+
 	// Return a purchase given the order ID
 	GetByOrderId(orderId string) (contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord, read bool, err error)
 
@@ -205,6 +220,9 @@ type Sales interface {
 	// Return a sale given the payment address
 	GetByPaymentAddress(addr btc.Address) (contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord, err error)
 
+	// Return a sale paid over Lightning given the invoice's payment hash
+	GetByPaymentHash(hash string) (contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord, err error)
+
 	// Return a sale given the order ID
 	GetByOrderId(orderId string) (contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord, read bool, err error)
 
@@ -330,6 +348,97 @@ type TxMetadata interface {
 	Delete(txid string) error
 }
 
+type UtxoMetadata interface {
+
+	// Put the label and frozen flag for an outpoint of a coin to the db
+	Put(m UtxoMeta) error
+
+	// Get the metadata given the coin and the outpoint in txid:index form
+	Get(coin, outpoint string) (UtxoMeta, error)
+
+	// Get a map of the outpoint to each metadata object of the coin
+	GetAll(coin string) (map[string]UtxoMeta, error)
+
+	// Delete a metadata entry
+	Delete(coin, outpoint string) error
+}
+
+type Sweeps interface {
+
+	// Put a sweep to the db
+	Put(s Sweep) error
+
+	// Return the sweeps of the coin, newest first. An empty coin returns the sweeps of all coins.
+	GetAll(coin string) ([]Sweep, error)
+}
+
+type RateHistory interface {
+
+	// Record the exchange rate of a coin at a point in time
+	Put(r RateSnapshot) error
+
+	// Return the recorded rate closest to the time. If none was recorded within maxDistance of it, return sql.ErrNoRows.
+	Get(coin, currency string, t time.Time, maxDistance time.Duration) (RateSnapshot, error)
+}
+
+type APITokens interface {
+
+	// Save a new token. The name must be unique, otherwise ErrAPITokenExists is returned.
+	Put(token APIToken) error
+
+	// Return the token with the given hash
+	GetByHash(hash string) (APIToken, error)
+
+	// Return all tokens ordered by name
+	GetAll() ([]APIToken, error)
+
+	// Delete the token with the given name
+	Delete(name string) error
+}
+
+type Webhooks interface {
+
+	// Save a webhook subscription
+	Put(hook Webhook) error
+
+	// Return the webhook with the given ID
+	Get(id string) (Webhook, error)
+
+	// Return all webhooks
+	GetAll() ([]Webhook, error)
+
+	// Delete a webhook and its delivery log
+	Delete(id string) error
+
+	// Log a new delivery and return its ID
+	PutDelivery(d WebhookDelivery) (int64, error)
+
+	// Update the status of a logged delivery
+	UpdateDelivery(d WebhookDelivery) error
+
+	// Return the most recent deliveries to a webhook, newest first
+	GetDeliveries(webhookID string, limit int) ([]WebhookDelivery, error)
+
+	// Return the pending deliveries due to be retried by the given time
+	GetPendingDeliveries(before time.Time) ([]WebhookDelivery, error)
+
+	// Delete deliveries created before the given time
+	PruneDeliveries(before time.Time) error
+}
+
+type EventLog interface {
+
+	// Append an event and return its sequence number
+	Put(e Event) (int64, error)
+
+	// Return up to limit events after the given sequence number, oldest first
+	GetSince(seq int64, limit int) ([]Event, error)
+
+	// Delete events older than the given time and all but the latest keep events.
+	// A zero time or keep disables that limit.
+	Prune(before time.Time, keep int) error
+}
+
 type ModeratedStores interface {
 	// Put a B58 encoded peer ID to the database
 	Put(peerId string) error
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"strings"
+	"sync"
+	"time"
+)
+
+type APITokensDB struct {
+	db   *sql.DB
+	lock *sync.Mutex
+}
+
+func (a *APITokensDB) Put(token repo.APIToken) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	tx, _ := a.db.Begin()
+	stmt, err := tx.Prepare("insert into apitokens(name, hash, scopes, created) values(?,?,?,?)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(token.Name, token.Hash, strings.Join(token.Scopes, ","), token.Created.Unix())
+	if err != nil {
+		tx.Rollback()
+		if strings.Contains(err.Error(), "UNIQUE constraint failed: apitokens.name") {
+			return repo.ErrAPITokenExists
+		}
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (a *APITokensDB) GetByHash(hash string) (repo.APIToken, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	stmt, err := a.db.Prepare("select name, scopes, created from apitokens where hash=?")
+	if err != nil {
+		return repo.APIToken{}, err
+	}
+	defer stmt.Close()
+	var name, scopes string
+	var created int64
+	if err := stmt.QueryRow(hash).Scan(&name, &scopes, &created); err != nil {
+		return repo.APIToken{}, err
+	}
+	return repo.APIToken{
+		Name:    name,
+		Hash:    hash,
+		Scopes:  strings.Split(scopes, ","),
+		Created: time.Unix(created, 0),
+	}, nil
+}
+
+func (a *APITokensDB) GetAll() ([]repo.APIToken, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	var ret []repo.APIToken
+	rows, err := a.db.Query("select name, hash, scopes, created from apitokens order by name")
+	if err != nil {
+		return ret, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, hash, scopes string
+		var created int64
+		if err := rows.Scan(&name, &hash, &scopes, &created); err != nil {
+			return ret, err
+		}
+		ret = append(ret, repo.APIToken{
+			Name:    name,
+			Hash:    hash,
+			Scopes:  strings.Split(scopes, ","),
+			Created: time.Unix(created, 0),
+		})
+	}
+	return ret, nil
+}
+
+func (a *APITokensDB) Delete(name string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	res, err := a.db.Exec("delete from apitokens where name=?", name)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"sync"
+	"testing"
+)
+
+var apiTokensDB APITokensDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	apiTokensDB = APITokensDB{
+		db:   conn,
+		lock: new(sync.Mutex),
+	}
+}
+
+func TestAPITokensDB(t *testing.T) {
+	token, secret, err := repo.NewAPIToken("fulfillment", []string{repo.ScopeReadOnly, repo.ScopeOrders})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := apiTokensDB.Put(token); err != nil {
+		t.Error(err)
+	}
+	if err := apiTokensDB.Put(token); err != repo.ErrAPITokenExists {
+		t.Error("Expected ErrAPITokenExists for a second token with the same name")
+	}
+
+	ret, err := apiTokensDB.GetByHash(repo.HashAPIToken(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.Name != "fulfillment" || len(ret.Scopes) != 2 || !ret.HasScope(repo.ScopeOrders) || ret.HasScope(repo.ScopeWalletSpend) {
+		t.Error("API tokens db returned wrong token")
+	}
+	if _, err := apiTokensDB.GetByHash(repo.HashAPIToken("wrong")); err == nil {
+		t.Error("API tokens db returned a token for the wrong hash")
+	}
+
+	tokens, err := apiTokensDB.GetAll()
+	if err != nil || len(tokens) != 1 {
+		t.Error("API tokens db returned wrong number of tokens")
+	}
+
+	if err := apiTokensDB.Delete("fulfillment"); err != nil {
+		t.Error(err)
+	}
+	if _, err := apiTokensDB.GetByHash(repo.HashAPIToken(secret)); err == nil {
+		t.Error("Failed to delete token")
+	}
+	if err := apiTokensDB.Delete("fulfillment"); err == nil {
+		t.Error("Deleted a token that doesn't exist")
+	}
+}
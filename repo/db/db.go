@@ -34,6 +34,12 @@ type SQLiteDatastore struct {
 	notifications   repo.Notifications
 	coupons         repo.Coupons
 	txMetadata      repo.TxMetadata
+	utxoMetadata    repo.UtxoMetadata
+	sweeps          repo.Sweeps
+	rateHistory     repo.RateHistory
+	apiTokens       repo.APITokens
+	webhooks        repo.Webhooks
+	eventLog        repo.EventLog
 	moderatedStores repo.ModeratedStores
 	db              *sql.DB
 	lock            *sync.Mutex
@@ -133,6 +139,30 @@ func Create(repoPath, password string, testnet bool) (*SQLiteDatastore, error) {
 			db:   conn,
 			lock: l,
 		},
+		utxoMetadata: &UtxoMetadataDB{
+			db:   conn,
+			lock: l,
+		},
+		sweeps: &SweepsDB{
+			db:   conn,
+			lock: l,
+		},
+		rateHistory: &RateHistoryDB{
+			db:   conn,
+			lock: l,
+		},
+		apiTokens: &APITokensDB{
+			db:   conn,
+			lock: l,
+		},
+		webhooks: &WebhooksDB{
+			db:   conn,
+			lock: l,
+		},
+		eventLog: &EventLogDB{
+			db:   conn,
+			lock: l,
+		},
 		moderatedStores: &ModeratedDB{
 			db:   conn,
 			lock: l,
@@ -228,6 +258,30 @@ func (d *SQLiteDatastore) TxMetadata() repo.TxMetadata {
 	return d.txMetadata
 }
 
+func (d *SQLiteDatastore) UtxoMetadata() repo.UtxoMetadata {
+	return d.utxoMetadata
+}
+
+func (d *SQLiteDatastore) Sweeps() repo.Sweeps {
+	return d.sweeps
+}
+
+func (d *SQLiteDatastore) RateHistory() repo.RateHistory {
+	return d.rateHistory
+}
+
+func (d *SQLiteDatastore) APITokens() repo.APITokens {
+	return d.apiTokens
+}
+
+func (d *SQLiteDatastore) Webhooks() repo.Webhooks {
+	return d.webhooks
+}
+
+func (d *SQLiteDatastore) EventLog() repo.EventLog {
+	return d.eventLog
+}
+
 func (d *SQLiteDatastore) ModeratedStores() repo.ModeratedStores {
 	return d.moderatedStores
 }
@@ -290,7 +344,19 @@ func initDatabaseTables(db *sql.DB, password string) error {
 	create table utxos (outpoint text primary key not null, value integer, height integer, scriptPubKey text, watchOnly integer);
 	create table stxos (outpoint text primary key not null, value integer, height integer, scriptPubKey text, watchOnly integer, spendHeight integer, spendTxid text);
 	create table txns (txid text primary key not null, value integer, height integer, timestamp integer, watchOnly integer, tx blob);
-	create table txmetadata (txid text primary key not null, address text, memo text, orderID text, thumbnail text, canBumpFee integer);
+	create table txmetadata (txid text primary key not null, address text, memo text, orderID text, thumbnail text, canBumpFee integer, outputMemos text);
+	create table utxometadata (coin text not null, outpoint text not null, label text, frozen integer, primary key (coin, outpoint));
+	create table sweeps (txid text primary key not null, coin text, destination text, address text, amount integer, timestamp integer);
+	create index index_sweeps on sweeps (coin);
+	create table ratehistory (coin text, currency text, rate real, timestamp integer);
+	create index index_ratehistory on ratehistory (coin, currency, timestamp);
+	create table apitokens (name text primary key not null, hash text, scopes text, created integer);
+	create unique index index_apitokens on apitokens (hash);
+	create table webhooks (id text primary key not null, url text, events text, secret text, created integer);
+	create table webhookdeliveries (id integer primary key autoincrement, webhookid text, event text, payload blob, status text, attempts integer, responsecode integer, error text, created integer, lastattempt integer, nextattempt integer);
+	create index index_webhookdeliveries on webhookdeliveries (webhookid, status, nextattempt);
+	create table events (seq integer primary key autoincrement, type text, payload blob, timestamp integer);
+	create index index_events on events (timestamp);
 	create table inventory (invID text primary key not null, slug text, variantIndex integer, count integer);
 	create index index_inventory on inventory (slug);
 	create table purchases (orderID text primary key not null, contract blob, state integer, read integer, timestamp integer, total integer, thumbnail text, vendorID text, vendorHandle text, title text, shippingName text, shippingAddress text, paymentAddr text, funded integer, transactions blob);
@@ -368,6 +434,20 @@ func (c *ConfigDB) GetMnemonic() (string, error) {
 	return mnemonic, nil
 }
 
+func (c *ConfigDB) ClearMnemonic() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update config set value='' where key=?", "mnemonic")
+	return err
+}
+
+func (c *ConfigDB) SetMnemonic(mnemonic string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update config set value=? where key=?", mnemonic, "mnemonic")
+	return err
+}
+
 func (c *ConfigDB) GetIdentityKey() ([]byte, error) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
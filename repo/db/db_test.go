@@ -1,8 +1,10 @@
 package db
 
 import (
+	"database/sql"
 	"os"
 	"path"
+	"sync"
 	"testing"
 	"time"
 )
@@ -52,6 +54,29 @@ func TestInit(t *testing.T) {
 	}
 }
 
+func TestClearMnemonic(t *testing.T) {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	config := &ConfigDB{db: conn, lock: new(sync.Mutex)}
+	if err := config.Init("Mnemonic Passphrase", []byte("Private Key"), "", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.ClearMnemonic(); err != nil {
+		t.Error(err)
+	}
+	if mn, _ := config.GetMnemonic(); mn != "" {
+		t.Error("Mnemonic was not cleared")
+	}
+	if pk, err := config.GetIdentityKey(); err != nil || string(pk) != "Private Key" {
+		t.Error("Clearing the mnemonic changed the identity key")
+	}
+	if err := config.SetMnemonic("Mnemonic Passphrase"); err != nil {
+		t.Error(err)
+	}
+	if mn, _ := config.GetMnemonic(); mn != "Mnemonic Passphrase" {
+		t.Error("Mnemonic was not restored")
+	}
+}
+
 func TestInterface(t *testing.T) {
 	if testDB.Config() != testDB.config {
 		t.Error("Config() return wrong value")
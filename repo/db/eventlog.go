@@ -0,0 +1,60 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"sync"
+	"time"
+)
+
+type EventLogDB struct {
+	db   *sql.DB
+	lock *sync.Mutex
+}
+
+func (e *EventLogDB) Put(event repo.Event) (int64, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	res, err := e.db.Exec("insert into events(type, payload, timestamp) values(?,?,?)", event.Type, event.Payload, event.Timestamp.Unix())
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+func (e *EventLogDB) GetSince(seq int64, limit int) ([]repo.Event, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	var ret []repo.Event
+	rows, err := e.db.Query("select seq, type, payload, timestamp from events where seq>? order by seq limit ?", seq, limit)
+	if err != nil {
+		return ret, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var event repo.Event
+		var timestamp int64
+		if err := rows.Scan(&event.Seq, &event.Type, &event.Payload, &timestamp); err != nil {
+			return ret, err
+		}
+		event.Timestamp = time.Unix(timestamp, 0)
+		ret = append(ret, event)
+	}
+	return ret, nil
+}
+
+func (e *EventLogDB) Prune(before time.Time, keep int) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if !before.IsZero() {
+		if _, err := e.db.Exec("delete from events where timestamp<?", before.Unix()); err != nil {
+			return err
+		}
+	}
+	if keep > 0 {
+		if _, err := e.db.Exec("delete from events where seq<=(select max(seq) from events)-?", keep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
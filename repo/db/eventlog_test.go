@@ -0,0 +1,71 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"sync"
+	"testing"
+	"time"
+)
+
+var eventLogDB EventLogDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	eventLogDB = EventLogDB{
+		db:   conn,
+		lock: new(sync.Mutex),
+	}
+}
+
+func TestEventLogDB(t *testing.T) {
+	now := time.Now()
+	var seqs []int64
+	for i, typ := range []string{"order", "chat", "payment", "wallet"} {
+		seq, err := eventLogDB.Put(repo.Event{
+			Type:      typ,
+			Payload:   []byte(`{"` + typ + `": {}}`),
+			Timestamp: now.Add(time.Duration(i-3) * time.Hour),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		seqs = append(seqs, seq)
+	}
+	for i := 1; i < len(seqs); i++ {
+		if seqs[i] <= seqs[i-1] {
+			t.Error("Sequence numbers are not increasing")
+		}
+	}
+
+	events, err := eventLogDB.GetSince(seqs[0], 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].Seq != seqs[1] || events[0].Type != "chat" || string(events[1].Payload) != `{"payment": {}}` {
+		t.Error("Event log returned wrong events")
+	}
+
+	if err := eventLogDB.Prune(now.Add(-150*time.Minute), 0); err != nil {
+		t.Fatal(err)
+	}
+	events, err = eventLogDB.GetSince(0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 || events[0].Type != "chat" {
+		t.Error("Failed to prune events by age")
+	}
+
+	if err := eventLogDB.Prune(time.Time{}, 1); err != nil {
+		t.Fatal(err)
+	}
+	events, err = eventLogDB.GetSince(0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Seq != seqs[3] {
+		t.Error("Failed to prune events by count")
+	}
+}
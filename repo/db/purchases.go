@@ -54,6 +54,8 @@ func (p *PurchasesDB) Put(orderID string, contract pb.RicardianContract, state p
 		paymentAddr = contract.BuyerOrder.Payment.Address
 	} else if contract.BuyerOrder.Payment.Method == pb.Order_Payment_ADDRESS_REQUEST {
 		paymentAddr = contract.VendorOrderConfirmation.PaymentAddress
+	} else if contract.BuyerOrder.Payment.Method == pb.Order_Payment_LIGHTNING {
+		paymentAddr = contract.VendorOrderConfirmation.PaymentHash
 	}
 	defer stmt.Close()
 	_, err = stmt.Exec(
@@ -206,6 +208,15 @@ func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sor
 }
 
 func (p *PurchasesDB) GetByPaymentAddress(addr btc.Address) (*pb.RicardianContract, pb.OrderState, bool, []*wallet.TransactionRecord, error) {
+	return p.getByPaymentAddr(addr.EncodeAddress())
+}
+
+func (p *PurchasesDB) GetByPaymentHash(hash string) (*pb.RicardianContract, pb.OrderState, bool, []*wallet.TransactionRecord, error) {
+	return p.getByPaymentAddr(hash)
+}
+
+// Lightning orders store the invoice's payment hash as their payment address
+func (p *PurchasesDB) getByPaymentAddr(paymentAddr string) (*pb.RicardianContract, pb.OrderState, bool, []*wallet.TransactionRecord, error) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
 	stmt, err := p.db.Prepare("select contract, state, funded, transactions from purchases where paymentAddr=?")
@@ -214,7 +225,7 @@ func (p *PurchasesDB) GetByPaymentAddress(addr btc.Address) (*pb.RicardianContra
 	var stateInt int
 	var fundedInt *int
 	var serializedTransactions []byte
-	err = stmt.QueryRow(addr.EncodeAddress()).Scan(&contract, &stateInt, &fundedInt, &serializedTransactions)
+	err = stmt.QueryRow(paymentAddr).Scan(&contract, &stateInt, &fundedInt, &serializedTransactions)
 	if err != nil {
 		return nil, pb.OrderState(0), false, nil, err
 	}
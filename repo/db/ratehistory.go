@@ -0,0 +1,53 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"sync"
+	"time"
+)
+
+type RateHistoryDB struct {
+	db   *sql.DB
+	lock *sync.Mutex
+}
+
+func (r *RateHistoryDB) Put(snapshot repo.RateSnapshot) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	tx, _ := r.db.Begin()
+	stmt, err := tx.Prepare("insert into ratehistory(coin, currency, rate, timestamp) values(?,?,?,?)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(snapshot.Coin, snapshot.Currency, snapshot.Rate, snapshot.Timestamp.Unix())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (r *RateHistoryDB) Get(coin, currency string, t time.Time, maxDistance time.Duration) (repo.RateSnapshot, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	stmt, err := r.db.Prepare("select rate, timestamp from ratehistory where coin=? and currency=? and abs(timestamp - ?) <= ? order by abs(timestamp - ?) limit 1")
+	if err != nil {
+		return repo.RateSnapshot{}, err
+	}
+	defer stmt.Close()
+	var rate float64
+	var timestamp int64
+	if err := stmt.QueryRow(coin, currency, t.Unix(), int64(maxDistance/time.Second), t.Unix()).Scan(&rate, &timestamp); err != nil {
+		return repo.RateSnapshot{}, err
+	}
+	return repo.RateSnapshot{
+		Coin:      coin,
+		Currency:  currency,
+		Rate:      rate,
+		Timestamp: time.Unix(timestamp, 0),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"sync"
+	"testing"
+	"time"
+)
+
+var rateHistoryDB RateHistoryDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	rateHistoryDB = RateHistoryDB{
+		db:   conn,
+		lock: new(sync.Mutex),
+	}
+}
+
+func TestRateHistoryDB_Get(t *testing.T) {
+	now := time.Now()
+	rateHistoryDB.Put(repo.RateSnapshot{Coin: "BTC", Currency: "USD", Rate: 6000, Timestamp: now.Add(-time.Hour * 24)})
+	rateHistoryDB.Put(repo.RateSnapshot{Coin: "BTC", Currency: "USD", Rate: 6500, Timestamp: now})
+	rateHistoryDB.Put(repo.RateSnapshot{Coin: "BTC", Currency: "EUR", Rate: 5000, Timestamp: now})
+
+	snapshot, err := rateHistoryDB.Get("BTC", "USD", now.Add(-time.Hour*23), time.Hour*2)
+	if err != nil {
+		t.Error(err)
+	}
+	if snapshot.Rate != 6000 {
+		t.Error("Rate history db returned wrong rate")
+	}
+	snapshot, err = rateHistoryDB.Get("BTC", "EUR", now.Add(-time.Hour), time.Hour*2)
+	if err != nil {
+		t.Error(err)
+	}
+	if snapshot.Rate != 5000 {
+		t.Error("Rate history db returned rate for the wrong currency")
+	}
+	if _, err := rateHistoryDB.Get("ZEC", "USD", now, time.Hour*2); err == nil {
+		t.Error("Rate history db returned a rate for an unknown coin")
+	}
+	if _, err := rateHistoryDB.Get("BTC", "USD", now.Add(-time.Hour*12), time.Hour*2); err != sql.ErrNoRows {
+		t.Error("Rate history db returned a rate recorded too far from the time")
+	}
+	if _, err := rateHistoryDB.Get("BTC", "USD", now.Add(-time.Hour*48), time.Hour*2); err != sql.ErrNoRows {
+		t.Error("Rate history db returned a rate for a time before the first snapshot")
+	}
+}
@@ -60,6 +60,8 @@ func (s *SalesDB) Put(orderID string, contract pb.RicardianContract, state pb.Or
 		address = contract.BuyerOrder.Payment.Address
 	} else if contract.BuyerOrder.Payment.Method == pb.Order_Payment_ADDRESS_REQUEST {
 		address = contract.VendorOrderConfirmation.PaymentAddress
+	} else if contract.BuyerOrder.Payment.Method == pb.Order_Payment_LIGHTNING {
+		address = contract.VendorOrderConfirmation.PaymentHash
 	}
 	defer stmt.Close()
 	_, err = stmt.Exec(
@@ -212,6 +214,15 @@ func (s *SalesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 }
 
 func (s *SalesDB) GetByPaymentAddress(addr btc.Address) (*pb.RicardianContract, pb.OrderState, bool, []*wallet.TransactionRecord, error) {
+	return s.getByPaymentAddr(addr.EncodeAddress())
+}
+
+func (s *SalesDB) GetByPaymentHash(hash string) (*pb.RicardianContract, pb.OrderState, bool, []*wallet.TransactionRecord, error) {
+	return s.getByPaymentAddr(hash)
+}
+
+// Lightning orders store the invoice's payment hash as their payment address
+func (s *SalesDB) getByPaymentAddr(paymentAddr string) (*pb.RicardianContract, pb.OrderState, bool, []*wallet.TransactionRecord, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 	stmt, err := s.db.Prepare("select contract, state, funded, transactions from sales where paymentAddr=?")
@@ -220,7 +231,7 @@ func (s *SalesDB) GetByPaymentAddress(addr btc.Address) (*pb.RicardianContract,
 	var stateInt int
 	var fundedInt *int
 	var serializedTransactions []byte
-	err = stmt.QueryRow(addr.EncodeAddress()).Scan(&contract, &stateInt, &fundedInt, &serializedTransactions)
+	err = stmt.QueryRow(paymentAddr).Scan(&contract, &stateInt, &fundedInt, &serializedTransactions)
 	if err != nil {
 		return nil, pb.OrderState(0), false, nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"sync"
 )
@@ -284,6 +285,26 @@ func TestSalesGetByPaymentAddress(t *testing.T) {
 	}
 }
 
+func TestSalesGetByPaymentHash(t *testing.T) {
+	c := *contract
+	c.BuyerOrder = proto.Clone(contract.BuyerOrder).(*pb.Order)
+	c.BuyerOrder.Payment.Method = pb.Order_Payment_LIGHTNING
+	c.VendorOrderConfirmation = &pb.OrderConfirmation{PaymentRequest: "lnbc1", PaymentHash: "0a0b0c"}
+	saldb.Put("lightningOrderID", c, 0, false)
+	defer saldb.Delete("lightningOrderID")
+	ret, _, _, _, err := saldb.GetByPaymentHash("0a0b0c")
+	if err != nil {
+		t.Error(err)
+	}
+	if ret != nil && ret.VendorOrderConfirmation.PaymentRequest != "lnbc1" {
+		t.Error("Returned the wrong sale")
+	}
+	_, _, _, _, err = saldb.GetByPaymentHash("0d0e0f")
+	if err == nil {
+		t.Error("Get by unknown payment hash failed to return error")
+	}
+}
+
 func TestSalesGetByOrderId(t *testing.T) {
 	saldb.Put("orderID", *contract, 0, false)
 	_, _, _, _, _, err := saldb.GetByOrderId("orderID")
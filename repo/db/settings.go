@@ -104,6 +104,12 @@ func (s *SettingsDB) Update(settings repo.SettingsData) error {
 	if settings.SMTPSettings == nil {
 		settings.SMTPSettings = current.SMTPSettings
 	}
+	if settings.PushNotifications == nil {
+		settings.PushNotifications = current.PushNotifications
+	}
+	if settings.NotificationPreferences == nil {
+		settings.NotificationPreferences = current.NotificationPreferences
+	}
 	if settings.Version == nil {
 		settings.Version = current.Version
 	}
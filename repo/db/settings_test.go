@@ -93,6 +93,16 @@ func TestSettingsUpdate(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+	push := []repo.PushSettings{{Notifications: true, Service: repo.PushServiceNtfy, URL: "https://ntfy.sh/store", Events: []string{"order"}}}
+	err = sdb.Update(repo.SettingsData{PushNotifications: &push})
+	if err != nil {
+		t.Error(err)
+	}
+	prefs := repo.NotificationPreferences{Events: map[string][]string{"chat": {repo.NotificationChannelUI}}, DailySummary: true}
+	err = sdb.Update(repo.SettingsData{NotificationPreferences: &prefs})
+	if err != nil {
+		t.Error(err)
+	}
 	r := "None"
 	setUpdt2 := repo.SettingsData{
 		TermsAndConditions: &r,
@@ -114,4 +124,10 @@ func TestSettingsUpdate(t *testing.T) {
 	if *set.TermsAndConditions != "None" {
 		t.Error("Settings update failed to put correct value")
 	}
+	if set.PushNotifications == nil || len(*set.PushNotifications) != 1 || (*set.PushNotifications)[0].URL != "https://ntfy.sh/store" {
+		t.Error("Settings update failed to keep the push notification settings")
+	}
+	if set.NotificationPreferences == nil || !set.NotificationPreferences.DailySummary {
+		t.Error("Settings update failed to keep the notification preferences")
+	}
 }
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"sync"
+	"time"
+)
+
+type SweepsDB struct {
+	db   *sql.DB
+	lock *sync.Mutex
+}
+
+func (s *SweepsDB) Put(sweep repo.Sweep) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	tx, _ := s.db.Begin()
+	stmt, err := tx.Prepare("insert or replace into sweeps(txid, coin, destination, address, amount, timestamp) values(?,?,?,?,?,?)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(sweep.Txid, sweep.Coin, sweep.Destination, sweep.Address, sweep.Amount, sweep.Timestamp.Unix())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (s *SweepsDB) GetAll(coin string) ([]repo.Sweep, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var ret []repo.Sweep
+	var rows *sql.Rows
+	var err error
+	if coin == "" {
+		rows, err = s.db.Query("select txid, coin, destination, address, amount, timestamp from sweeps order by timestamp desc")
+	} else {
+		rows, err = s.db.Query("select txid, coin, destination, address, amount, timestamp from sweeps where coin=? order by timestamp desc", coin)
+	}
+	if err != nil {
+		return ret, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var txid, c, destination, address string
+		var amount, timestamp int64
+		if err := rows.Scan(&txid, &c, &destination, &address, &amount, &timestamp); err != nil {
+			return ret, err
+		}
+		ret = append(ret, repo.Sweep{
+			Txid:        txid,
+			Coin:        c,
+			Destination: destination,
+			Address:     address,
+			Amount:      amount,
+			Timestamp:   time.Unix(timestamp, 0),
+		})
+	}
+	return ret, nil
+}
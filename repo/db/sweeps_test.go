@@ -0,0 +1,73 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"sync"
+	"testing"
+	"time"
+)
+
+var sweepsDB SweepsDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	sweepsDB = SweepsDB{
+		db:   conn,
+		lock: new(sync.Mutex),
+	}
+}
+
+func TestSweepsDB_Put(t *testing.T) {
+	sweep := repo.Sweep{
+		Txid:        "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f",
+		Coin:        "BTC",
+		Destination: "1HkqUSJkGgAGdeN3RvL69VrTQTMdPz2GGL",
+		Address:     "1HkqUSJkGgAGdeN3RvL69VrTQTMdPz2GGL",
+		Amount:      150000,
+		Timestamp:   time.Now(),
+	}
+	err := sweepsDB.Put(sweep)
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := sweepsDB.db.Prepare("select txid, coin, amount from sweeps where txid=?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+	var txid, coin string
+	var amount int64
+	err = stmt.QueryRow(sweep.Txid).Scan(&txid, &coin, &amount)
+	if err != nil {
+		t.Error(err)
+	}
+	if txid != sweep.Txid || coin != sweep.Coin || amount != sweep.Amount {
+		t.Error("Sweeps db returned wrong sweep")
+	}
+}
+
+func TestSweepsDB_GetAll(t *testing.T) {
+	now := time.Now()
+	sweepsDB.Put(repo.Sweep{Txid: "a1", Coin: "BCH", Amount: 1, Timestamp: now.Add(-time.Hour)})
+	sweepsDB.Put(repo.Sweep{Txid: "a2", Coin: "BCH", Amount: 2, Timestamp: now})
+	sweepsDB.Put(repo.Sweep{Txid: "a3", Coin: "ZEC", Amount: 3, Timestamp: now})
+	sweeps, err := sweepsDB.GetAll("BCH")
+	if err != nil {
+		t.Error(err)
+	}
+	if len(sweeps) != 2 {
+		t.Fatal("Returned incorrect number of sweeps")
+	}
+	if sweeps[0].Txid != "a2" || sweeps[1].Txid != "a1" {
+		t.Error("Sweeps are not newest first")
+	}
+	all, err := sweepsDB.GetAll("")
+	if err != nil {
+		t.Error(err)
+	}
+	if len(all) < 3 {
+		t.Error("Returned incorrect number of sweeps for all coins")
+	}
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"sync"
 )
@@ -15,7 +16,7 @@ func (t *TxMetadataDB) Put(m repo.Metadata) error {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	tx, _ := t.db.Begin()
-	stmt, err := tx.Prepare("insert or replace into txmetadata(txid, address, memo, orderID, thumbnail, canBumpFee) values(?,?,?,?,?,?)")
+	stmt, err := tx.Prepare("insert or replace into txmetadata(txid, address, memo, orderID, thumbnail, canBumpFee, outputMemos) values(?,?,?,?,?,?,?)")
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -25,7 +26,16 @@ func (t *TxMetadataDB) Put(m repo.Metadata) error {
 	if m.CanBumpFee {
 		bumpable = 1
 	}
-	_, err = stmt.Exec(m.Txid, m.Address, m.Memo, m.OrderId, m.Thumbnail, bumpable)
+	var outputMemos sql.NullString
+	if len(m.OutputMemos) > 0 {
+		ser, err := json.Marshal(m.OutputMemos)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		outputMemos = sql.NullString{String: string(ser), Valid: true}
+	}
+	_, err = stmt.Exec(m.Txid, m.Address, m.Memo, m.OrderId, m.Thumbnail, bumpable, outputMemos)
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -38,11 +48,12 @@ func (t *TxMetadataDB) Get(txid string) (repo.Metadata, error) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	var m repo.Metadata
-	stmt, err := t.db.Prepare("select txid, address, memo, orderID, thumbnail, canBumpFee from txmetadata where txid=?")
+	stmt, err := t.db.Prepare("select txid, address, memo, orderID, thumbnail, canBumpFee, outputMemos from txmetadata where txid=?")
 	defer stmt.Close()
 	var id, address, memo, orderId, thumbnail string
 	var canBumpFee int
-	err = stmt.QueryRow(txid).Scan(&id, &address, &memo, &orderId, &thumbnail, &canBumpFee)
+	var outputMemos sql.NullString
+	err = stmt.QueryRow(txid).Scan(&id, &address, &memo, &orderId, &thumbnail, &canBumpFee, &outputMemos)
 	if err != nil {
 		return m, err
 	}
@@ -50,7 +61,15 @@ func (t *TxMetadataDB) Get(txid string) (repo.Metadata, error) {
 	if canBumpFee > 0 {
 		bumpable = true
 	}
-	m = repo.Metadata{id, address, memo, orderId, thumbnail, bumpable}
+	m = repo.Metadata{
+		Txid:        id,
+		Address:     address,
+		Memo:        memo,
+		OrderId:     orderId,
+		Thumbnail:   thumbnail,
+		CanBumpFee:  bumpable,
+		OutputMemos: unmarshalOutputMemos(outputMemos),
+	}
 	return m, nil
 }
 
@@ -58,7 +77,7 @@ func (t *TxMetadataDB) GetAll() (map[string]repo.Metadata, error) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	ret := make(map[string]repo.Metadata)
-	stm := "select txid, address, memo, orderID, thumbnail, canBumpFee from txmetadata"
+	stm := "select txid, address, memo, orderID, thumbnail, canBumpFee, outputMemos from txmetadata"
 	rows, err := t.db.Query(stm)
 	if err != nil {
 		return ret, err
@@ -67,7 +86,8 @@ func (t *TxMetadataDB) GetAll() (map[string]repo.Metadata, error) {
 	for rows.Next() {
 		var txid, address, memo, orderId, thumbnail string
 		var canBumpFee int
-		if err := rows.Scan(&txid, &address, &memo, &orderId, &thumbnail, &canBumpFee); err != nil {
+		var outputMemos sql.NullString
+		if err := rows.Scan(&txid, &address, &memo, &orderId, &thumbnail, &canBumpFee, &outputMemos); err != nil {
 			return ret, err
 		}
 		bumpable := false
@@ -75,12 +95,13 @@ func (t *TxMetadataDB) GetAll() (map[string]repo.Metadata, error) {
 			bumpable = true
 		}
 		m := repo.Metadata{
-			Txid:       txid,
-			Address:    address,
-			Memo:       memo,
-			OrderId:    orderId,
-			Thumbnail:  thumbnail,
-			CanBumpFee: bumpable,
+			Txid:        txid,
+			Address:     address,
+			Memo:        memo,
+			OrderId:     orderId,
+			Thumbnail:   thumbnail,
+			CanBumpFee:  bumpable,
+			OutputMemos: unmarshalOutputMemos(outputMemos),
 		}
 		ret[txid] = m
 	}
@@ -96,3 +117,15 @@ func (t *TxMetadataDB) Delete(txid string) error {
 	}
 	return nil
 }
+
+// Memos for the outputs of a batch spend keyed by address. Rows without any are NULL.
+func unmarshalOutputMemos(s sql.NullString) map[string]string {
+	if !s.Valid || s.String == "" {
+		return nil
+	}
+	memos := make(map[string]string)
+	if err := json.Unmarshal([]byte(s.String), &memos); err != nil {
+		return nil
+	}
+	return memos
+}
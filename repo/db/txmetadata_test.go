@@ -17,7 +17,7 @@ func init() {
 		db:   conn,
 		lock: new(sync.Mutex),
 	}
-	m = repo.Metadata{"16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f", "1Xtkf3Rdq6eix4tFXpEuHdXfubt3Mt452", "Some memo", "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", "QmZY1kx6VrNjgDB4SJDByxvSVuiBfsisRLdUMJRDppTTsS", false}
+	m = repo.Metadata{"16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f", "1Xtkf3Rdq6eix4tFXpEuHdXfubt3Mt452", "Some memo", "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", "QmZY1kx6VrNjgDB4SJDByxvSVuiBfsisRLdUMJRDppTTsS", false, nil}
 }
 
 func TestTxMetadataDB_Put(t *testing.T) {
@@ -136,3 +136,32 @@ func TestTxMetadataDB_Delete(t *testing.T) {
 		t.Error("TxMetadataDB failed to delete row")
 	}
 }
+
+func TestTxMetadataDB_OutputMemos(t *testing.T) {
+	batch := repo.Metadata{
+		Txid: "7a3d3f5b2a1b0e6a9c8d7e6f5a4b3c2d1e0f9a8b7c6d5e4f3a2b1c0d9e8f7a6b",
+		Memo: "Weekly supplier payouts",
+		OutputMemos: map[string]string{
+			"1Xtkf3Rdq6eix4tFXpEuHdXfubt3Mt452":  "Supplier A",
+			"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2": "Affiliate B",
+		},
+	}
+	err := metDB.Put(batch)
+	if err != nil {
+		t.Error(err)
+	}
+	ret, err := metDB.Get(batch.Txid)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(ret.OutputMemos) != 2 || ret.OutputMemos["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"] != "Affiliate B" {
+		t.Error("TxMetadataDB failed to get output memos")
+	}
+	ret, err = metDB.Get(m.Txid)
+	if err != nil {
+		t.Error(err)
+	}
+	if ret.OutputMemos != nil {
+		t.Error("TxMetadataDB returned output memos for a transaction without any")
+	}
+}
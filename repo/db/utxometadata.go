@@ -0,0 +1,103 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"sync"
+)
+
+/* UtxoMetadataDB keys the metadata by coin as well as outpoint since chains which forked from
+   each other share the outpoints from before the fork. Rows saved before the coin was recorded
+   have an empty coin and apply to every coin until the outpoint is labeled again. */
+type UtxoMetadataDB struct {
+	db   *sql.DB
+	lock *sync.Mutex
+}
+
+func (u *UtxoMetadataDB) Put(m repo.UtxoMeta) error {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	tx, _ := u.db.Begin()
+	stmt, err := tx.Prepare("insert or replace into utxometadata(coin, outpoint, label, frozen) values(?,?,?,?)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	frozen := 0
+	if m.Frozen {
+		frozen = 1
+	}
+	_, err = stmt.Exec(m.Coin, m.Outpoint, m.Label, frozen)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	_, err = tx.Exec("delete from utxometadata where coin='' and outpoint=?", m.Outpoint)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (u *UtxoMetadataDB) Get(coin, outpoint string) (repo.UtxoMeta, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	var m repo.UtxoMeta
+	stmt, err := u.db.Prepare("select coin, outpoint, label, frozen from utxometadata where (coin=? or coin='') and outpoint=? order by coin desc limit 1")
+	if err != nil {
+		return m, err
+	}
+	defer stmt.Close()
+	var c, op, label string
+	var frozen int
+	err = stmt.QueryRow(coin, outpoint).Scan(&c, &op, &label, &frozen)
+	if err != nil {
+		return m, err
+	}
+	m = repo.UtxoMeta{
+		Coin:     c,
+		Outpoint: op,
+		Label:    label,
+		Frozen:   frozen > 0,
+	}
+	return m, nil
+}
+
+func (u *UtxoMetadataDB) GetAll(coin string) (map[string]repo.UtxoMeta, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	ret := make(map[string]repo.UtxoMeta)
+	rows, err := u.db.Query("select coin, outpoint, label, frozen from utxometadata where coin=? or coin='' order by coin", coin)
+	if err != nil {
+		return ret, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var c, op, label string
+		var frozen int
+		if err := rows.Scan(&c, &op, &label, &frozen); err != nil {
+			return ret, err
+		}
+		// Rows for the coin come after the old rows without one and replace them
+		ret[op] = repo.UtxoMeta{
+			Coin:     c,
+			Outpoint: op,
+			Label:    label,
+			Frozen:   frozen > 0,
+		}
+	}
+	return ret, nil
+}
+
+func (u *UtxoMetadataDB) Delete(coin, outpoint string) error {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	_, err := u.db.Exec("delete from utxometadata where (coin=? or coin='') and outpoint=?", coin, outpoint)
+	if err != nil {
+		return err
+	}
+	return nil
+}
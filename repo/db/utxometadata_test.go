@@ -0,0 +1,149 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"sync"
+	"testing"
+)
+
+var utxoMetaDB UtxoMetadataDB
+var um repo.UtxoMeta
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	utxoMetaDB = UtxoMetadataDB{
+		db:   conn,
+		lock: new(sync.Mutex),
+	}
+	um = repo.UtxoMeta{
+		Coin:     "BTC",
+		Outpoint: "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f:1",
+		Label:    "Escrow release for order QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		Frozen:   true,
+	}
+}
+
+func TestUtxoMetadataDB_Put(t *testing.T) {
+	err := utxoMetaDB.Put(um)
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := utxoMetaDB.db.Prepare("select outpoint, label, frozen from utxometadata where coin=? and outpoint=?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmt.Close()
+	var outpoint, label string
+	var frozen int
+	err = stmt.QueryRow(um.Coin, um.Outpoint).Scan(&outpoint, &label, &frozen)
+	if err != nil {
+		t.Error(err)
+	}
+	if outpoint != um.Outpoint {
+		t.Error("UtxoMetadataDB failed to put outpoint")
+	}
+	if label != um.Label {
+		t.Error("UtxoMetadataDB failed to put label")
+	}
+	if frozen != 1 {
+		t.Error("UtxoMetadataDB failed to put frozen")
+	}
+}
+
+func TestUtxoMetadataDB_Get(t *testing.T) {
+	err := utxoMetaDB.Put(um)
+	if err != nil {
+		t.Error(err)
+	}
+	ret, err := utxoMetaDB.Get(um.Coin, um.Outpoint)
+	if err != nil {
+		t.Error(err)
+	}
+	if ret.Outpoint != um.Outpoint {
+		t.Error("UtxoMetadataDB failed to get outpoint")
+	}
+	if ret.Label != um.Label {
+		t.Error("UtxoMetadataDB failed to get label")
+	}
+	if ret.Frozen != um.Frozen {
+		t.Error("UtxoMetadataDB failed to get frozen")
+	}
+}
+
+func TestUtxoMetadataDB_GetAll(t *testing.T) {
+	err := utxoMetaDB.Put(um)
+	if err != nil {
+		t.Error(err)
+	}
+	mds, err := utxoMetaDB.GetAll(um.Coin)
+	if err != nil {
+		t.Error(err)
+	}
+	ret, ok := mds[um.Outpoint]
+	if !ok {
+		t.Error("UtxoMetadataDB get all failed to fetch correct row")
+	}
+	if ret.Label != um.Label || ret.Frozen != um.Frozen {
+		t.Error("UtxoMetadataDB get all returned incorrect metadata")
+	}
+}
+
+func TestUtxoMetadataDB_Delete(t *testing.T) {
+	err := utxoMetaDB.Put(um)
+	if err != nil {
+		t.Error(err)
+	}
+	err = utxoMetaDB.Delete(um.Coin, um.Outpoint)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = utxoMetaDB.Get(um.Coin, um.Outpoint)
+	if err == nil {
+		t.Error("UtxoMetadataDB failed to delete")
+	}
+}
+
+func TestUtxoMetadataDB_KeyedByCoin(t *testing.T) {
+	err := utxoMetaDB.Put(um)
+	if err != nil {
+		t.Error(err)
+	}
+	mds, err := utxoMetaDB.GetAll("BCH")
+	if err != nil {
+		t.Error(err)
+	}
+	if _, ok := mds[um.Outpoint]; ok {
+		t.Error("UtxoMetadataDB returned the metadata of another coin")
+	}
+	if _, err := utxoMetaDB.Get("BCH", um.Outpoint); err == nil {
+		t.Error("UtxoMetadataDB returned the metadata of another coin")
+	}
+
+	// Metadata saved before it was keyed by coin applies to every coin until it is replaced
+	legacy := "26e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f:0"
+	_, err = utxoMetaDB.db.Exec("insert into utxometadata(coin, outpoint, label, frozen) values('',?,?,1)", legacy, "old")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mds, err = utxoMetaDB.GetAll("BCH")
+	if err != nil {
+		t.Error(err)
+	}
+	if !mds[legacy].Frozen {
+		t.Error("UtxoMetadataDB did not apply metadata without a coin")
+	}
+	err = utxoMetaDB.Put(repo.UtxoMeta{Coin: "BCH", Outpoint: legacy, Label: "new"})
+	if err != nil {
+		t.Error(err)
+	}
+	ret, err := utxoMetaDB.Get("BTC", legacy)
+	if err == nil {
+		t.Errorf("UtxoMetadataDB kept the metadata without a coin: %+v", ret)
+	}
+	ret, err = utxoMetaDB.Get("BCH", legacy)
+	if err != nil || ret.Label != "new" || ret.Frozen {
+		t.Error("UtxoMetadataDB failed to replace metadata without a coin")
+	}
+}
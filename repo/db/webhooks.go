@@ -0,0 +1,172 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"strings"
+	"sync"
+	"time"
+)
+
+type WebhooksDB struct {
+	db   *sql.DB
+	lock *sync.Mutex
+}
+
+func (w *WebhooksDB) Put(hook repo.Webhook) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	tx, _ := w.db.Begin()
+	stmt, err := tx.Prepare("insert or replace into webhooks(id, url, events, secret, created) values(?,?,?,?,?)")
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(hook.ID, hook.URL, strings.Join(hook.Events, ","), hook.Secret, hook.Created.Unix())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (w *WebhooksDB) Get(id string) (repo.Webhook, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	stmt, err := w.db.Prepare("select url, events, secret, created from webhooks where id=?")
+	if err != nil {
+		return repo.Webhook{}, err
+	}
+	defer stmt.Close()
+	var url, events, secret string
+	var created int64
+	if err := stmt.QueryRow(id).Scan(&url, &events, &secret, &created); err != nil {
+		return repo.Webhook{}, err
+	}
+	return repo.Webhook{
+		ID:      id,
+		URL:     url,
+		Events:  splitEvents(events),
+		Secret:  secret,
+		Created: time.Unix(created, 0),
+	}, nil
+}
+
+func (w *WebhooksDB) GetAll() ([]repo.Webhook, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	var ret []repo.Webhook
+	rows, err := w.db.Query("select id, url, events, secret, created from webhooks order by created")
+	if err != nil {
+		return ret, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, url, events, secret string
+		var created int64
+		if err := rows.Scan(&id, &url, &events, &secret, &created); err != nil {
+			return ret, err
+		}
+		ret = append(ret, repo.Webhook{
+			ID:      id,
+			URL:     url,
+			Events:  splitEvents(events),
+			Secret:  secret,
+			Created: time.Unix(created, 0),
+		})
+	}
+	return ret, nil
+}
+
+func (w *WebhooksDB) Delete(id string) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	res, err := w.db.Exec("delete from webhooks where id=?", id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return sql.ErrNoRows
+	}
+	_, err = w.db.Exec("delete from webhookdeliveries where webhookid=?", id)
+	return err
+}
+
+func (w *WebhooksDB) PutDelivery(d repo.WebhookDelivery) (int64, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	res, err := w.db.Exec("insert into webhookdeliveries(webhookid, event, payload, status, attempts, responsecode, error, created, lastattempt, nextattempt) values(?,?,?,?,?,?,?,?,?,?)",
+		d.WebhookID, d.Event, d.Payload, d.Status, d.Attempts, d.ResponseCode, d.Error, d.Created.Unix(), unixOrZero(d.LastAttempt), unixOrZero(d.NextAttempt))
+	if err != nil {
+		return 0, err
+	}
+	return res.LastInsertId()
+}
+
+func (w *WebhooksDB) UpdateDelivery(d repo.WebhookDelivery) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	_, err := w.db.Exec("update webhookdeliveries set status=?, attempts=?, responsecode=?, error=?, lastattempt=?, nextattempt=? where id=?",
+		d.Status, d.Attempts, d.ResponseCode, d.Error, unixOrZero(d.LastAttempt), unixOrZero(d.NextAttempt), d.ID)
+	return err
+}
+
+func (w *WebhooksDB) GetDeliveries(webhookID string, limit int) ([]repo.WebhookDelivery, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.queryDeliveries("select id, webhookid, event, payload, status, attempts, responsecode, error, created, lastattempt, nextattempt from webhookdeliveries where webhookid=? order by id desc limit ?", webhookID, limit)
+}
+
+func (w *WebhooksDB) GetPendingDeliveries(before time.Time) ([]repo.WebhookDelivery, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.queryDeliveries("select id, webhookid, event, payload, status, attempts, responsecode, error, created, lastattempt, nextattempt from webhookdeliveries where status=? and nextattempt<=? order by id", repo.WebhookDeliveryPending, before.Unix())
+}
+
+func (w *WebhooksDB) PruneDeliveries(before time.Time) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	_, err := w.db.Exec("delete from webhookdeliveries where created<? and status!=?", before.Unix(), repo.WebhookDeliveryPending)
+	return err
+}
+
+func (w *WebhooksDB) queryDeliveries(query string, args ...interface{}) ([]repo.WebhookDelivery, error) {
+	var ret []repo.WebhookDelivery
+	rows, err := w.db.Query(query, args...)
+	if err != nil {
+		return ret, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var d repo.WebhookDelivery
+		var created, lastAttempt, nextAttempt int64
+		if err := rows.Scan(&d.ID, &d.WebhookID, &d.Event, &d.Payload, &d.Status, &d.Attempts, &d.ResponseCode, &d.Error, &created, &lastAttempt, &nextAttempt); err != nil {
+			return ret, err
+		}
+		d.Created = time.Unix(created, 0)
+		if lastAttempt > 0 {
+			d.LastAttempt = time.Unix(lastAttempt, 0)
+		}
+		if nextAttempt > 0 {
+			d.NextAttempt = time.Unix(nextAttempt, 0)
+		}
+		ret = append(ret, d)
+	}
+	return ret, nil
+}
+
+func splitEvents(events string) []string {
+	if events == "" {
+		return []string{}
+	}
+	return strings.Split(events, ",")
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,108 @@
+package db
+
+import (
+	"database/sql"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"sync"
+	"testing"
+	"time"
+)
+
+var webhooksDB WebhooksDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	webhooksDB = WebhooksDB{
+		db:   conn,
+		lock: new(sync.Mutex),
+	}
+}
+
+func TestWebhooksDB(t *testing.T) {
+	hook := repo.Webhook{
+		ID:      "a1b2",
+		URL:     "https://erp.example.com/hooks/openbazaar",
+		Events:  []string{"order", "payment"},
+		Secret:  "hunter2",
+		Created: time.Now(),
+	}
+	if err := webhooksDB.Put(hook); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := webhooksDB.Get("a1b2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.URL != hook.URL || len(ret.Events) != 2 || ret.Events[1] != "payment" || ret.Secret != "hunter2" {
+		t.Error("Webhooks db returned wrong webhook")
+	}
+	all, err := webhooksDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 {
+		t.Error("Webhooks db returned wrong number of webhooks")
+	}
+
+	now := time.Now()
+	id, err := webhooksDB.PutDelivery(repo.WebhookDelivery{
+		WebhookID:   "a1b2",
+		Event:       "order",
+		Payload:     []byte(`{"notification":{}}`),
+		Status:      repo.WebhookDeliveryPending,
+		Created:     now,
+		NextAttempt: now.Add(-time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending, err := webhooksDB.GetPendingDeliveries(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].ID != id || string(pending[0].Payload) != `{"notification":{}}` {
+		t.Error("Webhooks db returned wrong pending deliveries")
+	}
+
+	d := pending[0]
+	d.Status = repo.WebhookDeliveryDelivered
+	d.Attempts = 1
+	d.ResponseCode = 200
+	d.LastAttempt = now
+	if err := webhooksDB.UpdateDelivery(d); err != nil {
+		t.Fatal(err)
+	}
+	pending, err = webhooksDB.GetPendingDeliveries(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Error("Delivered delivery is still pending")
+	}
+	log, err := webhooksDB.GetDeliveries("a1b2", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log) != 1 || log[0].Status != repo.WebhookDeliveryDelivered || log[0].ResponseCode != 200 || log[0].Attempts != 1 {
+		t.Error("Webhooks db returned wrong delivery log")
+	}
+
+	if err := webhooksDB.PruneDeliveries(now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	log, err = webhooksDB.GetDeliveries("a1b2", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(log) != 0 {
+		t.Error("Failed to prune deliveries")
+	}
+
+	if err := webhooksDB.Delete("a1b2"); err != nil {
+		t.Error(err)
+	}
+	if err := webhooksDB.Delete("a1b2"); err != sql.ErrNoRows {
+		t.Error("Deleting a missing webhook should return sql.ErrNoRows")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path"
 
+	"github.com/OpenBazaar/openbazaar-go/bitcoin/exchange"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/ipfs/go-ipfs/namesys"
@@ -19,7 +20,7 @@ import (
 	"time"
 )
 
-const RepoVersion = "6"
+const RepoVersion = "14"
 
 var log = logging.MustGetLogger("repo")
 var ErrRepoExists = errors.New("IPFS configuration file exists. Reinitializing would overwrite your keys. Use -f to force overwrite.")
@@ -102,6 +103,9 @@ func maybeCreateOBDirectories(repoRoot string) error {
 	if err := os.MkdirAll(path.Join(repoRoot, "root", "ratings"), os.ModePerm); err != nil {
 		return err
 	}
+	if err := os.MkdirAll(path.Join(repoRoot, "root", "givenratings"), os.ModePerm); err != nil {
+		return err
+	}
 	if err := os.MkdirAll(path.Join(repoRoot, "root", "images"), os.ModePerm); err != nil {
 		return err
 	}
@@ -221,6 +225,7 @@ func addConfigExtensions(repoRoot string, testnet bool) error {
 		Enabled:     true,
 		AllowedIPs:  []string{},
 		HTTPHeaders: nil,
+		RateLimits:  DefaultRateLimits,
 	}
 
 	var ds DataSharing = DataSharing{
@@ -232,6 +237,9 @@ func addConfigExtensions(repoRoot string, testnet bool) error {
 	if err := extendConfigFile(r, "Wallet", w); err != nil {
 		return err
 	}
+	if err := extendConfigFile(r, "AdditionalWallets", []WalletConfig{}); err != nil {
+		return err
+	}
 	var resolvers ResolverConfig = ResolverConfig{
 		Id: "https://resolver.onename.com/",
 	}
@@ -250,6 +258,38 @@ func addConfigExtensions(repoRoot string, testnet bool) error {
 	if err := extendConfigFile(r, "RepublishInterval", "24h"); err != nil {
 		return err
 	}
+	if err := extendConfigFile(r, "RatingAmendmentWindow", DefaultRatingAmendmentWindow.String()); err != nil {
+		return err
+	}
+	if err := extendConfigFile(r, "AutoSweep", []interface{}{}); err != nil {
+		return err
+	}
+	if err := extendConfigFile(r, "ColdStorage", ColdStorageConfig{}); err != nil {
+		return err
+	}
+	if err := extendConfigFile(r, "Lightning", map[string]interface{}{
+		"Backend":        "",
+		"Host":           "localhost:8080",
+		"TLSCert":        "",
+		"Macaroon":       "",
+		"MaxOrderAmount": 1000000,
+		"InvoiceExpiry":  "1h",
+	}); err != nil {
+		return err
+	}
+	if err := extendConfigFile(r, "EventLog", map[string]interface{}{
+		"MaxAge":    "168h",
+		"MaxEvents": 10000,
+	}); err != nil {
+		return err
+	}
+	if err := extendConfigFile(r, "ExchangeRates", map[string]interface{}{
+		"Providers":    exchange.DefaultProviders,
+		"MaxDeviation": exchange.DefaultMaxDeviation,
+		"MaxAge":       exchange.DefaultMaxAge.String(),
+	}); err != nil {
+		return err
+	}
 	if err := extendConfigFile(r, "JSON-API", a); err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 )
 
 type Migration interface {
@@ -20,6 +21,14 @@ var Migrations = []Migration{
 	migrations.Migration003,
 	migrations.Migration004,
 	migrations.Migration005,
+	migrations.Migration006,
+	migrations.Migration007,
+	migrations.Migration008,
+	migrations.Migration009,
+	migrations.Migration010,
+	migrations.Migration011,
+	migrations.Migration012,
+	migrations.Migration013,
 }
 
 // MigrateUp looks at the currently active migration version
@@ -31,7 +40,7 @@ func MigrateUp(repoPath, dbPassword string, testnet bool) error {
 	} else if err != nil && os.IsNotExist(err) {
 		version = []byte("0")
 	}
-	v, err := strconv.Atoi(string(version[0]))
+	v, err := strconv.Atoi(strings.TrimSpace(string(version)))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestMigration006(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+	}
+	db.Exec("PRAGMA key = 'letmein';")
+	var m migration006
+	err = m.Up("./", "letmein", false)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = db.Exec("INSERT INTO utxometadata (outpoint, label, frozen) values (?,?,?)", "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f:0", "label", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	repoVer, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(repoVer) != "7" {
+		t.Error("Failed to write new repo version")
+	}
+
+	err = m.Down("./", "letmein", false)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = db.Exec("INSERT INTO utxometadata (outpoint, label, frozen) values (?,?,?)", "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f:1", "label", 1)
+	if err == nil {
+		t.Error("Failed to drop table")
+		return
+	}
+	repoVer, err = ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(repoVer) != "6" {
+		t.Error("Failed to write new repo version")
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
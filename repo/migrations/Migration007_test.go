@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestMigration007(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+	}
+	db.Exec("PRAGMA key = 'letmein';create table txmetadata (txid text primary key not null, address text, memo text, orderID text, thumbnail text, canBumpFee integer);")
+	_, err = db.Exec("INSERT INTO txmetadata (txid, address, memo, orderID, thumbnail, canBumpFee) values (?,?,?,?,?,?)", "asdf", "1btc..", "memo", "Qm...", "zasfd", 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	var m migration007
+	err = m.Up("./", "letmein", false)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = db.Exec("UPDATE txmetadata set outputMemos=? WHERE txid=?", `{"1btc..":"memo"}`, "asdf")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	repoVer, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(repoVer) != "8" {
+		t.Error("Failed to write new repo version")
+	}
+
+	err = m.Down("./", "letmein", false)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = db.Exec("UPDATE txmetadata set outputMemos=? WHERE txid=?", `{"1btc..":"memo"}`, "asdf")
+	if err == nil {
+		t.Error("Failed to drop columns")
+		return
+	}
+	repoVer, err = ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(repoVer) != "7" {
+		t.Error("Failed to write new repo version")
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
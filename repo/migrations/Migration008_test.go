@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestMigration008(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+	}
+	db.Exec("PRAGMA key = 'letmein';")
+	var m migration008
+	err = m.Up("./", "letmein", false)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = db.Exec("INSERT INTO sweeps (txid, coin, destination, address, amount, timestamp) values (?,?,?,?,?,?)", "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff95380", "BTC", "dest", "addr", 1000, 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	repoVer, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(repoVer) != "9" {
+		t.Error("Failed to write new repo version")
+	}
+
+	err = m.Down("./", "letmein", false)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = db.Exec("INSERT INTO sweeps (txid, coin, destination, address, amount, timestamp) values (?,?,?,?,?,?)", "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff95381", "BTC", "dest", "addr", 1000, 0)
+	if err == nil {
+		t.Error("Failed to drop table")
+		return
+	}
+	repoVer, err = ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(repoVer) != "8" {
+		t.Error("Failed to write new repo version")
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestMigration011(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+	}
+	db.Exec("PRAGMA key = 'letmein';")
+	var m migration011
+	err = m.Up("./", "letmein", false)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = db.Exec("INSERT INTO webhookdeliveries (webhookid, event, payload, status, attempts, responsecode, error, created, lastattempt, nextattempt) values (?,?,?,?,?,?,?,?,?,?)", "a1b2", "order", []byte("{}"), "pending", 0, 0, "", 0, 0, 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	repoVer, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(repoVer) != "12" {
+		t.Error("Failed to write new repo version")
+	}
+
+	err = m.Down("./", "letmein", false)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = db.Exec("INSERT INTO webhookdeliveries (webhookid, event, payload, status, attempts, responsecode, error, created, lastattempt, nextattempt) values (?,?,?,?,?,?,?,?,?,?)", "a1b2", "order", []byte("{}"), "pending", 0, 0, "", 0, 0, 0)
+	if err == nil {
+		t.Error("Failed to drop table")
+		return
+	}
+	repoVer, err = ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(repoVer) != "11" {
+		t.Error("Failed to write new repo version")
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
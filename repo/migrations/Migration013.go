@@ -0,0 +1,102 @@
+package migrations
+
+import (
+	"database/sql"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+	"os"
+)
+
+var Migration013 migration013
+
+type migration013 struct{}
+
+func (migration013) Up(repoPath string, dbPassword string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if dbPassword != "" {
+		p := "pragma key='" + dbPassword + "';"
+		db.Exec(p)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	// Outpoints labeled before now keep an empty coin so they apply to every coin
+	for _, q := range []string{
+		"create table utxometadata_new (coin text not null, outpoint text not null, label text, frozen integer, primary key (coin, outpoint));",
+		"insert into utxometadata_new (coin, outpoint, label, frozen) select '', outpoint, label, frozen from utxometadata;",
+		"drop table utxometadata;",
+		"alter table utxometadata_new rename to utxometadata;",
+	} {
+		if _, err := tx.Exec(q); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	tx.Commit()
+	f1, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	_, err = f1.Write([]byte("14"))
+	if err != nil {
+		return err
+	}
+	f1.Close()
+	return nil
+}
+
+func (migration013) Down(repoPath string, dbPassword string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if dbPassword != "" {
+		p := "pragma key='" + dbPassword + "';"
+		db.Exec(p)
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	// Where an outpoint has metadata for several coins only one of them is kept
+	for _, q := range []string{
+		"create table utxometadata_old (outpoint text primary key not null, label text, frozen integer);",
+		"insert or replace into utxometadata_old (outpoint, label, frozen) select outpoint, label, frozen from utxometadata;",
+		"drop table utxometadata;",
+		"alter table utxometadata_old rename to utxometadata;",
+	} {
+		if _, err := tx.Exec(q); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	tx.Commit()
+	f1, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	_, err = f1.Write([]byte("13"))
+	if err != nil {
+		return err
+	}
+	f1.Close()
+	return nil
+}
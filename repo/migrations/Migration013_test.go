@@ -0,0 +1,68 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func TestMigration013(t *testing.T) {
+	var dbPath string
+	os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+	}
+	db.Exec("PRAGMA key = 'letmein';")
+	db.Exec("create table utxometadata (outpoint text primary key not null, label text, frozen integer);")
+	_, err = db.Exec("INSERT INTO utxometadata (outpoint, label, frozen) values (?,?,?)", "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f:0", "label", 1)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	var m migration013
+	err = m.Up("./", "letmein", false)
+	if err != nil {
+		t.Error(err)
+	}
+	var coin, label string
+	err = db.QueryRow("select coin, label from utxometadata where outpoint=?", "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f:0").Scan(&coin, &label)
+	if err != nil || coin != "" || label != "label" {
+		t.Error("Failed to keep existing metadata")
+	}
+	_, err = db.Exec("INSERT INTO utxometadata (coin, outpoint, label, frozen) values (?,?,?,?)", "BCH", "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f:0", "label", 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	repoVer, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(repoVer) != "14" {
+		t.Error("Failed to write new repo version")
+	}
+
+	err = m.Down("./", "letmein", false)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	_, err = db.Exec("INSERT INTO utxometadata (coin, outpoint, label, frozen) values (?,?,?,?)", "BTC", "16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f:1", "label", 1)
+	if err == nil {
+		t.Error("Failed to remove the coin column")
+		return
+	}
+	repoVer, err = ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(repoVer) != "13" {
+		t.Error("Failed to write new repo version")
+	}
+	os.RemoveAll("./datastore")
+	os.RemoveAll("./repover")
+}
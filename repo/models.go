@@ -5,19 +5,21 @@ import (
 )
 
 type SettingsData struct {
-	PaymentDataInQR    *bool              `json:"paymentDataInQR"`
-	ShowNotifications  *bool              `json:"showNotifications"`
-	ShowNsfw           *bool              `json:"showNsfw"`
-	ShippingAddresses  *[]ShippingAddress `json:"shippingAddresses"`
-	LocalCurrency      *string            `json:"localCurrency"`
-	Country            *string            `json:"country"`
-	TermsAndConditions *string            `json:"termsAndConditions"`
-	RefundPolicy       *string            `json:"refundPolicy"`
-	BlockedNodes       *[]string          `json:"blockedNodes"`
-	StoreModerators    *[]string          `json:"storeModerators"`
-	MisPaymentBuffer   *float32           `json:"mispaymentBuffer"`
-	SMTPSettings       *SMTPSettings      `json:"smtpSettings"`
-	Version            *string            `json:"version"`
+	PaymentDataInQR         *bool                    `json:"paymentDataInQR"`
+	ShowNotifications       *bool                    `json:"showNotifications"`
+	ShowNsfw                *bool                    `json:"showNsfw"`
+	ShippingAddresses       *[]ShippingAddress       `json:"shippingAddresses"`
+	LocalCurrency           *string                  `json:"localCurrency"`
+	Country                 *string                  `json:"country"`
+	TermsAndConditions      *string                  `json:"termsAndConditions"`
+	RefundPolicy            *string                  `json:"refundPolicy"`
+	BlockedNodes            *[]string                `json:"blockedNodes"`
+	StoreModerators         *[]string                `json:"storeModerators"`
+	MisPaymentBuffer        *float32                 `json:"mispaymentBuffer"`
+	SMTPSettings            *SMTPSettings            `json:"smtpSettings"`
+	PushNotifications       *[]PushSettings          `json:"pushNotifications"`
+	NotificationPreferences *NotificationPreferences `json:"notificationPreferences"`
+	Version                 *string                  `json:"version"`
 }
 
 type ShippingAddress struct {
@@ -32,13 +34,68 @@ type ShippingAddress struct {
 	AddressNotes   string `json:"addressNotes"`
 }
 
+/* SMTPSettings configures email notifications. Security chooses between opportunistic STARTTLS
+   (the default), required STARTTLS and implicit TLS. Templates customise the emails for each
+   event type, with "*" applying to the types which aren't listed. */
 type SMTPSettings struct {
-	Notifications  bool   `json:"notifications"`
-	ServerAddress  string `json:"serverAddress"`
-	Username       string `json:"username"`
-	Password       string `json:"password"`
-	SenderEmail    string `json:"senderEmail"`
-	RecipientEmail string `json:"recipientEmail"`
+	Notifications  bool                     `json:"notifications"`
+	ServerAddress  string                   `json:"serverAddress"`
+	Username       string                   `json:"username"`
+	Password       string                   `json:"password"`
+	SenderEmail    string                   `json:"senderEmail"`
+	RecipientEmail string                   `json:"recipientEmail"`
+	Security       string                   `json:"security,omitempty"`
+	AuthMethod     string                   `json:"authMethod,omitempty"`
+	Templates      map[string]EmailTemplate `json:"templates,omitempty"`
+}
+
+// The connection security options for SMTP
+const (
+	SMTPSecurityOpportunistic = ""
+	SMTPSecuritySTARTTLS      = "starttls"
+	SMTPSecurityTLS           = "tls"
+)
+
+// The SMTP authentication methods. PLAIN is used if none is set.
+const (
+	SMTPAuthPlain   = "plain"
+	SMTPAuthLogin   = "login"
+	SMTPAuthCRAMMD5 = "cram-md5"
+	SMTPAuthNone    = "none"
+)
+
+/* EmailTemplate overrides the subject and the plain text and HTML parts of an email. Each is a
+   Go template executed with the notification's title, body, order items, thumbnail and total,
+   and an empty one keeps the default. */
+type EmailTemplate struct {
+	Subject string `json:"subject,omitempty"`
+	Text    string `json:"text,omitempty"`
+	HTML    string `json:"html,omitempty"`
+}
+
+// The HTTP push services notifications can be sent to
+const (
+	PushServiceNtfy   = "ntfy"
+	PushServiceGotify = "gotify"
+	PushServiceMatrix = "matrix"
+	PushServiceJSON   = "json"
+)
+
+var PushServices = []string{PushServiceNtfy, PushServiceGotify, PushServiceMatrix, PushServiceJSON}
+
+/* PushSettings configures an HTTP push notification service. URL is the ntfy topic, the Gotify
+   server, the Matrix homeserver or the URL the json service posts to. Events limits the
+   notification types pushed, all of them if empty. The title and body are text/template
+   templates, defaulting to the notification's own title and body. */
+type PushSettings struct {
+	Notifications bool     `json:"notifications"`
+	Service       string   `json:"service"`
+	URL           string   `json:"url"`
+	Token         string   `json:"token"`
+	Room          string   `json:"room,omitempty"`
+	Events        []string `json:"events"`
+	TitleTemplate string   `json:"titleTemplate,omitempty"`
+	BodyTemplate  string   `json:"bodyTemplate,omitempty"`
 }
 
 type Follower struct {
@@ -77,12 +134,75 @@ type ChatConversation struct {
 }
 
 type Metadata struct {
-	Txid       string
-	Address    string
-	Memo       string
-	OrderId    string
-	Thumbnail  string
-	CanBumpFee bool
+	Txid        string
+	Address     string
+	Memo        string
+	OrderId     string
+	Thumbnail   string
+	CanBumpFee  bool
+	OutputMemos map[string]string
+}
+
+type UtxoMeta struct {
+	Coin     string
+	Outpoint string
+	Label    string
+	Frozen   bool
+}
+
+// A transfer of sales proceeds out of the wallet by the auto-sweep service
+type Sweep struct {
+	Txid        string    `json:"txid"`
+	Coin        string    `json:"coin"`
+	Destination string    `json:"destination"`
+	Address     string    `json:"address"`
+	Amount      int64     `json:"amount"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+/* Webhook is a URL notifications are posted to. Only notifications of the listed event types
+   are sent, or all of them if Events is empty. */
+type Webhook struct {
+	ID      string    `json:"id"`
+	URL     string    `json:"url"`
+	Events  []string  `json:"events"`
+	Secret  string    `json:"-"`
+	Created time.Time `json:"created"`
+}
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+type WebhookDelivery struct {
+	ID           int64     `json:"id"`
+	WebhookID    string    `json:"webhookId"`
+	Event        string    `json:"event"`
+	Payload      []byte    `json:"-"`
+	Status       string    `json:"status"`
+	Attempts     int       `json:"attempts"`
+	ResponseCode int       `json:"responseCode"`
+	Error        string    `json:"error"`
+	Created      time.Time `json:"created"`
+	LastAttempt  time.Time `json:"lastAttempt"`
+	NextAttempt  time.Time `json:"nextAttempt"`
+}
+
+// Event is a message broadcast to websocket clients, kept so they can replay missed events
+type Event struct {
+	Seq       int64
+	Type      string
+	Payload   []byte
+	Timestamp time.Time
+}
+
+type RateSnapshot struct {
+	Coin      string    `json:"coin"`
+	Currency  string    `json:"currency"`
+	Rate      float64   `json:"rate"`
+	Timestamp time.Time `json:"timestamp"`
 }
 
 type Purchase struct {
@@ -0,0 +1,131 @@
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// The channels notifications are delivered on
+const (
+	NotificationChannelUI      = "ui"
+	NotificationChannelEmail   = "email"
+	NotificationChannelPush    = "push"
+	NotificationChannelWebhook = "webhook"
+)
+
+var NotificationChannels = []string{NotificationChannelUI, NotificationChannelEmail, NotificationChannelPush, NotificationChannelWebhook}
+
+/* NotificationPreferences chooses the channels each type of notification is delivered on. Events
+   maps an event type such as "order" or "chat" to its channels, with "*" setting them for the
+   types which aren't listed. Types without an entry go to every channel.
+
+   During quiet hours email and push notifications are held and sent as a single digest when
+   the quiet hours end. With DailySummary set a summary of the orders, disputes and escrows
+   waiting on the user is emailed once a day at DailySummaryHour in the quiet hours time zone. */
+type NotificationPreferences struct {
+	Events           map[string][]string `json:"events"`
+	QuietHours       *QuietHours         `json:"quietHours,omitempty"`
+	DailySummary     bool                `json:"dailySummary"`
+	DailySummaryHour int                 `json:"dailySummaryHour"`
+}
+
+// QuietHours is a daily period given as "15:04" times. It wraps past midnight if End is before Start.
+type QuietHours struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone"` // an IANA time zone name, the local time zone if empty
+}
+
+// Allows returns whether notifications of the event type should be delivered on the channel
+func (p *NotificationPreferences) Allows(event, channel string) bool {
+	if p == nil {
+		return true
+	}
+	channels, ok := p.Events[event]
+	if !ok {
+		channels, ok = p.Events["*"]
+	}
+	if !ok {
+		return true
+	}
+	for _, c := range channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// Location returns the time zone of the quiet hours and daily summary
+func (p *NotificationPreferences) Location() (*time.Location, error) {
+	if p == nil || p.QuietHours == nil || p.QuietHours.Timezone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(p.QuietHours.Timezone)
+}
+
+// Quiet returns whether t falls in the quiet hours
+func (p *NotificationPreferences) Quiet(t time.Time) bool {
+	if p == nil || p.QuietHours == nil {
+		return false
+	}
+	loc, err := p.Location()
+	if err != nil {
+		return false
+	}
+	start, end, err := p.QuietHours.minutes()
+	if err != nil {
+		return false
+	}
+	t = t.In(loc)
+	m := t.Hour()*60 + t.Minute()
+	if start <= end {
+		return m >= start && m < end
+	}
+	return m >= start || m < end
+}
+
+func (q *QuietHours) minutes() (start, end int, err error) {
+	s, err := time.Parse("15:04", q.Start)
+	if err != nil {
+		return 0, 0, err
+	}
+	e, err := time.Parse("15:04", q.End)
+	if err != nil {
+		return 0, 0, err
+	}
+	return s.Hour()*60 + s.Minute(), e.Hour()*60 + e.Minute(), nil
+}
+
+// Validate returns an error if the preferences name an unknown channel or can't be evaluated
+func (p *NotificationPreferences) Validate() error {
+	if p == nil {
+		return nil
+	}
+	for event, channels := range p.Events {
+		for _, c := range channels {
+			known := false
+			for _, k := range NotificationChannels {
+				if c == k {
+					known = true
+				}
+			}
+			if !known {
+				return fmt.Errorf("Unknown notification channel %s for %s", c, event)
+			}
+		}
+	}
+	if p.QuietHours != nil {
+		if _, _, err := p.QuietHours.minutes(); err != nil {
+			return errors.New("Quiet hours must be given as HH:MM")
+		}
+	}
+	if _, err := p.Location(); err != nil {
+		return fmt.Errorf("Unknown time zone %s", p.QuietHours.Timezone)
+	}
+	if p.DailySummaryHour < 0 || p.DailySummaryHour > 23 {
+		return errors.New("Daily summary hour must be between 0 and 23")
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package repo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNotificationPreferencesAllows(t *testing.T) {
+	var none *NotificationPreferences
+	if !none.Allows("order", NotificationChannelEmail) {
+		t.Error("Without preferences every channel should be allowed")
+	}
+	p := &NotificationPreferences{Events: map[string][]string{
+		"chat":   {NotificationChannelUI},
+		"typing": {},
+	}}
+	tests := []struct {
+		event, channel string
+		allowed        bool
+	}{
+		{"chat", NotificationChannelUI, true},
+		{"chat", NotificationChannelEmail, false},
+		{"typing", NotificationChannelUI, false},
+		{"order", NotificationChannelPush, true},
+	}
+	for _, test := range tests {
+		if p.Allows(test.event, test.channel) != test.allowed {
+			t.Errorf("%s on %s: expected %t", test.event, test.channel, test.allowed)
+		}
+	}
+	p.Events["*"] = []string{NotificationChannelUI, NotificationChannelWebhook}
+	if p.Allows("order", NotificationChannelPush) || !p.Allows("order", NotificationChannelWebhook) {
+		t.Error("Default channels were not applied")
+	}
+}
+
+func TestNotificationPreferencesQuiet(t *testing.T) {
+	at := func(clock string) time.Time {
+		c, _ := time.Parse("15:04", clock)
+		return time.Date(2018, 3, 1, c.Hour(), c.Minute(), 0, 0, time.UTC)
+	}
+	overnight := &NotificationPreferences{QuietHours: &QuietHours{Start: "22:00", End: "07:30", Timezone: "UTC"}}
+	daytime := &NotificationPreferences{QuietHours: &QuietHours{Start: "09:00", End: "17:00", Timezone: "UTC"}}
+	tests := []struct {
+		prefs *NotificationPreferences
+		clock string
+		quiet bool
+	}{
+		{overnight, "23:15", true},
+		{overnight, "03:00", true},
+		{overnight, "07:30", false},
+		{overnight, "12:00", false},
+		{daytime, "09:00", true},
+		{daytime, "20:00", false},
+		{&NotificationPreferences{}, "03:00", false},
+	}
+	for _, test := range tests {
+		if test.prefs.Quiet(at(test.clock)) != test.quiet {
+			t.Errorf("%+v at %s: expected quiet %t", test.prefs.QuietHours, test.clock, test.quiet)
+		}
+	}
+
+	// 06:00 UTC is 01:00 in New York
+	ny := &NotificationPreferences{QuietHours: &QuietHours{Start: "00:00", End: "02:00", Timezone: "America/New_York"}}
+	if !ny.Quiet(at("06:00")) {
+		t.Error("Quiet hours ignored the time zone")
+	}
+}
+
+func TestNotificationPreferencesValidate(t *testing.T) {
+	valid := &NotificationPreferences{
+		Events:           map[string][]string{"order": {NotificationChannelEmail}},
+		QuietHours:       &QuietHours{Start: "22:00", End: "07:00"},
+		DailySummary:     true,
+		DailySummaryHour: 8,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Error(err)
+	}
+	invalid := []*NotificationPreferences{
+		{Events: map[string][]string{"order": {"carrier-pigeon"}}},
+		{QuietHours: &QuietHours{Start: "10pm", End: "07:00"}},
+		{QuietHours: &QuietHours{Start: "22:00", End: "07:00", Timezone: "Mars/Olympus_Mons"}},
+		{DailySummaryHour: 24},
+	}
+	for _, p := range invalid {
+		if err := p.Validate(); err == nil {
+			t.Errorf("%+v should be invalid", p)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package repo
+
+import (
+	"strings"
+	"time"
+)
+
+// Sort orders for list queries. Without one a list keeps its stored order.
+const (
+	SortDateAsc    = "date-asc"
+	SortDateDesc   = "date-desc"
+	SortPriceAsc   = "price-asc"
+	SortPriceDesc  = "price-desc"
+	SortTitleAsc   = "title-asc"
+	SortTitleDesc  = "title-desc"
+	SortRatingDesc = "rating-desc"
+)
+
+var (
+	ListingSorts           = []string{SortDateAsc, SortDateDesc, SortPriceAsc, SortPriceDesc, SortTitleAsc, SortTitleDesc, SortRatingDesc}
+	PostSorts              = []string{SortDateAsc, SortDateDesc}
+	WalletTransactionSorts = []string{SortDateAsc, SortDateDesc}
+)
+
+/* Page selects part of a list by the ID of the last item of the previous page rather than by
+   position so pages don't shift as items are added. A negative limit returns every item. */
+type Page struct {
+	OffsetID string
+	Limit    int
+}
+
+/* Bounds returns the range of a list of IDs on the page. An offset which isn't in the list
+   gives an empty page. */
+func (p Page) Bounds(ids []string) (start, end int) {
+	if p.OffsetID != "" {
+		start = len(ids)
+		for i, id := range ids {
+			if id == p.OffsetID {
+				start = i + 1
+				break
+			}
+		}
+	}
+	end = len(ids)
+	if p.Limit >= 0 && start+p.Limit < end {
+		end = start + p.Limit
+	}
+	return start, end
+}
+
+// TimeRange is an inclusive range of times. A zero bound leaves that side open.
+type TimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Contains returns whether t is in the range. An unknown (zero) time is only in an open range.
+func (r TimeRange) Contains(t time.Time) bool {
+	if t.IsZero() {
+		return r.From.IsZero() && r.To.IsZero()
+	}
+	return (r.From.IsZero() || !t.Before(r.From)) && (r.To.IsZero() || !t.After(r.To))
+}
+
+// ListingQuery filters, sorts and pages a listing index. Listings are paged by slug.
+type ListingQuery struct {
+	Tags         []string // listings with any of the tags
+	Categories   []string // listings in any of the categories
+	ContractType string
+	Currency     string // the pricing currency, which the price range is in if set
+	MinPrice     uint64
+	MaxPrice     uint64 // zero for no maximum
+	Created      TimeRange
+	SortBy       string
+	Page
+}
+
+// PostQuery filters, sorts and pages a post index. Posts are paged by slug.
+type PostQuery struct {
+	Tags   []string // posts with any of the tags
+	Posted TimeRange
+	SortBy string
+	Page
+}
+
+// WalletTransactionQuery filters, sorts and pages wallet transactions. Transactions are paged by txid.
+type WalletTransactionQuery struct {
+	Statuses []string // transactions with any of the statuses
+	Date     TimeRange
+	SortBy   string
+	Page
+}
+
+// Matches returns whether a transaction with the status and timestamp passes the filters
+func (q WalletTransactionQuery) Matches(status string, timestamp time.Time) bool {
+	return MatchesAny(q.Statuses, []string{status}) && q.Date.Contains(timestamp)
+}
+
+// MatchesAny returns whether any of the values is in the filter, ignoring case. An empty filter matches everything.
+func MatchesAny(filter, values []string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		for _, v := range values {
+			if strings.EqualFold(f, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package repo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPageBounds(t *testing.T) {
+	ids := []string{"a", "b", "c", "d"}
+	tests := []struct {
+		page       Page
+		start, end int
+	}{
+		{Page{"", -1}, 0, 4},
+		{Page{"", 2}, 0, 2},
+		{Page{"b", -1}, 2, 4},
+		{Page{"b", 1}, 2, 3},
+		{Page{"b", 10}, 2, 4},
+		{Page{"d", 2}, 4, 4},
+		{Page{"x", 2}, 4, 4},
+		{Page{"", 0}, 0, 0},
+	}
+	for _, test := range tests {
+		start, end := test.page.Bounds(ids)
+		if start != test.start || end != test.end {
+			t.Errorf("%+v: expected [%d:%d], got [%d:%d]", test.page, test.start, test.end, start, end)
+		}
+	}
+}
+
+func TestTimeRangeContains(t *testing.T) {
+	day := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+	r := TimeRange{From: day, To: day.Add(24 * time.Hour)}
+	if !r.Contains(day) || !r.Contains(day.Add(24*time.Hour)) || !r.Contains(day.Add(time.Hour)) {
+		t.Error("Expected the range to include its bounds")
+	}
+	if r.Contains(day.Add(-time.Second)) || r.Contains(day.Add(25*time.Hour)) {
+		t.Error("Expected times outside the range to be excluded")
+	}
+	if r.Contains(time.Time{}) {
+		t.Error("Expected an unknown time to be excluded from a bounded range")
+	}
+	if !(TimeRange{}).Contains(time.Time{}) || !(TimeRange{From: day}).Contains(day.Add(1000*time.Hour)) {
+		t.Error("Expected open ranges to include every time after From")
+	}
+}
+
+func TestWalletTransactionQueryMatches(t *testing.T) {
+	now := time.Now()
+	q := WalletTransactionQuery{Statuses: []string{"PENDING", "confirmed"}, Date: TimeRange{From: now.Add(-time.Hour)}}
+	if !q.Matches("CONFIRMED", now) {
+		t.Error("Expected statuses to match ignoring case")
+	}
+	if q.Matches("DEAD", now) {
+		t.Error("Expected other statuses to be excluded")
+	}
+	if q.Matches("PENDING", now.Add(-2*time.Hour)) {
+		t.Error("Expected transactions before the range to be excluded")
+	}
+	if !(WalletTransactionQuery{}).Matches("DEAD", time.Time{}) {
+		t.Error("Expected an empty query to match everything")
+	}
+}